@@ -12,14 +12,56 @@ import (
 	"github.com/oapi-codegen/runtime"
 )
 
+const (
+	BearerAuthScopes = "BearerAuth.Scopes"
+)
+
 // Defines values for ErrorResponseErrorCode.
 const (
-	NOCANDIDATE ErrorResponseErrorCode = "NO_CANDIDATE"
-	NOTASSIGNED ErrorResponseErrorCode = "NOT_ASSIGNED"
-	NOTFOUND    ErrorResponseErrorCode = "NOT_FOUND"
-	PREXISTS    ErrorResponseErrorCode = "PR_EXISTS"
-	PRMERGED    ErrorResponseErrorCode = "PR_MERGED"
-	TEAMEXISTS  ErrorResponseErrorCode = "TEAM_EXISTS"
+	CONFLICT                 ErrorResponseErrorCode = "CONFLICT"
+	IDEMPOTENCYKEYINPROGRESS ErrorResponseErrorCode = "IDEMPOTENCY_KEY_IN_PROGRESS"
+	IDEMPOTENCYKEYREUSED     ErrorResponseErrorCode = "IDEMPOTENCY_KEY_REUSED"
+	NOCANDIDATE              ErrorResponseErrorCode = "NO_CANDIDATE"
+	NOTASSIGNED              ErrorResponseErrorCode = "NOT_ASSIGNED"
+	NOTFOUND                 ErrorResponseErrorCode = "NOT_FOUND"
+	PREXISTS                 ErrorResponseErrorCode = "PR_EXISTS"
+	PRMERGED                 ErrorResponseErrorCode = "PR_MERGED"
+	TEAMEXISTS               ErrorResponseErrorCode = "TEAM_EXISTS"
+	TEAMHIERARCHYCYCLE       ErrorResponseErrorCode = "TEAM_HIERARCHY_CYCLE"
+)
+
+// Defines values for ExcludedCandidateReason.
+const (
+	Author      ExcludedCandidateReason = "author"
+	Inactive    ExcludedCandidateReason = "inactive"
+	OutOfOffice ExcludedCandidateReason = "out_of_office"
+	OverQuota   ExcludedCandidateReason = "over_quota"
+)
+
+// Defines values for ExportPullRequestStatus.
+const (
+	ExportPullRequestStatusMERGED ExportPullRequestStatus = "MERGED"
+	ExportPullRequestStatusOPEN   ExportPullRequestStatus = "OPEN"
+)
+
+// Defines values for ExportRecordType.
+const (
+	ExportRecordTypePullRequest ExportRecordType = "pull_request"
+	ExportRecordTypeTeam        ExportRecordType = "team"
+	ExportRecordTypeUser        ExportRecordType = "user"
+)
+
+// Defines values for ImportReportMode.
+const (
+	ImportReportModeFail   ImportReportMode = "fail"
+	ImportReportModeSkip   ImportReportMode = "skip"
+	ImportReportModeUpsert ImportReportMode = "upsert"
+)
+
+// Defines values for MemberFairnessOutlier.
+const (
+	Overloaded  MemberFairnessOutlier = "overloaded"
+	Underloaded MemberFairnessOutlier = "underloaded"
 )
 
 // Defines values for PullRequestStatus.
@@ -34,6 +76,54 @@ const (
 	PullRequestShortStatusOPEN   PullRequestShortStatus = "OPEN"
 )
 
+// Defines values for ReviewerSelectionReason.
+const (
+	ReviewerSelectionReasonCodeOwner    ReviewerSelectionReason = "code_owner"
+	ReviewerSelectionReasonEscalation   ReviewerSelectionReason = "escalation"
+	ReviewerSelectionReasonFairShare    ReviewerSelectionReason = "fair_share"
+	ReviewerSelectionReasonLowWorkload  ReviewerSelectionReason = "low_workload"
+	ReviewerSelectionReasonReassignment ReviewerSelectionReason = "reassignment"
+	ReviewerSelectionReasonSkillMatch   ReviewerSelectionReason = "skill_match"
+	ReviewerSelectionReasonTeamMember   ReviewerSelectionReason = "team_member"
+)
+
+// Defines values for StatsFormat.
+const (
+	Csv    StatsFormat = "csv"
+	Json   StatsFormat = "json"
+	Ndjson StatsFormat = "ndjson"
+)
+
+// Defines values for StatsStatus.
+const (
+	StatsStatusMERGED StatsStatus = "MERGED"
+	StatsStatusOPEN   StatsStatus = "OPEN"
+)
+
+// Defines values for PostAdminImportParamsMode.
+const (
+	PostAdminImportParamsModeFail   PostAdminImportParamsMode = "fail"
+	PostAdminImportParamsModeSkip   PostAdminImportParamsMode = "skip"
+	PostAdminImportParamsModeUpsert PostAdminImportParamsMode = "upsert"
+)
+
+// Defines values for GetPullRequestListParamsStatus.
+const (
+	GetPullRequestListParamsStatusMERGED GetPullRequestListParamsStatus = "MERGED"
+	GetPullRequestListParamsStatusOPEN   GetPullRequestListParamsStatus = "OPEN"
+)
+
+// AssignmentExplanation defines model for AssignmentExplanation.
+type AssignmentExplanation struct {
+	// CandidatePoolSize Число допустимых кандидатов, из которых шел выбор
+	CandidatePoolSize int                 `json:"candidate_pool_size"`
+	Excluded          []ExcludedCandidate `json:"excluded"`
+	Reviewers         []ReviewerSelection `json:"reviewers"`
+
+	// Strategy Этапы подбора через '+': code_owners, skills, team_random, fair_share, escalation
+	Strategy string `json:"strategy"`
+}
+
 // ErrorResponse defines model for ErrorResponse.
 type ErrorResponse struct {
 	Error struct {
@@ -45,6 +135,156 @@ type ErrorResponse struct {
 // ErrorResponseErrorCode defines model for ErrorResponse.Error.Code.
 type ErrorResponseErrorCode string
 
+// ExcludedCandidate defines model for ExcludedCandidate.
+type ExcludedCandidate struct {
+	// Reason Причина исключения из подбора
+	Reason ExcludedCandidateReason `json:"reason"`
+	UserId string                  `json:"user_id"`
+}
+
+// ExcludedCandidateReason Причина исключения из подбора
+type ExcludedCandidateReason string
+
+// ExportPullRequest defines model for ExportPullRequest.
+type ExportPullRequest struct {
+	AssignedReviewers []string  `json:"assigned_reviewers"`
+	AuthorId          string    `json:"author_id"`
+	CreatedAt         time.Time `json:"created_at"`
+
+	// MergedAt Обязательно для MERGED, отсутствует у OPEN
+	MergedAt        *time.Time              `json:"merged_at"`
+	PullRequestId   string                  `json:"pull_request_id"`
+	PullRequestName string                  `json:"pull_request_name"`
+	Rationale       *AssignmentExplanation  `json:"rationale,omitempty"`
+	Status          ExportPullRequestStatus `json:"status"`
+}
+
+// ExportPullRequestStatus defines model for ExportPullRequest.Status.
+type ExportPullRequestStatus string
+
+// ExportRecord Строка NDJSON выгрузки и загрузки: поле с именем из type содержит команду, пользователя или PR.
+// Пользователь выгружается с навыками, отметкой отсутствия и историей активности, PR - с ревьюверами,
+// временем создания и мержа и объяснением выбора ревьюверов.
+type ExportRecord struct {
+	PullRequest *ExportPullRequest `json:"pull_request,omitempty"`
+	Team        *ExportTeam        `json:"team,omitempty"`
+	Type        ExportRecordType   `json:"type"`
+	User        *ExportUser        `json:"user,omitempty"`
+}
+
+// ExportRecordType defines model for ExportRecord.Type.
+type ExportRecordType string
+
+// ExportTeam defines model for ExportTeam.
+type ExportTeam struct {
+	// ParentTeamName Родительская команда; в выгрузке родитель всегда идет раньше потомков
+	ParentTeamName *string `json:"parent_team_name"`
+	TeamName       string  `json:"team_name"`
+}
+
+// ExportUser defines model for ExportUser.
+type ExportUser struct {
+	IsActive bool `json:"is_active"`
+
+	// OutOfOfficeUntil До какого момента пользователь отсутствует
+	OutOfOfficeUntil *time.Time `json:"out_of_office_until"`
+
+	// Skills Теги навыков
+	Skills *[]string `json:"skills,omitempty"`
+
+	// StatusHistory История активности по возрастанию времени, по ней считается отчет о справедливости.
+	// Последняя запись должна совпадать с is_active. Пользователь без истории загружается
+	// с одной записью на начало эпохи Unix (статус не менялся).
+	StatusHistory *[]UserStatusChange `json:"status_history,omitempty"`
+	TeamName      string              `json:"team_name"`
+	UserId        string              `json:"user_id"`
+	Username      string              `json:"username"`
+}
+
+// FairnessReport defines model for FairnessReport.
+type FairnessReport struct {
+	// CreatedFrom Начало периода (включительно)
+	CreatedFrom time.Time `json:"created_from"`
+
+	// CreatedTo Конец периода (не включительно)
+	CreatedTo time.Time      `json:"created_to"`
+	Teams     []TeamFairness `json:"teams"`
+}
+
+// ImportRecordError defines model for ImportRecordError.
+type ImportRecordError struct {
+	// Code Код ошибки API (TEAM_EXISTS, NOT_FOUND, ...) или INVALID_RECORD для записи не по формату
+	Code string `json:"code"`
+
+	// Id Команда, пользователь или PR записи
+	Id *string `json:"id,omitempty"`
+
+	// Line Номер строки NDJSON, начиная с 1
+	Line    int    `json:"line"`
+	Message string `json:"message"`
+
+	// Type Тип записи, если строку удалось разобрать
+	Type *string `json:"type,omitempty"`
+}
+
+// ImportReport defines model for ImportReport.
+type ImportReport struct {
+	// Committed Изменения сохранены (нет ошибок и не dry_run)
+	Committed bool                `json:"committed"`
+	Created   int                 `json:"created"`
+	DryRun    bool                `json:"dry_run"`
+	Errors    []ImportRecordError `json:"errors"`
+	Mode      ImportReportMode    `json:"mode"`
+	Skipped   int                 `json:"skipped"`
+	Updated   int                 `json:"updated"`
+}
+
+// ImportReportMode defines model for ImportReport.Mode.
+type ImportReportMode string
+
+// MemberFairness defines model for MemberFairness.
+type MemberFairness struct {
+	// ActiveDays Дни в периоде, когда участник был активен
+	ActiveDays float64 `json:"active_days"`
+
+	// Assignments Назначения ревьювером на PR, созданные в периоде
+	Assignments int `json:"assignments"`
+
+	// Deviation Разница между фактическим и ожидаемым числом назначений
+	Deviation float64 `json:"deviation"`
+
+	// ExpectedAssignments Справедливая доля назначений команды, пропорциональная дням активности
+	ExpectedAssignments float64 `json:"expected_assignments"`
+
+	// Outlier Отклонение больше двух стандартных (sqrt от ожидаемого); нет поля - нагрузка в пределах нормы
+	Outlier  *MemberFairnessOutlier `json:"outlier,omitempty"`
+	UserId   string                 `json:"user_id"`
+	Username string                 `json:"username"`
+}
+
+// MemberFairnessOutlier Отклонение больше двух стандартных (sqrt от ожидаемого); нет поля - нагрузка в пределах нормы
+type MemberFairnessOutlier string
+
+// OwnershipRule defines model for OwnershipRule.
+type OwnershipRule struct {
+	// Pattern Glob-шаблон пути в стиле CODEOWNERS ("/internal/**", "*.sql", "docs/")
+	Pattern   string   `json:"pattern"`
+	TeamNames []string `json:"team_names"`
+	UserIds   []string `json:"user_ids"`
+}
+
+// PRAssignmentStat defines model for PRAssignmentStat.
+type PRAssignmentStat struct {
+	PullRequestId   string `json:"pull_request_id"`
+	PullRequestName string `json:"pull_request_name"`
+	ReviewersCount  int    `json:"reviewers_count"`
+}
+
+// PRAssignmentStats defines model for PRAssignmentStats.
+type PRAssignmentStats struct {
+	Stats []PRAssignmentStat `json:"stats"`
+}
+
 // PullRequest defines model for PullRequest.
 type PullRequest struct {
 	// AssignedReviewers user_id назначенных ревьюверов (0..2)
@@ -71,10 +311,65 @@ type PullRequestShort struct {
 // PullRequestShortStatus defines model for PullRequestShort.Status.
 type PullRequestShortStatus string
 
+// ReviewStat defines model for ReviewStat.
+type ReviewStat struct {
+	// ReviewCount Число назначений пользователя ревьювером на PR, подходящие под фильтр
+	ReviewCount int    `json:"review_count"`
+	UserId      string `json:"user_id"`
+	Username    string `json:"username"`
+}
+
+// ReviewStats defines model for ReviewStats.
+type ReviewStats struct {
+	Stats []ReviewStat `json:"stats"`
+}
+
+// ReviewerSelection defines model for ReviewerSelection.
+type ReviewerSelection struct {
+	// MatchedTags Требуемые навыки, которые есть у ревьювера
+	MatchedTags *[]string `json:"matched_tags,omitempty"`
+
+	// OpenReviews Открытые PR на ревью в момент выбора
+	OpenReviews *int `json:"open_reviews,omitempty"`
+
+	// Reason Причина выбора ревьювера
+	Reason ReviewerSelectionReason `json:"reason"`
+
+	// Score Итоговая оценка кандидата (skill_score + workload_score)
+	Score *float64 `json:"score,omitempty"`
+
+	// SkillScore Вклад навыков в оценку: 0.7 × доля покрытых тегов
+	SkillScore *float64 `json:"skill_score,omitempty"`
+	UserId     string   `json:"user_id"`
+
+	// WorkloadScore Вклад нагрузки в оценку: 0.3 / (1 + open_reviews)
+	WorkloadScore *float64 `json:"workload_score,omitempty"`
+}
+
+// ReviewerSelectionReason Причина выбора ревьювера
+type ReviewerSelectionReason string
+
 // Team defines model for Team.
 type Team struct {
-	Members  []TeamMember `json:"members"`
-	TeamName string       `json:"team_name"`
+	Members []TeamMember `json:"members"`
+
+	// ParentTeamName Родительская команда (отдел), null для корневой команды
+	ParentTeamName *string `json:"parent_team_name"`
+	TeamName       string  `json:"team_name"`
+}
+
+// TeamFairness defines model for TeamFairness.
+type TeamFairness struct {
+	// Assignments Назначения участников команды на PR, созданные в периоде
+	Assignments int `json:"assignments"`
+
+	// Gini Коэффициент Джини по назначениям на день активности (0 - поровну, ближе к 1 - неравномерно)
+	Gini float64 `json:"gini"`
+
+	// MaxMinSpread Разница между наибольшим и наименьшим отклонением участников от ожидаемого
+	MaxMinSpread float64          `json:"max_min_spread"`
+	Members      []MemberFairness `json:"members"`
+	TeamName     string           `json:"team_name"`
 }
 
 // TeamMember defines model for TeamMember.
@@ -84,38 +379,293 @@ type TeamMember struct {
 	Username string `json:"username"`
 }
 
+// TeamReviewStat defines model for TeamReviewStat.
+type TeamReviewStat struct {
+	// AvgReviewersPerPr Среднее число ревьюверов на PR команды (0, если PR нет)
+	AvgReviewersPerPr float64 `json:"avg_reviewers_per_pr"`
+
+	// CrossTeamReviewsShare Доля полученных ревью, назначенных участникам других команд (0..1)
+	CrossTeamReviewsShare float64 `json:"cross_team_reviews_share"`
+
+	// OpenPullRequests Открытые PR авторов из команды
+	OpenPullRequests int `json:"open_pull_requests"`
+
+	// PullRequests PR авторов из команды
+	PullRequests int `json:"pull_requests"`
+
+	// ReviewsGiven Назначения участников команды ревьюверами на любые PR
+	ReviewsGiven int `json:"reviews_given"`
+
+	// ReviewsReceived Назначения ревьюверов на PR команды
+	ReviewsReceived int    `json:"reviews_received"`
+	TeamName        string `json:"team_name"`
+}
+
+// TeamReviewStats defines model for TeamReviewStats.
+type TeamReviewStats struct {
+	Stats []TeamReviewStat `json:"stats"`
+}
+
+// TeamStats defines model for TeamStats.
+type TeamStats struct {
+	ActiveMembersCount int `json:"active_members_count"`
+	MembersCount       int `json:"members_count"`
+
+	// OpenPrsCount Открытые PR, автор которых состоит в команде
+	OpenPrsCount int `json:"open_prs_count"`
+
+	// OpenReviewsCount Назначения участников команды на открытые PR
+	OpenReviewsCount int `json:"open_reviews_count"`
+}
+
+// TeamTreeNode defines model for TeamTreeNode.
+type TeamTreeNode struct {
+	Children       []TeamTreeNode `json:"children"`
+	ParentTeamName *string        `json:"parent_team_name"`
+	Stats          TeamStats      `json:"stats"`
+	SubtreeStats   TeamStats      `json:"subtree_stats"`
+	TeamName       string         `json:"team_name"`
+}
+
 // User defines model for User.
 type User struct {
-	IsActive bool   `json:"is_active"`
-	TeamName string `json:"team_name"`
-	UserId   string `json:"user_id"`
-	Username string `json:"username"`
+	IsActive bool `json:"is_active"`
+
+	// OutOfOfficeUntil До какого момента пользователь отсутствует (возвращается в ответе /users/setOutOfOffice)
+	OutOfOfficeUntil *time.Time `json:"out_of_office_until,omitempty"`
+
+	// Skills Теги навыков (возвращаются в ответе /users/setSkills)
+	Skills   *[]string `json:"skills,omitempty"`
+	TeamName string    `json:"team_name"`
+	UserId   string    `json:"user_id"`
+	Username string    `json:"username"`
+}
+
+// UserStatusChange defines model for UserStatusChange.
+type UserStatusChange struct {
+	// ChangedAt С этого момента статус равен is_active
+	ChangedAt time.Time `json:"changed_at"`
+	IsActive  bool      `json:"is_active"`
 }
 
+// IdempotencyKey defines model for IdempotencyKey.
+type IdempotencyKey = string
+
+// IfMatch defines model for IfMatch.
+type IfMatch = string
+
+// StatsCreatedFrom defines model for StatsCreatedFrom.
+type StatsCreatedFrom = time.Time
+
+// StatsCreatedTo defines model for StatsCreatedTo.
+type StatsCreatedTo = time.Time
+
+// StatsFormat defines model for StatsFormat.
+type StatsFormat string
+
+// StatsStatus defines model for StatsStatus.
+type StatsStatus string
+
+// StatsTeamName defines model for StatsTeamName.
+type StatsTeamName = string
+
 // TeamNameQuery defines model for TeamNameQuery.
 type TeamNameQuery = string
 
 // UserIdQuery defines model for UserIdQuery.
 type UserIdQuery = string
 
+// PostAdminImportParams defines parameters for PostAdminImport.
+type PostAdminImportParams struct {
+	// Mode Если сущность уже существует: upsert - перезаписать, skip - пропустить, fail - ошибка записи
+	Mode *PostAdminImportParamsMode `form:"mode,omitempty" json:"mode,omitempty"`
+
+	// DryRun Только проверить записи, ничего не сохраняя
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+}
+
+// PostAdminImportParamsMode defines parameters for PostAdminImport.
+type PostAdminImportParamsMode string
+
+// GetCodeOwnersGetParams defines parameters for GetCodeOwnersGet.
+type GetCodeOwnersGetParams struct {
+	Repository string `form:"repository" json:"repository"`
+}
+
+// PostCodeOwnersSetJSONBody defines parameters for PostCodeOwnersSet.
+type PostCodeOwnersSetJSONBody struct {
+	Repository string          `json:"repository"`
+	Rules      []OwnershipRule `json:"rules"`
+}
+
 // PostPullRequestCreateJSONBody defines parameters for PostPullRequestCreate.
 type PostPullRequestCreateJSONBody struct {
-	AuthorId        string `json:"author_id"`
-	PullRequestId   string `json:"pull_request_id"`
-	PullRequestName string `json:"pull_request_name"`
+	AuthorId string `json:"author_id"`
+
+	// ChangedFiles Пути изменённых файлов относительно корня репозитория
+	ChangedFiles    *[]string `json:"changed_files,omitempty"`
+	PullRequestId   string    `json:"pull_request_id"`
+	PullRequestName string    `json:"pull_request_name"`
+	Repository      *string   `json:"repository,omitempty"`
+
+	// RequiredTags Навыки, которые желательно покрыть ревьюверами из команды автора
+	RequiredTags *[]string `json:"required_tags,omitempty"`
 }
 
+// PostPullRequestCreateParams defines parameters for PostPullRequestCreate.
+type PostPullRequestCreateParams struct {
+	// IdempotencyKey Ключ идемпотентности (1-255 печатных ASCII-символов, например UUID). Ответ на первый запрос
+	// с ключом хранится `IDEMPOTENCY_TTL` (по умолчанию 24 часа); повтор с тем же телом и If-Match получает
+	// сохраненный ответ (вместе с ETag) с заголовком `Idempotent-Replayed: true` и не выполняется заново.
+	// Ответы 5xx не сохраняются.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// GetPullRequestGetParams defines parameters for GetPullRequestGet.
+type GetPullRequestGetParams struct {
+	PullRequestId string `form:"pull_request_id" json:"pull_request_id"`
+
+	// Explain Вернуть объяснение выбора ревьюверов
+	Explain *bool `form:"explain,omitempty" json:"explain,omitempty"`
+}
+
+// GetPullRequestListParams defines parameters for GetPullRequestList.
+type GetPullRequestListParams struct {
+	Status   *GetPullRequestListParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+	AuthorId *string                         `form:"author_id,omitempty" json:"author_id,omitempty"`
+
+	// ReviewerId PR, где пользователь назначен ревьювером
+	ReviewerId *string `form:"reviewer_id,omitempty" json:"reviewer_id,omitempty"`
+
+	// TeamName Команда автора PR
+	TeamName *string `form:"team_name,omitempty" json:"team_name,omitempty"`
+
+	// CreatedFrom PR, созданные не раньше (включительно)
+	CreatedFrom *time.Time `form:"created_from,omitempty" json:"created_from,omitempty"`
+
+	// CreatedTo PR, созданные раньше (не включительно)
+	CreatedTo *time.Time `form:"created_to,omitempty" json:"created_to,omitempty"`
+
+	// Limit Размер страницы (1..100, по умолчанию 50)
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor next_cursor предыдущей страницы
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// GetPullRequestListParamsStatus defines parameters for GetPullRequestList.
+type GetPullRequestListParamsStatus string
+
 // PostPullRequestMergeJSONBody defines parameters for PostPullRequestMerge.
 type PostPullRequestMergeJSONBody struct {
 	PullRequestId string `json:"pull_request_id"`
 }
 
+// PostPullRequestMergeParams defines parameters for PostPullRequestMerge.
+type PostPullRequestMergeParams struct {
+	// IfMatch ETag PR из предыдущего ответа (например `"3"`) или `*`. Изменение выполняется, только если
+	// версия PR не изменилась, иначе 409 CONFLICT. Без заголовка конкурирующие изменения одного PR
+	// все равно не применяются дважды: проигравший запрос получает 409 CONFLICT.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
 // PostPullRequestReassignJSONBody defines parameters for PostPullRequestReassign.
 type PostPullRequestReassignJSONBody struct {
 	OldUserId     string `json:"old_user_id"`
 	PullRequestId string `json:"pull_request_id"`
 }
 
+// PostPullRequestReassignParams defines parameters for PostPullRequestReassign.
+type PostPullRequestReassignParams struct {
+	// IdempotencyKey Ключ идемпотентности (1-255 печатных ASCII-символов, например UUID). Ответ на первый запрос
+	// с ключом хранится `IDEMPOTENCY_TTL` (по умолчанию 24 часа); повтор с тем же телом и If-Match получает
+	// сохраненный ответ (вместе с ETag) с заголовком `Idempotent-Replayed: true` и не выполняется заново.
+	// Ответы 5xx не сохраняются.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+
+	// IfMatch ETag PR из предыдущего ответа (например `"3"`) или `*`. Изменение выполняется, только если
+	// версия PR не изменилась, иначе 409 CONFLICT. Без заголовка конкурирующие изменения одного PR
+	// все равно не применяются дважды: проигравший запрос получает 409 CONFLICT.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// GetStatsFairnessParams defines parameters for GetStatsFairness.
+type GetStatsFairnessParams struct {
+	// CreatedFrom Учитывать PR, созданные не раньше (включительно)
+	CreatedFrom *StatsCreatedFrom `form:"created_from,omitempty" json:"created_from,omitempty"`
+
+	// CreatedTo Учитывать PR, созданные раньше (не включительно)
+	CreatedTo *StatsCreatedTo `form:"created_to,omitempty" json:"created_to,omitempty"`
+
+	// TeamName Команда пользователя для /stats/reviews, команда автора PR для /stats/pr-assignments, единственная выводимая команда для /stats/teams и /stats/fairness
+	TeamName *StatsTeamName `form:"team_name,omitempty" json:"team_name,omitempty"`
+
+	// Format Формат ответа. Важнее заголовка `Accept`; без параметра формат выбирается по `Accept`
+	// (`text/csv`, `application/x-ndjson`, иначе JSON). CSV начинается со строки заголовков
+	// с именами полей JSON, NDJSON - по одной записи статистики на строку.
+	Format *StatsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetStatsPrAssignmentsParams defines parameters for GetStatsPrAssignments.
+type GetStatsPrAssignmentsParams struct {
+	// CreatedFrom Учитывать PR, созданные не раньше (включительно)
+	CreatedFrom *StatsCreatedFrom `form:"created_from,omitempty" json:"created_from,omitempty"`
+
+	// CreatedTo Учитывать PR, созданные раньше (не включительно)
+	CreatedTo *StatsCreatedTo `form:"created_to,omitempty" json:"created_to,omitempty"`
+
+	// TeamName Команда пользователя для /stats/reviews, команда автора PR для /stats/pr-assignments, единственная выводимая команда для /stats/teams и /stats/fairness
+	TeamName *StatsTeamName `form:"team_name,omitempty" json:"team_name,omitempty"`
+
+	// Status Учитывать только PR в этом статусе
+	Status *StatsStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Format Формат ответа. Важнее заголовка `Accept`; без параметра формат выбирается по `Accept`
+	// (`text/csv`, `application/x-ndjson`, иначе JSON). CSV начинается со строки заголовков
+	// с именами полей JSON, NDJSON - по одной записи статистики на строку.
+	Format *StatsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetStatsReviewsParams defines parameters for GetStatsReviews.
+type GetStatsReviewsParams struct {
+	// CreatedFrom Учитывать PR, созданные не раньше (включительно)
+	CreatedFrom *StatsCreatedFrom `form:"created_from,omitempty" json:"created_from,omitempty"`
+
+	// CreatedTo Учитывать PR, созданные раньше (не включительно)
+	CreatedTo *StatsCreatedTo `form:"created_to,omitempty" json:"created_to,omitempty"`
+
+	// TeamName Команда пользователя для /stats/reviews, команда автора PR для /stats/pr-assignments, единственная выводимая команда для /stats/teams и /stats/fairness
+	TeamName *StatsTeamName `form:"team_name,omitempty" json:"team_name,omitempty"`
+
+	// Status Учитывать только PR в этом статусе
+	Status *StatsStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Format Формат ответа. Важнее заголовка `Accept`; без параметра формат выбирается по `Accept`
+	// (`text/csv`, `application/x-ndjson`, иначе JSON). CSV начинается со строки заголовков
+	// с именами полей JSON, NDJSON - по одной записи статистики на строку.
+	Format *StatsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetStatsTeamsParams defines parameters for GetStatsTeams.
+type GetStatsTeamsParams struct {
+	// CreatedFrom Учитывать PR, созданные не раньше (включительно)
+	CreatedFrom *StatsCreatedFrom `form:"created_from,omitempty" json:"created_from,omitempty"`
+
+	// CreatedTo Учитывать PR, созданные раньше (не включительно)
+	CreatedTo *StatsCreatedTo `form:"created_to,omitempty" json:"created_to,omitempty"`
+
+	// TeamName Команда пользователя для /stats/reviews, команда автора PR для /stats/pr-assignments, единственная выводимая команда для /stats/teams и /stats/fairness
+	TeamName *StatsTeamName `form:"team_name,omitempty" json:"team_name,omitempty"`
+
+	// Status Учитывать только PR в этом статусе
+	Status *StatsStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Format Формат ответа. Важнее заголовка `Accept`; без параметра формат выбирается по `Accept`
+	// (`text/csv`, `application/x-ndjson`, иначе JSON). CSV начинается со строки заголовков
+	// с именами полей JSON, NDJSON - по одной записи статистики на строку.
+	Format *StatsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
 // PostTeamDeactivateJSONBody defines parameters for PostTeamDeactivate.
 type PostTeamDeactivateJSONBody struct {
 	TeamName string `json:"team_name"`
@@ -127,10 +677,37 @@ type GetTeamGetParams struct {
 	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
 }
 
+// PostTeamSetParentJSONBody defines parameters for PostTeamSetParent.
+type PostTeamSetParentJSONBody struct {
+	ParentTeamName *string `json:"parent_team_name"`
+	TeamName       string  `json:"team_name"`
+}
+
+// GetTeamTreeParams defines parameters for GetTeamTree.
+type GetTeamTreeParams struct {
+	// TeamName Корень поддерева (по умолчанию - все корневые команды)
+	TeamName *string `form:"team_name,omitempty" json:"team_name,omitempty"`
+}
+
 // GetUsersGetReviewParams defines parameters for GetUsersGetReview.
 type GetUsersGetReviewParams struct {
 	// UserId Идентификатор пользователя
 	UserId UserIdQuery `form:"user_id" json:"user_id"`
+
+	// Limit Размер страницы (1..100, по умолчанию 50)
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor next_cursor предыдущей страницы
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// GetUsersReviewStreamParams defines parameters for GetUsersReviewStream.
+type GetUsersReviewStreamParams struct {
+	// UserId Идентификатор пользователя
+	UserId UserIdQuery `form:"user_id" json:"user_id"`
+
+	// LastEventID id последнего полученного события
+	LastEventID *string `json:"Last-Event-ID,omitempty"`
 }
 
 // PostUsersSetIsActiveJSONBody defines parameters for PostUsersSetIsActive.
@@ -139,6 +716,22 @@ type PostUsersSetIsActiveJSONBody struct {
 	UserId   string `json:"user_id"`
 }
 
+// PostUsersSetOutOfOfficeJSONBody defines parameters for PostUsersSetOutOfOffice.
+type PostUsersSetOutOfOfficeJSONBody struct {
+	// Until До какого момента пользователь отсутствует; null или отсутствие поля снимает отметку
+	Until  *time.Time `json:"until,omitempty"`
+	UserId string     `json:"user_id"`
+}
+
+// PostUsersSetSkillsJSONBody defines parameters for PostUsersSetSkills.
+type PostUsersSetSkillsJSONBody struct {
+	Skills []string `json:"skills"`
+	UserId string   `json:"user_id"`
+}
+
+// PostCodeOwnersSetJSONRequestBody defines body for PostCodeOwnersSet for application/json ContentType.
+type PostCodeOwnersSetJSONRequestBody PostCodeOwnersSetJSONBody
+
 // PostPullRequestCreateJSONRequestBody defines body for PostPullRequestCreate for application/json ContentType.
 type PostPullRequestCreateJSONRequestBody PostPullRequestCreateJSONBody
 
@@ -154,26 +747,59 @@ type PostTeamAddJSONRequestBody = Team
 // PostTeamDeactivateJSONRequestBody defines body for PostTeamDeactivate for application/json ContentType.
 type PostTeamDeactivateJSONRequestBody PostTeamDeactivateJSONBody
 
+// PostTeamSetParentJSONRequestBody defines body for PostTeamSetParent for application/json ContentType.
+type PostTeamSetParentJSONRequestBody PostTeamSetParentJSONBody
+
 // PostUsersSetIsActiveJSONRequestBody defines body for PostUsersSetIsActive for application/json ContentType.
 type PostUsersSetIsActiveJSONRequestBody PostUsersSetIsActiveJSONBody
 
+// PostUsersSetOutOfOfficeJSONRequestBody defines body for PostUsersSetOutOfOffice for application/json ContentType.
+type PostUsersSetOutOfOfficeJSONRequestBody PostUsersSetOutOfOfficeJSONBody
+
+// PostUsersSetSkillsJSONRequestBody defines body for PostUsersSetSkills for application/json ContentType.
+type PostUsersSetSkillsJSONRequestBody PostUsersSetSkillsJSONBody
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Выгрузить команды, пользователей и PR в NDJSON
+	// (GET /admin/export)
+	GetAdminExport(ctx echo.Context) error
+	// Загрузить команды, пользователей и PR из NDJSON
+	// (POST /admin/import)
+	PostAdminImport(ctx echo.Context, params PostAdminImportParams) error
+	// Получить правила владения кодом репозитория
+	// (GET /codeOwners/get)
+	GetCodeOwnersGet(ctx echo.Context, params GetCodeOwnersGetParams) error
+	// Заменить правила владения кодом репозитория
+	// (POST /codeOwners/set)
+	PostCodeOwnersSet(ctx echo.Context) error
 	// Создать PR и автоматически назначить до 2 ревьюверов из команды автора
 	// (POST /pullRequest/create)
-	PostPullRequestCreate(ctx echo.Context) error
+	PostPullRequestCreate(ctx echo.Context, params PostPullRequestCreateParams) error
+	// Получить PR (с explain=true - с объяснением выбора ревьюверов)
+	// (GET /pullRequest/get)
+	GetPullRequestGet(ctx echo.Context, params GetPullRequestGetParams) error
+	// Список PR с фильтрами и keyset-пагинацией (от новых к старым)
+	// (GET /pullRequest/list)
+	GetPullRequestList(ctx echo.Context, params GetPullRequestListParams) error
 	// Пометить PR как MERGED (идемпотентная операция)
 	// (POST /pullRequest/merge)
-	PostPullRequestMerge(ctx echo.Context) error
+	PostPullRequestMerge(ctx echo.Context, params PostPullRequestMergeParams) error
 	// Переназначить конкретного ревьювера на другого из его команды
 	// (POST /pullRequest/reassign)
-	PostPullRequestReassign(ctx echo.Context) error
+	PostPullRequestReassign(ctx echo.Context, params PostPullRequestReassignParams) error
+	// Отчет о справедливости распределения ревью
+	// (GET /stats/fairness)
+	GetStatsFairness(ctx echo.Context, params GetStatsFairnessParams) error
 	// Получить статистику по количеству ревьюверов на PR
 	// (GET /stats/pr-assignments)
-	GetStatsPrAssignments(ctx echo.Context) error
+	GetStatsPrAssignments(ctx echo.Context, params GetStatsPrAssignmentsParams) error
 	// Получить статистику по количеству назначений на пользователей
 	// (GET /stats/reviews)
-	GetStatsReviews(ctx echo.Context) error
+	GetStatsReviews(ctx echo.Context, params GetStatsReviewsParams) error
+	// Получить показатели ревью по командам
+	// (GET /stats/teams)
+	GetStatsTeams(ctx echo.Context, params GetStatsTeamsParams) error
 	// Создать команду с участниками (создаёт/обновляет пользователей)
 	// (POST /team/add)
 	PostTeamAdd(ctx echo.Context) error
@@ -183,12 +809,27 @@ type ServerInterface interface {
 	// Получить команду с участниками
 	// (GET /team/get)
 	GetTeamGet(ctx echo.Context, params GetTeamGetParams) error
+	// Переместить команду под родительскую (null - сделать корневой)
+	// (POST /team/setParent)
+	PostTeamSetParent(ctx echo.Context) error
+	// Получить иерархию команд со статистикой по поддеревьям
+	// (GET /team/tree)
+	GetTeamTree(ctx echo.Context, params GetTeamTreeParams) error
 	// Получить PR'ы, где пользователь назначен ревьювером
 	// (GET /users/getReview)
 	GetUsersGetReview(ctx echo.Context, params GetUsersGetReviewParams) error
+	// Поток изменений назначений пользователя (Server-Sent Events)
+	// (GET /users/reviewStream)
+	GetUsersReviewStream(ctx echo.Context, params GetUsersReviewStreamParams) error
 	// Установить флаг активности пользователя
 	// (POST /users/setIsActive)
 	PostUsersSetIsActive(ctx echo.Context) error
+	// Отметить отсутствие пользователя
+	// (POST /users/setOutOfOffice)
+	PostUsersSetOutOfOffice(ctx echo.Context) error
+	// Заменить теги навыков пользователя
+	// (POST /users/setSkills)
+	PostUsersSetSkills(ctx echo.Context) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -196,12 +837,199 @@ type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
+// GetAdminExport converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAdminExport(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAdminExport(ctx)
+	return err
+}
+
+// PostAdminImport converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAdminImport(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostAdminImportParams
+	// ------------- Optional query parameter "mode" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "mode", ctx.QueryParams(), &params.Mode)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter mode: %s", err))
+	}
+
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", ctx.QueryParams(), &params.DryRun)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter dry_run: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostAdminImport(ctx, params)
+	return err
+}
+
+// GetCodeOwnersGet converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCodeOwnersGet(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCodeOwnersGetParams
+	// ------------- Required query parameter "repository" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "repository", ctx.QueryParams(), &params.Repository)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter repository: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetCodeOwnersGet(ctx, params)
+	return err
+}
+
+// PostCodeOwnersSet converts echo context to params.
+func (w *ServerInterfaceWrapper) PostCodeOwnersSet(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostCodeOwnersSet(ctx)
+	return err
+}
+
 // PostPullRequestCreate converts echo context to params.
 func (w *ServerInterfaceWrapper) PostPullRequestCreate(ctx echo.Context) error {
 	var err error
 
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostPullRequestCreateParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Idempotency-Key, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, valueList[0], &IdempotencyKey)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Idempotency-Key: %s", err))
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostPullRequestCreate(ctx, params)
+	return err
+}
+
+// GetPullRequestGet converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPullRequestGet(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPullRequestGetParams
+	// ------------- Required query parameter "pull_request_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "pull_request_id", ctx.QueryParams(), &params.PullRequestId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pull_request_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "explain" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "explain", ctx.QueryParams(), &params.Explain)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter explain: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetPullRequestGet(ctx, params)
+	return err
+}
+
+// GetPullRequestList converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPullRequestList(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPullRequestListParams
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", ctx.QueryParams(), &params.Status)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
+	}
+
+	// ------------- Optional query parameter "author_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "author_id", ctx.QueryParams(), &params.AuthorId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter author_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "reviewer_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "reviewer_id", ctx.QueryParams(), &params.ReviewerId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter reviewer_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_name", ctx.QueryParams(), &params.TeamName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter team_name: %s", err))
+	}
+
+	// ------------- Optional query parameter "created_from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_from", ctx.QueryParams(), &params.CreatedFrom)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter created_from: %s", err))
+	}
+
+	// ------------- Optional query parameter "created_to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_to", ctx.QueryParams(), &params.CreatedTo)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter created_to: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostPullRequestCreate(ctx)
+	err = w.Handler.GetPullRequestList(ctx, params)
 	return err
 }
 
@@ -209,8 +1037,30 @@ func (w *ServerInterfaceWrapper) PostPullRequestCreate(ctx echo.Context) error {
 func (w *ServerInterfaceWrapper) PostPullRequestMerge(ctx echo.Context) error {
 	var err error
 
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostPullRequestMergeParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, valueList[0], &IfMatch)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostPullRequestMerge(ctx)
+	err = w.Handler.PostPullRequestMerge(ctx, params)
 	return err
 }
 
@@ -218,8 +1068,86 @@ func (w *ServerInterfaceWrapper) PostPullRequestMerge(ctx echo.Context) error {
 func (w *ServerInterfaceWrapper) PostPullRequestReassign(ctx echo.Context) error {
 	var err error
 
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostPullRequestReassignParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Idempotency-Key, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, valueList[0], &IdempotencyKey)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Idempotency-Key: %s", err))
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+	}
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, valueList[0], &IfMatch)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostPullRequestReassign(ctx)
+	err = w.Handler.PostPullRequestReassign(ctx, params)
+	return err
+}
+
+// GetStatsFairness converts echo context to params.
+func (w *ServerInterfaceWrapper) GetStatsFairness(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStatsFairnessParams
+	// ------------- Optional query parameter "created_from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_from", ctx.QueryParams(), &params.CreatedFrom)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter created_from: %s", err))
+	}
+
+	// ------------- Optional query parameter "created_to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_to", ctx.QueryParams(), &params.CreatedTo)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter created_to: %s", err))
+	}
+
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_name", ctx.QueryParams(), &params.TeamName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter team_name: %s", err))
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetStatsFairness(ctx, params)
 	return err
 }
 
@@ -227,8 +1155,47 @@ func (w *ServerInterfaceWrapper) PostPullRequestReassign(ctx echo.Context) error
 func (w *ServerInterfaceWrapper) GetStatsPrAssignments(ctx echo.Context) error {
 	var err error
 
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStatsPrAssignmentsParams
+	// ------------- Optional query parameter "created_from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_from", ctx.QueryParams(), &params.CreatedFrom)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter created_from: %s", err))
+	}
+
+	// ------------- Optional query parameter "created_to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_to", ctx.QueryParams(), &params.CreatedTo)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter created_to: %s", err))
+	}
+
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_name", ctx.QueryParams(), &params.TeamName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter team_name: %s", err))
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", ctx.QueryParams(), &params.Status)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetStatsPrAssignments(ctx)
+	err = w.Handler.GetStatsPrAssignments(ctx, params)
 	return err
 }
 
@@ -236,8 +1203,95 @@ func (w *ServerInterfaceWrapper) GetStatsPrAssignments(ctx echo.Context) error {
 func (w *ServerInterfaceWrapper) GetStatsReviews(ctx echo.Context) error {
 	var err error
 
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStatsReviewsParams
+	// ------------- Optional query parameter "created_from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_from", ctx.QueryParams(), &params.CreatedFrom)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter created_from: %s", err))
+	}
+
+	// ------------- Optional query parameter "created_to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_to", ctx.QueryParams(), &params.CreatedTo)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter created_to: %s", err))
+	}
+
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_name", ctx.QueryParams(), &params.TeamName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter team_name: %s", err))
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", ctx.QueryParams(), &params.Status)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetStatsReviews(ctx)
+	err = w.Handler.GetStatsReviews(ctx, params)
+	return err
+}
+
+// GetStatsTeams converts echo context to params.
+func (w *ServerInterfaceWrapper) GetStatsTeams(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStatsTeamsParams
+	// ------------- Optional query parameter "created_from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_from", ctx.QueryParams(), &params.CreatedFrom)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter created_from: %s", err))
+	}
+
+	// ------------- Optional query parameter "created_to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_to", ctx.QueryParams(), &params.CreatedTo)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter created_to: %s", err))
+	}
+
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_name", ctx.QueryParams(), &params.TeamName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter team_name: %s", err))
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", ctx.QueryParams(), &params.Status)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetStatsTeams(ctx, params)
 	return err
 }
 
@@ -245,6 +1299,8 @@ func (w *ServerInterfaceWrapper) GetStatsReviews(ctx echo.Context) error {
 func (w *ServerInterfaceWrapper) PostTeamAdd(ctx echo.Context) error {
 	var err error
 
+	ctx.Set(BearerAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.PostTeamAdd(ctx)
 	return err
@@ -254,6 +1310,8 @@ func (w *ServerInterfaceWrapper) PostTeamAdd(ctx echo.Context) error {
 func (w *ServerInterfaceWrapper) PostTeamDeactivate(ctx echo.Context) error {
 	var err error
 
+	ctx.Set(BearerAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.PostTeamDeactivate(ctx)
 	return err
@@ -263,6 +1321,8 @@ func (w *ServerInterfaceWrapper) PostTeamDeactivate(ctx echo.Context) error {
 func (w *ServerInterfaceWrapper) GetTeamGet(ctx echo.Context) error {
 	var err error
 
+	ctx.Set(BearerAuthScopes, []string{})
+
 	// Parameter object where we will unmarshal all parameters from the context
 	var params GetTeamGetParams
 	// ------------- Required query parameter "team_name" -------------
@@ -277,10 +1337,43 @@ func (w *ServerInterfaceWrapper) GetTeamGet(ctx echo.Context) error {
 	return err
 }
 
+// PostTeamSetParent converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTeamSetParent(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTeamSetParent(ctx)
+	return err
+}
+
+// GetTeamTree converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTeamTree(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamTreeParams
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_name", ctx.QueryParams(), &params.TeamName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter team_name: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTeamTree(ctx, params)
+	return err
+}
+
 // GetUsersGetReview converts echo context to params.
 func (w *ServerInterfaceWrapper) GetUsersGetReview(ctx echo.Context) error {
 	var err error
 
+	ctx.Set(BearerAuthScopes, []string{})
+
 	// Parameter object where we will unmarshal all parameters from the context
 	var params GetUsersGetReviewParams
 	// ------------- Required query parameter "user_id" -------------
@@ -290,20 +1383,95 @@ func (w *ServerInterfaceWrapper) GetUsersGetReview(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
 	}
 
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.GetUsersGetReview(ctx, params)
 	return err
 }
 
+// GetUsersReviewStream converts echo context to params.
+func (w *ServerInterfaceWrapper) GetUsersReviewStream(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersReviewStreamParams
+	// ------------- Required query parameter "user_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", ctx.QueryParams(), &params.UserId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "Last-Event-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Last-Event-ID")]; found {
+		var LastEventID string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Last-Event-ID, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "Last-Event-ID", runtime.ParamLocationHeader, valueList[0], &LastEventID)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Last-Event-ID: %s", err))
+		}
+
+		params.LastEventID = &LastEventID
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetUsersReviewStream(ctx, params)
+	return err
+}
+
 // PostUsersSetIsActive converts echo context to params.
 func (w *ServerInterfaceWrapper) PostUsersSetIsActive(ctx echo.Context) error {
 	var err error
 
+	ctx.Set(BearerAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.PostUsersSetIsActive(ctx)
 	return err
 }
 
+// PostUsersSetOutOfOffice converts echo context to params.
+func (w *ServerInterfaceWrapper) PostUsersSetOutOfOffice(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostUsersSetOutOfOffice(ctx)
+	return err
+}
+
+// PostUsersSetSkills converts echo context to params.
+func (w *ServerInterfaceWrapper) PostUsersSetSkills(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostUsersSetSkills(ctx)
+	return err
+}
+
 // This is a simple interface which specifies echo.Route addition functions which
 // are present on both echo.Echo and echo.Group, since we want to allow using
 // either of them for path registration
@@ -332,15 +1500,28 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 		Handler: si,
 	}
 
+	router.GET(baseURL+"/admin/export", wrapper.GetAdminExport)
+	router.POST(baseURL+"/admin/import", wrapper.PostAdminImport)
+	router.GET(baseURL+"/codeOwners/get", wrapper.GetCodeOwnersGet)
+	router.POST(baseURL+"/codeOwners/set", wrapper.PostCodeOwnersSet)
 	router.POST(baseURL+"/pullRequest/create", wrapper.PostPullRequestCreate)
+	router.GET(baseURL+"/pullRequest/get", wrapper.GetPullRequestGet)
+	router.GET(baseURL+"/pullRequest/list", wrapper.GetPullRequestList)
 	router.POST(baseURL+"/pullRequest/merge", wrapper.PostPullRequestMerge)
 	router.POST(baseURL+"/pullRequest/reassign", wrapper.PostPullRequestReassign)
+	router.GET(baseURL+"/stats/fairness", wrapper.GetStatsFairness)
 	router.GET(baseURL+"/stats/pr-assignments", wrapper.GetStatsPrAssignments)
 	router.GET(baseURL+"/stats/reviews", wrapper.GetStatsReviews)
+	router.GET(baseURL+"/stats/teams", wrapper.GetStatsTeams)
 	router.POST(baseURL+"/team/add", wrapper.PostTeamAdd)
 	router.POST(baseURL+"/team/deactivate", wrapper.PostTeamDeactivate)
 	router.GET(baseURL+"/team/get", wrapper.GetTeamGet)
+	router.POST(baseURL+"/team/setParent", wrapper.PostTeamSetParent)
+	router.GET(baseURL+"/team/tree", wrapper.GetTeamTree)
 	router.GET(baseURL+"/users/getReview", wrapper.GetUsersGetReview)
+	router.GET(baseURL+"/users/reviewStream", wrapper.GetUsersReviewStream)
 	router.POST(baseURL+"/users/setIsActive", wrapper.PostUsersSetIsActive)
+	router.POST(baseURL+"/users/setOutOfOffice", wrapper.PostUsersSetOutOfOffice)
+	router.POST(baseURL+"/users/setSkills", wrapper.PostUsersSetSkills)
 
 }
@@ -0,0 +1,3285 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        (unknown)
+// source: reviewer.proto
+
+// gRPC API сервиса назначения ревьюверов. Повторяет операции REST API (api/openapi.yaml)
+// и вызывает те же usecase; ошибки возвращаются как gRPC-статусы, в сообщении - код ErrorResponse.
+
+package reviewerpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PullRequestStatus int32
+
+const (
+	PullRequestStatus_PULL_REQUEST_STATUS_UNSPECIFIED PullRequestStatus = 0
+	PullRequestStatus_PULL_REQUEST_STATUS_OPEN        PullRequestStatus = 1
+	PullRequestStatus_PULL_REQUEST_STATUS_MERGED      PullRequestStatus = 2
+)
+
+// Enum value maps for PullRequestStatus.
+var (
+	PullRequestStatus_name = map[int32]string{
+		0: "PULL_REQUEST_STATUS_UNSPECIFIED",
+		1: "PULL_REQUEST_STATUS_OPEN",
+		2: "PULL_REQUEST_STATUS_MERGED",
+	}
+	PullRequestStatus_value = map[string]int32{
+		"PULL_REQUEST_STATUS_UNSPECIFIED": 0,
+		"PULL_REQUEST_STATUS_OPEN":        1,
+		"PULL_REQUEST_STATUS_MERGED":      2,
+	}
+)
+
+func (x PullRequestStatus) Enum() *PullRequestStatus {
+	p := new(PullRequestStatus)
+	*p = x
+	return p
+}
+
+func (x PullRequestStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PullRequestStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_reviewer_proto_enumTypes[0].Descriptor()
+}
+
+func (PullRequestStatus) Type() protoreflect.EnumType {
+	return &file_reviewer_proto_enumTypes[0]
+}
+
+func (x PullRequestStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PullRequestStatus.Descriptor instead.
+func (PullRequestStatus) EnumDescriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{0}
+}
+
+type AssignmentEventType int32
+
+const (
+	AssignmentEventType_ASSIGNMENT_EVENT_TYPE_UNSPECIFIED AssignmentEventType = 0
+	AssignmentEventType_ASSIGNMENT_EVENT_TYPE_ASSIGNED    AssignmentEventType = 1
+	AssignmentEventType_ASSIGNMENT_EVENT_TYPE_UNASSIGNED  AssignmentEventType = 2
+	AssignmentEventType_ASSIGNMENT_EVENT_TYPE_MERGED      AssignmentEventType = 3
+)
+
+// Enum value maps for AssignmentEventType.
+var (
+	AssignmentEventType_name = map[int32]string{
+		0: "ASSIGNMENT_EVENT_TYPE_UNSPECIFIED",
+		1: "ASSIGNMENT_EVENT_TYPE_ASSIGNED",
+		2: "ASSIGNMENT_EVENT_TYPE_UNASSIGNED",
+		3: "ASSIGNMENT_EVENT_TYPE_MERGED",
+	}
+	AssignmentEventType_value = map[string]int32{
+		"ASSIGNMENT_EVENT_TYPE_UNSPECIFIED": 0,
+		"ASSIGNMENT_EVENT_TYPE_ASSIGNED":    1,
+		"ASSIGNMENT_EVENT_TYPE_UNASSIGNED":  2,
+		"ASSIGNMENT_EVENT_TYPE_MERGED":      3,
+	}
+)
+
+func (x AssignmentEventType) Enum() *AssignmentEventType {
+	p := new(AssignmentEventType)
+	*p = x
+	return p
+}
+
+func (x AssignmentEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AssignmentEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_reviewer_proto_enumTypes[1].Descriptor()
+}
+
+func (AssignmentEventType) Type() protoreflect.EnumType {
+	return &file_reviewer_proto_enumTypes[1]
+}
+
+func (x AssignmentEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AssignmentEventType.Descriptor instead.
+func (AssignmentEventType) EnumDescriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{1}
+}
+
+type TeamMember struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	IsActive      bool                   `protobuf:"varint,3,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeamMember) Reset() {
+	*x = TeamMember{}
+	mi := &file_reviewer_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamMember) ProtoMessage() {}
+
+func (x *TeamMember) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamMember.ProtoReflect.Descriptor instead.
+func (*TeamMember) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TeamMember) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *TeamMember) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *TeamMember) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+type Team struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	TeamName string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	// Пусто для корневой команды
+	ParentTeamName string        `protobuf:"bytes,2,opt,name=parent_team_name,json=parentTeamName,proto3" json:"parent_team_name,omitempty"`
+	Members        []*TeamMember `protobuf:"bytes,3,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Team) Reset() {
+	*x = Team{}
+	mi := &file_reviewer_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Team) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Team) ProtoMessage() {}
+
+func (x *Team) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Team.ProtoReflect.Descriptor instead.
+func (*Team) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Team) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *Team) GetParentTeamName() string {
+	if x != nil {
+		return x.ParentTeamName
+	}
+	return ""
+}
+
+func (x *Team) GetMembers() []*TeamMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type TeamStats struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	MembersCount       int64                  `protobuf:"varint,1,opt,name=members_count,json=membersCount,proto3" json:"members_count,omitempty"`
+	ActiveMembersCount int64                  `protobuf:"varint,2,opt,name=active_members_count,json=activeMembersCount,proto3" json:"active_members_count,omitempty"`
+	OpenPrsCount       int64                  `protobuf:"varint,3,opt,name=open_prs_count,json=openPrsCount,proto3" json:"open_prs_count,omitempty"`
+	OpenReviewsCount   int64                  `protobuf:"varint,4,opt,name=open_reviews_count,json=openReviewsCount,proto3" json:"open_reviews_count,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *TeamStats) Reset() {
+	*x = TeamStats{}
+	mi := &file_reviewer_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamStats) ProtoMessage() {}
+
+func (x *TeamStats) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamStats.ProtoReflect.Descriptor instead.
+func (*TeamStats) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TeamStats) GetMembersCount() int64 {
+	if x != nil {
+		return x.MembersCount
+	}
+	return 0
+}
+
+func (x *TeamStats) GetActiveMembersCount() int64 {
+	if x != nil {
+		return x.ActiveMembersCount
+	}
+	return 0
+}
+
+func (x *TeamStats) GetOpenPrsCount() int64 {
+	if x != nil {
+		return x.OpenPrsCount
+	}
+	return 0
+}
+
+func (x *TeamStats) GetOpenReviewsCount() int64 {
+	if x != nil {
+		return x.OpenReviewsCount
+	}
+	return 0
+}
+
+type TeamTreeNode struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TeamName       string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	ParentTeamName string                 `protobuf:"bytes,2,opt,name=parent_team_name,json=parentTeamName,proto3" json:"parent_team_name,omitempty"`
+	Stats          *TeamStats             `protobuf:"bytes,3,opt,name=stats,proto3" json:"stats,omitempty"`
+	SubtreeStats   *TeamStats             `protobuf:"bytes,4,opt,name=subtree_stats,json=subtreeStats,proto3" json:"subtree_stats,omitempty"`
+	Children       []*TeamTreeNode        `protobuf:"bytes,5,rep,name=children,proto3" json:"children,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TeamTreeNode) Reset() {
+	*x = TeamTreeNode{}
+	mi := &file_reviewer_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamTreeNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamTreeNode) ProtoMessage() {}
+
+func (x *TeamTreeNode) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamTreeNode.ProtoReflect.Descriptor instead.
+func (*TeamTreeNode) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TeamTreeNode) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *TeamTreeNode) GetParentTeamName() string {
+	if x != nil {
+		return x.ParentTeamName
+	}
+	return ""
+}
+
+func (x *TeamTreeNode) GetStats() *TeamStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+func (x *TeamTreeNode) GetSubtreeStats() *TeamStats {
+	if x != nil {
+		return x.SubtreeStats
+	}
+	return nil
+}
+
+func (x *TeamTreeNode) GetChildren() []*TeamTreeNode {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+type User struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	TeamName      string                 `protobuf:"bytes,3,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	IsActive      bool                   `protobuf:"varint,4,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	Skills        []string               `protobuf:"bytes,5,rep,name=skills,proto3" json:"skills,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *User) Reset() {
+	*x = User{}
+	mi := &file_reviewer_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User) ProtoMessage() {}
+
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *User) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *User) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *User) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *User) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *User) GetSkills() []string {
+	if x != nil {
+		return x.Skills
+	}
+	return nil
+}
+
+type PullRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId     string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	PullRequestName   string                 `protobuf:"bytes,2,opt,name=pull_request_name,json=pullRequestName,proto3" json:"pull_request_name,omitempty"`
+	AuthorId          string                 `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Status            PullRequestStatus      `protobuf:"varint,4,opt,name=status,proto3,enum=prreviewer.v1.PullRequestStatus" json:"status,omitempty"`
+	AssignedReviewers []string               `protobuf:"bytes,5,rep,name=assigned_reviewers,json=assignedReviewers,proto3" json:"assigned_reviewers,omitempty"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	MergedAt          *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=merged_at,json=mergedAt,proto3" json:"merged_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *PullRequest) Reset() {
+	*x = PullRequest{}
+	mi := &file_reviewer_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PullRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PullRequest) ProtoMessage() {}
+
+func (x *PullRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PullRequest.ProtoReflect.Descriptor instead.
+func (*PullRequest) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PullRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *PullRequest) GetPullRequestName() string {
+	if x != nil {
+		return x.PullRequestName
+	}
+	return ""
+}
+
+func (x *PullRequest) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *PullRequest) GetStatus() PullRequestStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PullRequestStatus_PULL_REQUEST_STATUS_UNSPECIFIED
+}
+
+func (x *PullRequest) GetAssignedReviewers() []string {
+	if x != nil {
+		return x.AssignedReviewers
+	}
+	return nil
+}
+
+func (x *PullRequest) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *PullRequest) GetMergedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.MergedAt
+	}
+	return nil
+}
+
+type ReviewerSelection struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// code_owner, skill_match, low_workload, team_member, fair_share, escalation, reassignment
+	Reason        string   `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	MatchedTags   []string `protobuf:"bytes,3,rep,name=matched_tags,json=matchedTags,proto3" json:"matched_tags,omitempty"`
+	OpenReviews   int32    `protobuf:"varint,4,opt,name=open_reviews,json=openReviews,proto3" json:"open_reviews,omitempty"`
+	SkillScore    float64  `protobuf:"fixed64,5,opt,name=skill_score,json=skillScore,proto3" json:"skill_score,omitempty"`
+	WorkloadScore float64  `protobuf:"fixed64,6,opt,name=workload_score,json=workloadScore,proto3" json:"workload_score,omitempty"`
+	Score         float64  `protobuf:"fixed64,7,opt,name=score,proto3" json:"score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewerSelection) Reset() {
+	*x = ReviewerSelection{}
+	mi := &file_reviewer_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewerSelection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewerSelection) ProtoMessage() {}
+
+func (x *ReviewerSelection) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewerSelection.ProtoReflect.Descriptor instead.
+func (*ReviewerSelection) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ReviewerSelection) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ReviewerSelection) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ReviewerSelection) GetMatchedTags() []string {
+	if x != nil {
+		return x.MatchedTags
+	}
+	return nil
+}
+
+func (x *ReviewerSelection) GetOpenReviews() int32 {
+	if x != nil {
+		return x.OpenReviews
+	}
+	return 0
+}
+
+func (x *ReviewerSelection) GetSkillScore() float64 {
+	if x != nil {
+		return x.SkillScore
+	}
+	return 0
+}
+
+func (x *ReviewerSelection) GetWorkloadScore() float64 {
+	if x != nil {
+		return x.WorkloadScore
+	}
+	return 0
+}
+
+func (x *ReviewerSelection) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type ExcludedCandidate struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// author, inactive
+	Reason        string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExcludedCandidate) Reset() {
+	*x = ExcludedCandidate{}
+	mi := &file_reviewer_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExcludedCandidate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExcludedCandidate) ProtoMessage() {}
+
+func (x *ExcludedCandidate) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExcludedCandidate.ProtoReflect.Descriptor instead.
+func (*ExcludedCandidate) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ExcludedCandidate) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ExcludedCandidate) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type AssignmentExplanation struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Strategy          string                 `protobuf:"bytes,1,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	CandidatePoolSize int32                  `protobuf:"varint,2,opt,name=candidate_pool_size,json=candidatePoolSize,proto3" json:"candidate_pool_size,omitempty"`
+	Reviewers         []*ReviewerSelection   `protobuf:"bytes,3,rep,name=reviewers,proto3" json:"reviewers,omitempty"`
+	Excluded          []*ExcludedCandidate   `protobuf:"bytes,4,rep,name=excluded,proto3" json:"excluded,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *AssignmentExplanation) Reset() {
+	*x = AssignmentExplanation{}
+	mi := &file_reviewer_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignmentExplanation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignmentExplanation) ProtoMessage() {}
+
+func (x *AssignmentExplanation) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignmentExplanation.ProtoReflect.Descriptor instead.
+func (*AssignmentExplanation) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AssignmentExplanation) GetStrategy() string {
+	if x != nil {
+		return x.Strategy
+	}
+	return ""
+}
+
+func (x *AssignmentExplanation) GetCandidatePoolSize() int32 {
+	if x != nil {
+		return x.CandidatePoolSize
+	}
+	return 0
+}
+
+func (x *AssignmentExplanation) GetReviewers() []*ReviewerSelection {
+	if x != nil {
+		return x.Reviewers
+	}
+	return nil
+}
+
+func (x *AssignmentExplanation) GetExcluded() []*ExcludedCandidate {
+	if x != nil {
+		return x.Excluded
+	}
+	return nil
+}
+
+type AddTeamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Team          *Team                  `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTeamRequest) Reset() {
+	*x = AddTeamRequest{}
+	mi := &file_reviewer_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTeamRequest) ProtoMessage() {}
+
+func (x *AddTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTeamRequest.ProtoReflect.Descriptor instead.
+func (*AddTeamRequest) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *AddTeamRequest) GetTeam() *Team {
+	if x != nil {
+		return x.Team
+	}
+	return nil
+}
+
+type GetTeamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeamRequest) Reset() {
+	*x = GetTeamRequest{}
+	mi := &file_reviewer_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamRequest) ProtoMessage() {}
+
+func (x *GetTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamRequest.ProtoReflect.Descriptor instead.
+func (*GetTeamRequest) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetTeamRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+type SetTeamParentRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	TeamName string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	// Пусто - сделать команду корневой
+	ParentTeamName string `protobuf:"bytes,2,opt,name=parent_team_name,json=parentTeamName,proto3" json:"parent_team_name,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SetTeamParentRequest) Reset() {
+	*x = SetTeamParentRequest{}
+	mi := &file_reviewer_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTeamParentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTeamParentRequest) ProtoMessage() {}
+
+func (x *SetTeamParentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTeamParentRequest.ProtoReflect.Descriptor instead.
+func (*SetTeamParentRequest) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SetTeamParentRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *SetTeamParentRequest) GetParentTeamName() string {
+	if x != nil {
+		return x.ParentTeamName
+	}
+	return ""
+}
+
+type GetTeamTreeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Корень поддерева; пусто - все корневые команды
+	TeamName      string `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeamTreeRequest) Reset() {
+	*x = GetTeamTreeRequest{}
+	mi := &file_reviewer_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamTreeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamTreeRequest) ProtoMessage() {}
+
+func (x *GetTeamTreeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamTreeRequest.ProtoReflect.Descriptor instead.
+func (*GetTeamTreeRequest) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetTeamTreeRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+type GetTeamTreeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Teams         []*TeamTreeNode        `protobuf:"bytes,1,rep,name=teams,proto3" json:"teams,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeamTreeResponse) Reset() {
+	*x = GetTeamTreeResponse{}
+	mi := &file_reviewer_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamTreeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamTreeResponse) ProtoMessage() {}
+
+func (x *GetTeamTreeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamTreeResponse.ProtoReflect.Descriptor instead.
+func (*GetTeamTreeResponse) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetTeamTreeResponse) GetTeams() []*TeamTreeNode {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+type DeactivateTeamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeactivateTeamRequest) Reset() {
+	*x = DeactivateTeamRequest{}
+	mi := &file_reviewer_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeactivateTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeactivateTeamRequest) ProtoMessage() {}
+
+func (x *DeactivateTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeactivateTeamRequest.ProtoReflect.Descriptor instead.
+func (*DeactivateTeamRequest) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DeactivateTeamRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+type DeactivateTeamResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	TeamName            string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	DeactivatedUsers    int32                  `protobuf:"varint,2,opt,name=deactivated_users,json=deactivatedUsers,proto3" json:"deactivated_users,omitempty"`
+	ReassignedPrs       int32                  `protobuf:"varint,3,opt,name=reassigned_prs,json=reassignedPrs,proto3" json:"reassigned_prs,omitempty"`
+	FailedReassignments int32                  `protobuf:"varint,4,opt,name=failed_reassignments,json=failedReassignments,proto3" json:"failed_reassignments,omitempty"`
+	DeactivatedUserIds  []string               `protobuf:"bytes,5,rep,name=deactivated_user_ids,json=deactivatedUserIds,proto3" json:"deactivated_user_ids,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *DeactivateTeamResponse) Reset() {
+	*x = DeactivateTeamResponse{}
+	mi := &file_reviewer_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeactivateTeamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeactivateTeamResponse) ProtoMessage() {}
+
+func (x *DeactivateTeamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeactivateTeamResponse.ProtoReflect.Descriptor instead.
+func (*DeactivateTeamResponse) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DeactivateTeamResponse) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *DeactivateTeamResponse) GetDeactivatedUsers() int32 {
+	if x != nil {
+		return x.DeactivatedUsers
+	}
+	return 0
+}
+
+func (x *DeactivateTeamResponse) GetReassignedPrs() int32 {
+	if x != nil {
+		return x.ReassignedPrs
+	}
+	return 0
+}
+
+func (x *DeactivateTeamResponse) GetFailedReassignments() int32 {
+	if x != nil {
+		return x.FailedReassignments
+	}
+	return 0
+}
+
+func (x *DeactivateTeamResponse) GetDeactivatedUserIds() []string {
+	if x != nil {
+		return x.DeactivatedUserIds
+	}
+	return nil
+}
+
+type SetUserActiveRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	IsActive      bool                   `protobuf:"varint,2,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetUserActiveRequest) Reset() {
+	*x = SetUserActiveRequest{}
+	mi := &file_reviewer_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUserActiveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserActiveRequest) ProtoMessage() {}
+
+func (x *SetUserActiveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserActiveRequest.ProtoReflect.Descriptor instead.
+func (*SetUserActiveRequest) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SetUserActiveRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetUserActiveRequest) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+type SetUserSkillsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Skills        []string               `protobuf:"bytes,2,rep,name=skills,proto3" json:"skills,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetUserSkillsRequest) Reset() {
+	*x = SetUserSkillsRequest{}
+	mi := &file_reviewer_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUserSkillsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserSkillsRequest) ProtoMessage() {}
+
+func (x *SetUserSkillsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserSkillsRequest.ProtoReflect.Descriptor instead.
+func (*SetUserSkillsRequest) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SetUserSkillsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetUserSkillsRequest) GetSkills() []string {
+	if x != nil {
+		return x.Skills
+	}
+	return nil
+}
+
+type GetUserReviewsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// 1..100, 0 - по умолчанию (50)
+	Limit         int32  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor        string `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserReviewsRequest) Reset() {
+	*x = GetUserReviewsRequest{}
+	mi := &file_reviewer_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserReviewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserReviewsRequest) ProtoMessage() {}
+
+func (x *GetUserReviewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserReviewsRequest.ProtoReflect.Descriptor instead.
+func (*GetUserReviewsRequest) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetUserReviewsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetUserReviewsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetUserReviewsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type GetUserReviewsResponse struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	UserId       string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PullRequests []*PullRequest         `protobuf:"bytes,2,rep,name=pull_requests,json=pullRequests,proto3" json:"pull_requests,omitempty"`
+	// Пусто на последней странице
+	NextCursor    string `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserReviewsResponse) Reset() {
+	*x = GetUserReviewsResponse{}
+	mi := &file_reviewer_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserReviewsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserReviewsResponse) ProtoMessage() {}
+
+func (x *GetUserReviewsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserReviewsResponse.ProtoReflect.Descriptor instead.
+func (*GetUserReviewsResponse) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetUserReviewsResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetUserReviewsResponse) GetPullRequests() []*PullRequest {
+	if x != nil {
+		return x.PullRequests
+	}
+	return nil
+}
+
+func (x *GetUserReviewsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type CreatePullRequestRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId   string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	PullRequestName string                 `protobuf:"bytes,2,opt,name=pull_request_name,json=pullRequestName,proto3" json:"pull_request_name,omitempty"`
+	AuthorId        string                 `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Repository      string                 `protobuf:"bytes,4,opt,name=repository,proto3" json:"repository,omitempty"`
+	ChangedFiles    []string               `protobuf:"bytes,5,rep,name=changed_files,json=changedFiles,proto3" json:"changed_files,omitempty"`
+	RequiredTags    []string               `protobuf:"bytes,6,rep,name=required_tags,json=requiredTags,proto3" json:"required_tags,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreatePullRequestRequest) Reset() {
+	*x = CreatePullRequestRequest{}
+	mi := &file_reviewer_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePullRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePullRequestRequest) ProtoMessage() {}
+
+func (x *CreatePullRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePullRequestRequest.ProtoReflect.Descriptor instead.
+func (*CreatePullRequestRequest) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CreatePullRequestRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *CreatePullRequestRequest) GetPullRequestName() string {
+	if x != nil {
+		return x.PullRequestName
+	}
+	return ""
+}
+
+func (x *CreatePullRequestRequest) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *CreatePullRequestRequest) GetRepository() string {
+	if x != nil {
+		return x.Repository
+	}
+	return ""
+}
+
+func (x *CreatePullRequestRequest) GetChangedFiles() []string {
+	if x != nil {
+		return x.ChangedFiles
+	}
+	return nil
+}
+
+func (x *CreatePullRequestRequest) GetRequiredTags() []string {
+	if x != nil {
+		return x.RequiredTags
+	}
+	return nil
+}
+
+type CreatePullRequestResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	PullRequest       *PullRequest           `protobuf:"bytes,1,opt,name=pull_request,json=pullRequest,proto3" json:"pull_request,omitempty"`
+	ReviewerSelection []*ReviewerSelection   `protobuf:"bytes,2,rep,name=reviewer_selection,json=reviewerSelection,proto3" json:"reviewer_selection,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CreatePullRequestResponse) Reset() {
+	*x = CreatePullRequestResponse{}
+	mi := &file_reviewer_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePullRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePullRequestResponse) ProtoMessage() {}
+
+func (x *CreatePullRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePullRequestResponse.ProtoReflect.Descriptor instead.
+func (*CreatePullRequestResponse) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CreatePullRequestResponse) GetPullRequest() *PullRequest {
+	if x != nil {
+		return x.PullRequest
+	}
+	return nil
+}
+
+func (x *CreatePullRequestResponse) GetReviewerSelection() []*ReviewerSelection {
+	if x != nil {
+		return x.ReviewerSelection
+	}
+	return nil
+}
+
+type GetPullRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	Explain       bool                   `protobuf:"varint,2,opt,name=explain,proto3" json:"explain,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPullRequestRequest) Reset() {
+	*x = GetPullRequestRequest{}
+	mi := &file_reviewer_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPullRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPullRequestRequest) ProtoMessage() {}
+
+func (x *GetPullRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPullRequestRequest.ProtoReflect.Descriptor instead.
+func (*GetPullRequestRequest) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetPullRequestRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *GetPullRequestRequest) GetExplain() bool {
+	if x != nil {
+		return x.Explain
+	}
+	return false
+}
+
+type GetPullRequestResponse struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	PullRequest *PullRequest           `protobuf:"bytes,1,opt,name=pull_request,json=pullRequest,proto3" json:"pull_request,omitempty"`
+	// Только при explain=true, если объяснение сохранено
+	Explanation   *AssignmentExplanation `protobuf:"bytes,2,opt,name=explanation,proto3" json:"explanation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPullRequestResponse) Reset() {
+	*x = GetPullRequestResponse{}
+	mi := &file_reviewer_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPullRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPullRequestResponse) ProtoMessage() {}
+
+func (x *GetPullRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPullRequestResponse.ProtoReflect.Descriptor instead.
+func (*GetPullRequestResponse) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetPullRequestResponse) GetPullRequest() *PullRequest {
+	if x != nil {
+		return x.PullRequest
+	}
+	return nil
+}
+
+func (x *GetPullRequestResponse) GetExplanation() *AssignmentExplanation {
+	if x != nil {
+		return x.Explanation
+	}
+	return nil
+}
+
+type ListPullRequestsRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Status      PullRequestStatus      `protobuf:"varint,1,opt,name=status,proto3,enum=prreviewer.v1.PullRequestStatus" json:"status,omitempty"`
+	AuthorId    string                 `protobuf:"bytes,2,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	ReviewerId  string                 `protobuf:"bytes,3,opt,name=reviewer_id,json=reviewerId,proto3" json:"reviewer_id,omitempty"`
+	TeamName    string                 `protobuf:"bytes,4,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	CreatedFrom *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_from,json=createdFrom,proto3" json:"created_from,omitempty"`
+	CreatedTo   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_to,json=createdTo,proto3" json:"created_to,omitempty"`
+	// 1..100, 0 - по умолчанию (50)
+	Limit         int32  `protobuf:"varint,7,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor        string `protobuf:"bytes,8,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPullRequestsRequest) Reset() {
+	*x = ListPullRequestsRequest{}
+	mi := &file_reviewer_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPullRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPullRequestsRequest) ProtoMessage() {}
+
+func (x *ListPullRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPullRequestsRequest.ProtoReflect.Descriptor instead.
+func (*ListPullRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListPullRequestsRequest) GetStatus() PullRequestStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PullRequestStatus_PULL_REQUEST_STATUS_UNSPECIFIED
+}
+
+func (x *ListPullRequestsRequest) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *ListPullRequestsRequest) GetReviewerId() string {
+	if x != nil {
+		return x.ReviewerId
+	}
+	return ""
+}
+
+func (x *ListPullRequestsRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *ListPullRequestsRequest) GetCreatedFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedFrom
+	}
+	return nil
+}
+
+func (x *ListPullRequestsRequest) GetCreatedTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedTo
+	}
+	return nil
+}
+
+func (x *ListPullRequestsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListPullRequestsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type ListPullRequestsResponse struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	PullRequests []*PullRequest         `protobuf:"bytes,1,rep,name=pull_requests,json=pullRequests,proto3" json:"pull_requests,omitempty"`
+	// Пусто на последней странице
+	NextCursor    string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPullRequestsResponse) Reset() {
+	*x = ListPullRequestsResponse{}
+	mi := &file_reviewer_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPullRequestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPullRequestsResponse) ProtoMessage() {}
+
+func (x *ListPullRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPullRequestsResponse.ProtoReflect.Descriptor instead.
+func (*ListPullRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListPullRequestsResponse) GetPullRequests() []*PullRequest {
+	if x != nil {
+		return x.PullRequests
+	}
+	return nil
+}
+
+func (x *ListPullRequestsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type MergePullRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergePullRequestRequest) Reset() {
+	*x = MergePullRequestRequest{}
+	mi := &file_reviewer_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergePullRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergePullRequestRequest) ProtoMessage() {}
+
+func (x *MergePullRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergePullRequestRequest.ProtoReflect.Descriptor instead.
+func (*MergePullRequestRequest) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *MergePullRequestRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+type ReassignReviewerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	OldUserId     string                 `protobuf:"bytes,2,opt,name=old_user_id,json=oldUserId,proto3" json:"old_user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReassignReviewerRequest) Reset() {
+	*x = ReassignReviewerRequest{}
+	mi := &file_reviewer_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReassignReviewerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReassignReviewerRequest) ProtoMessage() {}
+
+func (x *ReassignReviewerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReassignReviewerRequest.ProtoReflect.Descriptor instead.
+func (*ReassignReviewerRequest) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ReassignReviewerRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *ReassignReviewerRequest) GetOldUserId() string {
+	if x != nil {
+		return x.OldUserId
+	}
+	return ""
+}
+
+type ReassignReviewerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequest   *PullRequest           `protobuf:"bytes,1,opt,name=pull_request,json=pullRequest,proto3" json:"pull_request,omitempty"`
+	ReplacedBy    string                 `protobuf:"bytes,2,opt,name=replaced_by,json=replacedBy,proto3" json:"replaced_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReassignReviewerResponse) Reset() {
+	*x = ReassignReviewerResponse{}
+	mi := &file_reviewer_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReassignReviewerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReassignReviewerResponse) ProtoMessage() {}
+
+func (x *ReassignReviewerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReassignReviewerResponse.ProtoReflect.Descriptor instead.
+func (*ReassignReviewerResponse) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ReassignReviewerResponse) GetPullRequest() *PullRequest {
+	if x != nil {
+		return x.PullRequest
+	}
+	return nil
+}
+
+func (x *ReassignReviewerResponse) GetReplacedBy() string {
+	if x != nil {
+		return x.ReplacedBy
+	}
+	return ""
+}
+
+type GetReviewStatsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Только PR, созданные в [created_from, created_to); пустые границы не ограничивают
+	CreatedFrom   *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=created_from,json=createdFrom,proto3" json:"created_from,omitempty"`
+	CreatedTo     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_to,json=createdTo,proto3" json:"created_to,omitempty"`
+	TeamName      string                 `protobuf:"bytes,3,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	Status        PullRequestStatus      `protobuf:"varint,4,opt,name=status,proto3,enum=prreviewer.v1.PullRequestStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReviewStatsRequest) Reset() {
+	*x = GetReviewStatsRequest{}
+	mi := &file_reviewer_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReviewStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReviewStatsRequest) ProtoMessage() {}
+
+func (x *GetReviewStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReviewStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetReviewStatsRequest) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetReviewStatsRequest) GetCreatedFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedFrom
+	}
+	return nil
+}
+
+func (x *GetReviewStatsRequest) GetCreatedTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedTo
+	}
+	return nil
+}
+
+func (x *GetReviewStatsRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *GetReviewStatsRequest) GetStatus() PullRequestStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PullRequestStatus_PULL_REQUEST_STATUS_UNSPECIFIED
+}
+
+type ReviewStat struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	ReviewCount   int64                  `protobuf:"varint,3,opt,name=review_count,json=reviewCount,proto3" json:"review_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewStat) Reset() {
+	*x = ReviewStat{}
+	mi := &file_reviewer_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewStat) ProtoMessage() {}
+
+func (x *ReviewStat) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewStat.ProtoReflect.Descriptor instead.
+func (*ReviewStat) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ReviewStat) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ReviewStat) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ReviewStat) GetReviewCount() int64 {
+	if x != nil {
+		return x.ReviewCount
+	}
+	return 0
+}
+
+type GetReviewStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stats         []*ReviewStat          `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReviewStatsResponse) Reset() {
+	*x = GetReviewStatsResponse{}
+	mi := &file_reviewer_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReviewStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReviewStatsResponse) ProtoMessage() {}
+
+func (x *GetReviewStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReviewStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetReviewStatsResponse) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetReviewStatsResponse) GetStats() []*ReviewStat {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type GetPRAssignmentStatsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Только PR, созданные в [created_from, created_to); пустые границы не ограничивают
+	CreatedFrom   *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=created_from,json=createdFrom,proto3" json:"created_from,omitempty"`
+	CreatedTo     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_to,json=createdTo,proto3" json:"created_to,omitempty"`
+	TeamName      string                 `protobuf:"bytes,3,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	Status        PullRequestStatus      `protobuf:"varint,4,opt,name=status,proto3,enum=prreviewer.v1.PullRequestStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPRAssignmentStatsRequest) Reset() {
+	*x = GetPRAssignmentStatsRequest{}
+	mi := &file_reviewer_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPRAssignmentStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPRAssignmentStatsRequest) ProtoMessage() {}
+
+func (x *GetPRAssignmentStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPRAssignmentStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetPRAssignmentStatsRequest) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetPRAssignmentStatsRequest) GetCreatedFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedFrom
+	}
+	return nil
+}
+
+func (x *GetPRAssignmentStatsRequest) GetCreatedTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedTo
+	}
+	return nil
+}
+
+func (x *GetPRAssignmentStatsRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *GetPRAssignmentStatsRequest) GetStatus() PullRequestStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PullRequestStatus_PULL_REQUEST_STATUS_UNSPECIFIED
+}
+
+type PRAssignmentStat struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId   string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	PullRequestName string                 `protobuf:"bytes,2,opt,name=pull_request_name,json=pullRequestName,proto3" json:"pull_request_name,omitempty"`
+	ReviewersCount  int64                  `protobuf:"varint,3,opt,name=reviewers_count,json=reviewersCount,proto3" json:"reviewers_count,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PRAssignmentStat) Reset() {
+	*x = PRAssignmentStat{}
+	mi := &file_reviewer_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PRAssignmentStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PRAssignmentStat) ProtoMessage() {}
+
+func (x *PRAssignmentStat) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PRAssignmentStat.ProtoReflect.Descriptor instead.
+func (*PRAssignmentStat) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *PRAssignmentStat) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *PRAssignmentStat) GetPullRequestName() string {
+	if x != nil {
+		return x.PullRequestName
+	}
+	return ""
+}
+
+func (x *PRAssignmentStat) GetReviewersCount() int64 {
+	if x != nil {
+		return x.ReviewersCount
+	}
+	return 0
+}
+
+type GetPRAssignmentStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stats         []*PRAssignmentStat    `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPRAssignmentStatsResponse) Reset() {
+	*x = GetPRAssignmentStatsResponse{}
+	mi := &file_reviewer_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPRAssignmentStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPRAssignmentStatsResponse) ProtoMessage() {}
+
+func (x *GetPRAssignmentStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPRAssignmentStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetPRAssignmentStatsResponse) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetPRAssignmentStatsResponse) GetStats() []*PRAssignmentStat {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type GetTeamStatsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Только PR, созданные в [created_from, created_to); пустые границы не ограничивают
+	CreatedFrom   *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=created_from,json=createdFrom,proto3" json:"created_from,omitempty"`
+	CreatedTo     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_to,json=createdTo,proto3" json:"created_to,omitempty"`
+	TeamName      string                 `protobuf:"bytes,3,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	Status        PullRequestStatus      `protobuf:"varint,4,opt,name=status,proto3,enum=prreviewer.v1.PullRequestStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeamStatsRequest) Reset() {
+	*x = GetTeamStatsRequest{}
+	mi := &file_reviewer_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamStatsRequest) ProtoMessage() {}
+
+func (x *GetTeamStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetTeamStatsRequest) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetTeamStatsRequest) GetCreatedFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedFrom
+	}
+	return nil
+}
+
+func (x *GetTeamStatsRequest) GetCreatedTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedTo
+	}
+	return nil
+}
+
+func (x *GetTeamStatsRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *GetTeamStatsRequest) GetStatus() PullRequestStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PullRequestStatus_PULL_REQUEST_STATUS_UNSPECIFIED
+}
+
+type TeamReviewStat struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	TeamName              string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	PullRequests          int64                  `protobuf:"varint,2,opt,name=pull_requests,json=pullRequests,proto3" json:"pull_requests,omitempty"`
+	OpenPullRequests      int64                  `protobuf:"varint,3,opt,name=open_pull_requests,json=openPullRequests,proto3" json:"open_pull_requests,omitempty"`
+	AvgReviewersPerPr     float64                `protobuf:"fixed64,4,opt,name=avg_reviewers_per_pr,json=avgReviewersPerPr,proto3" json:"avg_reviewers_per_pr,omitempty"`
+	ReviewsGiven          int64                  `protobuf:"varint,5,opt,name=reviews_given,json=reviewsGiven,proto3" json:"reviews_given,omitempty"`
+	ReviewsReceived       int64                  `protobuf:"varint,6,opt,name=reviews_received,json=reviewsReceived,proto3" json:"reviews_received,omitempty"`
+	CrossTeamReviewsShare float64                `protobuf:"fixed64,7,opt,name=cross_team_reviews_share,json=crossTeamReviewsShare,proto3" json:"cross_team_reviews_share,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *TeamReviewStat) Reset() {
+	*x = TeamReviewStat{}
+	mi := &file_reviewer_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamReviewStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamReviewStat) ProtoMessage() {}
+
+func (x *TeamReviewStat) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamReviewStat.ProtoReflect.Descriptor instead.
+func (*TeamReviewStat) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *TeamReviewStat) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *TeamReviewStat) GetPullRequests() int64 {
+	if x != nil {
+		return x.PullRequests
+	}
+	return 0
+}
+
+func (x *TeamReviewStat) GetOpenPullRequests() int64 {
+	if x != nil {
+		return x.OpenPullRequests
+	}
+	return 0
+}
+
+func (x *TeamReviewStat) GetAvgReviewersPerPr() float64 {
+	if x != nil {
+		return x.AvgReviewersPerPr
+	}
+	return 0
+}
+
+func (x *TeamReviewStat) GetReviewsGiven() int64 {
+	if x != nil {
+		return x.ReviewsGiven
+	}
+	return 0
+}
+
+func (x *TeamReviewStat) GetReviewsReceived() int64 {
+	if x != nil {
+		return x.ReviewsReceived
+	}
+	return 0
+}
+
+func (x *TeamReviewStat) GetCrossTeamReviewsShare() float64 {
+	if x != nil {
+		return x.CrossTeamReviewsShare
+	}
+	return 0
+}
+
+type GetTeamStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stats         []*TeamReviewStat      `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeamStatsResponse) Reset() {
+	*x = GetTeamStatsResponse{}
+	mi := &file_reviewer_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamStatsResponse) ProtoMessage() {}
+
+func (x *GetTeamStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetTeamStatsResponse) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetTeamStatsResponse) GetStats() []*TeamReviewStat {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type GetFairnessReportRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Период [created_from, created_to); пусто - последние 30 дней
+	CreatedFrom   *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=created_from,json=createdFrom,proto3" json:"created_from,omitempty"`
+	CreatedTo     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_to,json=createdTo,proto3" json:"created_to,omitempty"`
+	TeamName      string                 `protobuf:"bytes,3,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFairnessReportRequest) Reset() {
+	*x = GetFairnessReportRequest{}
+	mi := &file_reviewer_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFairnessReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFairnessReportRequest) ProtoMessage() {}
+
+func (x *GetFairnessReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFairnessReportRequest.ProtoReflect.Descriptor instead.
+func (*GetFairnessReportRequest) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetFairnessReportRequest) GetCreatedFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedFrom
+	}
+	return nil
+}
+
+func (x *GetFairnessReportRequest) GetCreatedTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedTo
+	}
+	return nil
+}
+
+func (x *GetFairnessReportRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+type MemberFairness struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	UserId              string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username            string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	ActiveDays          float64                `protobuf:"fixed64,3,opt,name=active_days,json=activeDays,proto3" json:"active_days,omitempty"`
+	Assignments         int64                  `protobuf:"varint,4,opt,name=assignments,proto3" json:"assignments,omitempty"`
+	ExpectedAssignments float64                `protobuf:"fixed64,5,opt,name=expected_assignments,json=expectedAssignments,proto3" json:"expected_assignments,omitempty"`
+	Deviation           float64                `protobuf:"fixed64,6,opt,name=deviation,proto3" json:"deviation,omitempty"`
+	// overloaded, underloaded; пусто - отклонение в пределах нормы
+	Outlier       string `protobuf:"bytes,7,opt,name=outlier,proto3" json:"outlier,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemberFairness) Reset() {
+	*x = MemberFairness{}
+	mi := &file_reviewer_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemberFairness) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemberFairness) ProtoMessage() {}
+
+func (x *MemberFairness) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemberFairness.ProtoReflect.Descriptor instead.
+func (*MemberFairness) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *MemberFairness) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *MemberFairness) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *MemberFairness) GetActiveDays() float64 {
+	if x != nil {
+		return x.ActiveDays
+	}
+	return 0
+}
+
+func (x *MemberFairness) GetAssignments() int64 {
+	if x != nil {
+		return x.Assignments
+	}
+	return 0
+}
+
+func (x *MemberFairness) GetExpectedAssignments() float64 {
+	if x != nil {
+		return x.ExpectedAssignments
+	}
+	return 0
+}
+
+func (x *MemberFairness) GetDeviation() float64 {
+	if x != nil {
+		return x.Deviation
+	}
+	return 0
+}
+
+func (x *MemberFairness) GetOutlier() string {
+	if x != nil {
+		return x.Outlier
+	}
+	return ""
+}
+
+type TeamFairness struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	Assignments   int64                  `protobuf:"varint,2,opt,name=assignments,proto3" json:"assignments,omitempty"`
+	Gini          float64                `protobuf:"fixed64,3,opt,name=gini,proto3" json:"gini,omitempty"`
+	MaxMinSpread  float64                `protobuf:"fixed64,4,opt,name=max_min_spread,json=maxMinSpread,proto3" json:"max_min_spread,omitempty"`
+	Members       []*MemberFairness      `protobuf:"bytes,5,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeamFairness) Reset() {
+	*x = TeamFairness{}
+	mi := &file_reviewer_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamFairness) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamFairness) ProtoMessage() {}
+
+func (x *TeamFairness) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamFairness.ProtoReflect.Descriptor instead.
+func (*TeamFairness) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *TeamFairness) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *TeamFairness) GetAssignments() int64 {
+	if x != nil {
+		return x.Assignments
+	}
+	return 0
+}
+
+func (x *TeamFairness) GetGini() float64 {
+	if x != nil {
+		return x.Gini
+	}
+	return 0
+}
+
+func (x *TeamFairness) GetMaxMinSpread() float64 {
+	if x != nil {
+		return x.MaxMinSpread
+	}
+	return 0
+}
+
+func (x *TeamFairness) GetMembers() []*MemberFairness {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type GetFairnessReportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CreatedFrom   *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=created_from,json=createdFrom,proto3" json:"created_from,omitempty"`
+	CreatedTo     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_to,json=createdTo,proto3" json:"created_to,omitempty"`
+	Teams         []*TeamFairness        `protobuf:"bytes,3,rep,name=teams,proto3" json:"teams,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFairnessReportResponse) Reset() {
+	*x = GetFairnessReportResponse{}
+	mi := &file_reviewer_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFairnessReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFairnessReportResponse) ProtoMessage() {}
+
+func (x *GetFairnessReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFairnessReportResponse.ProtoReflect.Descriptor instead.
+func (*GetFairnessReportResponse) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetFairnessReportResponse) GetCreatedFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedFrom
+	}
+	return nil
+}
+
+func (x *GetFairnessReportResponse) GetCreatedTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedTo
+	}
+	return nil
+}
+
+func (x *GetFairnessReportResponse) GetTeams() []*TeamFairness {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+type WatchAssignmentsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Только события этого ревьювера; пусто - все события
+	ReviewerId    string `protobuf:"bytes,1,opt,name=reviewer_id,json=reviewerId,proto3" json:"reviewer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchAssignmentsRequest) Reset() {
+	*x = WatchAssignmentsRequest{}
+	mi := &file_reviewer_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchAssignmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchAssignmentsRequest) ProtoMessage() {}
+
+func (x *WatchAssignmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchAssignmentsRequest.ProtoReflect.Descriptor instead.
+func (*WatchAssignmentsRequest) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *WatchAssignmentsRequest) GetReviewerId() string {
+	if x != nil {
+		return x.ReviewerId
+	}
+	return ""
+}
+
+type AssignmentEvent struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type            AssignmentEventType    `protobuf:"varint,2,opt,name=type,proto3,enum=prreviewer.v1.AssignmentEventType" json:"type,omitempty"`
+	PullRequestId   string                 `protobuf:"bytes,3,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	PullRequestName string                 `protobuf:"bytes,4,opt,name=pull_request_name,json=pullRequestName,proto3" json:"pull_request_name,omitempty"`
+	AuthorId        string                 `protobuf:"bytes,5,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	ReviewerId      string                 `protobuf:"bytes,6,opt,name=reviewer_id,json=reviewerId,proto3" json:"reviewer_id,omitempty"`
+	OccurredAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AssignmentEvent) Reset() {
+	*x = AssignmentEvent{}
+	mi := &file_reviewer_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignmentEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignmentEvent) ProtoMessage() {}
+
+func (x *AssignmentEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignmentEvent.ProtoReflect.Descriptor instead.
+func (*AssignmentEvent) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *AssignmentEvent) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AssignmentEvent) GetType() AssignmentEventType {
+	if x != nil {
+		return x.Type
+	}
+	return AssignmentEventType_ASSIGNMENT_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *AssignmentEvent) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *AssignmentEvent) GetPullRequestName() string {
+	if x != nil {
+		return x.PullRequestName
+	}
+	return ""
+}
+
+func (x *AssignmentEvent) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *AssignmentEvent) GetReviewerId() string {
+	if x != nil {
+		return x.ReviewerId
+	}
+	return ""
+}
+
+func (x *AssignmentEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+var File_reviewer_proto protoreflect.FileDescriptor
+
+const file_reviewer_proto_rawDesc = "" +
+	"\n" +
+	"\x0ereviewer.proto\x12\rprreviewer.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"^\n" +
+	"\n" +
+	"TeamMember\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1b\n" +
+	"\tis_active\x18\x03 \x01(\bR\bisActive\"\x82\x01\n" +
+	"\x04Team\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12(\n" +
+	"\x10parent_team_name\x18\x02 \x01(\tR\x0eparentTeamName\x123\n" +
+	"\amembers\x18\x03 \x03(\v2\x19.prreviewer.v1.TeamMemberR\amembers\"\xb6\x01\n" +
+	"\tTeamStats\x12#\n" +
+	"\rmembers_count\x18\x01 \x01(\x03R\fmembersCount\x120\n" +
+	"\x14active_members_count\x18\x02 \x01(\x03R\x12activeMembersCount\x12$\n" +
+	"\x0eopen_prs_count\x18\x03 \x01(\x03R\fopenPrsCount\x12,\n" +
+	"\x12open_reviews_count\x18\x04 \x01(\x03R\x10openReviewsCount\"\xfd\x01\n" +
+	"\fTeamTreeNode\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12(\n" +
+	"\x10parent_team_name\x18\x02 \x01(\tR\x0eparentTeamName\x12.\n" +
+	"\x05stats\x18\x03 \x01(\v2\x18.prreviewer.v1.TeamStatsR\x05stats\x12=\n" +
+	"\rsubtree_stats\x18\x04 \x01(\v2\x18.prreviewer.v1.TeamStatsR\fsubtreeStats\x127\n" +
+	"\bchildren\x18\x05 \x03(\v2\x1b.prreviewer.v1.TeamTreeNodeR\bchildren\"\x8d\x01\n" +
+	"\x04User\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1b\n" +
+	"\tteam_name\x18\x03 \x01(\tR\bteamName\x12\x1b\n" +
+	"\tis_active\x18\x04 \x01(\bR\bisActive\x12\x16\n" +
+	"\x06skills\x18\x05 \x03(\tR\x06skills\"\xdb\x02\n" +
+	"\vPullRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12*\n" +
+	"\x11pull_request_name\x18\x02 \x01(\tR\x0fpullRequestName\x12\x1b\n" +
+	"\tauthor_id\x18\x03 \x01(\tR\bauthorId\x128\n" +
+	"\x06status\x18\x04 \x01(\x0e2 .prreviewer.v1.PullRequestStatusR\x06status\x12-\n" +
+	"\x12assigned_reviewers\x18\x05 \x03(\tR\x11assignedReviewers\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x127\n" +
+	"\tmerged_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\bmergedAt\"\xe8\x01\n" +
+	"\x11ReviewerSelection\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12!\n" +
+	"\fmatched_tags\x18\x03 \x03(\tR\vmatchedTags\x12!\n" +
+	"\fopen_reviews\x18\x04 \x01(\x05R\vopenReviews\x12\x1f\n" +
+	"\vskill_score\x18\x05 \x01(\x01R\n" +
+	"skillScore\x12%\n" +
+	"\x0eworkload_score\x18\x06 \x01(\x01R\rworkloadScore\x12\x14\n" +
+	"\x05score\x18\a \x01(\x01R\x05score\"D\n" +
+	"\x11ExcludedCandidate\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"\xe1\x01\n" +
+	"\x15AssignmentExplanation\x12\x1a\n" +
+	"\bstrategy\x18\x01 \x01(\tR\bstrategy\x12.\n" +
+	"\x13candidate_pool_size\x18\x02 \x01(\x05R\x11candidatePoolSize\x12>\n" +
+	"\treviewers\x18\x03 \x03(\v2 .prreviewer.v1.ReviewerSelectionR\treviewers\x12<\n" +
+	"\bexcluded\x18\x04 \x03(\v2 .prreviewer.v1.ExcludedCandidateR\bexcluded\"9\n" +
+	"\x0eAddTeamRequest\x12'\n" +
+	"\x04team\x18\x01 \x01(\v2\x13.prreviewer.v1.TeamR\x04team\"-\n" +
+	"\x0eGetTeamRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\"]\n" +
+	"\x14SetTeamParentRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12(\n" +
+	"\x10parent_team_name\x18\x02 \x01(\tR\x0eparentTeamName\"1\n" +
+	"\x12GetTeamTreeRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\"H\n" +
+	"\x13GetTeamTreeResponse\x121\n" +
+	"\x05teams\x18\x01 \x03(\v2\x1b.prreviewer.v1.TeamTreeNodeR\x05teams\"4\n" +
+	"\x15DeactivateTeamRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\"\xee\x01\n" +
+	"\x16DeactivateTeamResponse\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12+\n" +
+	"\x11deactivated_users\x18\x02 \x01(\x05R\x10deactivatedUsers\x12%\n" +
+	"\x0ereassigned_prs\x18\x03 \x01(\x05R\rreassignedPrs\x121\n" +
+	"\x14failed_reassignments\x18\x04 \x01(\x05R\x13failedReassignments\x120\n" +
+	"\x14deactivated_user_ids\x18\x05 \x03(\tR\x12deactivatedUserIds\"L\n" +
+	"\x14SetUserActiveRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tis_active\x18\x02 \x01(\bR\bisActive\"G\n" +
+	"\x14SetUserSkillsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06skills\x18\x02 \x03(\tR\x06skills\"^\n" +
+	"\x15GetUserReviewsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06cursor\x18\x03 \x01(\tR\x06cursor\"\x93\x01\n" +
+	"\x16GetUserReviewsResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12?\n" +
+	"\rpull_requests\x18\x02 \x03(\v2\x1a.prreviewer.v1.PullRequestR\fpullRequests\x12\x1f\n" +
+	"\vnext_cursor\x18\x03 \x01(\tR\n" +
+	"nextCursor\"\xf5\x01\n" +
+	"\x18CreatePullRequestRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12*\n" +
+	"\x11pull_request_name\x18\x02 \x01(\tR\x0fpullRequestName\x12\x1b\n" +
+	"\tauthor_id\x18\x03 \x01(\tR\bauthorId\x12\x1e\n" +
+	"\n" +
+	"repository\x18\x04 \x01(\tR\n" +
+	"repository\x12#\n" +
+	"\rchanged_files\x18\x05 \x03(\tR\fchangedFiles\x12#\n" +
+	"\rrequired_tags\x18\x06 \x03(\tR\frequiredTags\"\xab\x01\n" +
+	"\x19CreatePullRequestResponse\x12=\n" +
+	"\fpull_request\x18\x01 \x01(\v2\x1a.prreviewer.v1.PullRequestR\vpullRequest\x12O\n" +
+	"\x12reviewer_selection\x18\x02 \x03(\v2 .prreviewer.v1.ReviewerSelectionR\x11reviewerSelection\"Y\n" +
+	"\x15GetPullRequestRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12\x18\n" +
+	"\aexplain\x18\x02 \x01(\bR\aexplain\"\x9f\x01\n" +
+	"\x16GetPullRequestResponse\x12=\n" +
+	"\fpull_request\x18\x01 \x01(\v2\x1a.prreviewer.v1.PullRequestR\vpullRequest\x12F\n" +
+	"\vexplanation\x18\x02 \x01(\v2$.prreviewer.v1.AssignmentExplanationR\vexplanation\"\xd6\x02\n" +
+	"\x17ListPullRequestsRequest\x128\n" +
+	"\x06status\x18\x01 \x01(\x0e2 .prreviewer.v1.PullRequestStatusR\x06status\x12\x1b\n" +
+	"\tauthor_id\x18\x02 \x01(\tR\bauthorId\x12\x1f\n" +
+	"\vreviewer_id\x18\x03 \x01(\tR\n" +
+	"reviewerId\x12\x1b\n" +
+	"\tteam_name\x18\x04 \x01(\tR\bteamName\x12=\n" +
+	"\fcreated_from\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedFrom\x129\n" +
+	"\n" +
+	"created_to\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedTo\x12\x14\n" +
+	"\x05limit\x18\a \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06cursor\x18\b \x01(\tR\x06cursor\"|\n" +
+	"\x18ListPullRequestsResponse\x12?\n" +
+	"\rpull_requests\x18\x01 \x03(\v2\x1a.prreviewer.v1.PullRequestR\fpullRequests\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"A\n" +
+	"\x17MergePullRequestRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\"a\n" +
+	"\x17ReassignReviewerRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12\x1e\n" +
+	"\vold_user_id\x18\x02 \x01(\tR\toldUserId\"z\n" +
+	"\x18ReassignReviewerResponse\x12=\n" +
+	"\fpull_request\x18\x01 \x01(\v2\x1a.prreviewer.v1.PullRequestR\vpullRequest\x12\x1f\n" +
+	"\vreplaced_by\x18\x02 \x01(\tR\n" +
+	"replacedBy\"\xe8\x01\n" +
+	"\x15GetReviewStatsRequest\x12=\n" +
+	"\fcreated_from\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedFrom\x129\n" +
+	"\n" +
+	"created_to\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedTo\x12\x1b\n" +
+	"\tteam_name\x18\x03 \x01(\tR\bteamName\x128\n" +
+	"\x06status\x18\x04 \x01(\x0e2 .prreviewer.v1.PullRequestStatusR\x06status\"d\n" +
+	"\n" +
+	"ReviewStat\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12!\n" +
+	"\freview_count\x18\x03 \x01(\x03R\vreviewCount\"I\n" +
+	"\x16GetReviewStatsResponse\x12/\n" +
+	"\x05stats\x18\x01 \x03(\v2\x19.prreviewer.v1.ReviewStatR\x05stats\"\xee\x01\n" +
+	"\x1bGetPRAssignmentStatsRequest\x12=\n" +
+	"\fcreated_from\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedFrom\x129\n" +
+	"\n" +
+	"created_to\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedTo\x12\x1b\n" +
+	"\tteam_name\x18\x03 \x01(\tR\bteamName\x128\n" +
+	"\x06status\x18\x04 \x01(\x0e2 .prreviewer.v1.PullRequestStatusR\x06status\"\x8f\x01\n" +
+	"\x10PRAssignmentStat\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12*\n" +
+	"\x11pull_request_name\x18\x02 \x01(\tR\x0fpullRequestName\x12'\n" +
+	"\x0freviewers_count\x18\x03 \x01(\x03R\x0ereviewersCount\"U\n" +
+	"\x1cGetPRAssignmentStatsResponse\x125\n" +
+	"\x05stats\x18\x01 \x03(\v2\x1f.prreviewer.v1.PRAssignmentStatR\x05stats\"\xe6\x01\n" +
+	"\x13GetTeamStatsRequest\x12=\n" +
+	"\fcreated_from\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedFrom\x129\n" +
+	"\n" +
+	"created_to\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedTo\x12\x1b\n" +
+	"\tteam_name\x18\x03 \x01(\tR\bteamName\x128\n" +
+	"\x06status\x18\x04 \x01(\x0e2 .prreviewer.v1.PullRequestStatusR\x06status\"\xba\x02\n" +
+	"\x0eTeamReviewStat\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12#\n" +
+	"\rpull_requests\x18\x02 \x01(\x03R\fpullRequests\x12,\n" +
+	"\x12open_pull_requests\x18\x03 \x01(\x03R\x10openPullRequests\x12/\n" +
+	"\x14avg_reviewers_per_pr\x18\x04 \x01(\x01R\x11avgReviewersPerPr\x12#\n" +
+	"\rreviews_given\x18\x05 \x01(\x03R\freviewsGiven\x12)\n" +
+	"\x10reviews_received\x18\x06 \x01(\x03R\x0freviewsReceived\x127\n" +
+	"\x18cross_team_reviews_share\x18\a \x01(\x01R\x15crossTeamReviewsShare\"K\n" +
+	"\x14GetTeamStatsResponse\x123\n" +
+	"\x05stats\x18\x01 \x03(\v2\x1d.prreviewer.v1.TeamReviewStatR\x05stats\"\xb1\x01\n" +
+	"\x18GetFairnessReportRequest\x12=\n" +
+	"\fcreated_from\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedFrom\x129\n" +
+	"\n" +
+	"created_to\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedTo\x12\x1b\n" +
+	"\tteam_name\x18\x03 \x01(\tR\bteamName\"\xf3\x01\n" +
+	"\x0eMemberFairness\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1f\n" +
+	"\vactive_days\x18\x03 \x01(\x01R\n" +
+	"activeDays\x12 \n" +
+	"\vassignments\x18\x04 \x01(\x03R\vassignments\x121\n" +
+	"\x14expected_assignments\x18\x05 \x01(\x01R\x13expectedAssignments\x12\x1c\n" +
+	"\tdeviation\x18\x06 \x01(\x01R\tdeviation\x12\x18\n" +
+	"\aoutlier\x18\a \x01(\tR\aoutlier\"\xc0\x01\n" +
+	"\fTeamFairness\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12 \n" +
+	"\vassignments\x18\x02 \x01(\x03R\vassignments\x12\x12\n" +
+	"\x04gini\x18\x03 \x01(\x01R\x04gini\x12$\n" +
+	"\x0emax_min_spread\x18\x04 \x01(\x01R\fmaxMinSpread\x127\n" +
+	"\amembers\x18\x05 \x03(\v2\x1d.prreviewer.v1.MemberFairnessR\amembers\"\xc8\x01\n" +
+	"\x19GetFairnessReportResponse\x12=\n" +
+	"\fcreated_from\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedFrom\x129\n" +
+	"\n" +
+	"created_to\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedTo\x121\n" +
+	"\x05teams\x18\x03 \x03(\v2\x1b.prreviewer.v1.TeamFairnessR\x05teams\":\n" +
+	"\x17WatchAssignmentsRequest\x12\x1f\n" +
+	"\vreviewer_id\x18\x01 \x01(\tR\n" +
+	"reviewerId\"\xa8\x02\n" +
+	"\x0fAssignmentEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x126\n" +
+	"\x04type\x18\x02 \x01(\x0e2\".prreviewer.v1.AssignmentEventTypeR\x04type\x12&\n" +
+	"\x0fpull_request_id\x18\x03 \x01(\tR\rpullRequestId\x12*\n" +
+	"\x11pull_request_name\x18\x04 \x01(\tR\x0fpullRequestName\x12\x1b\n" +
+	"\tauthor_id\x18\x05 \x01(\tR\bauthorId\x12\x1f\n" +
+	"\vreviewer_id\x18\x06 \x01(\tR\n" +
+	"reviewerId\x12;\n" +
+	"\voccurred_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt*v\n" +
+	"\x11PullRequestStatus\x12#\n" +
+	"\x1fPULL_REQUEST_STATUS_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18PULL_REQUEST_STATUS_OPEN\x10\x01\x12\x1e\n" +
+	"\x1aPULL_REQUEST_STATUS_MERGED\x10\x02*\xa8\x01\n" +
+	"\x13AssignmentEventType\x12%\n" +
+	"!ASSIGNMENT_EVENT_TYPE_UNSPECIFIED\x10\x00\x12\"\n" +
+	"\x1eASSIGNMENT_EVENT_TYPE_ASSIGNED\x10\x01\x12$\n" +
+	" ASSIGNMENT_EVENT_TYPE_UNASSIGNED\x10\x02\x12 \n" +
+	"\x1cASSIGNMENT_EVENT_TYPE_MERGED\x10\x032\xdc\f\n" +
+	"\x0fReviewerService\x12=\n" +
+	"\aAddTeam\x12\x1d.prreviewer.v1.AddTeamRequest\x1a\x13.prreviewer.v1.Team\x12=\n" +
+	"\aGetTeam\x12\x1d.prreviewer.v1.GetTeamRequest\x1a\x13.prreviewer.v1.Team\x12I\n" +
+	"\rSetTeamParent\x12#.prreviewer.v1.SetTeamParentRequest\x1a\x13.prreviewer.v1.Team\x12T\n" +
+	"\vGetTeamTree\x12!.prreviewer.v1.GetTeamTreeRequest\x1a\".prreviewer.v1.GetTeamTreeResponse\x12]\n" +
+	"\x0eDeactivateTeam\x12$.prreviewer.v1.DeactivateTeamRequest\x1a%.prreviewer.v1.DeactivateTeamResponse\x12I\n" +
+	"\rSetUserActive\x12#.prreviewer.v1.SetUserActiveRequest\x1a\x13.prreviewer.v1.User\x12I\n" +
+	"\rSetUserSkills\x12#.prreviewer.v1.SetUserSkillsRequest\x1a\x13.prreviewer.v1.User\x12]\n" +
+	"\x0eGetUserReviews\x12$.prreviewer.v1.GetUserReviewsRequest\x1a%.prreviewer.v1.GetUserReviewsResponse\x12f\n" +
+	"\x11CreatePullRequest\x12'.prreviewer.v1.CreatePullRequestRequest\x1a(.prreviewer.v1.CreatePullRequestResponse\x12]\n" +
+	"\x0eGetPullRequest\x12$.prreviewer.v1.GetPullRequestRequest\x1a%.prreviewer.v1.GetPullRequestResponse\x12c\n" +
+	"\x10ListPullRequests\x12&.prreviewer.v1.ListPullRequestsRequest\x1a'.prreviewer.v1.ListPullRequestsResponse\x12V\n" +
+	"\x10MergePullRequest\x12&.prreviewer.v1.MergePullRequestRequest\x1a\x1a.prreviewer.v1.PullRequest\x12c\n" +
+	"\x10ReassignReviewer\x12&.prreviewer.v1.ReassignReviewerRequest\x1a'.prreviewer.v1.ReassignReviewerResponse\x12]\n" +
+	"\x0eGetReviewStats\x12$.prreviewer.v1.GetReviewStatsRequest\x1a%.prreviewer.v1.GetReviewStatsResponse\x12o\n" +
+	"\x14GetPRAssignmentStats\x12*.prreviewer.v1.GetPRAssignmentStatsRequest\x1a+.prreviewer.v1.GetPRAssignmentStatsResponse\x12W\n" +
+	"\fGetTeamStats\x12\".prreviewer.v1.GetTeamStatsRequest\x1a#.prreviewer.v1.GetTeamStatsResponse\x12f\n" +
+	"\x11GetFairnessReport\x12'.prreviewer.v1.GetFairnessReportRequest\x1a(.prreviewer.v1.GetFairnessReportResponse\x12\\\n" +
+	"\x10WatchAssignments\x12&.prreviewer.v1.WatchAssignmentsRequest\x1a\x1e.prreviewer.v1.AssignmentEvent0\x01B/Z-pr-reviewer-service/api/reviewerpb;reviewerpbb\x06proto3"
+
+var (
+	file_reviewer_proto_rawDescOnce sync.Once
+	file_reviewer_proto_rawDescData []byte
+)
+
+func file_reviewer_proto_rawDescGZIP() []byte {
+	file_reviewer_proto_rawDescOnce.Do(func() {
+		file_reviewer_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_reviewer_proto_rawDesc), len(file_reviewer_proto_rawDesc)))
+	})
+	return file_reviewer_proto_rawDescData
+}
+
+var file_reviewer_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_reviewer_proto_msgTypes = make([]protoimpl.MessageInfo, 44)
+var file_reviewer_proto_goTypes = []any{
+	(PullRequestStatus)(0),               // 0: prreviewer.v1.PullRequestStatus
+	(AssignmentEventType)(0),             // 1: prreviewer.v1.AssignmentEventType
+	(*TeamMember)(nil),                   // 2: prreviewer.v1.TeamMember
+	(*Team)(nil),                         // 3: prreviewer.v1.Team
+	(*TeamStats)(nil),                    // 4: prreviewer.v1.TeamStats
+	(*TeamTreeNode)(nil),                 // 5: prreviewer.v1.TeamTreeNode
+	(*User)(nil),                         // 6: prreviewer.v1.User
+	(*PullRequest)(nil),                  // 7: prreviewer.v1.PullRequest
+	(*ReviewerSelection)(nil),            // 8: prreviewer.v1.ReviewerSelection
+	(*ExcludedCandidate)(nil),            // 9: prreviewer.v1.ExcludedCandidate
+	(*AssignmentExplanation)(nil),        // 10: prreviewer.v1.AssignmentExplanation
+	(*AddTeamRequest)(nil),               // 11: prreviewer.v1.AddTeamRequest
+	(*GetTeamRequest)(nil),               // 12: prreviewer.v1.GetTeamRequest
+	(*SetTeamParentRequest)(nil),         // 13: prreviewer.v1.SetTeamParentRequest
+	(*GetTeamTreeRequest)(nil),           // 14: prreviewer.v1.GetTeamTreeRequest
+	(*GetTeamTreeResponse)(nil),          // 15: prreviewer.v1.GetTeamTreeResponse
+	(*DeactivateTeamRequest)(nil),        // 16: prreviewer.v1.DeactivateTeamRequest
+	(*DeactivateTeamResponse)(nil),       // 17: prreviewer.v1.DeactivateTeamResponse
+	(*SetUserActiveRequest)(nil),         // 18: prreviewer.v1.SetUserActiveRequest
+	(*SetUserSkillsRequest)(nil),         // 19: prreviewer.v1.SetUserSkillsRequest
+	(*GetUserReviewsRequest)(nil),        // 20: prreviewer.v1.GetUserReviewsRequest
+	(*GetUserReviewsResponse)(nil),       // 21: prreviewer.v1.GetUserReviewsResponse
+	(*CreatePullRequestRequest)(nil),     // 22: prreviewer.v1.CreatePullRequestRequest
+	(*CreatePullRequestResponse)(nil),    // 23: prreviewer.v1.CreatePullRequestResponse
+	(*GetPullRequestRequest)(nil),        // 24: prreviewer.v1.GetPullRequestRequest
+	(*GetPullRequestResponse)(nil),       // 25: prreviewer.v1.GetPullRequestResponse
+	(*ListPullRequestsRequest)(nil),      // 26: prreviewer.v1.ListPullRequestsRequest
+	(*ListPullRequestsResponse)(nil),     // 27: prreviewer.v1.ListPullRequestsResponse
+	(*MergePullRequestRequest)(nil),      // 28: prreviewer.v1.MergePullRequestRequest
+	(*ReassignReviewerRequest)(nil),      // 29: prreviewer.v1.ReassignReviewerRequest
+	(*ReassignReviewerResponse)(nil),     // 30: prreviewer.v1.ReassignReviewerResponse
+	(*GetReviewStatsRequest)(nil),        // 31: prreviewer.v1.GetReviewStatsRequest
+	(*ReviewStat)(nil),                   // 32: prreviewer.v1.ReviewStat
+	(*GetReviewStatsResponse)(nil),       // 33: prreviewer.v1.GetReviewStatsResponse
+	(*GetPRAssignmentStatsRequest)(nil),  // 34: prreviewer.v1.GetPRAssignmentStatsRequest
+	(*PRAssignmentStat)(nil),             // 35: prreviewer.v1.PRAssignmentStat
+	(*GetPRAssignmentStatsResponse)(nil), // 36: prreviewer.v1.GetPRAssignmentStatsResponse
+	(*GetTeamStatsRequest)(nil),          // 37: prreviewer.v1.GetTeamStatsRequest
+	(*TeamReviewStat)(nil),               // 38: prreviewer.v1.TeamReviewStat
+	(*GetTeamStatsResponse)(nil),         // 39: prreviewer.v1.GetTeamStatsResponse
+	(*GetFairnessReportRequest)(nil),     // 40: prreviewer.v1.GetFairnessReportRequest
+	(*MemberFairness)(nil),               // 41: prreviewer.v1.MemberFairness
+	(*TeamFairness)(nil),                 // 42: prreviewer.v1.TeamFairness
+	(*GetFairnessReportResponse)(nil),    // 43: prreviewer.v1.GetFairnessReportResponse
+	(*WatchAssignmentsRequest)(nil),      // 44: prreviewer.v1.WatchAssignmentsRequest
+	(*AssignmentEvent)(nil),              // 45: prreviewer.v1.AssignmentEvent
+	(*timestamppb.Timestamp)(nil),        // 46: google.protobuf.Timestamp
+}
+var file_reviewer_proto_depIdxs = []int32{
+	2,  // 0: prreviewer.v1.Team.members:type_name -> prreviewer.v1.TeamMember
+	4,  // 1: prreviewer.v1.TeamTreeNode.stats:type_name -> prreviewer.v1.TeamStats
+	4,  // 2: prreviewer.v1.TeamTreeNode.subtree_stats:type_name -> prreviewer.v1.TeamStats
+	5,  // 3: prreviewer.v1.TeamTreeNode.children:type_name -> prreviewer.v1.TeamTreeNode
+	0,  // 4: prreviewer.v1.PullRequest.status:type_name -> prreviewer.v1.PullRequestStatus
+	46, // 5: prreviewer.v1.PullRequest.created_at:type_name -> google.protobuf.Timestamp
+	46, // 6: prreviewer.v1.PullRequest.merged_at:type_name -> google.protobuf.Timestamp
+	8,  // 7: prreviewer.v1.AssignmentExplanation.reviewers:type_name -> prreviewer.v1.ReviewerSelection
+	9,  // 8: prreviewer.v1.AssignmentExplanation.excluded:type_name -> prreviewer.v1.ExcludedCandidate
+	3,  // 9: prreviewer.v1.AddTeamRequest.team:type_name -> prreviewer.v1.Team
+	5,  // 10: prreviewer.v1.GetTeamTreeResponse.teams:type_name -> prreviewer.v1.TeamTreeNode
+	7,  // 11: prreviewer.v1.GetUserReviewsResponse.pull_requests:type_name -> prreviewer.v1.PullRequest
+	7,  // 12: prreviewer.v1.CreatePullRequestResponse.pull_request:type_name -> prreviewer.v1.PullRequest
+	8,  // 13: prreviewer.v1.CreatePullRequestResponse.reviewer_selection:type_name -> prreviewer.v1.ReviewerSelection
+	7,  // 14: prreviewer.v1.GetPullRequestResponse.pull_request:type_name -> prreviewer.v1.PullRequest
+	10, // 15: prreviewer.v1.GetPullRequestResponse.explanation:type_name -> prreviewer.v1.AssignmentExplanation
+	0,  // 16: prreviewer.v1.ListPullRequestsRequest.status:type_name -> prreviewer.v1.PullRequestStatus
+	46, // 17: prreviewer.v1.ListPullRequestsRequest.created_from:type_name -> google.protobuf.Timestamp
+	46, // 18: prreviewer.v1.ListPullRequestsRequest.created_to:type_name -> google.protobuf.Timestamp
+	7,  // 19: prreviewer.v1.ListPullRequestsResponse.pull_requests:type_name -> prreviewer.v1.PullRequest
+	7,  // 20: prreviewer.v1.ReassignReviewerResponse.pull_request:type_name -> prreviewer.v1.PullRequest
+	46, // 21: prreviewer.v1.GetReviewStatsRequest.created_from:type_name -> google.protobuf.Timestamp
+	46, // 22: prreviewer.v1.GetReviewStatsRequest.created_to:type_name -> google.protobuf.Timestamp
+	0,  // 23: prreviewer.v1.GetReviewStatsRequest.status:type_name -> prreviewer.v1.PullRequestStatus
+	32, // 24: prreviewer.v1.GetReviewStatsResponse.stats:type_name -> prreviewer.v1.ReviewStat
+	46, // 25: prreviewer.v1.GetPRAssignmentStatsRequest.created_from:type_name -> google.protobuf.Timestamp
+	46, // 26: prreviewer.v1.GetPRAssignmentStatsRequest.created_to:type_name -> google.protobuf.Timestamp
+	0,  // 27: prreviewer.v1.GetPRAssignmentStatsRequest.status:type_name -> prreviewer.v1.PullRequestStatus
+	35, // 28: prreviewer.v1.GetPRAssignmentStatsResponse.stats:type_name -> prreviewer.v1.PRAssignmentStat
+	46, // 29: prreviewer.v1.GetTeamStatsRequest.created_from:type_name -> google.protobuf.Timestamp
+	46, // 30: prreviewer.v1.GetTeamStatsRequest.created_to:type_name -> google.protobuf.Timestamp
+	0,  // 31: prreviewer.v1.GetTeamStatsRequest.status:type_name -> prreviewer.v1.PullRequestStatus
+	38, // 32: prreviewer.v1.GetTeamStatsResponse.stats:type_name -> prreviewer.v1.TeamReviewStat
+	46, // 33: prreviewer.v1.GetFairnessReportRequest.created_from:type_name -> google.protobuf.Timestamp
+	46, // 34: prreviewer.v1.GetFairnessReportRequest.created_to:type_name -> google.protobuf.Timestamp
+	41, // 35: prreviewer.v1.TeamFairness.members:type_name -> prreviewer.v1.MemberFairness
+	46, // 36: prreviewer.v1.GetFairnessReportResponse.created_from:type_name -> google.protobuf.Timestamp
+	46, // 37: prreviewer.v1.GetFairnessReportResponse.created_to:type_name -> google.protobuf.Timestamp
+	42, // 38: prreviewer.v1.GetFairnessReportResponse.teams:type_name -> prreviewer.v1.TeamFairness
+	1,  // 39: prreviewer.v1.AssignmentEvent.type:type_name -> prreviewer.v1.AssignmentEventType
+	46, // 40: prreviewer.v1.AssignmentEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	11, // 41: prreviewer.v1.ReviewerService.AddTeam:input_type -> prreviewer.v1.AddTeamRequest
+	12, // 42: prreviewer.v1.ReviewerService.GetTeam:input_type -> prreviewer.v1.GetTeamRequest
+	13, // 43: prreviewer.v1.ReviewerService.SetTeamParent:input_type -> prreviewer.v1.SetTeamParentRequest
+	14, // 44: prreviewer.v1.ReviewerService.GetTeamTree:input_type -> prreviewer.v1.GetTeamTreeRequest
+	16, // 45: prreviewer.v1.ReviewerService.DeactivateTeam:input_type -> prreviewer.v1.DeactivateTeamRequest
+	18, // 46: prreviewer.v1.ReviewerService.SetUserActive:input_type -> prreviewer.v1.SetUserActiveRequest
+	19, // 47: prreviewer.v1.ReviewerService.SetUserSkills:input_type -> prreviewer.v1.SetUserSkillsRequest
+	20, // 48: prreviewer.v1.ReviewerService.GetUserReviews:input_type -> prreviewer.v1.GetUserReviewsRequest
+	22, // 49: prreviewer.v1.ReviewerService.CreatePullRequest:input_type -> prreviewer.v1.CreatePullRequestRequest
+	24, // 50: prreviewer.v1.ReviewerService.GetPullRequest:input_type -> prreviewer.v1.GetPullRequestRequest
+	26, // 51: prreviewer.v1.ReviewerService.ListPullRequests:input_type -> prreviewer.v1.ListPullRequestsRequest
+	28, // 52: prreviewer.v1.ReviewerService.MergePullRequest:input_type -> prreviewer.v1.MergePullRequestRequest
+	29, // 53: prreviewer.v1.ReviewerService.ReassignReviewer:input_type -> prreviewer.v1.ReassignReviewerRequest
+	31, // 54: prreviewer.v1.ReviewerService.GetReviewStats:input_type -> prreviewer.v1.GetReviewStatsRequest
+	34, // 55: prreviewer.v1.ReviewerService.GetPRAssignmentStats:input_type -> prreviewer.v1.GetPRAssignmentStatsRequest
+	37, // 56: prreviewer.v1.ReviewerService.GetTeamStats:input_type -> prreviewer.v1.GetTeamStatsRequest
+	40, // 57: prreviewer.v1.ReviewerService.GetFairnessReport:input_type -> prreviewer.v1.GetFairnessReportRequest
+	44, // 58: prreviewer.v1.ReviewerService.WatchAssignments:input_type -> prreviewer.v1.WatchAssignmentsRequest
+	3,  // 59: prreviewer.v1.ReviewerService.AddTeam:output_type -> prreviewer.v1.Team
+	3,  // 60: prreviewer.v1.ReviewerService.GetTeam:output_type -> prreviewer.v1.Team
+	3,  // 61: prreviewer.v1.ReviewerService.SetTeamParent:output_type -> prreviewer.v1.Team
+	15, // 62: prreviewer.v1.ReviewerService.GetTeamTree:output_type -> prreviewer.v1.GetTeamTreeResponse
+	17, // 63: prreviewer.v1.ReviewerService.DeactivateTeam:output_type -> prreviewer.v1.DeactivateTeamResponse
+	6,  // 64: prreviewer.v1.ReviewerService.SetUserActive:output_type -> prreviewer.v1.User
+	6,  // 65: prreviewer.v1.ReviewerService.SetUserSkills:output_type -> prreviewer.v1.User
+	21, // 66: prreviewer.v1.ReviewerService.GetUserReviews:output_type -> prreviewer.v1.GetUserReviewsResponse
+	23, // 67: prreviewer.v1.ReviewerService.CreatePullRequest:output_type -> prreviewer.v1.CreatePullRequestResponse
+	25, // 68: prreviewer.v1.ReviewerService.GetPullRequest:output_type -> prreviewer.v1.GetPullRequestResponse
+	27, // 69: prreviewer.v1.ReviewerService.ListPullRequests:output_type -> prreviewer.v1.ListPullRequestsResponse
+	7,  // 70: prreviewer.v1.ReviewerService.MergePullRequest:output_type -> prreviewer.v1.PullRequest
+	30, // 71: prreviewer.v1.ReviewerService.ReassignReviewer:output_type -> prreviewer.v1.ReassignReviewerResponse
+	33, // 72: prreviewer.v1.ReviewerService.GetReviewStats:output_type -> prreviewer.v1.GetReviewStatsResponse
+	36, // 73: prreviewer.v1.ReviewerService.GetPRAssignmentStats:output_type -> prreviewer.v1.GetPRAssignmentStatsResponse
+	39, // 74: prreviewer.v1.ReviewerService.GetTeamStats:output_type -> prreviewer.v1.GetTeamStatsResponse
+	43, // 75: prreviewer.v1.ReviewerService.GetFairnessReport:output_type -> prreviewer.v1.GetFairnessReportResponse
+	45, // 76: prreviewer.v1.ReviewerService.WatchAssignments:output_type -> prreviewer.v1.AssignmentEvent
+	59, // [59:77] is the sub-list for method output_type
+	41, // [41:59] is the sub-list for method input_type
+	41, // [41:41] is the sub-list for extension type_name
+	41, // [41:41] is the sub-list for extension extendee
+	0,  // [0:41] is the sub-list for field type_name
+}
+
+func init() { file_reviewer_proto_init() }
+func file_reviewer_proto_init() {
+	if File_reviewer_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_reviewer_proto_rawDesc), len(file_reviewer_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   44,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_reviewer_proto_goTypes,
+		DependencyIndexes: file_reviewer_proto_depIdxs,
+		EnumInfos:         file_reviewer_proto_enumTypes,
+		MessageInfos:      file_reviewer_proto_msgTypes,
+	}.Build()
+	File_reviewer_proto = out.File
+	file_reviewer_proto_goTypes = nil
+	file_reviewer_proto_depIdxs = nil
+}
@@ -0,0 +1,63 @@
+package client
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// Export выгружает все команды, пользователей и PR в порядке, пригодном для Import.
+func (a *API) Export(ctx context.Context) ([]ExportRecord, error) {
+	resp, err := call(ctx, a, true, func(ctx context.Context) (*GetAdminExportResponse, error) {
+		return a.raw.GetAdminExportWithResponse(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, newError(resp.HTTPResponse, resp.Body)
+	}
+
+	records := []ExportRecord{}
+	scanner := bufio.NewScanner(bytes.NewReader(resp.Body))
+	scanner.Buffer(nil, len(resp.Body)+1)
+	for scanner.Scan() {
+		var record ExportRecord
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	return records, scanner.Err()
+}
+
+// Import загружает записи в одной транзакции. Ошибочные записи не считаются ошибкой вызова:
+// их перечисляет отчет, а Committed = false.
+func (a *API) Import(ctx context.Context, records []ExportRecord, params PostAdminImportParams) (*ImportReport, error) {
+	var body bytes.Buffer
+	encoder := json.NewEncoder(&body)
+	for _, record := range records {
+		if err := encoder.Encode(record); err != nil {
+			return nil, err
+		}
+	}
+
+	// Повтор безопасен только для проверки без сохранения
+	idempotent := params.DryRun != nil && *params.DryRun
+	resp, err := call(ctx, a, idempotent, func(ctx context.Context) (*PostAdminImportResponse, error) {
+		return a.raw.PostAdminImportWithBodyWithResponse(ctx, &params, "application/x-ndjson", bytes.NewReader(body.Bytes()))
+	})
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case resp.JSON200 != nil:
+		return resp.JSON200, nil
+	case resp.JSON422 != nil:
+		return resp.JSON422, nil
+	default:
+		return nil, newError(resp.HTTPResponse, resp.Body)
+	}
+}
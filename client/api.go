@@ -0,0 +1,187 @@
+package client
+
+//go:generate go run github.com/deepmap/oapi-codegen/cmd/oapi-codegen@v1.16.3 --config=oapi-codegen.yaml ../api/openapi.yaml
+
+import (
+	"context"
+	cryptorand "crypto/rand"
+	"encoding/hex"
+	"errors"
+	"math/rand/v2"
+	"net/http"
+	"time"
+)
+
+// API - обертка над сгенерированным клиентом: методы принимают и возвращают модели API,
+// ответы с ошибкой превращаются в *Error (сопоставимый с domain.Err* через errors.Is),
+// а идемпотентные вызовы повторяются при сетевых ошибках и 502/503/504.
+type API struct {
+	raw     ClientWithResponsesInterface
+	retry   RetryPolicy
+	timeout time.Duration
+
+	idempotencyKeys bool
+}
+
+// RetryPolicy задает повторы идемпотентных вызовов: до Attempts попыток с паузой Backoff,
+// удваивающейся после каждой неудачи (не больше MaxBackoff).
+type RetryPolicy struct {
+	Attempts   int
+	Backoff    time.Duration
+	MaxBackoff time.Duration
+}
+
+// DefaultRetryPolicy - политика повторов по умолчанию.
+var DefaultRetryPolicy = RetryPolicy{Attempts: 3, Backoff: 100 * time.Millisecond, MaxBackoff: 2 * time.Second}
+
+// Option настраивает API.
+type Option func(*apiOptions)
+
+type apiOptions struct {
+	clientOpts      []ClientOption
+	retry           RetryPolicy
+	timeout         time.Duration
+	idempotencyKeys bool
+}
+
+// WithToken добавляет в каждый запрос заголовок Authorization: Bearer <token>.
+func WithToken(token string) Option {
+	return func(o *apiOptions) {
+		o.clientOpts = append(o.clientOpts, WithRequestEditorFn(func(_ context.Context, req *http.Request) error {
+			req.Header.Set("Authorization", "Bearer "+token)
+			return nil
+		}))
+	}
+}
+
+// WithDoer заменяет HTTP-клиент (например, для своих таймаутов транспорта или TLS).
+func WithDoer(doer HttpRequestDoer) Option {
+	return func(o *apiOptions) {
+		o.clientOpts = append(o.clientOpts, WithHTTPClient(doer))
+	}
+}
+
+// WithClientOptions передает опции сгенерированному клиенту (например WithRequestEditorFn).
+func WithClientOptions(opts ...ClientOption) Option {
+	return func(o *apiOptions) {
+		o.clientOpts = append(o.clientOpts, opts...)
+	}
+}
+
+// WithRetryPolicy задает повторы идемпотентных вызовов; Attempts <= 1 отключает повторы.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(o *apiOptions) {
+		o.retry = policy
+	}
+}
+
+// WithTimeout ограничивает каждый вызов (вместе с повторами), если у контекста нет своего дедлайна.
+func WithTimeout(timeout time.Duration) Option {
+	return func(o *apiOptions) {
+		o.timeout = timeout
+	}
+}
+
+// WithIdempotencyKeys отправляет создание PR и переназначение с заголовком Idempotency-Key
+// (один ключ на все попытки вызова) и повторяет их, как идемпотентные вызовы.
+// Сервис без поддержки Idempotency-Key выполнил бы повтор заново.
+func WithIdempotencyKeys() Option {
+	return func(o *apiOptions) {
+		o.idempotencyKeys = true
+	}
+}
+
+// New создает API для сервиса по адресу baseURL (например http://localhost:8080).
+func New(baseURL string, opts ...Option) (*API, error) {
+	o := apiOptions{retry: DefaultRetryPolicy}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	raw, err := NewClientWithResponses(baseURL, o.clientOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &API{raw: raw, retry: o.retry, timeout: o.timeout, idempotencyKeys: o.idempotencyKeys}, nil
+}
+
+// Raw возвращает сгенерированный клиент для вызовов, которых нет в обертке.
+func (a *API) Raw() ClientWithResponsesInterface {
+	return a.raw
+}
+
+// response - общее у сгенерированных типизированных ответов
+type response interface {
+	StatusCode() int
+}
+
+// call выполняет запрос с таймаутом по умолчанию; идемпотентные запросы повторяются
+// при временных ошибках. Повторы прекращаются, когда истекает контекст.
+func call[R response](ctx context.Context, a *API, idempotent bool, fn func(ctx context.Context) (R, error)) (R, error) {
+	if _, ok := ctx.Deadline(); !ok && a.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, a.timeout)
+		defer cancel()
+	}
+
+	attempts := 1
+	if idempotent && a.retry.Attempts > 1 {
+		attempts = a.retry.Attempts
+	}
+
+	backoff := a.retry.Backoff
+	for attempt := 1; ; attempt++ {
+		resp, err := fn(ctx)
+		if attempt >= attempts || !retryable(ctx, resp, err) {
+			return resp, err
+		}
+
+		timer := time.NewTimer(jitter(backoff))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return resp, err
+		case <-timer.C:
+		}
+
+		backoff *= 2
+		if a.retry.MaxBackoff > 0 && backoff > a.retry.MaxBackoff {
+			backoff = a.retry.MaxBackoff
+		}
+	}
+}
+
+// retryable - ошибка транспорта (не отмена контекста) или временная недоступность сервиса
+func retryable(ctx context.Context, resp response, err error) bool {
+	if err != nil {
+		return ctx.Err() == nil && !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+	}
+
+	switch resp.StatusCode() {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+// jitter добавляет к паузе до 50% случайной величины, чтобы клиенты не повторяли синхронно
+func jitter(d time.Duration) time.Duration {
+	if d <= 0 {
+		return 0
+	}
+	return d + rand.N(d/2+1) //nolint:gosec // не криптография
+}
+
+// idempotencyKey генерирует ключ из 32 hex-символов для всех попыток одного вызова
+// или возвращает nil без WithIdempotencyKeys
+func (a *API) idempotencyKey() *IdempotencyKey {
+	if !a.idempotencyKeys {
+		return nil
+	}
+	b := make([]byte, 16)
+	_, _ = cryptorand.Read(b)
+	key := hex.EncodeToString(b)
+	return &key
+}
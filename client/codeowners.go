@@ -0,0 +1,32 @@
+package client
+
+import "context"
+
+// SetCodeOwners полностью заменяет правила владения кодом репозитория.
+func (a *API) SetCodeOwners(ctx context.Context, repository string, rules []OwnershipRule) ([]OwnershipRule, error) {
+	body := PostCodeOwnersSetJSONRequestBody{Repository: repository, Rules: rules}
+	resp, err := call(ctx, a, true, func(ctx context.Context) (*PostCodeOwnersSetResponse, error) {
+		return a.raw.PostCodeOwnersSetWithResponse(ctx, body)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, newError(resp.HTTPResponse, resp.Body)
+	}
+	return resp.JSON200.Rules, nil
+}
+
+// GetCodeOwners возвращает правила владения кодом репозитория в порядке применения.
+func (a *API) GetCodeOwners(ctx context.Context, repository string) ([]OwnershipRule, error) {
+	resp, err := call(ctx, a, true, func(ctx context.Context) (*GetCodeOwnersGetResponse, error) {
+		return a.raw.GetCodeOwnersGetWithResponse(ctx, &GetCodeOwnersGetParams{Repository: repository})
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, newError(resp.HTTPResponse, resp.Body)
+	}
+	return resp.JSON200.Rules, nil
+}
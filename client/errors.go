@@ -0,0 +1,55 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"pr-reviewer-service/internal/domain"
+)
+
+// Error - ответ сервиса с ошибкой. Если код и сообщение соответствуют domain ошибке,
+// она доступна через errors.Is: errors.Is(err, domain.ErrPRNotFound).
+type Error struct {
+	StatusCode int
+	Code       string
+	Message    string
+
+	domainErr error
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s: %s (HTTP %d)", e.Code, e.Message, e.StatusCode)
+}
+
+// Unwrap возвращает соответствующую domain ошибку или nil.
+func (e *Error) Unwrap() error {
+	return e.domainErr
+}
+
+// newError разбирает тело ответа в формате ErrorResponse; для ответов в другом формате
+// (например от прокси) код строится из HTTP-статуса
+func newError(resp *http.Response, body []byte) error {
+	status := 0
+	if resp != nil {
+		status = resp.StatusCode
+	}
+
+	var errResp ErrorResponse
+	if err := json.Unmarshal(body, &errResp); err == nil && errResp.Error.Code != "" {
+		code, message := string(errResp.Error.Code), errResp.Error.Message
+		return &Error{
+			StatusCode: status,
+			Code:       code,
+			Message:    message,
+			domainErr:  domain.FromHTTPError(code, message),
+		}
+	}
+
+	message := strings.TrimSpace(string(body))
+	if message == "" {
+		message = http.StatusText(status)
+	}
+	return &Error{StatusCode: status, Code: fmt.Sprintf("HTTP_%d", status), Message: message}
+}
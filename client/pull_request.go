@@ -0,0 +1,101 @@
+package client
+
+import "context"
+
+// CreatedPullRequest - созданный PR и объяснение выбора его ревьюверов.
+type CreatedPullRequest struct {
+	PullRequest       PullRequest
+	ReviewerSelection []ReviewerSelection
+}
+
+// PullRequestPage - страница списка PR; NextCursor равен nil на последней странице.
+type PullRequestPage struct {
+	PullRequests []PullRequest
+	NextCursor   *string
+}
+
+// CreatePullRequest создает PR; ревьюверы назначаются сервисом.
+// Повторяется только с WithIdempotencyKeys: без ключа повтор после потерянного ответа вернул бы PR_EXISTS.
+func (a *API) CreatePullRequest(ctx context.Context, req PostPullRequestCreateJSONRequestBody) (*CreatedPullRequest, error) {
+	params := &PostPullRequestCreateParams{IdempotencyKey: a.idempotencyKey()}
+	resp, err := call(ctx, a, a.idempotencyKeys, func(ctx context.Context) (*PostPullRequestCreateResponse, error) {
+		return a.raw.PostPullRequestCreateWithResponse(ctx, params, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON201 == nil || resp.JSON201.Pr == nil {
+		return nil, newError(resp.HTTPResponse, resp.Body)
+	}
+
+	created := &CreatedPullRequest{PullRequest: *resp.JSON201.Pr}
+	if resp.JSON201.ReviewerSelection != nil {
+		created.ReviewerSelection = *resp.JSON201.ReviewerSelection
+	}
+	return created, nil
+}
+
+// GetPullRequest возвращает PR; при explain=true - и объяснение выбора ревьюверов (может быть nil).
+func (a *API) GetPullRequest(ctx context.Context, pullRequestID string, explain bool) (*PullRequest, *AssignmentExplanation, error) {
+	params := &GetPullRequestGetParams{PullRequestId: pullRequestID}
+	if explain {
+		params.Explain = &explain
+	}
+
+	resp, err := call(ctx, a, true, func(ctx context.Context) (*GetPullRequestGetResponse, error) {
+		return a.raw.GetPullRequestGetWithResponse(ctx, params)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, nil, newError(resp.HTTPResponse, resp.Body)
+	}
+	return &resp.JSON200.Pr, resp.JSON200.Explanation, nil
+}
+
+// ListPullRequests возвращает страницу PR по фильтрам; следующая страница - с params.Cursor = NextCursor.
+func (a *API) ListPullRequests(ctx context.Context, params GetPullRequestListParams) (*PullRequestPage, error) {
+	resp, err := call(ctx, a, true, func(ctx context.Context) (*GetPullRequestListResponse, error) {
+		return a.raw.GetPullRequestListWithResponse(ctx, &params)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, newError(resp.HTTPResponse, resp.Body)
+	}
+	return &PullRequestPage{PullRequests: resp.JSON200.PullRequests, NextCursor: resp.JSON200.NextCursor}, nil
+}
+
+// MergePullRequest переводит PR в MERGED. Операция идемпотентна и повторяется при сбоях.
+func (a *API) MergePullRequest(ctx context.Context, pullRequestID string) (*PullRequest, error) {
+	body := PostPullRequestMergeJSONRequestBody{PullRequestId: pullRequestID}
+	resp, err := call(ctx, a, true, func(ctx context.Context) (*PostPullRequestMergeResponse, error) {
+		return a.raw.PostPullRequestMergeWithResponse(ctx, &PostPullRequestMergeParams{}, body)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil || resp.JSON200.Pr == nil {
+		return nil, newError(resp.HTTPResponse, resp.Body)
+	}
+	return resp.JSON200.Pr, nil
+}
+
+// ReassignReviewer заменяет ревьювера oldUserID и возвращает PR и идентификатор нового ревьювера.
+// Повторяется только с WithIdempotencyKeys: без ключа повтор заменил бы уже нового ревьювера.
+func (a *API) ReassignReviewer(ctx context.Context, pullRequestID, oldUserID string) (*PullRequest, string, error) {
+	params := &PostPullRequestReassignParams{IdempotencyKey: a.idempotencyKey()}
+	body := PostPullRequestReassignJSONRequestBody{PullRequestId: pullRequestID, OldUserId: oldUserID}
+	resp, err := call(ctx, a, a.idempotencyKeys, func(ctx context.Context) (*PostPullRequestReassignResponse, error) {
+		return a.raw.PostPullRequestReassignWithResponse(ctx, params, body)
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	if resp.JSON200 == nil {
+		return nil, "", newError(resp.HTTPResponse, resp.Body)
+	}
+	return &resp.JSON200.Pr, resp.JSON200.ReplacedBy, nil
+}
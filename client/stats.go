@@ -0,0 +1,59 @@
+package client
+
+import "context"
+
+// ReviewStats возвращает статистику назначений по пользователям; params ограничивают выборку PR.
+func (a *API) ReviewStats(ctx context.Context, params GetStatsReviewsParams) ([]ReviewStat, error) {
+	resp, err := call(ctx, a, true, func(ctx context.Context) (*GetStatsReviewsResponse, error) {
+		return a.raw.GetStatsReviewsWithResponse(ctx, &params)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, newError(resp.HTTPResponse, resp.Body)
+	}
+	return resp.JSON200.Stats, nil
+}
+
+// PRAssignmentStats возвращает число ревьюверов по PR, подходящим под params.
+func (a *API) PRAssignmentStats(ctx context.Context, params GetStatsPrAssignmentsParams) ([]PRAssignmentStat, error) {
+	resp, err := call(ctx, a, true, func(ctx context.Context) (*GetStatsPrAssignmentsResponse, error) {
+		return a.raw.GetStatsPrAssignmentsWithResponse(ctx, &params)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, newError(resp.HTTPResponse, resp.Body)
+	}
+	return resp.JSON200.Stats, nil
+}
+
+// TeamStats возвращает показатели ревью по командам в порядке имен.
+func (a *API) TeamStats(ctx context.Context, params GetStatsTeamsParams) ([]TeamReviewStat, error) {
+	resp, err := call(ctx, a, true, func(ctx context.Context) (*GetStatsTeamsResponse, error) {
+		return a.raw.GetStatsTeamsWithResponse(ctx, &params)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, newError(resp.HTTPResponse, resp.Body)
+	}
+	return resp.JSON200.Stats, nil
+}
+
+// FairnessReport возвращает отчет о справедливости распределения ревью по командам.
+func (a *API) FairnessReport(ctx context.Context, params GetStatsFairnessParams) (*FairnessReport, error) {
+	resp, err := call(ctx, a, true, func(ctx context.Context) (*GetStatsFairnessResponse, error) {
+		return a.raw.GetStatsFairnessWithResponse(ctx, &params)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, newError(resp.HTTPResponse, resp.Body)
+	}
+	return resp.JSON200, nil
+}
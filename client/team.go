@@ -0,0 +1,106 @@
+package client
+
+import "context"
+
+// TeamDeactivation - результат массовой деактивации команды.
+type TeamDeactivation struct {
+	TeamName            string
+	DeactivatedUsers    int
+	ReassignedPRs       int
+	FailedReassignments int
+	DeactivatedUserIDs  []string
+}
+
+// CreateTeam создает команду с участниками (пользователи создаются или обновляются).
+func (a *API) CreateTeam(ctx context.Context, team Team) (*Team, error) {
+	resp, err := call(ctx, a, false, func(ctx context.Context) (*PostTeamAddResponse, error) {
+		return a.raw.PostTeamAddWithResponse(ctx, team)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON201 == nil || resp.JSON201.Team == nil {
+		return nil, newError(resp.HTTPResponse, resp.Body)
+	}
+	return resp.JSON201.Team, nil
+}
+
+// GetTeam возвращает команду с участниками.
+func (a *API) GetTeam(ctx context.Context, teamName string) (*Team, error) {
+	resp, err := call(ctx, a, true, func(ctx context.Context) (*GetTeamGetResponse, error) {
+		return a.raw.GetTeamGetWithResponse(ctx, &GetTeamGetParams{TeamName: teamName})
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, newError(resp.HTTPResponse, resp.Body)
+	}
+	return resp.JSON200, nil
+}
+
+// SetTeamParent перемещает команду под parentTeamName; nil делает ее корневой.
+func (a *API) SetTeamParent(ctx context.Context, teamName string, parentTeamName *string) (*Team, error) {
+	body := PostTeamSetParentJSONRequestBody{TeamName: teamName, ParentTeamName: parentTeamName}
+	resp, err := call(ctx, a, true, func(ctx context.Context) (*PostTeamSetParentResponse, error) {
+		return a.raw.PostTeamSetParentWithResponse(ctx, body)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil || resp.JSON200.Team == nil {
+		return nil, newError(resp.HTTPResponse, resp.Body)
+	}
+	return resp.JSON200.Team, nil
+}
+
+// GetTeamTree возвращает иерархию команд; пустое rootTeam - все корневые команды.
+func (a *API) GetTeamTree(ctx context.Context, rootTeam string) ([]TeamTreeNode, error) {
+	params := &GetTeamTreeParams{}
+	if rootTeam != "" {
+		params.TeamName = &rootTeam
+	}
+
+	resp, err := call(ctx, a, true, func(ctx context.Context) (*GetTeamTreeResponse, error) {
+		return a.raw.GetTeamTreeWithResponse(ctx, params)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, newError(resp.HTTPResponse, resp.Body)
+	}
+	return resp.JSON200.Teams, nil
+}
+
+// DeactivateTeam деактивирует всех участников команды с переназначением их открытых ревью.
+func (a *API) DeactivateTeam(ctx context.Context, teamName string) (*TeamDeactivation, error) {
+	resp, err := call(ctx, a, false, func(ctx context.Context) (*PostTeamDeactivateResponse, error) {
+		return a.raw.PostTeamDeactivateWithResponse(ctx, PostTeamDeactivateJSONRequestBody{TeamName: teamName})
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, newError(resp.HTTPResponse, resp.Body)
+	}
+
+	result := &TeamDeactivation{TeamName: teamName}
+	body := resp.JSON200
+	if body.TeamName != nil {
+		result.TeamName = *body.TeamName
+	}
+	if body.DeactivatedUsers != nil {
+		result.DeactivatedUsers = *body.DeactivatedUsers
+	}
+	if body.ReassignedPrs != nil {
+		result.ReassignedPRs = *body.ReassignedPrs
+	}
+	if body.FailedReassignments != nil {
+		result.FailedReassignments = *body.FailedReassignments
+	}
+	if body.DeactivatedUserIds != nil {
+		result.DeactivatedUserIDs = *body.DeactivatedUserIds
+	}
+	return result, nil
+}
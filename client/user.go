@@ -0,0 +1,72 @@
+package client
+
+import (
+	"context"
+	"time"
+)
+
+// SetUserActive включает или выключает пользователя.
+func (a *API) SetUserActive(ctx context.Context, userID string, active bool) (*User, error) {
+	body := PostUsersSetIsActiveJSONRequestBody{UserId: userID, IsActive: active}
+	resp, err := call(ctx, a, true, func(ctx context.Context) (*PostUsersSetIsActiveResponse, error) {
+		return a.raw.PostUsersSetIsActiveWithResponse(ctx, body)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil || resp.JSON200.User == nil {
+		return nil, newError(resp.HTTPResponse, resp.Body)
+	}
+	return resp.JSON200.User, nil
+}
+
+// SetUserSkills заменяет теги навыков пользователя.
+func (a *API) SetUserSkills(ctx context.Context, userID string, skills []string) (*User, error) {
+	body := PostUsersSetSkillsJSONRequestBody{UserId: userID, Skills: skills}
+	resp, err := call(ctx, a, true, func(ctx context.Context) (*PostUsersSetSkillsResponse, error) {
+		return a.raw.PostUsersSetSkillsWithResponse(ctx, body)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil || resp.JSON200.User == nil {
+		return nil, newError(resp.HTTPResponse, resp.Body)
+	}
+	return resp.JSON200.User, nil
+}
+
+// SetUserOutOfOffice отмечает пользователя отсутствующим до until; nil снимает отметку.
+func (a *API) SetUserOutOfOffice(ctx context.Context, userID string, until *time.Time) (*User, error) {
+	body := PostUsersSetOutOfOfficeJSONRequestBody{UserId: userID, Until: until}
+	resp, err := call(ctx, a, true, func(ctx context.Context) (*PostUsersSetOutOfOfficeResponse, error) {
+		return a.raw.PostUsersSetOutOfOfficeWithResponse(ctx, body)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil || resp.JSON200.User == nil {
+		return nil, newError(resp.HTTPResponse, resp.Body)
+	}
+	return resp.JSON200.User, nil
+}
+
+// UserReviewPage - страница PR на ревью у пользователя; NextCursor равен nil на последней странице.
+type UserReviewPage struct {
+	PullRequests []PullRequestShort
+	NextCursor   *string
+}
+
+// GetUserReviews возвращает страницу PR, где пользователь назначен ревьювером, от новых к старым;
+// следующая страница - с params.Cursor = NextCursor.
+func (a *API) GetUserReviews(ctx context.Context, params GetUsersGetReviewParams) (*UserReviewPage, error) {
+	resp, err := call(ctx, a, true, func(ctx context.Context) (*GetUsersGetReviewResponse, error) {
+		return a.raw.GetUsersGetReviewWithResponse(ctx, &params)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, newError(resp.HTTPResponse, resp.Body)
+	}
+	return &UserReviewPage{PullRequests: resp.JSON200.PullRequests, NextCursor: resp.JSON200.NextCursor}, nil
+}
@@ -1,92 +1,84 @@
 package main
 
 import (
-	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
 	"os"
-	"os/signal"
-	"syscall"
-	"time"
+	"strings"
 
-	"pr-reviewer-service/api"
 	"pr-reviewer-service/internal/config"
-	"pr-reviewer-service/internal/database"
-	"pr-reviewer-service/internal/handler"
-	"pr-reviewer-service/internal/repository"
-	"pr-reviewer-service/internal/usecase"
+	"pr-reviewer-service/internal/logging"
 
-	"github.com/labstack/echo/v4"
-	"github.com/labstack/echo/v4/middleware"
 	"github.com/sirupsen/logrus"
 )
 
-func main() {
-	// Логгер
-	logger := logrus.New()
-	logger.SetLevel(logrus.InfoLevel)
-	logger.SetFormatter(&logrus.JSONFormatter{})
+// version задается при сборке: go build -ldflags "-X main.version=v1.2.3"
+var version = "dev"
 
-	// Конфиг
-	cfg, err := config.LoadConfig()
-	if err != nil {
-		logger.Warnf(".env not found: %v", err)
+func main() {
+	// Без подкоманды (или сразу с флагами) запускается сервер - как раньше
+	command, args := "serve", os.Args[1:]
+	if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		command, args = args[0], args[1:]
 	}
 
-	// База данных (database/sql)
-	db, err := database.NewPostgresDB(cfg)
-	if err != nil {
-		logger.Fatalf("Database connection failed: %v", err)
+	switch command {
+	case "serve":
+		runServe(args)
+	case "migrate":
+		runMigrate(args)
+	case "version":
+		runVersion(os.Stdout)
+	case "help":
+		usage(os.Stdout)
+	default:
+		fmt.Fprintf(os.Stderr, "unknown command %q\n\n", command)
+		usage(os.Stderr)
+		os.Exit(2)
 	}
-	defer db.Close()
-	logger.Info("Database connected")
-
-	// SQLC queries
-	queries := database.New(db)
-
-	// Репозитории
-	teamRepo := repository.NewTeamRepository(db, queries)
-	userRepo := repository.NewUserRepository(db, queries)
-	prRepo := repository.NewPRRepository(db, queries)
-	statsRepo := repository.NewStatsRepository(queries)
+}
 
-	// Use Cases
-	teamUC := usecase.NewTeamUseCase(teamRepo, userRepo, prRepo)
-	userUC := usecase.NewUserUseCase(userRepo, prRepo)
-	prUC := usecase.NewPRUseCase(prRepo, userRepo)
-	statsUC := usecase.NewStatsUseCase(statsRepo)
+func usage(w io.Writer) {
+	fmt.Fprint(w, `Usage: pr-reviewer-service <command> [flags]
 
-	// Echo + Handlers
-	e := echo.New()
-	e.Use(middleware.Recover())
-	e.Use(middleware.CORS())
-	e.Use(handler.LoggingMiddleware(logger))
+Commands:
+  serve                          запустить HTTP-сервер (по умолчанию)
+  migrate up|down|status|redo    управлять миграциями схемы
+  version                        вывести версию сервиса и схемы
 
-	// Handlers
-	apiHandler := handler.NewAPIHandler(teamUC, userUC, prUC, statsUC, logger)
-	api.RegisterHandlers(e, apiHandler)
+Флаги конфигурации (--config, --print-config, --<ключ YAML>) принимаются serve и migrate;
+список - в "serve --help".
+`)
+}
 
-	e.GET("/health", func(c echo.Context) error {
-		return c.JSON(200, map[string]string{"status": "ok"})
-	})
+// newLogger создает логгер; уровень и формат выставляются после загрузки конфига
+func newLogger() *logrus.Logger {
+	logger := logrus.New()
+	logger.SetFormatter(&logrus.JSONFormatter{})
+	return logger
+}
 
-	// Запуск сервера
-	go func() {
-		if err := e.Start(":8080"); err != nil {
-			logger.Infof("Server stopped: %v", err)
+// loadConfig загружает конфиг (значения по умолчанию < YAML-файл < окружение < флаги)
+// и настраивает логгер. false - команду выполнять не нужно (--help или --print-config).
+func loadConfig(logger *logrus.Logger, args []string) (config.Config, bool) {
+	cfg, opts, err := config.Load(args)
+	if errors.Is(err, flag.ErrHelp) {
+		return cfg, false
+	}
+	if err != nil {
+		logger.Fatalf("Invalid configuration: %v", err)
+	}
+	if opts.PrintConfig {
+		if err := cfg.WriteYAML(os.Stdout); err != nil {
+			logger.Fatalf("Failed to print configuration: %v", err)
 		}
-	}()
-
-	// Graceful shutdown
-	stop := make(chan os.Signal, 1)
-	signal.Notify(stop, os.Interrupt, syscall.SIGTERM)
-	<-stop
-
-	logger.Info("Shutting down...")
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-	defer cancel()
-
-	if err := e.Shutdown(ctx); err != nil {
-		logger.Fatalf("Shutdown failed: %v", err)
+		return cfg, false
+	}
+	if err := logging.Configure(logger, cfg.Log.Level, cfg.Log.Format); err != nil {
+		logger.Fatalf("Invalid logging configuration: %v", err)
 	}
 
-	logger.Info("Server exited")
+	return cfg, true
 }
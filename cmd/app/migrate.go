@@ -0,0 +1,96 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"pr-reviewer-service/internal/config"
+
+	"github.com/pressly/goose/v3"
+)
+
+// runMigrate выполняет "migrate up|down|status|redo".
+func runMigrate(args []string) {
+	if len(args) == 0 {
+		fmt.Fprintln(os.Stderr, "usage: pr-reviewer-service migrate up|down|status|redo [flags]")
+		os.Exit(2)
+	}
+	action, args := args[0], args[1:]
+
+	logger := newLogger()
+	cfg, ok := loadConfig(logger, args)
+	if !ok {
+		return
+	}
+
+	if cfg.Storage == config.StorageMemory {
+		logger.Fatalf("Migrations are not applicable to %s storage", cfg.Storage)
+	}
+
+	// Миграции могут идти дольше обычных запросов
+	cfg.Database.StatementTimeout = 0
+
+	ctx := context.Background()
+	db, migrator := openDatabase(ctx, cfg, logger)
+	defer db.Close()
+
+	switch action {
+	case "up":
+		results, err := migrator.Up(ctx)
+		printMigrationResults(results)
+		if err != nil {
+			logger.Fatalf("Migration failed: %v", err)
+		}
+		if len(results) == 0 {
+			fmt.Println("no pending migrations")
+		}
+	case "down":
+		result, err := migrator.Down(ctx)
+		if errors.Is(err, goose.ErrNoNextVersion) {
+			fmt.Println("no migrations to roll back")
+			return
+		}
+		if err != nil {
+			logger.Fatalf("Rollback failed: %v", err)
+		}
+		printMigrationResults([]*goose.MigrationResult{result})
+	case "redo":
+		results, err := migrator.Redo(ctx)
+		printMigrationResults(results)
+		if err != nil {
+			logger.Fatalf("Redo failed: %v", err)
+		}
+	case "status":
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			logger.Fatalf("Failed to get migration status: %v", err)
+		}
+		printMigrationStatus(statuses)
+	default:
+		fmt.Fprintf(os.Stderr, "unknown migrate action %q: expected up, down, status or redo\n", action)
+		os.Exit(2)
+	}
+}
+
+func printMigrationResults(results []*goose.MigrationResult) {
+	for _, result := range results {
+		fmt.Println(result.String())
+	}
+}
+
+func printMigrationStatus(statuses []*goose.MigrationStatus) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "VERSION\tSTATE\tAPPLIED AT\tFILE")
+	for _, status := range statuses {
+		appliedAt := "-"
+		if !status.AppliedAt.IsZero() {
+			appliedAt = status.AppliedAt.UTC().Format(time.RFC3339)
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\n", status.Source.Version, status.State, appliedAt, status.Source.Path)
+	}
+	_ = w.Flush()
+}
@@ -0,0 +1,225 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"pr-reviewer-service/api"
+	"pr-reviewer-service/internal/config"
+	"pr-reviewer-service/internal/database"
+	"pr-reviewer-service/internal/domain"
+	"pr-reviewer-service/internal/events"
+	"pr-reviewer-service/internal/graphqlapi"
+	"pr-reviewer-service/internal/grpcserver"
+	"pr-reviewer-service/internal/handler"
+	"pr-reviewer-service/internal/logging"
+	"pr-reviewer-service/internal/metrics"
+	"pr-reviewer-service/internal/tracing"
+	"pr-reviewer-service/internal/usecase"
+
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+)
+
+// runServe запускает HTTP-сервер.
+func runServe(args []string) {
+	logger := newLogger()
+	cfg, ok := loadConfig(logger, args)
+	if !ok {
+		return
+	}
+
+	// Хранилище: PostgreSQL или память процесса (--storage)
+	store := openStorage(cfg, logger)
+	defer store.close()
+
+	// Трассировка
+	traceExporter, closeTraceExporter, err := tracing.NewExporter(cfg.Tracing.Exporter, cfg.Tracing.File)
+	if err != nil {
+		logger.Fatalf("Invalid tracing configuration: %v", err)
+	}
+	defer closeTraceExporter()
+	tracer := tracing.NewTracer(traceExporter)
+
+	// Политика эскалации назначения ревьюверов (значение уже проверено при загрузке конфига)
+	escalationPolicy, err := domain.ParseEscalationPolicy(cfg.Assignment.EscalationPolicy)
+	if err != nil {
+		logger.Fatalf("Invalid assignment.escalation_policy %q: %v", cfg.Assignment.EscalationPolicy, err)
+	}
+
+	// Метрики
+	serviceMetrics := metrics.New()
+
+	// События назначения для стримов gRPC и SSE
+	broker := events.NewBroker()
+
+	// Use Cases
+	teamUC := usecase.NewTeamUseCase(store.teams, store.users, store.prs,
+		usecase.WithTeamMetrics(serviceMetrics),
+		usecase.WithTeamEvents(broker),
+	)
+	userUC := usecase.NewUserUseCase(store.users, store.prs)
+	prUC := usecase.NewPRUseCase(store.prs, store.users,
+		usecase.WithEscalation(store.teams, escalationPolicy),
+		usecase.WithMaxReviewers(cfg.Assignment.MaxReviewers),
+		usecase.WithOwnershipRules(store.ownership, store.teams),
+		usecase.WithMetrics(serviceMetrics),
+		usecase.WithEvents(broker),
+		usecase.WithFairShare(store.stats, cfg.Assignment.FairShareWindow),
+		usecase.WithReviewQuota(cfg.Assignment.MaxOpenReviews),
+	)
+	statsUC := usecase.NewStatsUseCase(store.stats)
+	ownershipUC := usecase.NewOwnershipUseCase(store.ownership, store.users, store.teams)
+	transferUC := usecase.NewTransferUseCase(store.transfer)
+
+	// Echo + Handlers
+	e := echo.New()
+	e.HideBanner = true
+	e.Server.ReadTimeout = cfg.Server.ReadTimeout
+	e.Server.ReadHeaderTimeout = cfg.Server.ReadHeaderTimeout
+	e.Server.WriteTimeout = cfg.Server.WriteTimeout
+	e.Server.IdleTimeout = cfg.Server.IdleTimeout
+
+	e.Use(middleware.Recover())
+	if len(cfg.CORS.AllowedOrigins) > 0 {
+		e.Use(middleware.CORSWithConfig(middleware.CORSConfig{
+			AllowOrigins:  cfg.CORS.AllowedOrigins,
+			AllowHeaders:  []string{echo.HeaderContentType, echo.HeaderAuthorization, logging.RequestIDHeader, handler.IdempotencyKeyHeader, handler.IfMatchHeader},
+			ExposeHeaders: []string{logging.RequestIDHeader, handler.IdempotentReplayedHeader, handler.ETagHeader},
+		}))
+	}
+	e.Use(handler.RequestIDMiddleware())
+	e.Use(handler.TracingMiddleware(tracer))
+	e.Use(handler.LoggingMiddleware(logger, serviceMetrics))
+	if cfg.Auth.Enabled {
+		e.Use(handler.AuthMiddleware(cfg.Auth.Tokens, "/health", "/metrics", "/livez", "/readyz"))
+	}
+	// Повтор создания PR или переназначения с тем же Idempotency-Key получает сохраненный ответ
+	e.Use(handler.IdempotencyMiddleware(store.idempotency, cfg.Server.IdempotencyTTL, cfg.Server.IdempotencyLease, logger,
+		"/pullRequest/create", "/pullRequest/reassign"))
+
+	// Handlers
+	apiHandler := handler.NewAPIHandler(teamUC, userUC, prUC, statsUC, ownershipUC, transferUC,
+		broker, cfg.Server.StreamHeartbeatInterval, logger)
+	api.RegisterHandlers(e, apiHandler)
+
+	// GraphQL только для чтения, поверх тех же репозиториев
+	graphqlService, err := graphqlapi.NewService(store.teams, store.users, store.prs, graphqlapi.Limits{
+		MaxDepth:      cfg.GraphQL.MaxDepth,
+		MaxComplexity: cfg.GraphQL.MaxComplexity,
+	})
+	if err != nil {
+		logger.Fatalf("GraphQL schema is invalid: %v", err)
+	}
+	graphqlHandler := handler.NewGraphQLHandler(graphqlService, logger)
+	e.GET("/graphql", graphqlHandler.Serve)
+	e.POST("/graphql", graphqlHandler.Serve)
+
+	e.GET("/health", func(c echo.Context) error {
+		return c.JSON(200, map[string]string{"status": "ok"})
+	})
+	e.GET("/metrics", echo.WrapHandler(serviceMetrics.Handler()))
+
+	healthHandler := handler.NewHealthHandler(cfg.Server.ReadinessTimeout, logger, store.checks...)
+	e.GET("/livez", healthHandler.GetLivez)
+	e.GET("/readyz", healthHandler.GetReadyz)
+
+	// Периодическое обновление метрик нагрузки из базы
+	metricsCtx, stopMetrics := context.WithCancel(context.Background())
+	defer stopMetrics()
+	go serviceMetrics.RunWorkloadRefresher(metricsCtx, store.stats, cfg.Metrics.RefreshInterval, logger)
+	go handler.PurgeExpiredIdempotencyKeys(metricsCtx, store.idempotency, logger)
+
+	// gRPC API на отдельном порту, с теми же usecase и токенами
+	var grpcServer *grpc.Server
+	if cfg.Server.GRPCPort != 0 {
+		listener, err := net.Listen("tcp", fmt.Sprintf(":%d", cfg.Server.GRPCPort))
+		if err != nil {
+			logger.Fatalf("gRPC listen failed: %v", err)
+		}
+
+		var grpcTokens []string
+		if cfg.Auth.Enabled {
+			grpcTokens = cfg.Auth.Tokens
+		}
+		grpcServer = grpcserver.NewGRPCServer(
+			grpcserver.NewServer(teamUC, userUC, prUC, statsUC, broker, logger),
+			grpcTokens,
+		)
+		go func() {
+			if err := grpcServer.Serve(listener); err != nil {
+				logger.Infof("gRPC server stopped: %v", err)
+			}
+		}()
+		logger.WithField("port", cfg.Server.GRPCPort).Info("gRPC server started")
+	}
+
+	// Запуск сервера
+	go func() {
+		if err := e.Start(fmt.Sprintf(":%d", cfg.Server.Port)); err != nil {
+			logger.Infof("Server stopped: %v", err)
+		}
+	}()
+
+	// Graceful shutdown
+	stop := make(chan os.Signal, 1)
+	signal.Notify(stop, os.Interrupt, syscall.SIGTERM)
+	<-stop
+
+	logger.Info("Shutting down...")
+
+	// Сначала перестаем быть готовыми, чтобы оркестратор успел убрать под из балансировки
+	healthHandler.SetDraining()
+	time.Sleep(cfg.Server.ShutdownDrainDelay)
+
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.Server.ShutdownTimeout)
+	defer cancel()
+
+	// Стримы событий сами не завершатся - закрываем подписки, иначе GracefulStop и Shutdown будут ждать их вечно
+	broker.Close()
+	if grpcServer != nil {
+		stopGRPC(ctx, grpcServer)
+	}
+
+	if err := e.Shutdown(ctx); err != nil {
+		logger.Fatalf("Shutdown failed: %v", err)
+	}
+
+	logger.Info("Server exited")
+}
+
+// stopGRPC дожидается завершения активных вызовов, а по истечении ctx обрывает их
+func stopGRPC(ctx context.Context, srv *grpc.Server) {
+	stopped := make(chan struct{})
+	go func() {
+		srv.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		srv.Stop()
+	}
+}
+
+// prepareSchema в режиме auto применяет ожидающие миграции (под advisory-блокировкой,
+// поэтому реплики не мешают друг другу), а в режиме check только проверяет, что схема не отстает.
+func prepareSchema(ctx context.Context, migrator *database.Migrator, mode string, logger *logrus.Logger) error {
+	if mode == config.MigrationModeCheck {
+		return migrator.Check(ctx)
+	}
+
+	results, err := migrator.Up(ctx)
+	for _, result := range results {
+		logger.WithField("migration", result.Source.Path).WithField("duration", result.Duration).Info("Migration applied")
+	}
+	return err
+}
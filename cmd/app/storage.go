@@ -0,0 +1,123 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+
+	"pr-reviewer-service/internal/config"
+	"pr-reviewer-service/internal/database"
+	"pr-reviewer-service/internal/database/sqlitedb"
+	"pr-reviewer-service/internal/domain"
+	"pr-reviewer-service/internal/handler"
+	"pr-reviewer-service/internal/repository"
+	"pr-reviewer-service/internal/repository/memory"
+	"pr-reviewer-service/internal/repository/sqlite"
+	"pr-reviewer-service/internal/tracing"
+
+	"github.com/sirupsen/logrus"
+)
+
+// storage - репозитории выбранного хранилища и проверки его готовности для /readyz
+type storage struct {
+	teams       domain.TeamRepository
+	users       domain.UserRepository
+	prs         domain.PRRepository
+	stats       domain.StatsRepository
+	ownership   domain.OwnershipRepository
+	transfer    domain.TransferRepository
+	idempotency domain.IdempotencyRepository
+
+	checks []handler.ReadinessCheck
+	close  func()
+}
+
+// openStorage подключает хранилище из cfg.Storage. Для PostgreSQL и SQLite миграции применяются
+// или проверяются по database.migration_mode; хранилищу в памяти схема не нужна.
+func openStorage(cfg config.Config, logger *logrus.Logger) *storage {
+	if cfg.Storage == config.StorageMemory {
+		logger.Warn("Using in-memory storage: data is lost on restart")
+		store := memory.NewStore()
+		return &storage{
+			teams:       memory.NewTeamRepository(store),
+			users:       memory.NewUserRepository(store),
+			prs:         memory.NewPRRepository(store),
+			stats:       memory.NewStatsRepository(store),
+			ownership:   memory.NewOwnershipRepository(store),
+			transfer:    memory.NewTransferRepository(store),
+			idempotency: memory.NewIdempotencyRepository(store),
+			close:       func() {},
+		}
+	}
+
+	// База данных (database/sql)
+	db, migrator := openDatabase(context.Background(), cfg, logger)
+	logger.WithField("storage", cfg.Storage).Info("Database connected")
+
+	// Миграции: применяем сами или отказываемся работать со старой схемой
+	if err := prepareSchema(context.Background(), migrator, cfg.Database.MigrationMode, logger); err != nil {
+		logger.Fatalf("Database schema is not ready: %v", err)
+	}
+	checks := []handler.ReadinessCheck{
+		{Name: "database", Check: db.PingContext},
+		{Name: "migrations", Check: migrator.Check},
+	}
+
+	if cfg.Storage == config.StorageSQLite {
+		// Повторы не нужны: SQLite ждет блокировку записи сам (busy_timeout)
+		queries := sqlitedb.New(tracing.WrapDBSystem(db, "sqlite"))
+		return &storage{
+			teams:       sqlite.NewTeamRepository(db, queries),
+			users:       sqlite.NewUserRepository(db, queries),
+			prs:         sqlite.NewPRRepository(db, queries),
+			stats:       sqlite.NewStatsRepository(queries),
+			ownership:   sqlite.NewOwnershipRepository(db, queries),
+			transfer:    sqlite.NewTransferRepository(db, queries),
+			idempotency: sqlite.NewIdempotencyRepository(db, queries),
+			checks:      checks,
+			close:       func() { _ = db.Close() },
+		}
+	}
+
+	// SQLC queries: каждый запрос - отдельный спан, временные ошибки повторяются
+	retryPolicy := database.RetryPolicyFromConfig(cfg.Database)
+	queries := database.New(tracing.WrapDB(database.NewRetryDB(db, retryPolicy)))
+
+	// Репозитории (транзакции при временных ошибках повторяются целиком)
+	return &storage{
+		teams:       repository.NewTeamRepository(db, queries, repository.WithRetryPolicy(retryPolicy)),
+		users:       repository.NewUserRepository(db, queries, repository.WithRetryPolicy(retryPolicy)),
+		prs:         repository.NewPRRepository(db, queries, repository.WithRetryPolicy(retryPolicy)),
+		stats:       repository.NewStatsRepository(queries),
+		ownership:   repository.NewOwnershipRepository(db, queries, repository.WithRetryPolicy(retryPolicy)),
+		transfer:    repository.NewTransferRepository(db, queries, repository.WithRetryPolicy(retryPolicy)),
+		idempotency: repository.NewIdempotencyRepository(queries),
+		checks:      checks,
+		close:       func() { _ = db.Close() },
+	}
+}
+
+// openDatabase подключает базу PostgreSQL или SQLite (по cfg.Storage)
+// и создает Migrator для ее встроенных миграций.
+func openDatabase(ctx context.Context, cfg config.Config, logger *logrus.Logger) (*sql.DB, *database.Migrator) {
+	if cfg.Storage == config.StorageSQLite {
+		db, err := sqlitedb.Open(ctx, cfg.SQLite.Path)
+		if err != nil {
+			logger.Fatalf("Database connection failed: %v", err)
+		}
+		migrator, err := sqlitedb.NewMigrator(db)
+		if err != nil {
+			logger.Fatalf("Migrations unavailable: %v", err)
+		}
+		return db, migrator
+	}
+
+	db, err := database.NewPostgresDB(ctx, cfg.Database, logger)
+	if err != nil {
+		logger.Fatalf("Database connection failed: %v", err)
+	}
+	migrator, err := database.NewMigrator(db)
+	if err != nil {
+		logger.Fatalf("Migrations unavailable: %v", err)
+	}
+	return db, migrator
+}
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"runtime"
+	"runtime/debug"
+
+	"pr-reviewer-service/internal/database"
+)
+
+// runVersion выводит версию сервиса, коммит сборки и версию схемы, которую ожидает бинарник.
+func runVersion(w io.Writer) {
+	fmt.Fprintf(w, "version:  %s\n", version)
+	if revision := vcsRevision(); revision != "" {
+		fmt.Fprintf(w, "commit:   %s\n", revision)
+	}
+	fmt.Fprintf(w, "go:       %s\n", runtime.Version())
+
+	schema, err := database.ExpectedMigrationVersion()
+	if err != nil {
+		fmt.Fprintf(w, "schema:   unknown (%v)\n", err)
+		return
+	}
+	fmt.Fprintf(w, "schema:   %d\n", schema)
+}
+
+// vcsRevision возвращает коммит, из которого собран бинарник (если сборка шла из git)
+func vcsRevision() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return ""
+	}
+	for _, setting := range info.Settings {
+		if setting.Key == "vcs.revision" {
+			return setting.Value
+		}
+	}
+	return ""
+}
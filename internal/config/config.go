@@ -1,37 +1,334 @@
+// Package config загружает конфигурацию сервиса.
+//
+// Источники применяются по возрастанию приоритета: значения по умолчанию, YAML-файл
+// (--config или CONFIG_FILE), переменные окружения (в том числе из .env) и флаги командной строки.
+// Каждая настройка доступна во всех трех источниках: ключ YAML "server.port" - это флаг
+// --server.port и переменная SERVER_PORT (см. fields).
 package config
 
 import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
 	"os"
+	"time"
 
 	"github.com/joho/godotenv"
+	"gopkg.in/yaml.v3"
 )
 
+// Config - полная конфигурация сервиса.
 type Config struct {
-	DBHost     string
-	DBPort     string
-	DBUser     string
-	DBPassword string
-	DBName     string
-	ServerPort string
+	// Хранилище данных: postgres, sqlite (файл sqlite.path) или memory (данные в памяти процесса,
+	// теряются при остановке)
+	Storage string `yaml:"storage"`
+
+	Server     ServerConfig     `yaml:"server"`
+	Database   DatabaseConfig   `yaml:"database"`
+	SQLite     SQLiteConfig     `yaml:"sqlite"`
+	Assignment AssignmentConfig `yaml:"assignment"`
+	Auth       AuthConfig       `yaml:"auth"`
+	CORS       CORSConfig       `yaml:"cors"`
+	Log        LogConfig        `yaml:"log"`
+	Metrics    MetricsConfig    `yaml:"metrics"`
+	Tracing    TracingConfig    `yaml:"tracing"`
+	GraphQL    GraphQLConfig    `yaml:"graphql"`
 }
 
-func LoadConfig() (Config, error) {
+// ServerConfig - параметры HTTP-сервера. Нулевые read/write/idle таймауты означают отсутствие ограничения.
+type ServerConfig struct {
+	Port              int           `yaml:"port"`
+	ReadTimeout       time.Duration `yaml:"read_timeout"`
+	ReadHeaderTimeout time.Duration `yaml:"read_header_timeout"`
+	WriteTimeout      time.Duration `yaml:"write_timeout"`
+	IdleTimeout       time.Duration `yaml:"idle_timeout"`
+
+	// Таймаут каждой проверки /readyz
+	ReadinessTimeout time.Duration `yaml:"readiness_timeout"`
+
+	// Сколько /readyz отвечает 503 перед остановкой и сколько ждать завершения активных запросов
+	ShutdownDrainDelay time.Duration `yaml:"shutdown_drain_delay"`
+	ShutdownTimeout    time.Duration `yaml:"shutdown_timeout"`
+
+	// Порт gRPC API; 0 - gRPC-сервер не запускается
+	GRPCPort int `yaml:"grpc_port"`
+
+	// Период heartbeat-комментариев в потоке /users/reviewStream
+	StreamHeartbeatInterval time.Duration `yaml:"stream_heartbeat_interval"`
+
+	// Сколько хранится ответ на запрос с Idempotency-Key
+	IdempotencyTTL time.Duration `yaml:"idempotency_ttl"`
+	// Сколько ключ занят выполняющимся запросом; после этого ключ без ответа может занять повтор
+	IdempotencyLease time.Duration `yaml:"idempotency_lease"`
+}
+
+// DatabaseConfig - подключение к PostgreSQL, пул соединений, таймауты и повторы.
+type DatabaseConfig struct {
+	Host     string `yaml:"host"`
+	Port     int    `yaml:"port"`
+	User     string `yaml:"user"`
+	Password string `yaml:"password"`
+	Name     string `yaml:"name"`
+
+	// Режим TLS (disable, allow, prefer, require, verify-ca, verify-full) и файлы сертификатов
+	SSLMode     string `yaml:"ssl_mode"`
+	SSLRootCert string `yaml:"ssl_root_cert"`
+	SSLCert     string `yaml:"ssl_cert"`
+	SSLKey      string `yaml:"ssl_key"`
+
+	// Ограничения пула database/sql; 0 - без ограничения
+	MaxOpenConns    int           `yaml:"max_open_conns"`
+	MaxIdleConns    int           `yaml:"max_idle_conns"`
+	ConnMaxLifetime time.Duration `yaml:"conn_max_lifetime"`
+	ConnMaxIdleTime time.Duration `yaml:"conn_max_idle_time"`
+
+	// Серверный statement_timeout для каждого запроса; 0 - без ограничения
+	StatementTimeout time.Duration `yaml:"statement_timeout"`
+
+	// Попытки подключения при старте, пока PostgreSQL не поднялся, и пауза между ними
+	ConnectAttempts int           `yaml:"connect_attempts"`
+	ConnectBackoff  time.Duration `yaml:"connect_backoff"`
+
+	// Попытки запросов и транзакций при временных ошибках и пауза перед первым повтором
+	RetryAttempts int           `yaml:"retry_attempts"`
+	RetryBackoff  time.Duration `yaml:"retry_backoff"`
+
+	// Что делать с неприменёнными миграциями при запуске serve: auto - применить, check - отказаться стартовать
+	MigrationMode string `yaml:"migration_mode"`
+}
+
+// SQLiteConfig - файл базы для storage=sqlite. Миграции при запуске применяются
+// или проверяются так же, как для PostgreSQL, по database.migration_mode.
+type SQLiteConfig struct {
+	Path string `yaml:"path"`
+}
 
-	err := godotenv.Load()
+// Режимы миграций при запуске serve
+const (
+	MigrationModeAuto  = "auto"
+	MigrationModeCheck = "check"
+)
+
+// Хранилища данных
+const (
+	StoragePostgres = "postgres"
+	StorageSQLite   = "sqlite"
+	StorageMemory   = "memory"
+)
+
+// AssignmentConfig - параметры назначения ревьюверов по умолчанию.
+type AssignmentConfig struct {
+	// Политика эскалации по иерархии команд: none, siblings, parent, siblings_then_parent
+	EscalationPolicy string `yaml:"escalation_policy"`
+
+	// Сколько ревьюверов назначается на PR из команды автора и при эскалации
+	MaxReviewers int `yaml:"max_reviewers"`
+
+	// Окно отчета о справедливости, по которому выбираются участники команды автора
+	// (больше других недополучившие назначений); 0 - случайный выбор
+	FairShareWindow time.Duration `yaml:"fair_share_window"`
+
+	// Квота открытых PR на ревью у одного пользователя: достигшие ее не назначаются; 0 - без ограничения
+	MaxOpenReviews int `yaml:"max_open_reviews"`
+}
+
+// AuthConfig - аутентификация запросов к API по статическим Bearer-токенам.
+type AuthConfig struct {
+	Enabled bool     `yaml:"enabled"`
+	Tokens  []string `yaml:"tokens"`
+}
+
+// CORSConfig - источники, которым разрешены кросс-доменные запросы. Пустой список отключает CORS.
+type CORSConfig struct {
+	AllowedOrigins []string `yaml:"allowed_origins"`
+}
+
+// LogConfig - уровень (trace, debug, info, warn, error) и формат (json, text) логов.
+type LogConfig struct {
+	Level  string `yaml:"level"`
+	Format string `yaml:"format"`
+}
+
+// MetricsConfig - период обновления метрик нагрузки из базы.
+type MetricsConfig struct {
+	RefreshInterval time.Duration `yaml:"refresh_interval"`
+}
 
+// TracingConfig - экспортер трассировки (stdout, file, none) и файл для экспортера file.
+type TracingConfig struct {
+	Exporter string `yaml:"exporter"`
+	File     string `yaml:"file"`
+}
+
+// GraphQLConfig - ограничения запросов к /graphql, проверяемые до выполнения.
+// Сложность - число полей с учетом множителя для списков.
+type GraphQLConfig struct {
+	MaxDepth      int `yaml:"max_depth"`
+	MaxComplexity int `yaml:"max_complexity"`
+}
+
+// Options - параметры запуска, не относящиеся к конфигурации сервиса.
+type Options struct {
+	// Путь к YAML-файлу конфигурации
+	File string
+
+	// Вывести итоговую конфигурацию (секреты скрыты) и завершиться
+	PrintConfig bool
+}
+
+// Default возвращает конфигурацию по умолчанию.
+func Default() Config {
 	return Config{
-		DBHost:     getEnv("DB_HOST", "localhost"),
-		DBPort:     getEnv("DB_PORT", "5432"),
-		DBUser:     getEnv("DB_USER", "postgres"),
-		DBPassword: getEnv("DB_PASSWORD", "password"),
-		DBName:     getEnv("DB_NAME", "pr_reviewer"),
-		ServerPort: getEnv("SERVER_PORT", "8080"),
-	}, err
+		Storage: StoragePostgres,
+		Server: ServerConfig{
+			Port:               8080,
+			ReadTimeout:        15 * time.Second,
+			ReadHeaderTimeout:  5 * time.Second,
+			WriteTimeout:       30 * time.Second,
+			IdleTimeout:        60 * time.Second,
+			ReadinessTimeout:   2 * time.Second,
+			ShutdownDrainDelay: 5 * time.Second,
+			ShutdownTimeout:    10 * time.Second,
+			GRPCPort:           9090,
+
+			StreamHeartbeatInterval: 15 * time.Second,
+			IdempotencyTTL:          24 * time.Hour,
+			IdempotencyLease:        time.Minute,
+		},
+		Database: DatabaseConfig{
+			Host:             "localhost",
+			Port:             5432,
+			User:             "postgres",
+			Password:         "password",
+			Name:             "pr_reviewer",
+			SSLMode:          "disable",
+			MaxOpenConns:     25,
+			MaxIdleConns:     5,
+			ConnMaxLifetime:  30 * time.Minute,
+			ConnMaxIdleTime:  5 * time.Minute,
+			StatementTimeout: 10 * time.Second,
+			ConnectAttempts:  10,
+			ConnectBackoff:   time.Second,
+			RetryAttempts:    3,
+			RetryBackoff:     50 * time.Millisecond,
+			MigrationMode:    MigrationModeAuto,
+		},
+		SQLite: SQLiteConfig{
+			Path: "pr_reviewer.db",
+		},
+		Assignment: AssignmentConfig{
+			EscalationPolicy: "none",
+			MaxReviewers:     2,
+		},
+		Auth: AuthConfig{
+			Tokens: []string{},
+		},
+		CORS: CORSConfig{
+			AllowedOrigins: []string{},
+		},
+		Log: LogConfig{
+			Level:  "info",
+			Format: "json",
+		},
+		Metrics: MetricsConfig{
+			RefreshInterval: 15 * time.Second,
+		},
+		Tracing: TracingConfig{
+			Exporter: "stdout",
+			File:     "traces.jsonl",
+		},
+		GraphQL: GraphQLConfig{
+			MaxDepth:      8,
+			MaxComplexity: 5000,
+		},
+	}
+}
+
+// Load собирает конфигурацию из всех источников и проверяет ее.
+// args - аргументы командной строки без имени программы.
+func Load(args []string) (Config, Options, error) {
+	var opts Options
+	cfg := Default()
+
+	// .env необязателен: его переменные просто дополняют окружение
+	if err := godotenv.Load(); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return cfg, opts, fmt.Errorf("failed to load .env: %w", err)
+	}
+
+	fields := cfg.fields()
+
+	// Флаги разбираются первыми (нужен путь к файлу), но применяются последними
+	var overrides []override
+	fs := flag.NewFlagSet("pr-reviewer-service", flag.ContinueOnError)
+	fs.StringVar(&opts.File, "config", os.Getenv("CONFIG_FILE"), "путь к YAML-файлу конфигурации (CONFIG_FILE)")
+	fs.BoolVar(&opts.PrintConfig, "print-config", false, "вывести итоговую конфигурацию со скрытыми секретами и выйти")
+	for _, f := range fields {
+		f := f
+		record := func(value string) error {
+			overrides = append(overrides, override{field: f, value: value})
+			return nil
+		}
+		usage := fmt.Sprintf("%s (%s)", f.usage, f.env)
+		if isBoolField(f) {
+			fs.BoolFunc(f.key, usage, record)
+		} else {
+			fs.Func(f.key, usage, record)
+		}
+	}
+	if err := fs.Parse(args); err != nil {
+		return cfg, opts, err
+	}
+	if fs.NArg() > 0 {
+		return cfg, opts, fmt.Errorf("unexpected arguments: %v", fs.Args())
+	}
+
+	if opts.File != "" {
+		if err := cfg.loadFile(opts.File); err != nil {
+			return cfg, opts, err
+		}
+	}
+
+	for _, f := range fields {
+		if value := os.Getenv(f.env); value != "" {
+			if err := f.value.Set(value); err != nil {
+				return cfg, opts, fmt.Errorf("invalid %s: %w", f.env, err)
+			}
+		}
+	}
+
+	for _, o := range overrides {
+		if err := o.field.value.Set(o.value); err != nil {
+			return cfg, opts, fmt.Errorf("invalid flag --%s: %w", o.field.key, err)
+		}
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return cfg, opts, err
+	}
+
+	return cfg, opts, nil
+}
+
+// override - значение флага, отложенное до применения файла и окружения
+type override struct {
+	field field
+	value string
 }
 
-func getEnv(key, defaultValue string) string {
-	if value := os.Getenv(key); value != "" {
-		return value
+// loadFile накладывает значения из YAML-файла; неизвестные ключи считаются ошибкой
+func (c *Config) loadFile(path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open config file: %w", err)
+	}
+	defer file.Close()
+
+	decoder := yaml.NewDecoder(file)
+	decoder.KnownFields(true)
+	if err := decoder.Decode(c); err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("failed to parse config file %s: %w", path, err)
 	}
-	return defaultValue
+
+	return nil
 }
@@ -0,0 +1,155 @@
+package config
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// field связывает настройку с ее ключом YAML (он же имя флага) и переменной окружения
+type field struct {
+	key    string
+	env    string
+	usage  string
+	secret bool
+	value  value
+}
+
+// value - значение настройки, которое можно задать строкой из окружения или флага
+type value interface {
+	Set(s string) error
+	Get() interface{}
+}
+
+// fields перечисляет все настройки в порядке вывода --print-config
+func (c *Config) fields() []field {
+	return []field{
+		{key: "storage", env: "STORAGE", usage: "хранилище данных: postgres, sqlite, memory", value: stringValue{&c.Storage}},
+
+		{key: "server.port", env: "SERVER_PORT", usage: "порт HTTP-сервера", value: intValue{&c.Server.Port}},
+		{key: "server.read_timeout", env: "SERVER_READ_TIMEOUT", usage: "таймаут чтения запроса", value: durationValue{&c.Server.ReadTimeout}},
+		{key: "server.read_header_timeout", env: "SERVER_READ_HEADER_TIMEOUT", usage: "таймаут чтения заголовков", value: durationValue{&c.Server.ReadHeaderTimeout}},
+		{key: "server.write_timeout", env: "SERVER_WRITE_TIMEOUT", usage: "таймаут записи ответа", value: durationValue{&c.Server.WriteTimeout}},
+		{key: "server.idle_timeout", env: "SERVER_IDLE_TIMEOUT", usage: "таймаут простоя keep-alive соединения", value: durationValue{&c.Server.IdleTimeout}},
+		{key: "server.readiness_timeout", env: "READINESS_TIMEOUT", usage: "таймаут каждой проверки /readyz", value: durationValue{&c.Server.ReadinessTimeout}},
+		{key: "server.shutdown_drain_delay", env: "SHUTDOWN_DRAIN_DELAY", usage: "сколько /readyz отвечает 503 перед остановкой", value: durationValue{&c.Server.ShutdownDrainDelay}},
+		{key: "server.shutdown_timeout", env: "SHUTDOWN_TIMEOUT", usage: "ожидание завершения активных запросов при остановке", value: durationValue{&c.Server.ShutdownTimeout}},
+		{key: "server.grpc_port", env: "GRPC_PORT", usage: "порт gRPC API (0 - не запускать)", value: intValue{&c.Server.GRPCPort}},
+		{key: "server.stream_heartbeat_interval", env: "STREAM_HEARTBEAT_INTERVAL", usage: "период heartbeat в потоке /users/reviewStream", value: durationValue{&c.Server.StreamHeartbeatInterval}},
+		{key: "server.idempotency_ttl", env: "IDEMPOTENCY_TTL", usage: "срок хранения ответа на запрос с Idempotency-Key", value: durationValue{&c.Server.IdempotencyTTL}},
+		{key: "server.idempotency_lease", env: "IDEMPOTENCY_LEASE", usage: "аренда Idempotency-Key выполняющимся запросом", value: durationValue{&c.Server.IdempotencyLease}},
+
+		{key: "database.host", env: "DB_HOST", usage: "хост PostgreSQL", value: stringValue{&c.Database.Host}},
+		{key: "database.port", env: "DB_PORT", usage: "порт PostgreSQL", value: intValue{&c.Database.Port}},
+		{key: "database.user", env: "DB_USER", usage: "пользователь PostgreSQL", value: stringValue{&c.Database.User}},
+		{key: "database.password", env: "DB_PASSWORD", usage: "пароль PostgreSQL", secret: true, value: stringValue{&c.Database.Password}},
+		{key: "database.name", env: "DB_NAME", usage: "имя базы данных", value: stringValue{&c.Database.Name}},
+		{key: "database.ssl_mode", env: "DB_SSL_MODE", usage: "режим TLS: disable, allow, prefer, require, verify-ca, verify-full", value: stringValue{&c.Database.SSLMode}},
+		{key: "database.ssl_root_cert", env: "DB_SSL_ROOT_CERT", usage: "CA-сертификат сервера для verify-ca и verify-full", value: stringValue{&c.Database.SSLRootCert}},
+		{key: "database.ssl_cert", env: "DB_SSL_CERT", usage: "клиентский сертификат", value: stringValue{&c.Database.SSLCert}},
+		{key: "database.ssl_key", env: "DB_SSL_KEY", usage: "ключ клиентского сертификата", value: stringValue{&c.Database.SSLKey}},
+		{key: "database.max_open_conns", env: "DB_MAX_OPEN_CONNS", usage: "максимум открытых соединений (0 - без ограничения)", value: intValue{&c.Database.MaxOpenConns}},
+		{key: "database.max_idle_conns", env: "DB_MAX_IDLE_CONNS", usage: "максимум простаивающих соединений", value: intValue{&c.Database.MaxIdleConns}},
+		{key: "database.conn_max_lifetime", env: "DB_CONN_MAX_LIFETIME", usage: "максимальное время жизни соединения (0 - без ограничения)", value: durationValue{&c.Database.ConnMaxLifetime}},
+		{key: "database.conn_max_idle_time", env: "DB_CONN_MAX_IDLE_TIME", usage: "максимальное время простоя соединения (0 - без ограничения)", value: durationValue{&c.Database.ConnMaxIdleTime}},
+		{key: "database.statement_timeout", env: "DB_STATEMENT_TIMEOUT", usage: "серверный таймаут каждого запроса (0 - без ограничения)", value: durationValue{&c.Database.StatementTimeout}},
+		{key: "database.connect_attempts", env: "DB_CONNECT_ATTEMPTS", usage: "попытки подключения при старте", value: intValue{&c.Database.ConnectAttempts}},
+		{key: "database.connect_backoff", env: "DB_CONNECT_BACKOFF", usage: "пауза перед первой повторной попыткой подключения", value: durationValue{&c.Database.ConnectBackoff}},
+		{key: "database.retry_attempts", env: "DB_RETRY_ATTEMPTS", usage: "попытки запроса или транзакции при временных ошибках", value: intValue{&c.Database.RetryAttempts}},
+		{key: "database.retry_backoff", env: "DB_RETRY_BACKOFF", usage: "пауза перед первым повтором запроса", value: durationValue{&c.Database.RetryBackoff}},
+		{key: "database.migration_mode", env: "DB_MIGRATION_MODE", usage: "миграции при запуске serve: auto - применить, check - не стартовать при отстающей схеме", value: stringValue{&c.Database.MigrationMode}},
+
+		{key: "sqlite.path", env: "SQLITE_PATH", usage: "файл базы SQLite для storage=sqlite", value: stringValue{&c.SQLite.Path}},
+
+		{key: "assignment.escalation_policy", env: "ASSIGNMENT_ESCALATION_POLICY", usage: "политика эскалации: none, siblings, parent, siblings_then_parent", value: stringValue{&c.Assignment.EscalationPolicy}},
+		{key: "assignment.max_reviewers", env: "ASSIGNMENT_MAX_REVIEWERS", usage: "количество ревьюверов на PR", value: intValue{&c.Assignment.MaxReviewers}},
+		{key: "assignment.fair_share_window", env: "ASSIGNMENT_FAIR_SHARE_WINDOW", usage: "окно отчета о справедливости для выбора участников команды (0 - случайный выбор)", value: durationValue{&c.Assignment.FairShareWindow}},
+		{key: "assignment.max_open_reviews", env: "ASSIGNMENT_MAX_OPEN_REVIEWS", usage: "максимум открытых PR на ревью у пользователя (0 - без ограничения)", value: intValue{&c.Assignment.MaxOpenReviews}},
+
+		{key: "auth.enabled", env: "AUTH_ENABLED", usage: "требовать Bearer-токен для запросов к API", value: boolValue{&c.Auth.Enabled}},
+		{key: "auth.tokens", env: "AUTH_TOKENS", usage: "допустимые токены через запятую", secret: true, value: listValue{&c.Auth.Tokens}},
+
+		{key: "cors.allowed_origins", env: "CORS_ALLOWED_ORIGINS", usage: "разрешенные источники CORS через запятую (* - любой)", value: listValue{&c.CORS.AllowedOrigins}},
+
+		{key: "log.level", env: "LOG_LEVEL", usage: "уровень логирования: trace, debug, info, warn, error", value: stringValue{&c.Log.Level}},
+		{key: "log.format", env: "LOG_FORMAT", usage: "формат логов: json, text", value: stringValue{&c.Log.Format}},
+
+		{key: "metrics.refresh_interval", env: "METRICS_REFRESH_INTERVAL", usage: "период обновления метрик нагрузки", value: durationValue{&c.Metrics.RefreshInterval}},
+
+		{key: "tracing.exporter", env: "TRACING_EXPORTER", usage: "экспортер спанов: stdout, file, none", value: stringValue{&c.Tracing.Exporter}},
+		{key: "tracing.file", env: "TRACING_FILE", usage: "файл для экспортера file", value: stringValue{&c.Tracing.File}},
+
+		{key: "graphql.max_depth", env: "GRAPHQL_MAX_DEPTH", usage: "максимальная вложенность запроса /graphql", value: intValue{&c.GraphQL.MaxDepth}},
+		{key: "graphql.max_complexity", env: "GRAPHQL_MAX_COMPLEXITY", usage: "максимальная сложность запроса /graphql", value: intValue{&c.GraphQL.MaxComplexity}},
+	}
+}
+
+func isBoolField(f field) bool {
+	_, ok := f.value.(boolValue)
+	return ok
+}
+
+type stringValue struct{ p *string }
+
+func (v stringValue) Set(s string) error {
+	*v.p = s
+	return nil
+}
+
+func (v stringValue) Get() interface{} { return *v.p }
+
+type intValue struct{ p *int }
+
+func (v intValue) Set(s string) error {
+	n, err := strconv.Atoi(strings.TrimSpace(s))
+	if err != nil {
+		return err
+	}
+	*v.p = n
+	return nil
+}
+
+func (v intValue) Get() interface{} { return *v.p }
+
+type boolValue struct{ p *bool }
+
+func (v boolValue) Set(s string) error {
+	b, err := strconv.ParseBool(strings.TrimSpace(s))
+	if err != nil {
+		return err
+	}
+	*v.p = b
+	return nil
+}
+
+func (v boolValue) Get() interface{} { return *v.p }
+
+type durationValue struct{ p *time.Duration }
+
+func (v durationValue) Set(s string) error {
+	d, err := time.ParseDuration(strings.TrimSpace(s))
+	if err != nil {
+		return err
+	}
+	*v.p = d
+	return nil
+}
+
+// Get возвращает длительность строкой ("15s"), как она записывается в YAML
+func (v durationValue) Get() interface{} { return v.p.String() }
+
+// listValue - список через запятую; пустые элементы отбрасываются
+type listValue struct{ p *[]string }
+
+func (v listValue) Set(s string) error {
+	items := []string{}
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	*v.p = items
+	return nil
+}
+
+func (v listValue) Get() interface{} { return *v.p }
@@ -0,0 +1,72 @@
+package config
+
+import (
+	"io"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// redacted заменяет значения секретов при выводе конфигурации
+const redacted = "<redacted>"
+
+// WriteYAML выводит конфигурацию в формате YAML-файла; пароли и токены заменяются на "<redacted>".
+func (c Config) WriteYAML(w io.Writer) error {
+	root := &yaml.Node{Kind: yaml.MappingNode}
+	sections := make(map[string]*yaml.Node)
+
+	for _, f := range c.fields() {
+		var valueNode yaml.Node
+		if err := valueNode.Encode(redact(f)); err != nil {
+			return err
+		}
+
+		// Настройки верхнего уровня (storage) выводятся без секции
+		sectionName, key, nested := strings.Cut(f.key, ".")
+		if !nested {
+			root.Content = append(root.Content, scalarNode(f.key), &valueNode)
+			continue
+		}
+
+		section, ok := sections[sectionName]
+		if !ok {
+			section = &yaml.Node{Kind: yaml.MappingNode}
+			sections[sectionName] = section
+			root.Content = append(root.Content, scalarNode(sectionName), section)
+		}
+		section.Content = append(section.Content, scalarNode(key), &valueNode)
+	}
+
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(root); err != nil {
+		return err
+	}
+	return encoder.Close()
+}
+
+// redact скрывает непустые значения секретных настроек
+func redact(f field) interface{} {
+	v := f.value.Get()
+	if !f.secret {
+		return v
+	}
+
+	switch v := v.(type) {
+	case string:
+		if v != "" {
+			return redacted
+		}
+	case []string:
+		hidden := make([]string, len(v))
+		for i := range hidden {
+			hidden[i] = redacted
+		}
+		return hidden
+	}
+	return v
+}
+
+func scalarNode(value string) *yaml.Node {
+	return &yaml.Node{Kind: yaml.ScalarNode, Value: value}
+}
@@ -0,0 +1,126 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"pr-reviewer-service/internal/domain"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Validate проверяет конфигурацию и возвращает все найденные ошибки разом.
+func (c Config) Validate() error {
+	var errs []error
+	check := func(ok bool, format string, args ...interface{}) {
+		if !ok {
+			errs = append(errs, fmt.Errorf(format, args...))
+		}
+	}
+
+	check(c.Storage == StoragePostgres || c.Storage == StorageSQLite || c.Storage == StorageMemory,
+		"storage: expected %s, %s or %s, got %q", StoragePostgres, StorageSQLite, StorageMemory, c.Storage)
+	check(c.Storage != StorageSQLite || c.SQLite.Path != "", "sqlite.path: must not be empty for storage %s", StorageSQLite)
+
+	// Сервер
+	check(validPort(c.Server.Port), "server.port: must be between 1 and 65535, got %d", c.Server.Port)
+	check(c.Server.ReadTimeout >= 0, "server.read_timeout: must not be negative")
+	check(c.Server.ReadHeaderTimeout >= 0, "server.read_header_timeout: must not be negative")
+	check(c.Server.WriteTimeout >= 0, "server.write_timeout: must not be negative")
+	check(c.Server.IdleTimeout >= 0, "server.idle_timeout: must not be negative")
+	check(c.Server.ReadinessTimeout > 0, "server.readiness_timeout: must be positive")
+	check(c.Server.ShutdownDrainDelay >= 0, "server.shutdown_drain_delay: must not be negative")
+	check(c.Server.ShutdownTimeout > 0, "server.shutdown_timeout: must be positive")
+	check(c.Server.GRPCPort == 0 || validPort(c.Server.GRPCPort), "server.grpc_port: must be 0 or between 1 and 65535, got %d", c.Server.GRPCPort)
+	check(c.Server.StreamHeartbeatInterval > 0, "server.stream_heartbeat_interval: must be positive")
+	check(c.Server.IdempotencyTTL > 0, "server.idempotency_ttl: must be positive")
+	check(c.Server.IdempotencyLease > 0 && c.Server.IdempotencyLease <= c.Server.IdempotencyTTL,
+		"server.idempotency_lease: must be positive and not exceed server.idempotency_ttl")
+	check(c.Server.GRPCPort != c.Server.Port, "server.grpc_port: must differ from server.port %d", c.Server.Port)
+
+	// База данных
+	check(c.Database.Host != "", "database.host: must not be empty")
+	check(validPort(c.Database.Port), "database.port: must be between 1 and 65535, got %d", c.Database.Port)
+	check(c.Database.User != "", "database.user: must not be empty")
+	check(c.Database.Name != "", "database.name: must not be empty")
+	check(c.Database.MaxOpenConns >= 0, "database.max_open_conns: must not be negative")
+	check(c.Database.MaxIdleConns >= 0, "database.max_idle_conns: must not be negative")
+	check(c.Database.MaxOpenConns == 0 || c.Database.MaxIdleConns <= c.Database.MaxOpenConns,
+		"database.max_idle_conns: must not exceed max_open_conns (%d)", c.Database.MaxOpenConns)
+	check(sslModes[c.Database.SSLMode], "database.ssl_mode: unknown mode %q", c.Database.SSLMode)
+	check((c.Database.SSLCert == "") == (c.Database.SSLKey == ""), "database.ssl_cert, database.ssl_key: must be set together")
+	check(c.Database.ConnMaxLifetime >= 0, "database.conn_max_lifetime: must not be negative")
+	check(c.Database.ConnMaxIdleTime >= 0, "database.conn_max_idle_time: must not be negative")
+	check(c.Database.StatementTimeout >= 0, "database.statement_timeout: must not be negative")
+	check(c.Database.ConnectAttempts >= 1, "database.connect_attempts: must be at least 1, got %d", c.Database.ConnectAttempts)
+	check(c.Database.ConnectBackoff >= 0, "database.connect_backoff: must not be negative")
+	check(c.Database.RetryAttempts >= 1, "database.retry_attempts: must be at least 1, got %d", c.Database.RetryAttempts)
+	check(c.Database.RetryBackoff >= 0, "database.retry_backoff: must not be negative")
+	check(c.Database.MigrationMode == MigrationModeAuto || c.Database.MigrationMode == MigrationModeCheck,
+		"database.migration_mode: expected %s or %s, got %q", MigrationModeAuto, MigrationModeCheck, c.Database.MigrationMode)
+
+	// Назначение ревьюверов
+	_, err := domain.ParseEscalationPolicy(c.Assignment.EscalationPolicy)
+	check(err == nil, "assignment.escalation_policy: unknown policy %q", c.Assignment.EscalationPolicy)
+	check(c.Assignment.MaxReviewers >= 1, "assignment.max_reviewers: must be at least 1, got %d", c.Assignment.MaxReviewers)
+	check(c.Assignment.FairShareWindow >= 0, "assignment.fair_share_window: must not be negative")
+	check(c.Assignment.MaxOpenReviews >= 0, "assignment.max_open_reviews: must not be negative, got %d", c.Assignment.MaxOpenReviews)
+
+	// Аутентификация
+	check(!c.Auth.Enabled || len(c.Auth.Tokens) > 0, "auth.tokens: at least one token is required when auth is enabled")
+	for _, token := range c.Auth.Tokens {
+		check(strings.TrimSpace(token) != "", "auth.tokens: tokens must not be empty")
+	}
+
+	// CORS
+	for _, origin := range c.CORS.AllowedOrigins {
+		check(validOrigin(origin), "cors.allowed_origins: invalid origin %q, expected * or scheme://host[:port]", origin)
+	}
+
+	// Логирование, метрики и трассировка
+	_, err = logrus.ParseLevel(c.Log.Level)
+	check(err == nil, "log.level: unknown level %q", c.Log.Level)
+	check(c.Log.Format == "json" || c.Log.Format == "text", "log.format: expected json or text, got %q", c.Log.Format)
+	check(c.Metrics.RefreshInterval > 0, "metrics.refresh_interval: must be positive")
+	switch c.Tracing.Exporter {
+	case "stdout", "none":
+	case "file":
+		check(c.Tracing.File != "", "tracing.file: must not be empty for the file exporter")
+	default:
+		check(false, "tracing.exporter: expected stdout, file or none, got %q", c.Tracing.Exporter)
+	}
+
+	// GraphQL
+	check(c.GraphQL.MaxDepth >= 1, "graphql.max_depth: must be at least 1, got %d", c.GraphQL.MaxDepth)
+	check(c.GraphQL.MaxComplexity >= 1, "graphql.max_complexity: must be at least 1, got %d", c.GraphQL.MaxComplexity)
+
+	return errors.Join(errs...)
+}
+
+// sslModes - режимы sslmode, которые понимает libpq и pgx
+var sslModes = map[string]bool{
+	"disable":     true,
+	"allow":       true,
+	"prefer":      true,
+	"require":     true,
+	"verify-ca":   true,
+	"verify-full": true,
+}
+
+func validPort(port int) bool {
+	return port > 0 && port <= 65535
+}
+
+// validOrigin допускает "*" или источник вида scheme://host[:port] без пути
+func validOrigin(origin string) bool {
+	if origin == "*" {
+		return true
+	}
+	u, err := url.Parse(origin)
+	if err != nil {
+		return false
+	}
+	return (u.Scheme == "http" || u.Scheme == "https") && u.Host != "" && (u.Path == "" || u.Path == "/") && u.RawQuery == ""
+}
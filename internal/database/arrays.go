@@ -0,0 +1,54 @@
+package database
+
+import (
+	"database/sql"
+	"fmt"
+	"sync"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// arrayTypeMap разбирает массивы PostgreSQL: через database/sql драйвер pgx отдает их
+// текстом вида {a,"b c"}. pgtype.Map кэширует планы сканирования и не потокобезопасна.
+var (
+	arrayTypeMap   = pgtype.NewMap()
+	arrayTypeMapMu sync.Mutex
+)
+
+// textArray сканирует значение text[] в срез строк; NULL и пустой массив дают пустой срез
+func textArray(dst *[]string) sql.Scanner {
+	return &textArrayScanner{dst: dst}
+}
+
+type textArrayScanner struct {
+	dst *[]string
+}
+
+func (s *textArrayScanner) Scan(src any) error {
+	var raw []byte
+	switch src := src.(type) {
+	case nil:
+		*s.dst = []string{}
+		return nil
+	case string:
+		raw = []byte(src)
+	case []byte:
+		raw = src
+	default:
+		return fmt.Errorf("cannot scan %T into text array", src)
+	}
+
+	var values []string
+	arrayTypeMapMu.Lock()
+	err := arrayTypeMap.Scan(pgtype.TextArrayOID, pgtype.TextFormatCode, raw, &values)
+	arrayTypeMapMu.Unlock()
+	if err != nil {
+		return fmt.Errorf("failed to scan text array: %w", err)
+	}
+
+	if values == nil {
+		values = []string{}
+	}
+	*s.dst = values
+	return nil
+}
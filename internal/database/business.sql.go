@@ -7,8 +7,21 @@ package database
 
 import (
 	"context"
+	"database/sql"
+	"time"
 )
 
+const countOpenPRs = `-- name: CountOpenPRs :one
+SELECT COUNT(*) FROM pull_requests WHERE status = 'OPEN'
+`
+
+func (q *Queries) CountOpenPRs(ctx context.Context) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countOpenPRs)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const deactivateTeamUsers = `-- name: DeactivateTeamUsers :exec
 UPDATE users 
 SET is_active = false 
@@ -81,6 +94,61 @@ func (q *Queries) GetAllTeams(ctx context.Context) ([]string, error) {
 	return items, nil
 }
 
+const getMemberAssignments = `-- name: GetMemberAssignments :many
+SELECT u.user_id, u.username, u.team_name, COUNT(pr.pull_request_id) AS assignments_count
+FROM users u
+LEFT JOIN reviewers r ON u.user_id = r.user_id
+LEFT JOIN pull_requests pr ON pr.pull_request_id = r.pull_request_id
+    AND pr.created_at >= $1
+    AND pr.created_at < $2
+WHERE ($3::text IS NULL OR u.team_name = $3)
+GROUP BY u.user_id, u.username, u.team_name
+ORDER BY u.team_name, u.user_id
+`
+
+type GetMemberAssignmentsParams struct {
+	CreatedFrom time.Time
+	CreatedTo   time.Time
+	TeamName    sql.NullString
+}
+
+type GetMemberAssignmentsRow struct {
+	UserID           string
+	Username         string
+	TeamName         string
+	AssignmentsCount int64
+}
+
+// Назначения каждого пользователя на PR, созданные в интервале [created_from, created_to),
+// для отчета о справедливости; пользователи без назначений попадают в выборку с нулем.
+func (q *Queries) GetMemberAssignments(ctx context.Context, arg GetMemberAssignmentsParams) ([]GetMemberAssignmentsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getMemberAssignments, arg.CreatedFrom, arg.CreatedTo, arg.TeamName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetMemberAssignmentsRow
+	for rows.Next() {
+		var i GetMemberAssignmentsRow
+		if err := rows.Scan(
+			&i.UserID,
+			&i.Username,
+			&i.TeamName,
+			&i.AssignmentsCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getOpenPRsWithTeamReviewers = `-- name: GetOpenPRsWithTeamReviewers :many
 SELECT DISTINCT pr.pull_request_id
 FROM pull_requests pr
@@ -120,19 +188,37 @@ SELECT
     pr.pull_request_name,
     COUNT(r.user_id) as reviewers_count
 FROM pull_requests pr
+JOIN users u ON u.user_id = pr.author_id
 LEFT JOIN reviewers r ON pr.pull_request_id = r.pull_request_id
+WHERE ($1::text IS NULL OR pr.status = $1)
+  AND ($2::timestamptz IS NULL OR pr.created_at >= $2)
+  AND ($3::timestamptz IS NULL OR pr.created_at < $3)
+  AND ($4::text IS NULL OR u.team_name = $4)
 GROUP BY pr.pull_request_id, pr.pull_request_name
 ORDER BY reviewers_count DESC
 `
 
+type GetPRAssignmentStatsParams struct {
+	Status      sql.NullString
+	CreatedFrom sql.NullTime
+	CreatedTo   sql.NullTime
+	TeamName    sql.NullString
+}
+
 type GetPRAssignmentStatsRow struct {
 	PullRequestID   string
 	PullRequestName string
 	ReviewersCount  int64
 }
 
-func (q *Queries) GetPRAssignmentStats(ctx context.Context) ([]GetPRAssignmentStatsRow, error) {
-	rows, err := q.db.QueryContext(ctx, getPRAssignmentStats)
+// Фильтр по команде - по команде автора PR.
+func (q *Queries) GetPRAssignmentStats(ctx context.Context, arg GetPRAssignmentStatsParams) ([]GetPRAssignmentStatsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getPRAssignmentStats,
+		arg.Status,
+		arg.CreatedFrom,
+		arg.CreatedTo,
+		arg.TeamName,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -192,21 +278,40 @@ func (q *Queries) GetPRReviewersFromTeam(ctx context.Context, arg GetPRReviewers
 }
 
 const getReviewStats = `-- name: GetReviewStats :many
-SELECT u.user_id, u.username, COUNT(r.pull_request_id) as review_count
+SELECT u.user_id, u.username, COUNT(pr.pull_request_id) as review_count
 FROM users u
 LEFT JOIN reviewers r ON u.user_id = r.user_id
+LEFT JOIN pull_requests pr ON pr.pull_request_id = r.pull_request_id
+    AND ($1::text IS NULL OR pr.status = $1)
+    AND ($2::timestamptz IS NULL OR pr.created_at >= $2)
+    AND ($3::timestamptz IS NULL OR pr.created_at < $3)
+WHERE ($4::text IS NULL OR u.team_name = $4)
 GROUP BY u.user_id, u.username
 ORDER BY review_count DESC
 `
 
+type GetReviewStatsParams struct {
+	Status      sql.NullString
+	CreatedFrom sql.NullTime
+	CreatedTo   sql.NullTime
+	TeamName    sql.NullString
+}
+
 type GetReviewStatsRow struct {
 	UserID      string
 	Username    string
 	ReviewCount int64
 }
 
-func (q *Queries) GetReviewStats(ctx context.Context) ([]GetReviewStatsRow, error) {
-	rows, err := q.db.QueryContext(ctx, getReviewStats)
+// Назначения считаются только на PR, подходящие под фильтр по статусу и времени создания;
+// пользователи без таких назначений попадают в выборку с нулем. Фильтр по команде - по команде пользователя.
+func (q *Queries) GetReviewStats(ctx context.Context, arg GetReviewStatsParams) ([]GetReviewStatsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getReviewStats,
+		arg.Status,
+		arg.CreatedFrom,
+		arg.CreatedTo,
+		arg.TeamName,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -227,3 +332,224 @@ func (q *Queries) GetReviewStats(ctx context.Context) ([]GetReviewStatsRow, erro
 	}
 	return items, nil
 }
+
+const getTeamReviewLoad = `-- name: GetTeamReviewLoad :many
+SELECT t.team_name, COUNT(pr.pull_request_id) AS open_reviews_count
+FROM teams t
+LEFT JOIN users u ON u.team_name = t.team_name
+LEFT JOIN reviewers r ON r.user_id = u.user_id
+LEFT JOIN pull_requests pr ON pr.pull_request_id = r.pull_request_id AND pr.status = 'OPEN'
+GROUP BY t.team_name
+ORDER BY t.team_name
+`
+
+type GetTeamReviewLoadRow struct {
+	TeamName         string
+	OpenReviewsCount int64
+}
+
+func (q *Queries) GetTeamReviewLoad(ctx context.Context) ([]GetTeamReviewLoadRow, error) {
+	rows, err := q.db.QueryContext(ctx, getTeamReviewLoad)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetTeamReviewLoadRow
+	for rows.Next() {
+		var i GetTeamReviewLoadRow
+		if err := rows.Scan(&i.TeamName, &i.OpenReviewsCount); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTeamReviewStats = `-- name: GetTeamReviewStats :many
+WITH prs AS (
+    SELECT pr.pull_request_id, pr.status, u.team_name
+    FROM pull_requests pr
+    JOIN users u ON u.user_id = pr.author_id
+    WHERE ($1::text IS NULL OR pr.status = $1)
+      AND ($2::timestamptz IS NULL OR pr.created_at >= $2)
+      AND ($3::timestamptz IS NULL OR pr.created_at < $3)
+),
+reviews AS (
+    SELECT prs.team_name AS author_team, u.team_name AS reviewer_team
+    FROM prs
+    JOIN reviewers r ON r.pull_request_id = prs.pull_request_id
+    JOIN users u ON u.user_id = r.user_id
+)
+SELECT
+    t.team_name,
+    (SELECT COUNT(*) FROM prs WHERE prs.team_name = t.team_name) AS pull_requests_count,
+    (SELECT COUNT(*) FROM prs WHERE prs.team_name = t.team_name AND prs.status = 'OPEN') AS open_pull_requests_count,
+    (SELECT COUNT(*) FROM reviews WHERE reviews.reviewer_team = t.team_name) AS reviews_given_count,
+    (SELECT COUNT(*) FROM reviews WHERE reviews.author_team = t.team_name) AS reviews_received_count,
+    (SELECT COUNT(*) FROM reviews
+        WHERE reviews.author_team = t.team_name AND reviews.reviewer_team <> t.team_name) AS cross_team_reviews_count
+FROM teams t
+WHERE ($4::text IS NULL OR t.team_name = $4)
+ORDER BY t.team_name
+`
+
+type GetTeamReviewStatsParams struct {
+	Status      sql.NullString
+	CreatedFrom sql.NullTime
+	CreatedTo   sql.NullTime
+	TeamName    sql.NullString
+}
+
+type GetTeamReviewStatsRow struct {
+	TeamName              string
+	PullRequestsCount     int64
+	OpenPullRequestsCount int64
+	ReviewsGivenCount     int64
+	ReviewsReceivedCount  int64
+	CrossTeamReviewsCount int64
+}
+
+// Показатели команд по PR, подходящим под фильтр: PR и полученные ревью считаются по команде автора,
+// выполненные ревью - по команде ревьювера.
+func (q *Queries) GetTeamReviewStats(ctx context.Context, arg GetTeamReviewStatsParams) ([]GetTeamReviewStatsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getTeamReviewStats,
+		arg.Status,
+		arg.CreatedFrom,
+		arg.CreatedTo,
+		arg.TeamName,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetTeamReviewStatsRow
+	for rows.Next() {
+		var i GetTeamReviewStatsRow
+		if err := rows.Scan(
+			&i.TeamName,
+			&i.PullRequestsCount,
+			&i.OpenPullRequestsCount,
+			&i.ReviewsGivenCount,
+			&i.ReviewsReceivedCount,
+			&i.CrossTeamReviewsCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTeamTreeStats = `-- name: GetTeamTreeStats :many
+SELECT
+    t.team_name,
+    t.parent_team_name,
+    (SELECT COUNT(*) FROM users u
+        WHERE u.team_name = t.team_name) AS members_count,
+    (SELECT COUNT(*) FROM users u
+        WHERE u.team_name = t.team_name AND u.is_active = true) AS active_members_count,
+    (SELECT COUNT(*) FROM pull_requests pr
+        JOIN users u ON pr.author_id = u.user_id
+        WHERE u.team_name = t.team_name AND pr.status = 'OPEN') AS open_prs_count,
+    (SELECT COUNT(*) FROM reviewers r
+        JOIN pull_requests pr ON r.pull_request_id = pr.pull_request_id
+        JOIN users u ON r.user_id = u.user_id
+        WHERE u.team_name = t.team_name AND pr.status = 'OPEN') AS open_reviews_count
+FROM teams t
+ORDER BY t.team_name
+`
+
+type GetTeamTreeStatsRow struct {
+	TeamName           string
+	ParentTeamName     sql.NullString
+	MembersCount       int64
+	ActiveMembersCount int64
+	OpenPrsCount       int64
+	OpenReviewsCount   int64
+}
+
+func (q *Queries) GetTeamTreeStats(ctx context.Context) ([]GetTeamTreeStatsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getTeamTreeStats)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetTeamTreeStatsRow
+	for rows.Next() {
+		var i GetTeamTreeStatsRow
+		if err := rows.Scan(
+			&i.TeamName,
+			&i.ParentTeamName,
+			&i.MembersCount,
+			&i.ActiveMembersCount,
+			&i.OpenPrsCount,
+			&i.OpenReviewsCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getUserStatusHistory = `-- name: GetUserStatusHistory :many
+SELECT h.user_id, h.is_active, h.changed_at
+FROM user_status_history h
+JOIN users u ON u.user_id = h.user_id
+WHERE ($1::text IS NULL OR u.team_name = $1)
+  AND h.changed_at < $2
+ORDER BY h.user_id, h.changed_at, h.id
+`
+
+type GetUserStatusHistoryParams struct {
+	TeamName      sql.NullString
+	ChangedBefore time.Time
+}
+
+type GetUserStatusHistoryRow struct {
+	UserID    string
+	IsActive  bool
+	ChangedAt time.Time
+}
+
+// Смены активности пользователей команды (или всех пользователей) до момента changed_before.
+func (q *Queries) GetUserStatusHistory(ctx context.Context, arg GetUserStatusHistoryParams) ([]GetUserStatusHistoryRow, error) {
+	rows, err := q.db.QueryContext(ctx, getUserStatusHistory, arg.TeamName, arg.ChangedBefore)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetUserStatusHistoryRow
+	for rows.Next() {
+		var i GetUserStatusHistoryRow
+		if err := rows.Scan(&i.UserID, &i.IsActive, &i.ChangedAt); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
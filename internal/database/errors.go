@@ -0,0 +1,18 @@
+package database
+
+import (
+	"errors"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// sqlStateUniqueViolation - нарушение первичного ключа или уникального индекса
+const sqlStateUniqueViolation = "23505"
+
+// IsUniqueViolation сообщает, что запись с таким ключом уже существует.
+// Репозитории переводят такую ошибку в доменную (ErrTeamAlreadyExists, ErrPRAlreadyExists),
+// чтобы конкурентное создание давало тот же ответ, что и проверка существования в usecase.
+func IsUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == sqlStateUniqueViolation
+}
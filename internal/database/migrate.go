@@ -0,0 +1,100 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io/fs"
+
+	"github.com/pressly/goose/v3"
+	"github.com/pressly/goose/v3/lock"
+)
+
+// ErrSchemaBehind - в базе применены не все встроенные миграции.
+var ErrSchemaBehind = errors.New("database schema is behind the service version")
+
+// Migrator применяет встроенные миграции. Для PostgreSQL изменяющие операции берут advisory-блокировку,
+// поэтому несколько реплик или команд migrate не выполняют миграции одновременно.
+type Migrator struct {
+	provider *goose.Provider
+}
+
+// NewMigrator создает Migrator для встроенных миграций.
+func NewMigrator(db *sql.DB) (*Migrator, error) {
+	migrations, err := fs.Sub(EmbedMigrations, "migrations")
+	if err != nil {
+		return nil, fmt.Errorf("failed to open embedded migrations: %w", err)
+	}
+
+	locker, err := lock.NewPostgresSessionLocker()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create migration lock: %w", err)
+	}
+
+	return NewMigratorFor(goose.DialectPostgres, db, migrations, goose.WithSessionLocker(locker))
+}
+
+// NewMigratorFor создает Migrator для другого набора миграций и диалекта
+// (например, встроенных миграций SQLite).
+func NewMigratorFor(dialect goose.Dialect, db *sql.DB, migrations fs.FS, opts ...goose.ProviderOption) (*Migrator, error) {
+	provider, err := goose.NewProvider(dialect, db, migrations, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create migration provider: %w", err)
+	}
+
+	return &Migrator{provider: provider}, nil
+}
+
+// Up применяет все ожидающие миграции.
+func (m *Migrator) Up(ctx context.Context) ([]*goose.MigrationResult, error) {
+	return m.provider.Up(ctx)
+}
+
+// Down откатывает последнюю примененную миграцию.
+func (m *Migrator) Down(ctx context.Context) (*goose.MigrationResult, error) {
+	return m.provider.Down(ctx)
+}
+
+// Redo откатывает и заново применяет последнюю миграцию.
+func (m *Migrator) Redo(ctx context.Context) ([]*goose.MigrationResult, error) {
+	down, err := m.provider.Down(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	up, err := m.provider.UpByOne(ctx)
+	if err != nil {
+		return []*goose.MigrationResult{down}, err
+	}
+
+	return []*goose.MigrationResult{down, up}, nil
+}
+
+// Status возвращает состояние каждой встроенной миграции.
+func (m *Migrator) Status(ctx context.Context) ([]*goose.MigrationStatus, error) {
+	return m.provider.Status(ctx)
+}
+
+// Versions возвращает версию схемы в базе и версию последней встроенной миграции.
+func (m *Migrator) Versions(ctx context.Context) (current, target int64, err error) {
+	return m.provider.GetVersions(ctx)
+}
+
+// Check возвращает ErrSchemaBehind, если в базе есть неприменённые миграции. Блокировку не берет,
+// поэтому подходит для проверок готовности и не ждет идущих миграций.
+func (m *Migrator) Check(ctx context.Context) error {
+	pending, err := m.provider.HasPending(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to check migrations: %w", err)
+	}
+	if !pending {
+		return nil
+	}
+
+	current, target, err := m.Versions(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrSchemaBehind, err)
+	}
+	return fmt.Errorf("%w: version %d, expected %d", ErrSchemaBehind, current, target)
+}
@@ -0,0 +1,14 @@
+-- +goose Up
+-- Родительская команда (отдел/подразделение), NULL для корневых команд
+ALTER TABLE teams
+    ADD COLUMN parent_team_name VARCHAR(100) NULL REFERENCES teams(team_name) ON DELETE SET NULL,
+    ADD CONSTRAINT chk_teams_parent_not_self CHECK (parent_team_name IS NULL OR parent_team_name <> team_name);
+
+-- Индекс для поиска дочерних команд (эскалация назначения, дерево команд)
+CREATE INDEX IF NOT EXISTS idx_teams_parent
+ON teams(parent_team_name);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_teams_parent;
+ALTER TABLE teams DROP CONSTRAINT IF EXISTS chk_teams_parent_not_self;
+ALTER TABLE teams DROP COLUMN IF EXISTS parent_team_name;
@@ -0,0 +1,22 @@
+-- +goose Up
+-- Правила владения кодом (CODEOWNERS) по репозиториям; порядок важен - побеждает последнее совпавшее правило
+CREATE TABLE ownership_rules (
+    repository VARCHAR(200) NOT NULL,
+    position INTEGER NOT NULL,
+    pattern VARCHAR(500) NOT NULL,
+    PRIMARY KEY (repository, position)
+);
+
+-- Владельцы правила: пользователи и команды
+CREATE TABLE ownership_rule_owners (
+    repository VARCHAR(200) NOT NULL,
+    position INTEGER NOT NULL,
+    owner_type VARCHAR(10) NOT NULL CHECK (owner_type IN ('user', 'team')),
+    owner_id VARCHAR(100) NOT NULL,
+    PRIMARY KEY (repository, position, owner_type, owner_id),
+    FOREIGN KEY (repository, position) REFERENCES ownership_rules(repository, position) ON DELETE CASCADE
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS ownership_rule_owners;
+DROP TABLE IF EXISTS ownership_rules;
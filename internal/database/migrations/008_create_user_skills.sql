@@ -0,0 +1,13 @@
+-- +goose Up
+-- Теги экспертизы пользователей (go, postgres, frontend, ...) для подбора ревьюверов по навыкам
+CREATE TABLE user_skills (
+    user_id VARCHAR(50) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    tag VARCHAR(50) NOT NULL,
+    PRIMARY KEY (user_id, tag)
+);
+
+CREATE INDEX idx_user_skills_tag ON user_skills(tag);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_user_skills_tag;
+DROP TABLE IF EXISTS user_skills;
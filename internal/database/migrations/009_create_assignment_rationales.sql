@@ -0,0 +1,33 @@
+-- +goose Up
+-- Объяснение назначения ревьюверов: стратегия и размер пула кандидатов
+CREATE TABLE assignment_rationales (
+    pull_request_id VARCHAR(100) PRIMARY KEY REFERENCES pull_requests(pull_request_id) ON DELETE CASCADE,
+    strategy VARCHAR(100) NOT NULL,
+    candidate_pool_size INTEGER NOT NULL
+);
+
+-- Причина выбора каждого ревьювера и компоненты оценки (matched_tags - теги через запятую)
+CREATE TABLE reviewer_selections (
+    pull_request_id VARCHAR(100) NOT NULL REFERENCES assignment_rationales(pull_request_id) ON DELETE CASCADE,
+    user_id VARCHAR(50) NOT NULL,
+    position INTEGER NOT NULL,
+    reason VARCHAR(30) NOT NULL,
+    matched_tags TEXT NOT NULL DEFAULT '',
+    open_reviews INTEGER NOT NULL DEFAULT 0,
+    skill_score DOUBLE PRECISION NOT NULL DEFAULT 0,
+    workload_score DOUBLE PRECISION NOT NULL DEFAULT 0,
+    PRIMARY KEY (pull_request_id, user_id)
+);
+
+-- Пользователи, исключенные из подбора, и причина
+CREATE TABLE reviewer_exclusions (
+    pull_request_id VARCHAR(100) NOT NULL REFERENCES assignment_rationales(pull_request_id) ON DELETE CASCADE,
+    user_id VARCHAR(50) NOT NULL,
+    reason VARCHAR(30) NOT NULL,
+    PRIMARY KEY (pull_request_id, user_id)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS reviewer_exclusions;
+DROP TABLE IF EXISTS reviewer_selections;
+DROP TABLE IF EXISTS assignment_rationales;
@@ -0,0 +1,9 @@
+-- +goose Up
+-- Отсутствие пользователей (отпуск, больничный): до until пользователь не назначается ревьювером
+CREATE TABLE user_out_of_office (
+    user_id VARCHAR(50) PRIMARY KEY REFERENCES users(user_id) ON DELETE CASCADE,
+    until TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS user_out_of_office;
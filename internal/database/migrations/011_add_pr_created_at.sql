@@ -0,0 +1,28 @@
+-- +goose Up
+-- Время создания PR: нужно для сортировки и keyset-пагинации списка PR.
+-- Для уже существующих PR проставляется время применения миграции.
+ALTER TABLE pull_requests
+ADD COLUMN created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW();
+
+-- Индекс для постраничного списка PR без фильтров (/pullRequest/list)
+CREATE INDEX IF NOT EXISTS idx_pr_created
+ON pull_requests(created_at DESC, pull_request_id DESC);
+
+-- Индекс для списка PR с фильтром по статусу
+CREATE INDEX IF NOT EXISTS idx_pr_status_created
+ON pull_requests(status, created_at DESC, pull_request_id DESC);
+
+-- Индекс для списка PR с фильтром по автору
+CREATE INDEX IF NOT EXISTS idx_pr_author_created
+ON pull_requests(author_id, created_at DESC, pull_request_id DESC);
+
+-- Индекс для фильтра по команде автора (включая неактивных пользователей)
+CREATE INDEX IF NOT EXISTS idx_users_team_name
+ON users(team_name);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_users_team_name;
+DROP INDEX IF EXISTS idx_pr_author_created;
+DROP INDEX IF EXISTS idx_pr_status_created;
+DROP INDEX IF EXISTS idx_pr_created;
+ALTER TABLE pull_requests DROP COLUMN IF EXISTS created_at;
@@ -0,0 +1,8 @@
+-- +goose Up
+-- Версия PR для оптимистичной блокировки: увеличивается при каждом изменении
+-- (мерж, переназначение ревьювера) и возвращается клиенту как ETag.
+ALTER TABLE pull_requests
+ADD COLUMN version BIGINT NOT NULL DEFAULT 1;
+
+-- +goose Down
+ALTER TABLE pull_requests DROP COLUMN IF EXISTS version;
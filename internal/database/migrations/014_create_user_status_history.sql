@@ -0,0 +1,37 @@
+-- +goose Up
+-- История активности пользователей: по ней отчет о справедливости считает дни, когда участник
+-- мог получать ревью. Записи добавляет триггер при создании пользователя и смене is_active,
+-- поэтому историю ведут все пути записи (API, деактивация команды, импорт).
+-- Для уже существующих пользователей считается, что статус не менялся с начала эпохи Unix.
+CREATE TABLE user_status_history (
+    id BIGSERIAL PRIMARY KEY,
+    user_id VARCHAR(50) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    is_active BOOLEAN NOT NULL,
+    changed_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_status_history_user
+ON user_status_history(user_id, changed_at);
+
+INSERT INTO user_status_history (user_id, is_active, changed_at)
+SELECT user_id, is_active, 'epoch'::timestamptz FROM users;
+
+-- +goose StatementBegin
+CREATE FUNCTION record_user_status() RETURNS trigger AS $$
+BEGIN
+    IF TG_OP = 'INSERT' OR NEW.is_active IS DISTINCT FROM OLD.is_active THEN
+        INSERT INTO user_status_history (user_id, is_active) VALUES (NEW.user_id, NEW.is_active);
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE TRIGGER users_status_history
+AFTER INSERT OR UPDATE OF is_active ON users
+FOR EACH ROW EXECUTE FUNCTION record_user_status();
+
+-- +goose Down
+DROP TRIGGER IF EXISTS users_status_history ON users;
+DROP FUNCTION IF EXISTS record_user_status();
+DROP TABLE IF EXISTS user_status_history;
@@ -0,0 +1,8 @@
+-- +goose Up
+-- ETag сохраненного ответа: повтор запроса по ключу идемпотентности возвращает
+-- клиенту версию PR, чтобы следующий запрос мог передать ее в If-Match.
+ALTER TABLE idempotency_keys
+ADD COLUMN etag VARCHAR(255);
+
+-- +goose Down
+ALTER TABLE idempotency_keys DROP COLUMN IF EXISTS etag;
@@ -0,0 +1,8 @@
+-- +goose Up
+-- Аренда ключа выполняющимся запросом: если запрос не завершился к lease_expires_at
+-- (например, процесс упал), ключ может занять повтор. Ключи, занятые до миграции, считаются брошенными.
+ALTER TABLE idempotency_keys
+ADD COLUMN lease_expires_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW();
+
+-- +goose Down
+ALTER TABLE idempotency_keys DROP COLUMN IF EXISTS lease_expires_at;
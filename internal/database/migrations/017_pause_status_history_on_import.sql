@@ -0,0 +1,29 @@
+-- +goose Up
+-- Загрузка /admin/import переносит историю активности из выгрузки сама, поэтому в ее транзакции
+-- триггер историю не пишет: транзакция выставляет pr_reviewer.status_history_paused = 'on'
+-- (set_config с is_local, сбрасывается по окончании транзакции).
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION record_user_status() RETURNS trigger AS $$
+BEGIN
+    IF current_setting('pr_reviewer.status_history_paused', true) = 'on' THEN
+        RETURN NEW;
+    END IF;
+    IF TG_OP = 'INSERT' OR NEW.is_active IS DISTINCT FROM OLD.is_active THEN
+        INSERT INTO user_status_history (user_id, is_active) VALUES (NEW.user_id, NEW.is_active);
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION record_user_status() RETURNS trigger AS $$
+BEGIN
+    IF TG_OP = 'INSERT' OR NEW.is_active IS DISTINCT FROM OLD.is_active THEN
+        INSERT INTO user_status_history (user_id, is_active) VALUES (NEW.user_id, NEW.is_active);
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
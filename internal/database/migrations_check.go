@@ -0,0 +1,27 @@
+package database
+
+import (
+	"fmt"
+	"io/fs"
+
+	"github.com/pressly/goose/v3"
+)
+
+// ExpectedMigrationVersion возвращает версию последней встроенной миграции.
+func ExpectedMigrationVersion() (int64, error) {
+	entries, err := fs.ReadDir(EmbedMigrations, "migrations")
+	if err != nil {
+		return 0, fmt.Errorf("failed to read embedded migrations: %w", err)
+	}
+
+	var latest int64
+	for _, entry := range entries {
+		version, err := goose.NumericComponent(entry.Name())
+		if err != nil {
+			return 0, fmt.Errorf("failed to parse migration %s: %w", entry.Name(), err)
+		}
+		latest = max(latest, version)
+	}
+
+	return latest, nil
+}
@@ -6,14 +6,47 @@ package database
 
 import (
 	"database/sql"
+	"time"
 )
 
+type AssignmentRationale struct {
+	PullRequestID     string
+	Strategy          string
+	CandidatePoolSize int32
+}
+
+type IdempotencyKey struct {
+	IdempotencyKey string
+	Fingerprint    string
+	StatusCode     sql.NullInt32
+	ContentType    sql.NullString
+	ResponseBody   []byte
+	ExpiresAt      time.Time
+	Etag           sql.NullString
+	LeaseExpiresAt time.Time
+}
+
+type OwnershipRule struct {
+	Repository string
+	Position   int32
+	Pattern    string
+}
+
+type OwnershipRuleOwner struct {
+	Repository string
+	Position   int32
+	OwnerType  string
+	OwnerID    string
+}
+
 type PullRequest struct {
 	PullRequestID   string
 	PullRequestName string
 	AuthorID        string
 	Status          string
 	MergedAt        sql.NullTime
+	CreatedAt       time.Time
+	Version         int64
 }
 
 type Reviewer struct {
@@ -21,8 +54,26 @@ type Reviewer struct {
 	UserID        string
 }
 
+type ReviewerExclusion struct {
+	PullRequestID string
+	UserID        string
+	Reason        string
+}
+
+type ReviewerSelection struct {
+	PullRequestID string
+	UserID        string
+	Position      int32
+	Reason        string
+	MatchedTags   string
+	OpenReviews   int32
+	SkillScore    float64
+	WorkloadScore float64
+}
+
 type Team struct {
-	TeamName string
+	TeamName       string
+	ParentTeamName sql.NullString
 }
 
 type User struct {
@@ -31,3 +82,20 @@ type User struct {
 	TeamName string
 	IsActive bool
 }
+
+type UserOutOfOffice struct {
+	UserID string
+	Until  time.Time
+}
+
+type UserSkill struct {
+	UserID string
+	Tag    string
+}
+
+type UserStatusHistory struct {
+	ID        int64
+	UserID    string
+	IsActive  bool
+	ChangedAt time.Time
+}
@@ -1,47 +1,119 @@
 package database
 
 import (
+	"context"
 	"database/sql"
 	"embed"
+	"errors"
 	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
 
 	"pr-reviewer-service/internal/config"
 
+	"github.com/jackc/pgx/v5/pgconn"
 	_ "github.com/jackc/pgx/v5/stdlib"
-	"github.com/pressly/goose/v3"
+	"github.com/sirupsen/logrus"
 )
 
 //go:embed migrations/*.sql
 var EmbedMigrations embed.FS
 
-func NewPostgresDB(cfg config.Config) (*sql.DB, error) {
-	dsn := fmt.Sprintf(
-		"postgres://%s:%s@%s:%s/%s?sslmode=disable",
-		cfg.DBUser, cfg.DBPassword, cfg.DBHost, cfg.DBPort, cfg.DBName,
-	)
+// maxConnectBackoff ограничивает рост паузы между попытками подключения при старте
+const maxConnectBackoff = 30 * time.Second
 
-	db, err := sql.Open("pgx", dsn)
+// NewPostgresDB открывает пул соединений и дожидается готовности PostgreSQL. Миграции не применяются -
+// см. Migrator. Пока база недоступна, подключение повторяется до cfg.ConnectAttempts раз с растущей паузой.
+func NewPostgresDB(ctx context.Context, cfg config.DatabaseConfig, logger logrus.FieldLogger) (*sql.DB, error) {
+	db, err := sql.Open("pgx", DSN(cfg))
 	if err != nil {
 		return nil, err
 	}
 
-	if err = MigrateDB(db); err != nil {
+	db.SetMaxOpenConns(cfg.MaxOpenConns)
+	db.SetMaxIdleConns(cfg.MaxIdleConns)
+	db.SetConnMaxLifetime(cfg.ConnMaxLifetime)
+	db.SetConnMaxIdleTime(cfg.ConnMaxIdleTime)
+
+	if err = waitForDB(ctx, db, cfg, logger); err != nil {
+		_ = db.Close()
 		return nil, err
 	}
 
 	return db, nil
 }
 
-func MigrateDB(db *sql.DB) error {
-	goose.SetBaseFS(EmbedMigrations)
+// DSN собирает строку подключения pgx: TLS-параметры и statement_timeout передаются серверу при подключении.
+func DSN(cfg config.DatabaseConfig) string {
+	params := url.Values{}
+	params.Set("sslmode", cfg.SSLMode)
+	if cfg.SSLRootCert != "" {
+		params.Set("sslrootcert", cfg.SSLRootCert)
+	}
+	if cfg.SSLCert != "" {
+		params.Set("sslcert", cfg.SSLCert)
+		params.Set("sslkey", cfg.SSLKey)
+	}
+	if cfg.StatementTimeout > 0 {
+		params.Set("statement_timeout", strconv.FormatInt(cfg.StatementTimeout.Milliseconds(), 10))
+	}
 
-	if err := goose.SetDialect("postgres"); err != nil {
-		return err
+	dsn := url.URL{
+		Scheme:   "postgres",
+		User:     url.UserPassword(cfg.User, cfg.Password),
+		Host:     net.JoinHostPort(cfg.Host, strconv.Itoa(cfg.Port)),
+		Path:     "/" + cfg.Name,
+		RawQuery: params.Encode(),
 	}
+	return dsn.String()
+}
 
-	if err := goose.Up(db, "migrations"); err != nil {
-		return err
+// RetryPolicyFromConfig возвращает политику повторов запросов и транзакций из конфигурации.
+func RetryPolicyFromConfig(cfg config.DatabaseConfig) RetryPolicy {
+	return RetryPolicy{
+		Attempts:   cfg.RetryAttempts,
+		Backoff:    cfg.RetryBackoff,
+		MaxBackoff: DefaultRetryPolicy.MaxBackoff,
 	}
+}
+
+// waitForDB пингует базу, пока она не ответит. Ошибки аутентификации и отсутствие базы
+// не исправятся ожиданием, поэтому на них попытки прекращаются сразу.
+func waitForDB(ctx context.Context, db *sql.DB, cfg config.DatabaseConfig, logger logrus.FieldLogger) error {
+	policy := RetryPolicy{Attempts: cfg.ConnectAttempts, Backoff: cfg.ConnectBackoff, MaxBackoff: maxConnectBackoff}
 
-	return nil
+	for attempt := 1; ; attempt++ {
+		err := db.PingContext(ctx)
+		if err == nil {
+			return nil
+		}
+		if attempt >= policy.Attempts || isPermanentConnectError(err) {
+			return fmt.Errorf("database is unavailable after %d attempt(s): %w", attempt, err)
+		}
+
+		delay := policy.delay(attempt)
+		logger.WithError(err).WithFields(logrus.Fields{
+			"attempt":  attempt,
+			"attempts": policy.Attempts,
+			"retry_in": delay.String(),
+		}).Warn("Database is not ready, retrying")
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+	}
+}
+
+// isPermanentConnectError - неверные учетные данные (класс 28) или несуществующая база (класс 3D)
+func isPermanentConnectError(err error) bool {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return false
+	}
+	return strings.HasPrefix(pgErr.Code, "28") || strings.HasPrefix(pgErr.Code, "3D")
 }
@@ -7,12 +7,25 @@ package database
 
 import (
 	"context"
+	"database/sql"
+	"time"
 )
 
+const bumpPullRequestVersion = `-- name: BumpPullRequestVersion :exec
+UPDATE pull_requests 
+SET version = version + 1 
+WHERE pull_request_id = $1
+`
+
+func (q *Queries) BumpPullRequestVersion(ctx context.Context, pullRequestID string) error {
+	_, err := q.db.ExecContext(ctx, bumpPullRequestVersion, pullRequestID)
+	return err
+}
+
 const createPullRequest = `-- name: CreatePullRequest :one
 INSERT INTO pull_requests (pull_request_id, pull_request_name, author_id, status) 
 VALUES ($1, $2, $3, 'OPEN') 
-RETURNING pull_request_id, pull_request_name, author_id, status
+RETURNING pull_request_id, pull_request_name, author_id, status, created_at, version
 `
 
 type CreatePullRequestParams struct {
@@ -26,6 +39,8 @@ type CreatePullRequestRow struct {
 	PullRequestName string
 	AuthorID        string
 	Status          string
+	CreatedAt       time.Time
+	Version         int64
 }
 
 func (q *Queries) CreatePullRequest(ctx context.Context, arg CreatePullRequestParams) (CreatePullRequestRow, error) {
@@ -36,12 +51,14 @@ func (q *Queries) CreatePullRequest(ctx context.Context, arg CreatePullRequestPa
 		&i.PullRequestName,
 		&i.AuthorID,
 		&i.Status,
+		&i.CreatedAt,
+		&i.Version,
 	)
 	return i, err
 }
 
 const getPullRequestByID = `-- name: GetPullRequestByID :one
-SELECT pull_request_id, pull_request_name, author_id, status, merged_at 
+SELECT pull_request_id, pull_request_name, author_id, status, merged_at, created_at, version 
 FROM pull_requests 
 WHERE pull_request_id = $1
 `
@@ -55,6 +72,8 @@ func (q *Queries) GetPullRequestByID(ctx context.Context, pullRequestID string)
 		&i.AuthorID,
 		&i.Status,
 		&i.MergedAt,
+		&i.CreatedAt,
+		&i.Version,
 	)
 	return i, err
 }
@@ -72,15 +91,130 @@ func (q *Queries) IsPRMerged(ctx context.Context, pullRequestID string) (bool, e
 	return is_merged, err
 }
 
+const listPullRequests = `-- name: ListPullRequests :many
+SELECT pr.pull_request_id, pr.pull_request_name, pr.author_id, pr.status, pr.merged_at, pr.created_at, pr.version,
+    COALESCE((
+        SELECT array_agg(r.user_id ORDER BY r.user_id)
+        FROM reviewers r
+        WHERE r.pull_request_id = pr.pull_request_id
+    ), '{}')::text[] AS reviewer_ids
+FROM pull_requests pr
+JOIN users u ON u.user_id = pr.author_id
+WHERE ($1::text IS NULL OR pr.status = $1)
+  AND ($2::text IS NULL OR pr.author_id = $2)
+  AND ($3::text IS NULL OR EXISTS (
+        SELECT 1 FROM reviewers r
+        WHERE r.pull_request_id = pr.pull_request_id AND r.user_id = $3
+  ))
+  AND ($4::text IS NULL OR u.team_name = $4)
+  AND ($5::timestamptz IS NULL OR pr.created_at >= $5)
+  AND ($6::timestamptz IS NULL OR pr.created_at < $6)
+  AND ($7::timestamptz IS NULL
+       OR (pr.created_at, pr.pull_request_id) < ($7, $8::text))
+ORDER BY pr.created_at DESC, pr.pull_request_id DESC
+LIMIT $9
+`
+
+type ListPullRequestsParams struct {
+	Status          sql.NullString
+	AuthorID        sql.NullString
+	ReviewerID      sql.NullString
+	TeamName        sql.NullString
+	CreatedFrom     sql.NullTime
+	CreatedTo       sql.NullTime
+	CursorCreatedAt sql.NullTime
+	CursorID        sql.NullString
+	PageLimit       int32
+}
+
+type ListPullRequestsRow struct {
+	PullRequestID   string
+	PullRequestName string
+	AuthorID        string
+	Status          string
+	MergedAt        sql.NullTime
+	CreatedAt       time.Time
+	Version         int64
+	ReviewerIds     []string
+}
+
+// Keyset-пагинация: PR отсортированы от новых к старым, курсор - (created_at, pull_request_id)
+// последнего PR предыдущей страницы. Фильтр по команде - по команде автора.
+func (q *Queries) ListPullRequests(ctx context.Context, arg ListPullRequestsParams) ([]ListPullRequestsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listPullRequests,
+		arg.Status,
+		arg.AuthorID,
+		arg.ReviewerID,
+		arg.TeamName,
+		arg.CreatedFrom,
+		arg.CreatedTo,
+		arg.CursorCreatedAt,
+		arg.CursorID,
+		arg.PageLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListPullRequestsRow
+	for rows.Next() {
+		var i ListPullRequestsRow
+		if err := rows.Scan(
+			&i.PullRequestID,
+			&i.PullRequestName,
+			&i.AuthorID,
+			&i.Status,
+			&i.MergedAt,
+			&i.CreatedAt,
+			&i.Version,
+			textArray(&i.ReviewerIds),
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lockPullRequest = `-- name: LockPullRequest :one
+SELECT status, version 
+FROM pull_requests 
+WHERE pull_request_id = $1 
+FOR UPDATE
+`
+
+type LockPullRequestRow struct {
+	Status  string
+	Version int64
+}
+
+// Блокирует строку PR до конца транзакции: проверка версии и изменение выполняются атомарно.
+func (q *Queries) LockPullRequest(ctx context.Context, pullRequestID string) (LockPullRequestRow, error) {
+	row := q.db.QueryRowContext(ctx, lockPullRequest, pullRequestID)
+	var i LockPullRequestRow
+	err := row.Scan(&i.Status, &i.Version)
+	return i, err
+}
+
 const mergePullRequest = `-- name: MergePullRequest :one
 UPDATE pull_requests 
 SET status = 'MERGED', 
     merged_at = CASE 
         WHEN status = 'OPEN' THEN NOW()  
         ELSE merged_at                    
+    END,
+    version = CASE 
+        WHEN status = 'OPEN' THEN version + 1 
+        ELSE version 
     END
 WHERE pull_request_id = $1
-RETURNING pull_request_id, pull_request_name, author_id, status, merged_at
+RETURNING pull_request_id, pull_request_name, author_id, status, merged_at, created_at, version
 `
 
 func (q *Queries) MergePullRequest(ctx context.Context, pullRequestID string) (PullRequest, error) {
@@ -92,6 +226,8 @@ func (q *Queries) MergePullRequest(ctx context.Context, pullRequestID string) (P
 		&i.AuthorID,
 		&i.Status,
 		&i.MergedAt,
+		&i.CreatedAt,
+		&i.Version,
 	)
 	return i, err
 }
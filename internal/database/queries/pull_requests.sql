@@ -1,22 +1,63 @@
 -- name: CreatePullRequest :one
 INSERT INTO pull_requests (pull_request_id, pull_request_name, author_id, status) 
 VALUES ($1, $2, $3, 'OPEN') 
-RETURNING pull_request_id, pull_request_name, author_id, status;
+RETURNING pull_request_id, pull_request_name, author_id, status, created_at, version;
 
 -- name: GetPullRequestByID :one
-SELECT pull_request_id, pull_request_name, author_id, status, merged_at 
+SELECT pull_request_id, pull_request_name, author_id, status, merged_at, created_at, version 
 FROM pull_requests 
 WHERE pull_request_id = $1;
 
+-- name: ListPullRequests :many
+-- Keyset-пагинация: PR отсортированы от новых к старым, курсор - (created_at, pull_request_id)
+-- последнего PR предыдущей страницы. Фильтр по команде - по команде автора.
+SELECT pr.pull_request_id, pr.pull_request_name, pr.author_id, pr.status, pr.merged_at, pr.created_at, pr.version,
+    COALESCE((
+        SELECT array_agg(r.user_id ORDER BY r.user_id)
+        FROM reviewers r
+        WHERE r.pull_request_id = pr.pull_request_id
+    ), '{}')::text[] AS reviewer_ids
+FROM pull_requests pr
+JOIN users u ON u.user_id = pr.author_id
+WHERE (sqlc.narg('status')::text IS NULL OR pr.status = sqlc.narg('status'))
+  AND (sqlc.narg('author_id')::text IS NULL OR pr.author_id = sqlc.narg('author_id'))
+  AND (sqlc.narg('reviewer_id')::text IS NULL OR EXISTS (
+        SELECT 1 FROM reviewers r
+        WHERE r.pull_request_id = pr.pull_request_id AND r.user_id = sqlc.narg('reviewer_id')
+  ))
+  AND (sqlc.narg('team_name')::text IS NULL OR u.team_name = sqlc.narg('team_name'))
+  AND (sqlc.narg('created_from')::timestamptz IS NULL OR pr.created_at >= sqlc.narg('created_from'))
+  AND (sqlc.narg('created_to')::timestamptz IS NULL OR pr.created_at < sqlc.narg('created_to'))
+  AND (sqlc.narg('cursor_created_at')::timestamptz IS NULL
+       OR (pr.created_at, pr.pull_request_id) < (sqlc.narg('cursor_created_at'), sqlc.narg('cursor_id')::text))
+ORDER BY pr.created_at DESC, pr.pull_request_id DESC
+LIMIT sqlc.arg('page_limit');
+
 -- name: MergePullRequest :one
 UPDATE pull_requests 
 SET status = 'MERGED', 
     merged_at = CASE 
         WHEN status = 'OPEN' THEN NOW()  
         ELSE merged_at                    
+    END,
+    version = CASE 
+        WHEN status = 'OPEN' THEN version + 1 
+        ELSE version 
     END
 WHERE pull_request_id = $1
-RETURNING pull_request_id, pull_request_name, author_id, status, merged_at;
+RETURNING pull_request_id, pull_request_name, author_id, status, merged_at, created_at, version;
+
+-- name: LockPullRequest :one
+-- Блокирует строку PR до конца транзакции: проверка версии и изменение выполняются атомарно.
+SELECT status, version 
+FROM pull_requests 
+WHERE pull_request_id = $1 
+FOR UPDATE;
+
+-- name: BumpPullRequestVersion :exec
+UPDATE pull_requests 
+SET version = version + 1 
+WHERE pull_request_id = $1;
 
 -- name: PRExists :one
 SELECT COUNT(*) FROM pull_requests WHERE pull_request_id = $1;
@@ -0,0 +1,40 @@
+-- name: CreateAssignmentRationale :exec
+INSERT INTO assignment_rationales (pull_request_id, strategy, candidate_pool_size)
+VALUES ($1, $2, $3);
+
+-- name: AddReviewerSelection :exec
+INSERT INTO reviewer_selections (
+    pull_request_id, user_id, position, reason, matched_tags, open_reviews, skill_score, workload_score
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8);
+
+-- name: AddReviewerExclusion :exec
+INSERT INTO reviewer_exclusions (pull_request_id, user_id, reason)
+VALUES ($1, $2, $3);
+
+-- name: GetAssignmentRationale :one
+SELECT strategy, candidate_pool_size
+FROM assignment_rationales
+WHERE pull_request_id = $1;
+
+-- name: GetReviewerSelections :many
+SELECT user_id, reason, matched_tags, open_reviews, skill_score, workload_score
+FROM reviewer_selections
+WHERE pull_request_id = $1
+ORDER BY position;
+
+-- name: GetReviewerExclusions :many
+SELECT user_id, reason
+FROM reviewer_exclusions
+WHERE pull_request_id = $1
+ORDER BY user_id;
+
+-- name: ReplaceReviewerSelection :exec
+UPDATE reviewer_selections
+SET user_id = $3,
+    reason = 'reassignment',
+    matched_tags = '',
+    open_reviews = 0,
+    skill_score = 0,
+    workload_score = 0
+WHERE pull_request_id = $1 AND user_id = $2;
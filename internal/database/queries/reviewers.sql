@@ -6,7 +6,7 @@ VALUES ($1, $2);
 SELECT user_id FROM reviewers 
 WHERE pull_request_id = $1;
 
--- name: RemoveReviewer :exec
+-- name: RemoveReviewer :execrows
 DELETE FROM reviewers 
 WHERE pull_request_id = $1 AND user_id = $2;
 
@@ -14,11 +14,5 @@ WHERE pull_request_id = $1 AND user_id = $2;
 SELECT COUNT(*) FROM reviewers 
 WHERE pull_request_id = $1 AND user_id = $2;
 
--- name: GetUserAssignedPRs :many
-SELECT pr.pull_request_id, pr.pull_request_name, pr.author_id, pr.status
-FROM pull_requests pr
-JOIN reviewers r ON pr.pull_request_id = r.pull_request_id
-WHERE r.user_id = $1;
-
 -- name: CountReviewers :one
 SELECT COUNT(*) FROM reviewers WHERE pull_request_id = $1;
\ No newline at end of file
@@ -0,0 +1,20 @@
+-- name: DeleteUserSkills :exec
+DELETE FROM user_skills
+WHERE user_id = $1;
+
+-- name: AddUserSkill :exec
+INSERT INTO user_skills (user_id, tag)
+VALUES ($1, $2);
+
+-- name: GetUserSkills :many
+SELECT tag
+FROM user_skills
+WHERE user_id = $1
+ORDER BY tag;
+
+-- name: GetTeamSkills :many
+SELECT us.user_id, us.tag
+FROM user_skills us
+JOIN users u ON u.user_id = us.user_id
+WHERE u.team_name = $1
+ORDER BY us.user_id, us.tag;
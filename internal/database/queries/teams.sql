@@ -4,4 +4,22 @@ VALUES ($1)
 RETURNING team_name;
 
 -- name: TeamExists :one
-SELECT COUNT(*) FROM teams WHERE team_name = $1;
\ No newline at end of file
+SELECT COUNT(*) FROM teams WHERE team_name = $1;
+
+-- name: SetTeamParent :exec
+UPDATE teams
+SET parent_team_name = $2
+WHERE team_name = $1;
+
+-- name: GetTeamParent :one
+SELECT parent_team_name FROM teams WHERE team_name = $1;
+
+-- name: LockTeamParent :one
+-- Родитель команды с блокировкой строки до конца транзакции: проверка иерархии и ее изменение
+-- не пересекаются со встречным переносом.
+SELECT parent_team_name FROM teams WHERE team_name = $1 FOR UPDATE;
+
+-- name: GetChildTeams :many
+SELECT team_name FROM teams
+WHERE parent_team_name = $1
+ORDER BY team_name;
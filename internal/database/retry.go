@@ -0,0 +1,158 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"math/rand/v2"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// RetryPolicy ограничивает повторы операций при временных ошибках базы.
+type RetryPolicy struct {
+	// Общее число попыток, включая первую; 1 и меньше - без повторов
+	Attempts int
+
+	// Пауза перед первым повтором; каждая следующая вдвое больше, но не больше MaxBackoff
+	Backoff    time.Duration
+	MaxBackoff time.Duration
+}
+
+// DefaultRetryPolicy - три попытки с паузами 50 и 100 мс.
+var DefaultRetryPolicy = RetryPolicy{Attempts: 3, Backoff: 50 * time.Millisecond, MaxBackoff: time.Second}
+
+// delay возвращает паузу перед повтором номер attempt (с 1) со случайным разбросом до +50%,
+// чтобы одновременно упавшие запросы не повторялись синхронно
+func (p RetryPolicy) delay(attempt int) time.Duration {
+	d := p.Backoff
+	for i := 1; i < attempt && (p.MaxBackoff <= 0 || d < p.MaxBackoff); i++ {
+		d *= 2
+	}
+	if p.MaxBackoff > 0 && d > p.MaxBackoff {
+		d = p.MaxBackoff
+	}
+	if d <= 0 {
+		return 0
+	}
+	return d + rand.N(d/2+1) //nolint:gosec // не криптография
+}
+
+// Retry выполняет fn и повторяет ее, пока она возвращает временную ошибку (см. IsTransient)
+// и не исчерпаны попытки. Возвращает последнюю ошибку.
+func Retry(ctx context.Context, policy RetryPolicy, fn func() error) error {
+	var err error
+	for attempt := 1; ; attempt++ {
+		err = fn()
+		if err == nil || attempt >= policy.Attempts || !IsTransient(err) {
+			return err
+		}
+
+		timer := time.NewTimer(policy.delay(attempt))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+	}
+}
+
+// Коды SQLSTATE, после которых операцию можно безопасно повторить
+const (
+	sqlStateSerializationFailure = "40001"
+	sqlStateDeadlockDetected     = "40P01"
+	sqlStateAdminShutdown        = "57P01"
+	sqlStateCrashShutdown        = "57P02"
+	sqlStateCannotConnectNow     = "57P03"
+
+	// Класс 08 - ошибки соединения
+	sqlStateConnectionClass = "08"
+)
+
+// IsTransient сообщает, что операция не была применена и ее можно повторить:
+// конфликт сериализации или взаимоблокировка (сервер откатил транзакцию), перезапуск сервера
+// или сбой соединения до отправки запроса. Обрыв соединения посреди запроса временным не считается -
+// неизвестно, успел ли он выполниться.
+func IsTransient(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.Code {
+		case sqlStateSerializationFailure, sqlStateDeadlockDetected,
+			sqlStateAdminShutdown, sqlStateCrashShutdown, sqlStateCannotConnectNow:
+			return true
+		}
+		return strings.HasPrefix(pgErr.Code, sqlStateConnectionClass)
+	}
+
+	var connectErr *pgconn.ConnectError
+	if errors.As(err, &connectErr) {
+		return true
+	}
+
+	return errors.Is(err, driver.ErrBadConn) || pgconn.SafeToRetry(err)
+}
+
+// RetryDB оборачивает соединение и повторяет отдельные запросы при временных ошибках.
+// Транзакции не оборачиваются: после ошибки транзакция прервана, и повторять нужно ее целиком.
+type RetryDB struct {
+	db     DBTX
+	policy RetryPolicy
+}
+
+// NewRetryDB оборачивает соединение повторами по policy.
+func NewRetryDB(db DBTX, policy RetryPolicy) *RetryDB {
+	return &RetryDB{db: db, policy: policy}
+}
+
+// ExecContext выполняет запрос без результата.
+func (d *RetryDB) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	var result sql.Result
+	err := Retry(ctx, d.policy, func() error {
+		var err error
+		result, err = d.db.ExecContext(ctx, query, args...)
+		return err
+	})
+	return result, err
+}
+
+// PrepareContext подготавливает запрос.
+func (d *RetryDB) PrepareContext(ctx context.Context, query string) (*sql.Stmt, error) {
+	var stmt *sql.Stmt
+	err := Retry(ctx, d.policy, func() error {
+		var err error
+		stmt, err = d.db.PrepareContext(ctx, query)
+		return err
+	})
+	return stmt, err
+}
+
+// QueryContext выполняет запрос, возвращающий строки. Повторяется только выполнение запроса,
+// ошибки при чтении строк возвращаются вызывающему коду.
+func (d *RetryDB) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	var rows *sql.Rows
+	err := Retry(ctx, d.policy, func() error {
+		var err error
+		rows, err = d.db.QueryContext(ctx, query, args...)
+		return err
+	})
+	return rows, err
+}
+
+// QueryRowContext выполняет запрос, возвращающий одну строку. database/sql выполняет запрос сразу,
+// поэтому его ошибка доступна в row.Err() еще до Scan.
+func (d *RetryDB) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	var row *sql.Row
+	_ = Retry(ctx, d.policy, func() error {
+		row = d.db.QueryRowContext(ctx, query, args...)
+		return row.Err()
+	})
+	return row
+}
@@ -63,48 +63,6 @@ func (q *Queries) GetPRReviewers(ctx context.Context, pullRequestID string) ([]s
 	return items, nil
 }
 
-const getUserAssignedPRs = `-- name: GetUserAssignedPRs :many
-SELECT pr.pull_request_id, pr.pull_request_name, pr.author_id, pr.status
-FROM pull_requests pr
-JOIN reviewers r ON pr.pull_request_id = r.pull_request_id
-WHERE r.user_id = $1
-`
-
-type GetUserAssignedPRsRow struct {
-	PullRequestID   string
-	PullRequestName string
-	AuthorID        string
-	Status          string
-}
-
-func (q *Queries) GetUserAssignedPRs(ctx context.Context, userID string) ([]GetUserAssignedPRsRow, error) {
-	rows, err := q.db.QueryContext(ctx, getUserAssignedPRs, userID)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	var items []GetUserAssignedPRsRow
-	for rows.Next() {
-		var i GetUserAssignedPRsRow
-		if err := rows.Scan(
-			&i.PullRequestID,
-			&i.PullRequestName,
-			&i.AuthorID,
-			&i.Status,
-		); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
-	}
-	if err := rows.Close(); err != nil {
-		return nil, err
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-	return items, nil
-}
-
 const isUserReviewer = `-- name: IsUserReviewer :one
 SELECT COUNT(*) FROM reviewers 
 WHERE pull_request_id = $1 AND user_id = $2
@@ -122,7 +80,7 @@ func (q *Queries) IsUserReviewer(ctx context.Context, arg IsUserReviewerParams)
 	return count, err
 }
 
-const removeReviewer = `-- name: RemoveReviewer :exec
+const removeReviewer = `-- name: RemoveReviewer :execrows
 DELETE FROM reviewers 
 WHERE pull_request_id = $1 AND user_id = $2
 `
@@ -132,7 +90,10 @@ type RemoveReviewerParams struct {
 	UserID        string
 }
 
-func (q *Queries) RemoveReviewer(ctx context.Context, arg RemoveReviewerParams) error {
-	_, err := q.db.ExecContext(ctx, removeReviewer, arg.PullRequestID, arg.UserID)
-	return err
+func (q *Queries) RemoveReviewer(ctx context.Context, arg RemoveReviewerParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, removeReviewer, arg.PullRequestID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
 }
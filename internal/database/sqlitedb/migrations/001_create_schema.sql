@@ -0,0 +1,113 @@
+-- +goose Up
+-- Схема SQLite повторяет итоговую схему миграций PostgreSQL.
+-- Время хранится в микросекундах Unix (INTEGER): так его можно сравнивать и сортировать
+-- без учета формата строки, а точность совпадает с TIMESTAMP WITH TIME ZONE.
+CREATE TABLE teams (
+    team_name TEXT PRIMARY KEY,
+    -- Родительская команда (отдел/подразделение), NULL для корневых команд
+    parent_team_name TEXT NULL REFERENCES teams(team_name) ON DELETE SET NULL,
+    CHECK (parent_team_name IS NULL OR parent_team_name <> team_name)
+);
+
+CREATE INDEX idx_teams_parent ON teams(parent_team_name);
+
+CREATE TABLE users (
+    user_id TEXT PRIMARY KEY,
+    username TEXT NOT NULL,
+    team_name TEXT NOT NULL,
+    is_active BOOLEAN NOT NULL DEFAULT 1
+);
+
+CREATE INDEX idx_users_team_name ON users(team_name);
+
+CREATE TABLE pull_requests (
+    pull_request_id TEXT PRIMARY KEY,
+    pull_request_name TEXT NOT NULL,
+    author_id TEXT NOT NULL REFERENCES users(user_id),
+    status TEXT NOT NULL DEFAULT 'OPEN' CHECK (status IN ('OPEN', 'MERGED')),
+    merged_at INTEGER NULL,
+    created_at INTEGER NOT NULL
+);
+
+CREATE INDEX idx_pr_created ON pull_requests(created_at DESC, pull_request_id DESC);
+CREATE INDEX idx_pr_status_created ON pull_requests(status, created_at DESC, pull_request_id DESC);
+CREATE INDEX idx_pr_author_created ON pull_requests(author_id, created_at DESC, pull_request_id DESC);
+
+CREATE TABLE reviewers (
+    pull_request_id TEXT NOT NULL REFERENCES pull_requests(pull_request_id) ON DELETE CASCADE,
+    user_id TEXT NOT NULL REFERENCES users(user_id),
+    PRIMARY KEY (pull_request_id, user_id)
+);
+
+CREATE INDEX idx_reviewers_user_id ON reviewers(user_id);
+
+-- Правила владения кодом (CODEOWNERS) по репозиториям; порядок важен - побеждает последнее совпавшее правило
+CREATE TABLE ownership_rules (
+    repository TEXT NOT NULL,
+    position INTEGER NOT NULL,
+    pattern TEXT NOT NULL,
+    PRIMARY KEY (repository, position)
+);
+
+CREATE TABLE ownership_rule_owners (
+    repository TEXT NOT NULL,
+    position INTEGER NOT NULL,
+    owner_type TEXT NOT NULL CHECK (owner_type IN ('user', 'team')),
+    owner_id TEXT NOT NULL,
+    PRIMARY KEY (repository, position, owner_type, owner_id),
+    FOREIGN KEY (repository, position) REFERENCES ownership_rules(repository, position) ON DELETE CASCADE
+);
+
+-- Теги экспертизы пользователей для подбора ревьюверов по навыкам
+CREATE TABLE user_skills (
+    user_id TEXT NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    tag TEXT NOT NULL,
+    PRIMARY KEY (user_id, tag)
+);
+
+CREATE INDEX idx_user_skills_tag ON user_skills(tag);
+
+-- Объяснение назначения ревьюверов: стратегия, причины выбора и исключения кандидатов
+CREATE TABLE assignment_rationales (
+    pull_request_id TEXT PRIMARY KEY REFERENCES pull_requests(pull_request_id) ON DELETE CASCADE,
+    strategy TEXT NOT NULL,
+    candidate_pool_size INTEGER NOT NULL
+);
+
+CREATE TABLE reviewer_selections (
+    pull_request_id TEXT NOT NULL REFERENCES assignment_rationales(pull_request_id) ON DELETE CASCADE,
+    user_id TEXT NOT NULL,
+    position INTEGER NOT NULL,
+    reason TEXT NOT NULL,
+    matched_tags TEXT NOT NULL DEFAULT '',
+    open_reviews INTEGER NOT NULL DEFAULT 0,
+    skill_score REAL NOT NULL DEFAULT 0,
+    workload_score REAL NOT NULL DEFAULT 0,
+    PRIMARY KEY (pull_request_id, user_id)
+);
+
+CREATE TABLE reviewer_exclusions (
+    pull_request_id TEXT NOT NULL REFERENCES assignment_rationales(pull_request_id) ON DELETE CASCADE,
+    user_id TEXT NOT NULL,
+    reason TEXT NOT NULL,
+    PRIMARY KEY (pull_request_id, user_id)
+);
+
+-- Отсутствие пользователей: до until пользователь не назначается ревьювером
+CREATE TABLE user_out_of_office (
+    user_id TEXT PRIMARY KEY REFERENCES users(user_id) ON DELETE CASCADE,
+    until INTEGER NOT NULL
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS user_out_of_office;
+DROP TABLE IF EXISTS reviewer_exclusions;
+DROP TABLE IF EXISTS reviewer_selections;
+DROP TABLE IF EXISTS assignment_rationales;
+DROP TABLE IF EXISTS user_skills;
+DROP TABLE IF EXISTS ownership_rule_owners;
+DROP TABLE IF EXISTS ownership_rules;
+DROP TABLE IF EXISTS reviewers;
+DROP TABLE IF EXISTS pull_requests;
+DROP TABLE IF EXISTS users;
+DROP TABLE IF EXISTS teams;
@@ -0,0 +1,18 @@
+-- +goose Up
+-- Ключи идемпотентности мутирующих запросов и сохраненные ответы на них (как в PostgreSQL).
+-- status_code остается NULL, пока исходный запрос выполняется; expires_at - микросекунды Unix.
+CREATE TABLE idempotency_keys (
+    idempotency_key TEXT PRIMARY KEY,
+    fingerprint TEXT NOT NULL,
+    status_code INTEGER,
+    content_type TEXT,
+    response_body BLOB,
+    expires_at INTEGER NOT NULL
+);
+
+CREATE INDEX idx_idempotency_keys_expires
+ON idempotency_keys(expires_at);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_idempotency_keys_expires;
+DROP TABLE IF EXISTS idempotency_keys;
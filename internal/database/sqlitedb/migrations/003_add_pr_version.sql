@@ -0,0 +1,6 @@
+-- +goose Up
+-- Версия PR для оптимистичной блокировки (как в PostgreSQL).
+ALTER TABLE pull_requests ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
+
+-- +goose Down
+ALTER TABLE pull_requests DROP COLUMN version;
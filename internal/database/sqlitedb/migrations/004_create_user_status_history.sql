@@ -0,0 +1,38 @@
+-- +goose Up
+-- История активности пользователей (как в PostgreSQL): записи добавляют триггеры при создании
+-- пользователя и смене is_active. changed_at - микросекунды Unix; для уже существующих
+-- пользователей - 0 (статус не менялся с начала эпохи).
+CREATE TABLE user_status_history (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    user_id TEXT NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    is_active BOOLEAN NOT NULL,
+    changed_at INTEGER NOT NULL
+);
+
+CREATE INDEX idx_user_status_history_user ON user_status_history(user_id, changed_at);
+
+INSERT INTO user_status_history (user_id, is_active, changed_at)
+SELECT user_id, is_active, 0 FROM users;
+
+-- +goose StatementBegin
+CREATE TRIGGER users_status_history_insert AFTER INSERT ON users
+BEGIN
+    INSERT INTO user_status_history (user_id, is_active, changed_at)
+    VALUES (NEW.user_id, NEW.is_active, CAST(unixepoch('subsec') * 1000000 AS INTEGER));
+END;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER users_status_history_update AFTER UPDATE OF is_active ON users
+WHEN NEW.is_active <> OLD.is_active
+BEGIN
+    INSERT INTO user_status_history (user_id, is_active, changed_at)
+    VALUES (NEW.user_id, NEW.is_active, CAST(unixepoch('subsec') * 1000000 AS INTEGER));
+END;
+-- +goose StatementEnd
+
+-- +goose Down
+DROP TRIGGER IF EXISTS users_status_history_update;
+DROP TRIGGER IF EXISTS users_status_history_insert;
+DROP INDEX IF EXISTS idx_user_status_history_user;
+DROP TABLE IF EXISTS user_status_history;
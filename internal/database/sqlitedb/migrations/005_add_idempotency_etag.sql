@@ -0,0 +1,6 @@
+-- +goose Up
+-- ETag сохраненного ответа для повторов по ключу идемпотентности (как в PostgreSQL).
+ALTER TABLE idempotency_keys ADD COLUMN etag TEXT;
+
+-- +goose Down
+ALTER TABLE idempotency_keys DROP COLUMN etag;
@@ -0,0 +1,6 @@
+-- +goose Up
+-- Аренда ключа выполняющимся запросом (как в PostgreSQL), время в микросекундах Unix.
+ALTER TABLE idempotency_keys ADD COLUMN lease_expires_at INTEGER NOT NULL DEFAULT 0;
+
+-- +goose Down
+ALTER TABLE idempotency_keys DROP COLUMN lease_expires_at;
@@ -0,0 +1,51 @@
+-- +goose Up
+-- Загрузка /admin/import переносит историю активности из выгрузки сама (как в PostgreSQL):
+-- пока в status_history_pause есть строка, триггеры истории не срабатывают. Строку добавляет
+-- и удаляет транзакция загрузки, поэтому другие соединения ее не видят.
+CREATE TABLE status_history_pause (
+    id INTEGER PRIMARY KEY
+);
+
+DROP TRIGGER users_status_history_insert;
+DROP TRIGGER users_status_history_update;
+
+-- +goose StatementBegin
+CREATE TRIGGER users_status_history_insert AFTER INSERT ON users
+WHEN NOT EXISTS (SELECT 1 FROM status_history_pause)
+BEGIN
+    INSERT INTO user_status_history (user_id, is_active, changed_at)
+    VALUES (NEW.user_id, NEW.is_active, CAST(unixepoch('subsec') * 1000000 AS INTEGER));
+END;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER users_status_history_update AFTER UPDATE OF is_active ON users
+WHEN NEW.is_active <> OLD.is_active AND NOT EXISTS (SELECT 1 FROM status_history_pause)
+BEGIN
+    INSERT INTO user_status_history (user_id, is_active, changed_at)
+    VALUES (NEW.user_id, NEW.is_active, CAST(unixepoch('subsec') * 1000000 AS INTEGER));
+END;
+-- +goose StatementEnd
+
+-- +goose Down
+DROP TRIGGER users_status_history_update;
+DROP TRIGGER users_status_history_insert;
+
+-- +goose StatementBegin
+CREATE TRIGGER users_status_history_insert AFTER INSERT ON users
+BEGIN
+    INSERT INTO user_status_history (user_id, is_active, changed_at)
+    VALUES (NEW.user_id, NEW.is_active, CAST(unixepoch('subsec') * 1000000 AS INTEGER));
+END;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER users_status_history_update AFTER UPDATE OF is_active ON users
+WHEN NEW.is_active <> OLD.is_active
+BEGIN
+    INSERT INTO user_status_history (user_id, is_active, changed_at)
+    VALUES (NEW.user_id, NEW.is_active, CAST(unixepoch('subsec') * 1000000 AS INTEGER));
+END;
+-- +goose StatementEnd
+
+DROP TABLE IF EXISTS status_history_pause;
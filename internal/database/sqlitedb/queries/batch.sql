@@ -0,0 +1,43 @@
+-- name: GetTeamsByNames :many
+-- Пакетные выборки для GraphQL: ключи передаются одной строкой - JSON-массивом,
+-- который разворачивает json_each.
+SELECT team_name, parent_team_name
+FROM teams
+WHERE team_name IN (SELECT value FROM json_each(?1))
+ORDER BY team_name;
+
+-- name: GetUsersByIDs :many
+SELECT user_id, username, team_name, is_active
+FROM users
+WHERE user_id IN (SELECT value FROM json_each(?1))
+ORDER BY user_id;
+
+-- name: GetUsersByTeams :many
+SELECT user_id, username, team_name, is_active
+FROM users
+WHERE team_name IN (SELECT value FROM json_each(?1))
+ORDER BY team_name, user_id;
+
+-- name: GetPullRequestsByIDs :many
+SELECT pr.pull_request_id, pr.pull_request_name, pr.author_id, pr.status, pr.merged_at, pr.created_at,
+    COALESCE((
+        SELECT group_concat(r.user_id, ',' ORDER BY r.user_id)
+        FROM reviewers r
+        WHERE r.pull_request_id = pr.pull_request_id
+    ), '') AS reviewer_ids
+FROM pull_requests pr
+WHERE pr.pull_request_id IN (SELECT value FROM json_each(?1))
+ORDER BY pr.pull_request_id;
+
+-- name: GetAssignedPRsByReviewers :many
+SELECT ar.user_id AS reviewer_id,
+    pr.pull_request_id, pr.pull_request_name, pr.author_id, pr.status, pr.merged_at, pr.created_at,
+    COALESCE((
+        SELECT group_concat(r.user_id, ',' ORDER BY r.user_id)
+        FROM reviewers r
+        WHERE r.pull_request_id = pr.pull_request_id
+    ), '') AS reviewer_ids
+FROM reviewers ar
+JOIN pull_requests pr ON pr.pull_request_id = ar.pull_request_id
+WHERE ar.user_id IN (SELECT value FROM json_each(?1))
+ORDER BY ar.user_id, pr.created_at DESC, pr.pull_request_id DESC;
@@ -0,0 +1,138 @@
+-- name: GetReviewStats :many
+-- Назначения считаются только на PR, подходящие под фильтр по статусу и времени создания;
+-- пользователи без таких назначений попадают в выборку с нулем. Фильтр по команде - по команде пользователя.
+SELECT u.user_id, u.username, COUNT(pr.pull_request_id) AS review_count
+FROM users u
+LEFT JOIN reviewers r ON u.user_id = r.user_id
+LEFT JOIN pull_requests pr ON pr.pull_request_id = r.pull_request_id
+    AND (?1 IS NULL OR pr.status = ?1)
+    AND (?2 IS NULL OR pr.created_at >= ?2)
+    AND (?3 IS NULL OR pr.created_at < ?3)
+WHERE (?4 IS NULL OR u.team_name = ?4)
+GROUP BY u.user_id, u.username
+ORDER BY review_count DESC;
+
+-- name: GetPRAssignmentStats :many
+-- Фильтр по команде - по команде автора PR.
+SELECT
+    pr.pull_request_id,
+    pr.pull_request_name,
+    COUNT(r.user_id) AS reviewers_count
+FROM pull_requests pr
+JOIN users u ON u.user_id = pr.author_id
+LEFT JOIN reviewers r ON pr.pull_request_id = r.pull_request_id
+WHERE (?1 IS NULL OR pr.status = ?1)
+  AND (?2 IS NULL OR pr.created_at >= ?2)
+  AND (?3 IS NULL OR pr.created_at < ?3)
+  AND (?4 IS NULL OR u.team_name = ?4)
+GROUP BY pr.pull_request_id, pr.pull_request_name
+ORDER BY reviewers_count DESC;
+
+-- name: GetTeamReviewStats :many
+-- Показатели команд по PR, подходящим под фильтр: PR и полученные ревью считаются по команде автора,
+-- выполненные ревью - по команде ревьювера.
+WITH prs AS (
+    SELECT pr.pull_request_id, pr.status, u.team_name
+    FROM pull_requests pr
+    JOIN users u ON u.user_id = pr.author_id
+    WHERE (?1 IS NULL OR pr.status = ?1)
+      AND (?2 IS NULL OR pr.created_at >= ?2)
+      AND (?3 IS NULL OR pr.created_at < ?3)
+),
+reviews AS (
+    SELECT prs.team_name AS author_team, u.team_name AS reviewer_team
+    FROM prs
+    JOIN reviewers r ON r.pull_request_id = prs.pull_request_id
+    JOIN users u ON u.user_id = r.user_id
+)
+SELECT
+    t.team_name,
+    (SELECT COUNT(*) FROM prs WHERE prs.team_name = t.team_name) AS pull_requests_count,
+    (SELECT COUNT(*) FROM prs WHERE prs.team_name = t.team_name AND prs.status = 'OPEN') AS open_pull_requests_count,
+    (SELECT COUNT(*) FROM reviews WHERE reviews.reviewer_team = t.team_name) AS reviews_given_count,
+    (SELECT COUNT(*) FROM reviews WHERE reviews.author_team = t.team_name) AS reviews_received_count,
+    (SELECT COUNT(*) FROM reviews
+        WHERE reviews.author_team = t.team_name AND reviews.reviewer_team <> t.team_name) AS cross_team_reviews_count
+FROM teams t
+WHERE (?4 IS NULL OR t.team_name = ?4)
+ORDER BY t.team_name;
+
+-- name: GetMemberAssignments :many
+-- Назначения каждого пользователя на PR, созданные в интервале [created_from, created_to),
+-- для отчета о справедливости; пользователи без назначений попадают в выборку с нулем.
+SELECT u.user_id, u.username, u.team_name, COUNT(pr.pull_request_id) AS assignments_count
+FROM users u
+LEFT JOIN reviewers r ON u.user_id = r.user_id
+LEFT JOIN pull_requests pr ON pr.pull_request_id = r.pull_request_id
+    AND pr.created_at >= ?1
+    AND pr.created_at < ?2
+WHERE (?3 IS NULL OR u.team_name = ?3)
+GROUP BY u.user_id, u.username, u.team_name
+ORDER BY u.team_name, u.user_id;
+
+-- name: GetUserStatusHistory :many
+-- Смены активности пользователей команды (или всех пользователей) до момента changed_before.
+SELECT h.user_id, h.is_active, h.changed_at
+FROM user_status_history h
+JOIN users u ON u.user_id = h.user_id
+WHERE (?1 IS NULL OR u.team_name = ?1)
+  AND h.changed_at < ?2
+ORDER BY h.user_id, h.changed_at, h.id;
+
+-- name: GetOpenPRsWithTeamReviewers :many
+SELECT DISTINCT pr.pull_request_id
+FROM pull_requests pr
+JOIN reviewers r ON pr.pull_request_id = r.pull_request_id
+JOIN users u ON r.user_id = u.user_id
+WHERE pr.status = 'OPEN'
+AND u.team_name = ?1
+AND u.is_active = 1
+ORDER BY pr.pull_request_id;
+
+-- name: GetPRReviewersFromTeam :many
+SELECT r.user_id
+FROM reviewers r
+JOIN users u ON r.user_id = u.user_id
+WHERE r.pull_request_id = ?1
+AND u.team_name = ?2
+AND u.is_active = 1;
+
+-- name: GetActiveUsersFromTeam :many
+SELECT user_id, username, team_name, is_active
+FROM users
+WHERE team_name = ?1 AND is_active = 1
+ORDER BY user_id;
+
+-- name: GetAllTeams :many
+SELECT team_name FROM teams
+ORDER BY team_name;
+
+-- name: GetTeamTreeStats :many
+SELECT
+    t.team_name,
+    t.parent_team_name,
+    (SELECT COUNT(*) FROM users u
+        WHERE u.team_name = t.team_name) AS members_count,
+    (SELECT COUNT(*) FROM users u
+        WHERE u.team_name = t.team_name AND u.is_active = 1) AS active_members_count,
+    (SELECT COUNT(*) FROM pull_requests pr
+        JOIN users u ON pr.author_id = u.user_id
+        WHERE u.team_name = t.team_name AND pr.status = 'OPEN') AS open_prs_count,
+    (SELECT COUNT(*) FROM reviewers r
+        JOIN pull_requests pr ON r.pull_request_id = pr.pull_request_id
+        JOIN users u ON r.user_id = u.user_id
+        WHERE u.team_name = t.team_name AND pr.status = 'OPEN') AS open_reviews_count
+FROM teams t
+ORDER BY t.team_name;
+
+-- name: CountOpenPRs :one
+SELECT COUNT(*) FROM pull_requests WHERE status = 'OPEN';
+
+-- name: GetTeamReviewLoad :many
+SELECT t.team_name, COUNT(pr.pull_request_id) AS open_reviews_count
+FROM teams t
+LEFT JOIN users u ON u.team_name = t.team_name
+LEFT JOIN reviewers r ON r.user_id = u.user_id
+LEFT JOIN pull_requests pr ON pr.pull_request_id = r.pull_request_id AND pr.status = 'OPEN'
+GROUP BY t.team_name
+ORDER BY t.team_name;
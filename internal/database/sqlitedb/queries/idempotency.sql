@@ -0,0 +1,36 @@
+-- name: ReserveIdempotencyKey :execrows
+-- Занимает свободный ключ или перезанимает истекший либо брошенный (запрос без ответа, чья аренда истекла);
+-- действующий ключ не меняется (0 строк).
+INSERT INTO idempotency_keys (idempotency_key, fingerprint, lease_expires_at, expires_at)
+VALUES (?1, ?2, ?3, ?4)
+ON CONFLICT (idempotency_key) DO UPDATE
+SET fingerprint = excluded.fingerprint,
+    status_code = NULL,
+    content_type = NULL,
+    response_body = NULL,
+    etag = NULL,
+    lease_expires_at = excluded.lease_expires_at,
+    expires_at = excluded.expires_at
+WHERE idempotency_keys.expires_at <= CAST(unixepoch('subsec') * 1000000 AS INTEGER)
+   OR (idempotency_keys.status_code IS NULL AND idempotency_keys.lease_expires_at <= CAST(unixepoch('subsec') * 1000000 AS INTEGER));
+
+-- name: GetIdempotencyKey :one
+SELECT idempotency_key, fingerprint, status_code, content_type, response_body, expires_at, etag, lease_expires_at
+FROM idempotency_keys
+WHERE idempotency_key = ?1;
+
+-- name: CompleteIdempotencyKey :execrows
+-- Сохраняет ответ, только если ключ все еще занят этим запросом: после истечения аренды его мог перезанять повтор.
+UPDATE idempotency_keys
+SET status_code = ?3, content_type = ?4, response_body = ?5, etag = ?6
+WHERE idempotency_key = ?1 AND fingerprint = ?2 AND status_code IS NULL;
+
+-- name: DeleteIdempotencyKey :exec
+-- Удаляет только ключ без ответа, занятый этим запросом, чтобы не потерять уже сохраненный результат
+-- и не освободить ключ, перезанятый повтором.
+DELETE FROM idempotency_keys
+WHERE idempotency_key = ?1 AND fingerprint = ?2 AND status_code IS NULL;
+
+-- name: DeleteExpiredIdempotencyKeys :execrows
+DELETE FROM idempotency_keys
+WHERE expires_at <= CAST(unixepoch('subsec') * 1000000 AS INTEGER);
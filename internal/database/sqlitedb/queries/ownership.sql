@@ -0,0 +1,19 @@
+-- name: DeleteOwnershipRules :exec
+DELETE FROM ownership_rules
+WHERE repository = ?1;
+
+-- name: CreateOwnershipRule :exec
+INSERT INTO ownership_rules (repository, position, pattern)
+VALUES (?1, ?2, ?3);
+
+-- name: AddOwnershipRuleOwner :exec
+INSERT INTO ownership_rule_owners (repository, position, owner_type, owner_id)
+VALUES (?1, ?2, ?3, ?4);
+
+-- name: GetOwnershipRules :many
+SELECT r.position, r.pattern, o.owner_type, o.owner_id
+FROM ownership_rules r
+LEFT JOIN ownership_rule_owners o
+    ON o.repository = r.repository AND o.position = r.position
+WHERE r.repository = ?1
+ORDER BY r.position, o.owner_type, o.owner_id;
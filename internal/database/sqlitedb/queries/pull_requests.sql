@@ -0,0 +1,62 @@
+-- name: CreatePullRequest :exec
+-- Время создания передает приложение (микросекунды Unix), как и время слияния в MergePullRequest.
+INSERT INTO pull_requests (pull_request_id, pull_request_name, author_id, status, created_at)
+VALUES (?1, ?2, ?3, 'OPEN', ?4);
+
+-- name: GetPullRequestByID :one
+SELECT pull_request_id, pull_request_name, author_id, status, merged_at, created_at, version
+FROM pull_requests
+WHERE pull_request_id = ?1;
+
+-- name: ListPullRequests :many
+-- Keyset-пагинация: PR отсортированы от новых к старым, курсор - (created_at, pull_request_id)
+-- последнего PR предыдущей страницы. Фильтр по команде - по команде автора.
+SELECT pr.pull_request_id, pr.pull_request_name, pr.author_id, pr.status, pr.merged_at, pr.created_at, pr.version,
+    COALESCE((
+        SELECT group_concat(r.user_id, ',' ORDER BY r.user_id)
+        FROM reviewers r
+        WHERE r.pull_request_id = pr.pull_request_id
+    ), '') AS reviewer_ids
+FROM pull_requests pr
+JOIN users u ON u.user_id = pr.author_id
+WHERE (?1 IS NULL OR pr.status = ?1)
+  AND (?2 IS NULL OR pr.author_id = ?2)
+  AND (?3 IS NULL OR EXISTS (
+        SELECT 1 FROM reviewers r
+        WHERE r.pull_request_id = pr.pull_request_id AND r.user_id = ?3
+  ))
+  AND (?4 IS NULL OR u.team_name = ?4)
+  AND (?5 IS NULL OR pr.created_at >= ?5)
+  AND (?6 IS NULL OR pr.created_at < ?6)
+  AND (?7 IS NULL
+       OR (pr.created_at, pr.pull_request_id) < (?7, ?8))
+ORDER BY pr.created_at DESC, pr.pull_request_id DESC
+LIMIT ?9;
+
+-- name: MergePullRequest :one
+UPDATE pull_requests
+SET status = 'MERGED',
+    merged_at = CASE
+        WHEN status = 'OPEN' THEN ?2
+        ELSE merged_at
+    END,
+    version = CASE
+        WHEN status = 'OPEN' THEN version + 1
+        ELSE version
+    END
+WHERE pull_request_id = ?1
+RETURNING pull_request_id, pull_request_name, author_id, status, merged_at, created_at, version;
+
+-- name: GetPullRequestVersion :one
+-- Вызывается в транзакции с блокировкой записи: проверка версии и изменение выполняются атомарно.
+SELECT status, version
+FROM pull_requests
+WHERE pull_request_id = ?1;
+
+-- name: BumpPullRequestVersion :exec
+UPDATE pull_requests
+SET version = version + 1
+WHERE pull_request_id = ?1;
+
+-- name: PRExists :one
+SELECT COUNT(*) FROM pull_requests WHERE pull_request_id = ?1;
@@ -0,0 +1,16 @@
+-- name: AssignReviewer :exec
+INSERT INTO reviewers (pull_request_id, user_id)
+VALUES (?1, ?2);
+
+-- name: GetPRReviewers :many
+SELECT user_id FROM reviewers
+WHERE pull_request_id = ?1
+ORDER BY rowid;
+
+-- name: RemoveReviewer :execrows
+DELETE FROM reviewers
+WHERE pull_request_id = ?1 AND user_id = ?2;
+
+-- name: IsUserReviewer :one
+SELECT COUNT(*) FROM reviewers
+WHERE pull_request_id = ?1 AND user_id = ?2;
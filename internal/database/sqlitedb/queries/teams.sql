@@ -0,0 +1,20 @@
+-- name: CreateTeam :one
+INSERT INTO teams (team_name)
+VALUES (?1)
+RETURNING team_name;
+
+-- name: TeamExists :one
+SELECT COUNT(*) FROM teams WHERE team_name = ?1;
+
+-- name: SetTeamParent :exec
+UPDATE teams
+SET parent_team_name = ?2
+WHERE team_name = ?1;
+
+-- name: GetTeamParent :one
+SELECT parent_team_name FROM teams WHERE team_name = ?1;
+
+-- name: GetChildTeams :many
+SELECT team_name FROM teams
+WHERE parent_team_name = ?1
+ORDER BY team_name;
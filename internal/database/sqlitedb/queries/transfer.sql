@@ -0,0 +1,110 @@
+-- name: ExportTeams :many
+SELECT team_name, parent_team_name
+FROM teams
+ORDER BY team_name;
+
+-- name: ExportUsers :many
+-- Выгрузка идет страницами: пользователи после after_user_id по порядку user_id
+SELECT u.user_id, u.username, u.team_name, u.is_active, o.until AS out_of_office_until
+FROM users u
+LEFT JOIN user_out_of_office o ON o.user_id = u.user_id
+WHERE u.user_id > ?1
+ORDER BY u.user_id
+LIMIT ?2;
+
+-- name: ExportUserSkills :many
+SELECT user_id, tag
+FROM user_skills
+WHERE user_id IN (SELECT value FROM json_each(?1))
+ORDER BY user_id, tag;
+
+-- name: ExportUserStatusHistory :many
+SELECT user_id, is_active, changed_at
+FROM user_status_history
+WHERE user_id IN (SELECT value FROM json_each(?1))
+ORDER BY user_id, changed_at, id;
+
+-- name: ExportPullRequests :many
+-- Выгрузка идет страницами: PR после after_pull_request_id по порядку pull_request_id
+SELECT pull_request_id, pull_request_name, author_id, status, merged_at, created_at, version
+FROM pull_requests
+WHERE pull_request_id > ?1
+ORDER BY pull_request_id
+LIMIT ?2;
+
+-- name: ExportReviewers :many
+SELECT pull_request_id, user_id
+FROM reviewers
+WHERE pull_request_id IN (SELECT value FROM json_each(?1))
+ORDER BY pull_request_id, user_id;
+
+-- name: ExportAssignmentRationales :many
+SELECT pull_request_id, strategy, candidate_pool_size
+FROM assignment_rationales
+WHERE pull_request_id IN (SELECT value FROM json_each(?1))
+ORDER BY pull_request_id;
+
+-- name: ExportReviewerSelections :many
+SELECT pull_request_id, user_id, position, reason, matched_tags, open_reviews, skill_score, workload_score
+FROM reviewer_selections
+WHERE pull_request_id IN (SELECT value FROM json_each(?1))
+ORDER BY pull_request_id, position;
+
+-- name: ExportReviewerExclusions :many
+SELECT pull_request_id, user_id, reason
+FROM reviewer_exclusions
+WHERE pull_request_id IN (SELECT value FROM json_each(?1))
+ORDER BY pull_request_id, user_id;
+
+-- name: UpsertTeam :exec
+INSERT INTO teams (team_name, parent_team_name)
+VALUES (?1, ?2)
+ON CONFLICT (team_name)
+DO UPDATE SET parent_team_name = excluded.parent_team_name;
+
+-- name: ReplaceUser :exec
+-- В отличие от UpsertUser перезаписывает все поля пользователя
+INSERT INTO users (user_id, username, team_name, is_active)
+VALUES (?1, ?2, ?3, ?4)
+ON CONFLICT (user_id)
+DO UPDATE SET
+    username = excluded.username,
+    team_name = excluded.team_name,
+    is_active = excluded.is_active;
+
+-- name: DeleteUserStatusHistory :exec
+DELETE FROM user_status_history
+WHERE user_id = ?1;
+
+-- name: AddUserStatusChange :exec
+INSERT INTO user_status_history (user_id, is_active, changed_at)
+VALUES (?1, ?2, ?3);
+
+-- name: PauseStatusHistory :exec
+-- Выключает триггеры истории активности до ResumeStatusHistory в той же транзакции
+INSERT OR IGNORE INTO status_history_pause (id) VALUES (1);
+
+-- name: ResumeStatusHistory :exec
+DELETE FROM status_history_pause;
+
+-- name: UpsertPullRequest :exec
+-- Перезапись PR увеличивает версию, чтобы If-Match с прежним ETag не прошел
+INSERT INTO pull_requests (pull_request_id, pull_request_name, author_id, status, merged_at, created_at, version)
+VALUES (?1, ?2, ?3, ?4, ?5, ?6, 1)
+ON CONFLICT (pull_request_id)
+DO UPDATE SET
+    pull_request_name = excluded.pull_request_name,
+    author_id = excluded.author_id,
+    status = excluded.status,
+    merged_at = excluded.merged_at,
+    created_at = excluded.created_at,
+    version = pull_requests.version + 1;
+
+-- name: DeletePRReviewers :exec
+DELETE FROM reviewers
+WHERE pull_request_id = ?1;
+
+-- name: DeleteAssignmentRationale :exec
+-- Выбор и исключения кандидатов удаляются каскадно
+DELETE FROM assignment_rationales
+WHERE pull_request_id = ?1;
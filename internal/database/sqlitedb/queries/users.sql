@@ -0,0 +1,69 @@
+-- name: GetUserByID :one
+SELECT user_id, username, team_name, is_active
+FROM users
+WHERE user_id = ?1;
+
+-- name: GetActiveUsersByTeam :many
+SELECT user_id, username, team_name, is_active
+FROM users
+WHERE team_name = ?1 AND is_active = 1
+AND user_id != ?2
+ORDER BY random()
+LIMIT 2;
+
+-- name: UpdateUserActiveStatus :one
+UPDATE users
+SET is_active = ?2
+WHERE user_id = ?1
+RETURNING user_id, username, team_name, is_active;
+
+-- name: GetUserTeam :one
+SELECT team_name FROM users WHERE user_id = ?1;
+
+-- name: UpsertUser :one
+INSERT INTO users (user_id, username, team_name, is_active)
+VALUES (?1, ?2, ?3, ?4)
+ON CONFLICT (user_id)
+DO UPDATE SET
+    team_name = excluded.team_name
+RETURNING user_id, username, team_name, is_active;
+
+-- name: GetAllUsersByTeam :many
+SELECT user_id, username, team_name, is_active
+FROM users
+WHERE team_name = ?1
+ORDER BY user_id;
+
+-- name: GetTeamRoster :many
+SELECT u.user_id, u.username, u.team_name, u.is_active,
+    (SELECT COUNT(*)
+     FROM reviewers r
+     JOIN pull_requests pr ON pr.pull_request_id = r.pull_request_id
+     WHERE r.user_id = u.user_id AND pr.status = 'OPEN') AS open_reviews_count,
+    o.until AS out_of_office_until
+FROM users u
+LEFT JOIN user_out_of_office o ON o.user_id = u.user_id
+WHERE u.team_name = ?1
+ORDER BY u.user_id;
+
+-- name: GetReviewCandidates :many
+SELECT u.user_id, u.username, u.team_name, u.is_active,
+    (SELECT COUNT(*)
+     FROM reviewers r
+     JOIN pull_requests pr ON pr.pull_request_id = r.pull_request_id
+     WHERE r.user_id = u.user_id AND pr.status = 'OPEN') AS open_reviews_count,
+    o.until AS out_of_office_until
+FROM users u
+LEFT JOIN user_out_of_office o ON o.user_id = u.user_id
+WHERE u.user_id IN (SELECT value FROM json_each(?1))
+ORDER BY u.user_id;
+
+-- name: SetUserOutOfOffice :exec
+INSERT INTO user_out_of_office (user_id, until)
+VALUES (?1, ?2)
+ON CONFLICT (user_id)
+DO UPDATE SET until = excluded.until;
+
+-- name: DeleteUserOutOfOffice :exec
+DELETE FROM user_out_of_office
+WHERE user_id = ?1;
@@ -0,0 +1,71 @@
+// Package sqlitedb - запросы и миграции для хранилища SQLite (storage=sqlite).
+// Схема повторяет PostgreSQL, но время хранится в микросекундах Unix, а списки ключей
+// пакетных выборок передаются JSON-массивом.
+package sqlitedb
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/url"
+
+	"pr-reviewer-service/internal/database"
+
+	"github.com/pressly/goose/v3"
+	"modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+)
+
+//go:embed migrations/*.sql
+var EmbedMigrations embed.FS
+
+// busyTimeoutMs - сколько соединение ждет блокировку записи, прежде чем вернуть SQLITE_BUSY
+const busyTimeoutMs = 5000
+
+// Open открывает файл базы SQLite (создает при отсутствии) и проверяет соединение.
+// Внешние ключи включаются на каждом соединении, транзакции сразу берут блокировку записи,
+// а журнал WAL позволяет читать во время записи.
+func Open(ctx context.Context, path string) (*sql.DB, error) {
+	query := url.Values{}
+	query.Add("_pragma", "foreign_keys(1)")
+	query.Add("_pragma", fmt.Sprintf("busy_timeout(%d)", busyTimeoutMs))
+	query.Add("_pragma", "journal_mode(WAL)")
+	query.Set("_txlock", "immediate")
+
+	db, err := sql.Open("sqlite", "file:"+path+"?"+query.Encode())
+	if err != nil {
+		return nil, fmt.Errorf("failed to open sqlite database: %w", err)
+	}
+
+	if err := db.PingContext(ctx); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("failed to connect to sqlite database %s: %w", path, err)
+	}
+
+	return db, nil
+}
+
+// NewMigrator создает database.Migrator для встроенных миграций SQLite.
+// Блокировка миграций не нужна: SQLite сам сериализует запись.
+func NewMigrator(db *sql.DB) (*database.Migrator, error) {
+	migrations, err := fs.Sub(EmbedMigrations, "migrations")
+	if err != nil {
+		return nil, fmt.Errorf("failed to open embedded migrations: %w", err)
+	}
+
+	return database.NewMigratorFor(goose.DialectSQLite3, db, migrations)
+}
+
+// IsUniqueViolation сообщает, что запись с таким ключом уже существует
+// (аналог database.IsUniqueViolation для SQLite).
+func IsUniqueViolation(err error) bool {
+	var sqliteErr *sqlite.Error
+	if !errors.As(err, &sqliteErr) {
+		return false
+	}
+	code := sqliteErr.Code()
+	return code == sqlite3.SQLITE_CONSTRAINT_PRIMARYKEY || code == sqlite3.SQLITE_CONSTRAINT_UNIQUE
+}
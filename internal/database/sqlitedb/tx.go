@@ -0,0 +1,16 @@
+package sqlitedb
+
+import (
+	"database/sql"
+
+	"pr-reviewer-service/internal/database"
+)
+
+// InTx возвращает Queries, выполняющие запросы в транзакции tx.
+// Как и database.Queries.InTx, сохраняет обертку соединения (трассировку), если она есть.
+func (q *Queries) InTx(tx *sql.Tx) *Queries {
+	if wrapper, ok := q.db.(database.TxWrapper); ok {
+		return &Queries{db: wrapper.WithTx(tx)}
+	}
+	return q.WithTx(tx)
+}
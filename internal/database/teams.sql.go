@@ -7,6 +7,7 @@ package database
 
 import (
 	"context"
+	"database/sql"
 )
 
 const createTeam = `-- name: CreateTeam :one
@@ -22,6 +23,75 @@ func (q *Queries) CreateTeam(ctx context.Context, teamName string) (string, erro
 	return team_name, err
 }
 
+const getChildTeams = `-- name: GetChildTeams :many
+SELECT team_name FROM teams
+WHERE parent_team_name = $1
+ORDER BY team_name
+`
+
+func (q *Queries) GetChildTeams(ctx context.Context, parentTeamName sql.NullString) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, getChildTeams, parentTeamName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var team_name string
+		if err := rows.Scan(&team_name); err != nil {
+			return nil, err
+		}
+		items = append(items, team_name)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTeamParent = `-- name: GetTeamParent :one
+SELECT parent_team_name FROM teams WHERE team_name = $1
+`
+
+func (q *Queries) GetTeamParent(ctx context.Context, teamName string) (sql.NullString, error) {
+	row := q.db.QueryRowContext(ctx, getTeamParent, teamName)
+	var parent_team_name sql.NullString
+	err := row.Scan(&parent_team_name)
+	return parent_team_name, err
+}
+
+const lockTeamParent = `-- name: LockTeamParent :one
+SELECT parent_team_name FROM teams WHERE team_name = $1 FOR UPDATE
+`
+
+// Родитель команды с блокировкой строки до конца транзакции: проверка иерархии и ее изменение
+// не пересекаются со встречным переносом.
+func (q *Queries) LockTeamParent(ctx context.Context, teamName string) (sql.NullString, error) {
+	row := q.db.QueryRowContext(ctx, lockTeamParent, teamName)
+	var parent_team_name sql.NullString
+	err := row.Scan(&parent_team_name)
+	return parent_team_name, err
+}
+
+const setTeamParent = `-- name: SetTeamParent :exec
+UPDATE teams
+SET parent_team_name = $2
+WHERE team_name = $1
+`
+
+type SetTeamParentParams struct {
+	TeamName       string
+	ParentTeamName sql.NullString
+}
+
+func (q *Queries) SetTeamParent(ctx context.Context, arg SetTeamParentParams) error {
+	_, err := q.db.ExecContext(ctx, setTeamParent, arg.TeamName, arg.ParentTeamName)
+	return err
+}
+
 const teamExists = `-- name: TeamExists :one
 SELECT COUNT(*) FROM teams WHERE team_name = $1
 `
@@ -0,0 +1,17 @@
+package database
+
+import "database/sql"
+
+// TxWrapper - обертка DBTX (например, трассировка запросов), которая умеет обернуть и транзакцию.
+type TxWrapper interface {
+	WithTx(tx *sql.Tx) DBTX
+}
+
+// InTx возвращает Queries, выполняющие запросы в транзакции tx.
+// В отличие от сгенерированного WithTx сохраняет обертку соединения, если она есть.
+func (q *Queries) InTx(tx *sql.Tx) *Queries {
+	if wrapper, ok := q.db.(TxWrapper); ok {
+		return &Queries{db: wrapper.WithTx(tx)}
+	}
+	return q.WithTx(tx)
+}
@@ -7,8 +7,20 @@ package database
 
 import (
 	"context"
+	"database/sql"
+	"time"
 )
 
+const deleteUserOutOfOffice = `-- name: DeleteUserOutOfOffice :exec
+DELETE FROM user_out_of_office
+WHERE user_id = $1
+`
+
+func (q *Queries) DeleteUserOutOfOffice(ctx context.Context, userID string) error {
+	_, err := q.db.ExecContext(ctx, deleteUserOutOfOffice, userID)
+	return err
+}
+
 const getActiveUsersByTeam = `-- name: GetActiveUsersByTeam :many
 SELECT user_id, username, team_name, is_active 
 FROM users 
@@ -85,6 +97,110 @@ func (q *Queries) GetAllUsersByTeam(ctx context.Context, teamName string) ([]Use
 	return items, nil
 }
 
+const getReviewCandidates = `-- name: GetReviewCandidates :many
+SELECT u.user_id, u.username, u.team_name, u.is_active,
+    (SELECT COUNT(*)
+     FROM reviewers r
+     JOIN pull_requests pr ON pr.pull_request_id = r.pull_request_id
+     WHERE r.user_id = u.user_id AND pr.status = 'OPEN') AS open_reviews_count,
+    o.until AS out_of_office_until
+FROM users u
+LEFT JOIN user_out_of_office o ON o.user_id = u.user_id
+WHERE u.user_id = ANY($1::text[])
+ORDER BY u.user_id
+`
+
+type GetReviewCandidatesRow struct {
+	UserID           string
+	Username         string
+	TeamName         string
+	IsActive         bool
+	OpenReviewsCount int64
+	OutOfOfficeUntil sql.NullTime
+}
+
+func (q *Queries) GetReviewCandidates(ctx context.Context, userIds []string) ([]GetReviewCandidatesRow, error) {
+	rows, err := q.db.QueryContext(ctx, getReviewCandidates, userIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetReviewCandidatesRow
+	for rows.Next() {
+		var i GetReviewCandidatesRow
+		if err := rows.Scan(
+			&i.UserID,
+			&i.Username,
+			&i.TeamName,
+			&i.IsActive,
+			&i.OpenReviewsCount,
+			&i.OutOfOfficeUntil,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTeamRoster = `-- name: GetTeamRoster :many
+SELECT u.user_id, u.username, u.team_name, u.is_active,
+    (SELECT COUNT(*)
+     FROM reviewers r
+     JOIN pull_requests pr ON pr.pull_request_id = r.pull_request_id
+     WHERE r.user_id = u.user_id AND pr.status = 'OPEN') AS open_reviews_count,
+    o.until AS out_of_office_until
+FROM users u
+LEFT JOIN user_out_of_office o ON o.user_id = u.user_id
+WHERE u.team_name = $1
+ORDER BY u.user_id
+`
+
+type GetTeamRosterRow struct {
+	UserID           string
+	Username         string
+	TeamName         string
+	IsActive         bool
+	OpenReviewsCount int64
+	OutOfOfficeUntil sql.NullTime
+}
+
+func (q *Queries) GetTeamRoster(ctx context.Context, teamName string) ([]GetTeamRosterRow, error) {
+	rows, err := q.db.QueryContext(ctx, getTeamRoster, teamName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetTeamRosterRow
+	for rows.Next() {
+		var i GetTeamRosterRow
+		if err := rows.Scan(
+			&i.UserID,
+			&i.Username,
+			&i.TeamName,
+			&i.IsActive,
+			&i.OpenReviewsCount,
+			&i.OutOfOfficeUntil,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getUserByID = `-- name: GetUserByID :one
 SELECT user_id, username, team_name, is_active 
 FROM users 
@@ -114,6 +230,23 @@ func (q *Queries) GetUserTeam(ctx context.Context, userID string) (string, error
 	return team_name, err
 }
 
+const setUserOutOfOffice = `-- name: SetUserOutOfOffice :exec
+INSERT INTO user_out_of_office (user_id, until)
+VALUES ($1, $2)
+ON CONFLICT (user_id)
+DO UPDATE SET until = EXCLUDED.until
+`
+
+type SetUserOutOfOfficeParams struct {
+	UserID string
+	Until  time.Time
+}
+
+func (q *Queries) SetUserOutOfOffice(ctx context.Context, arg SetUserOutOfOfficeParams) error {
+	_, err := q.db.ExecContext(ctx, setUserOutOfOffice, arg.UserID, arg.Until)
+	return err
+}
+
 const updateUserActiveStatus = `-- name: UpdateUserActiveStatus :one
 UPDATE users 
 SET is_active = $2 
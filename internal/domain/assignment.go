@@ -0,0 +1,106 @@
+package domain
+
+// EscalationPolicy определяет, где искать ревьюверов, если в команде автора нет кандидатов.
+type EscalationPolicy string
+
+const (
+	// EscalationNone - поиск только в команде автора (поведение по умолчанию).
+	EscalationNone EscalationPolicy = "none"
+	// EscalationSiblings - поиск в соседних командах (общий родитель).
+	EscalationSiblings EscalationPolicy = "siblings"
+	// EscalationParent - поиск вверх по цепочке родительских команд.
+	EscalationParent EscalationPolicy = "parent"
+	// EscalationSiblingsThenParent - на каждом уровне сначала соседние команды, затем родитель.
+	EscalationSiblingsThenParent EscalationPolicy = "siblings_then_parent"
+)
+
+// ParseEscalationPolicy преобразует строку конфигурации в EscalationPolicy.
+func ParseEscalationPolicy(value string) (EscalationPolicy, error) {
+	switch policy := EscalationPolicy(value); policy {
+	case EscalationNone, EscalationSiblings, EscalationParent, EscalationSiblingsThenParent:
+		return policy, nil
+	case "":
+		return EscalationNone, nil
+	default:
+		return "", ErrInvalidEscalationPolicy
+	}
+}
+
+// SelectionReason - причина, по которой пользователь выбран ревьювером.
+type SelectionReason string
+
+const (
+	// SelectionCodeOwner - владелец затронутого кода.
+	SelectionCodeOwner SelectionReason = "code_owner"
+	// SelectionSkillMatch - покрывает требуемые навыки.
+	SelectionSkillMatch SelectionReason = "skill_match"
+	// SelectionLowWorkload - требуемых навыков нет, выбран как наименее загруженный.
+	SelectionLowWorkload SelectionReason = "low_workload"
+	// SelectionTeamMember - случайный активный участник команды автора.
+	SelectionTeamMember SelectionReason = "team_member"
+	// SelectionFairShare - участник команды автора, больше других недополучивший назначений
+	// относительно справедливой доли за окно отчета о справедливости.
+	SelectionFairShare SelectionReason = "fair_share"
+	// SelectionEscalation - найден в другой команде по политике эскалации.
+	SelectionEscalation SelectionReason = "escalation"
+	// SelectionReassignment - назначен при переназначении вместо другого ревьювера.
+	SelectionReassignment SelectionReason = "reassignment"
+)
+
+// Этапы подбора ревьюверов, из которых складывается стратегия назначения.
+const (
+	StrategyCodeOwners = "code_owners"
+	StrategySkills     = "skills"
+	StrategyTeamRandom = "team_random"
+	StrategyFairShare  = "fair_share"
+	StrategyEscalation = "escalation"
+)
+
+// ExclusionReason - причина, по которой пользователь не рассматривался как ревьювер.
+type ExclusionReason string
+
+const (
+	// ExclusionAuthor - автор PR не может ревьюить свой PR.
+	ExclusionAuthor ExclusionReason = "author"
+	// ExclusionInactive - пользователь неактивен.
+	ExclusionInactive ExclusionReason = "inactive"
+	// ExclusionOutOfOffice - пользователь отсутствует (отпуск, больничный).
+	ExclusionOutOfOffice ExclusionReason = "out_of_office"
+	// ExclusionOverQuota - у пользователя уже максимум открытых PR на ревью.
+	ExclusionOverQuota ExclusionReason = "over_quota"
+)
+
+// ReviewCandidate - кандидат в ревьюверы (с заполненными навыками и отсутствием) и его текущая нагрузка.
+type ReviewCandidate struct {
+	User        *User
+	OpenReviews int
+}
+
+// ReviewerSelection описывает, почему пользователь назначен ревьювером.
+// OpenReviews заполняется при выборе из команды автора, MatchedTags и оценка - только при подборе по навыкам.
+// Score = SkillScore + WorkloadScore.
+type ReviewerSelection struct {
+	UserID        string
+	Reason        SelectionReason
+	MatchedTags   []string
+	OpenReviews   int
+	SkillScore    float64
+	WorkloadScore float64
+	Score         float64
+}
+
+// ExcludedCandidate - пользователь, исключенный из подбора, и причина исключения.
+type ExcludedCandidate struct {
+	UserID string
+	Reason ExclusionReason
+}
+
+// AssignmentRationale объясняет назначение ревьюверов на PR.
+type AssignmentRationale struct {
+	// Strategy - использованные этапы подбора через "+", например "code_owners+skills"
+	Strategy string
+	// CandidatePoolSize - число допустимых кандидатов, из которых шел выбор
+	CandidatePoolSize int
+	Reviewers         []*ReviewerSelection
+	Excluded          []*ExcludedCandidate
+}
@@ -5,11 +5,23 @@ import "errors"
 // Domain errors (для бизнес-логики)
 var (
 	// Validation errors
-	ErrInvalidPRID         = errors.New("invalid pull request id")
-	ErrInvalidPRName       = errors.New("invalid pull request name")
-	ErrInvalidUserID       = errors.New("invalid user id")
-	ErrInvalidTeamName     = errors.New("invalid team name")
-	ErrTeamMustHaveMembers = errors.New("team must have members")
+	ErrInvalidPRID             = errors.New("invalid pull request id")
+	ErrInvalidPRName           = errors.New("invalid pull request name")
+	ErrInvalidUserID           = errors.New("invalid user id")
+	ErrInvalidTeamName         = errors.New("invalid team name")
+	ErrTeamMustHaveMembers     = errors.New("team must have members")
+	ErrInvalidRepository       = errors.New("invalid repository")
+	ErrInvalidOwnershipPattern = errors.New("invalid ownership pattern")
+	ErrInvalidSkillTag         = errors.New("invalid skill tag")
+	ErrInvalidPRStatus         = errors.New("invalid pull request status")
+	ErrInvalidPageLimit        = errors.New("invalid page limit")
+	ErrInvalidCursor           = errors.New("invalid cursor")
+	ErrInvalidTimeRange        = errors.New("invalid time range")
+	ErrInvalidIdempotencyKey   = errors.New("invalid idempotency key")
+	ErrInvalidIfMatch          = errors.New("invalid If-Match header")
+	ErrInvalidImportMode       = errors.New("invalid import mode")
+	ErrInvalidImportRecord     = errors.New("invalid import record")
+	ErrInvalidStatsFormat      = errors.New("invalid stats format")
 
 	// User errors
 	ErrUserNotFound      = errors.New("user not found")
@@ -19,11 +31,18 @@ var (
 	ErrTeamNotFound      = errors.New("team not found")
 	ErrTeamAlreadyExists = errors.New("team already exists")
 
+	// Team hierarchy errors
+	ErrParentTeamNotFound      = errors.New("parent team not found")
+	ErrTeamHierarchyCycle      = errors.New("team hierarchy cycle")
+	ErrInvalidEscalationPolicy = errors.New("invalid escalation policy")
+
 	// PR errors
 	ErrPRNotFound       = errors.New("pull request not found")
 	ErrPRAlreadyExists  = errors.New("pull request already exists")
 	ErrPRAlreadyMerged  = errors.New("pull request already merged")
 	ErrPRAuthorNotFound = errors.New("pull request author not found")
+	// ErrPRVersionConflict - PR изменен после того, как клиент (или usecase) прочитал его версию
+	ErrPRVersionConflict = errors.New("pull request version conflict")
 
 	// Reviewer errors
 	ErrReviewerNotAssigned = errors.New("reviewer not assigned to this PR")
@@ -36,6 +55,12 @@ var (
 
 	// Mass operation errors
 	ErrPartialReassignment = errors.New("partial reassignment completed with failures")
+
+	// Idempotency errors
+	ErrIdempotencyKeyReused     = errors.New("idempotency key reused with a different request")
+	ErrIdempotencyKeyInProgress = errors.New("request with this idempotency key is in progress")
+	// ErrIdempotencyLeaseLost - аренда ключа истекла, и ключ перезанят повтором: ответ не сохранен
+	ErrIdempotencyLeaseLost = errors.New("idempotency key lease expired before the response was saved")
 )
 
 // HTTPError для соответствия OpenAPI
@@ -50,19 +75,37 @@ type ErrorResponse struct {
 
 // Маппинг domain ошибок в HTTP ошибки
 var ErrorMapping = map[error]HTTPError{
-	ErrTeamAlreadyExists:      {Code: "TEAM_EXISTS", Message: "team_name already exists"},
-	ErrPRAlreadyExists:        {Code: "PR_EXISTS", Message: "PR id already exists"},
-	ErrPRAlreadyMerged:        {Code: "PR_MERGED", Message: "cannot reassign on merged PR"},
-	ErrReviewerNotAssigned:    {Code: "NOT_ASSIGNED", Message: "reviewer is not assigned to this PR"},
-	ErrNoReviewerCandidate:    {Code: "NO_CANDIDATE", Message: "no active replacement candidate in team"},
-	ErrUserNotFound:           {Code: "NOT_FOUND", Message: "user not found"},
-	ErrTeamNotFound:           {Code: "NOT_FOUND", Message: "team not found"},
-	ErrPRNotFound:             {Code: "NOT_FOUND", Message: "pull request not found"},
-	ErrPRAuthorNotFound:       {Code: "NOT_FOUND", Message: "author not found"},
-	ErrTeamDeactivationFailed: {Code: "DEACTIVATION_FAILED", Message: "team deactivation failed"},
-	ErrNoActiveUsersInTeam:    {Code: "NO_ACTIVE_USERS", Message: "no active users in team to deactivate"},
-	ErrPRReassignmentFailed:   {Code: "REASSIGNMENT_FAILED", Message: "PR reassignment failed during deactivation"},
-	ErrPartialReassignment:    {Code: "PARTIAL_REASSIGNMENT", Message: "partial reassignment completed with some failures"},
+	ErrTeamAlreadyExists:        {Code: "TEAM_EXISTS", Message: "team_name already exists"},
+	ErrPRAlreadyExists:          {Code: "PR_EXISTS", Message: "PR id already exists"},
+	ErrPRAlreadyMerged:          {Code: "PR_MERGED", Message: "cannot reassign on merged PR"},
+	ErrReviewerNotAssigned:      {Code: "NOT_ASSIGNED", Message: "reviewer is not assigned to this PR"},
+	ErrNoReviewerCandidate:      {Code: "NO_CANDIDATE", Message: "no active replacement candidate in team"},
+	ErrUserNotFound:             {Code: "NOT_FOUND", Message: "user not found"},
+	ErrTeamNotFound:             {Code: "NOT_FOUND", Message: "team not found"},
+	ErrPRNotFound:               {Code: "NOT_FOUND", Message: "pull request not found"},
+	ErrPRAuthorNotFound:         {Code: "NOT_FOUND", Message: "author not found"},
+	ErrParentTeamNotFound:       {Code: "NOT_FOUND", Message: "parent team not found"},
+	ErrTeamHierarchyCycle:       {Code: "TEAM_HIERARCHY_CYCLE", Message: "team cannot be placed under itself or its subteam"},
+	ErrInvalidRepository:        {Code: "INVALID_REQUEST", Message: "repository is required"},
+	ErrInvalidOwnershipPattern:  {Code: "INVALID_REQUEST", Message: "invalid ownership rule pattern"},
+	ErrInvalidSkillTag:          {Code: "INVALID_REQUEST", Message: "skill tags must be 1-50 chars of a-z, 0-9, '+', '#', '.', '-', '_'"},
+	ErrInvalidPRStatus:          {Code: "INVALID_REQUEST", Message: "status must be OPEN or MERGED"},
+	ErrInvalidPageLimit:         {Code: "INVALID_REQUEST", Message: "limit must be between 1 and 100"},
+	ErrInvalidCursor:            {Code: "INVALID_REQUEST", Message: "invalid cursor"},
+	ErrInvalidTimeRange:         {Code: "INVALID_REQUEST", Message: "created_from must be before created_to"},
+	ErrInvalidIdempotencyKey:    {Code: "INVALID_REQUEST", Message: "Idempotency-Key must be 1-255 printable ASCII characters"},
+	ErrInvalidIfMatch:           {Code: "INVALID_REQUEST", Message: "If-Match must be a quoted PR version or *"},
+	ErrInvalidImportMode:        {Code: "INVALID_REQUEST", Message: "mode must be upsert, skip or fail"},
+	ErrInvalidStatsFormat:       {Code: "INVALID_REQUEST", Message: "format must be csv, json or ndjson"},
+	ErrInvalidImportRecord:      {Code: "INVALID_RECORD", Message: "record does not match the export format"},
+	ErrUserAlreadyExists:        {Code: "USER_EXISTS", Message: "user_id already exists"},
+	ErrPRVersionConflict:        {Code: "CONFLICT", Message: "pull request was modified, reload it and retry"},
+	ErrTeamDeactivationFailed:   {Code: "DEACTIVATION_FAILED", Message: "team deactivation failed"},
+	ErrNoActiveUsersInTeam:      {Code: "NO_ACTIVE_USERS", Message: "no active users in team to deactivate"},
+	ErrPRReassignmentFailed:     {Code: "REASSIGNMENT_FAILED", Message: "PR reassignment failed during deactivation"},
+	ErrPartialReassignment:      {Code: "PARTIAL_REASSIGNMENT", Message: "partial reassignment completed with some failures"},
+	ErrIdempotencyKeyReused:     {Code: "IDEMPOTENCY_KEY_REUSED", Message: "Idempotency-Key was already used with a different request"},
+	ErrIdempotencyKeyInProgress: {Code: "IDEMPOTENCY_KEY_IN_PROGRESS", Message: "request with this Idempotency-Key is still in progress"},
 }
 
 // ToHTTPError преобразует domain ошибку в HTTP ошибку
@@ -70,3 +113,81 @@ func ToHTTPError(err error) (HTTPError, bool) {
 	httpErr, exists := ErrorMapping[err]
 	return httpErr, exists
 }
+
+// FromHTTPError восстанавливает domain ошибку по коду и сообщению из ответа API, nil - если такой нет.
+// Сообщение нужно, потому что один код (например NOT_FOUND) соответствует нескольким ошибкам.
+func FromHTTPError(code, message string) error {
+	for err, httpErr := range ErrorMapping {
+		if httpErr.Code == code && httpErr.Message == message {
+			return err
+		}
+	}
+	return nil
+}
+
+// ErrorKind - класс ошибки, по которому транспорты выбирают статус ответа.
+// REST (handler.getHTTPStatusCode) и gRPC (grpcserver.getGRPCCode) читают одну таблицу errorKinds,
+// поэтому новая ошибка получает согласованные коды в обоих API.
+type ErrorKind int
+
+const (
+	// ErrorKindInternal - ошибка вне таблицы: 500 / Internal
+	ErrorKindInternal ErrorKind = iota
+	// ErrorKindInvalidArgument - неверные параметры запроса: 400 / InvalidArgument
+	ErrorKindInvalidArgument
+	// ErrorKindNotFound - сущность не найдена: 404 / NotFound
+	ErrorKindNotFound
+	// ErrorKindAlreadyExists - сущность уже существует: 409 / AlreadyExists
+	ErrorKindAlreadyExists
+	// ErrorKindFailedPrecondition - операция невозможна в текущем состоянии: 409 / FailedPrecondition
+	ErrorKindFailedPrecondition
+	// ErrorKindAborted - конкурирующее изменение, запрос можно повторить: 409 / Aborted
+	ErrorKindAborted
+	// ErrorKindUnprocessable - запрос корректен, но противоречит ранее принятому: 422 / InvalidArgument
+	ErrorKindUnprocessable
+)
+
+var errorKinds = map[error]ErrorKind{
+	ErrInvalidPRID:             ErrorKindInvalidArgument,
+	ErrInvalidPRName:           ErrorKindInvalidArgument,
+	ErrInvalidUserID:           ErrorKindInvalidArgument,
+	ErrInvalidTeamName:         ErrorKindInvalidArgument,
+	ErrTeamMustHaveMembers:     ErrorKindInvalidArgument,
+	ErrInvalidRepository:       ErrorKindInvalidArgument,
+	ErrInvalidOwnershipPattern: ErrorKindInvalidArgument,
+	ErrInvalidSkillTag:         ErrorKindInvalidArgument,
+	ErrInvalidPRStatus:         ErrorKindInvalidArgument,
+	ErrInvalidPageLimit:        ErrorKindInvalidArgument,
+	ErrInvalidCursor:           ErrorKindInvalidArgument,
+	ErrInvalidTimeRange:        ErrorKindInvalidArgument,
+	ErrInvalidIdempotencyKey:   ErrorKindInvalidArgument,
+	ErrInvalidIfMatch:          ErrorKindInvalidArgument,
+	ErrInvalidImportMode:       ErrorKindInvalidArgument,
+	ErrInvalidStatsFormat:      ErrorKindInvalidArgument,
+
+	ErrUserNotFound:       ErrorKindNotFound,
+	ErrTeamNotFound:       ErrorKindNotFound,
+	ErrPRNotFound:         ErrorKindNotFound,
+	ErrPRAuthorNotFound:   ErrorKindNotFound,
+	ErrParentTeamNotFound: ErrorKindNotFound,
+
+	ErrTeamAlreadyExists: ErrorKindAlreadyExists,
+	ErrPRAlreadyExists:   ErrorKindAlreadyExists,
+
+	ErrPRAlreadyMerged:          ErrorKindFailedPrecondition,
+	ErrReviewerNotAssigned:      ErrorKindFailedPrecondition,
+	ErrNoReviewerCandidate:      ErrorKindFailedPrecondition,
+	ErrPartialReassignment:      ErrorKindFailedPrecondition,
+	ErrNoActiveUsersInTeam:      ErrorKindFailedPrecondition,
+	ErrTeamHierarchyCycle:       ErrorKindFailedPrecondition,
+	ErrIdempotencyKeyInProgress: ErrorKindFailedPrecondition,
+
+	ErrPRVersionConflict: ErrorKindAborted,
+
+	ErrIdempotencyKeyReused: ErrorKindUnprocessable,
+}
+
+// KindOf возвращает класс ошибки; ошибки вне таблицы (в том числе обернутые) - ErrorKindInternal
+func KindOf(err error) ErrorKind {
+	return errorKinds[err]
+}
@@ -0,0 +1,33 @@
+package domain
+
+import "time"
+
+// Типы событий назначения ревьюверов
+const (
+	// AssignmentAssigned - ревьювер назначен на PR (при создании или переназначении)
+	AssignmentAssigned = "assigned"
+	// AssignmentUnassigned - ревьювер снят с PR при переназначении
+	AssignmentUnassigned = "unassigned"
+	// AssignmentMerged - PR, на который назначен ревьювер, смержен
+	AssignmentMerged = "merged"
+)
+
+// AssignmentEvent - изменение назначений ревьюверов. События публикуются по одному на ревьювера:
+// переназначение дает пару unassigned + assigned, мерж - merged для каждого ревьювера PR.
+// Мерж идемпотентен, поэтому повторный вызов публикует merged еще раз.
+type AssignmentEvent struct {
+	// ID - порядковый номер события, присваивается брокером при публикации
+	ID              uint64
+	Type            string
+	PullRequestID   string
+	PullRequestName string
+	AuthorID        string
+	ReviewerID      string
+	OccurredAt      time.Time
+}
+
+// AssignmentEventPublisher получает события назначения из usecase.
+// Публикация не должна блокироваться: usecase вызывает ее после фиксации изменений.
+type AssignmentEventPublisher interface {
+	PublishAssignment(event AssignmentEvent)
+}
@@ -0,0 +1,64 @@
+package domain
+
+import "time"
+
+// UserStatusChange - запись истории активности пользователя: с момента ChangedAt статус равен IsActive.
+type UserStatusChange struct {
+	UserID    string
+	IsActive  bool
+	ChangedAt time.Time
+}
+
+// MemberAssignments - пользователь и число его назначений ревьювером на PR, созданные за период.
+type MemberAssignments struct {
+	UserID      string
+	Username    string
+	TeamName    string
+	Assignments int64
+}
+
+// FairnessOutlier - заметное отклонение нагрузки участника от справедливой доли.
+type FairnessOutlier string
+
+const (
+	// FairnessOverloaded - назначений заметно больше ожидаемого.
+	FairnessOverloaded FairnessOutlier = "overloaded"
+	// FairnessUnderloaded - назначений заметно меньше ожидаемого.
+	FairnessUnderloaded FairnessOutlier = "underloaded"
+)
+
+// MemberFairness - нагрузка участника команды по сравнению со справедливой долей.
+// ExpectedAssignments - доля назначений команды, пропорциональная дням активности участника за период;
+// Outlier пуст, если отклонение в пределах нормы.
+type MemberFairness struct {
+	UserID              string
+	Username            string
+	ActiveDays          float64
+	Assignments         int64
+	ExpectedAssignments float64
+	Outlier             FairnessOutlier
+}
+
+// Deviation возвращает разницу между фактическим и ожидаемым числом назначений.
+func (m *MemberFairness) Deviation() float64 {
+	return float64(m.Assignments) - m.ExpectedAssignments
+}
+
+// TeamFairness - справедливость распределения назначений внутри команды за период.
+// Gini считается по числу назначений на день активности (0 - поровну, ближе к 1 - неравномерно),
+// MaxMinSpread - разница между наибольшим и наименьшим отклонением от ожидаемого.
+// Оба показателя учитывают только участников, активных в периоде.
+type TeamFairness struct {
+	TeamName     string
+	Assignments  int64
+	Gini         float64
+	MaxMinSpread float64
+	Members      []*MemberFairness
+}
+
+// FairnessReport - отчет о справедливости назначений по командам за период [From, To).
+type FairnessReport struct {
+	From  time.Time
+	To    time.Time
+	Teams []*TeamFairness
+}
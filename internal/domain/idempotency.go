@@ -0,0 +1,35 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// IdempotencyRecord - запрос с ключом идемпотентности (Idempotency-Key) и сохраненный ответ на него.
+// Fingerprint - хеш метода, пути, If-Match и тела запроса; StatusCode равен 0, пока исходный запрос выполняется.
+// LeaseExpiresAt - срок аренды ключа выполняющимся запросом: позже ключ без ответа считается брошенным.
+// ETag - заголовок ETag ответа (версия PR), пустой, если ответ его не содержал.
+type IdempotencyRecord struct {
+	Key            string
+	Fingerprint    string
+	StatusCode     int
+	ContentType    string
+	ETag           string
+	Body           []byte
+	ExpiresAt      time.Time
+	LeaseExpiresAt time.Time
+}
+
+// IdempotencyRepository определяет контракт для хранения ключей идемпотентности и ответов на запросы.
+type IdempotencyRepository interface {
+	// Reserve занимает ключ до expiresAt с арендой до leaseExpiresAt и возвращает true, если ключ свободен,
+	// истек или брошен (ответа нет, аренда истекла). Иначе возвращает действующую запись и false.
+	Reserve(ctx context.Context, key, fingerprint string, leaseExpiresAt, expiresAt time.Time) (*IdempotencyRecord, bool, error)
+	// Complete сохраняет ответ, если ключ все еще занят запросом с этим fingerprint и ответа нет;
+	// иначе (ключ перезанят после истечения аренды) - ErrIdempotencyLeaseLost
+	Complete(ctx context.Context, key, fingerprint string, statusCode int, contentType, etag string, body []byte) error
+	// Release освобождает ключ, если он все еще занят запросом с этим fingerprint и ответа нет
+	Release(ctx context.Context, key, fingerprint string) error
+	// DeleteExpired удаляет истекшие ключи и возвращает их количество
+	DeleteExpired(ctx context.Context) (int64, error)
+}
@@ -0,0 +1,16 @@
+package domain
+
+// Этапы деактивации команды, на которых возможен сбой
+const (
+	DeactivationStageUsers    = "deactivate_users"
+	DeactivationStageReassign = "reassign_pr"
+)
+
+// MetricsRecorder получает доменные события для метрик сервиса.
+type MetricsRecorder interface {
+	PRCreated()
+	PRMerged()
+	ReviewerReassigned()
+	NoReviewerCandidate()
+	DeactivationFailed(stage string)
+}
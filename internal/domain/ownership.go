@@ -0,0 +1,17 @@
+package domain
+
+import "context"
+
+// OwnershipRule представляет правило владения кодом в стиле CODEOWNERS:
+// glob-шаблон пути и владельцы (пользователи и/или команды).
+type OwnershipRule struct {
+	Pattern   string
+	UserIDs   []string
+	TeamNames []string
+}
+
+// OwnershipRepository определяет контракт для хранения правил владения кодом по репозиториям.
+type OwnershipRepository interface {
+	ReplaceRules(ctx context.Context, repository string, rules []*OwnershipRule) error
+	GetRules(ctx context.Context, repository string) ([]*OwnershipRule, error)
+}
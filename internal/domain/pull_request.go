@@ -12,17 +12,78 @@ type PullRequest struct {
 	AuthorID          string
 	Status            string
 	AssignedReviewers []string
+	CreatedAt         *time.Time
 	MergedAt          *time.Time
+	// Version растет с каждым изменением PR (мерж, переназначение ревьювера); отдается клиенту как ETag
+	Version int64
+	// Rationale - объяснение выбора ревьюверов (заполняется при создании PR и по запросу explain)
+	Rationale *AssignmentRationale
+}
+
+// CreatePRRequest содержит параметры создания PR.
+// Repository и ChangedFiles необязательны: если для затронутых путей есть правила владения,
+// среди ревьюверов будет хотя бы один владелец каждого пути.
+// RequiredTags - навыки, которые желательно покрыть ревьюверами из команды автора.
+type CreatePRRequest struct {
+	ID           string
+	Name         string
+	AuthorID     string
+	Repository   string
+	ChangedFiles []string
+	RequiredTags []string
+}
+
+// Статусы PR
+const (
+	PRStatusOpen   = "OPEN"
+	PRStatusMerged = "MERGED"
+)
+
+// PRListFilter содержит фильтры и параметры страницы списка PR.
+// Пустые поля не ограничивают выборку; TeamName - команда автора PR.
+// CreatedFrom включительно, CreatedTo - не включительно.
+// Cursor - непрозрачный курсор из NextCursor предыдущей страницы.
+type PRListFilter struct {
+	Status      string
+	AuthorID    string
+	ReviewerID  string
+	TeamName    string
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+	Cursor      string
+	Limit       int
+}
+
+// PRCursor - позиция keyset-пагинации: последний PR предыдущей страницы.
+type PRCursor struct {
+	CreatedAt time.Time
+	ID        string
+}
+
+// PRPage - страница списка PR, отсортированного от новых к старым.
+// NextCursor пуст, если страница последняя.
+type PRPage struct {
+	PullRequests []*PullRequest
+	NextCursor   string
 }
 
 // PRRepository определяет контракт для работы с хранилищем пул-реквестов.
 type PRRepository interface {
 	CreateWithReviewers(ctx context.Context, pr *PullRequest, reviewerIDs []string) error
 	GetByID(ctx context.Context, prID string) (*PullRequest, error)
-	Merge(ctx context.Context, prID string) (*PullRequest, error)
-	ReassignReviewer(ctx context.Context, prID, oldReviewerID, newReviewerID string) error
-	GetUserAssignedPRs(ctx context.Context, userID string) ([]*PullRequest, error)
+	// Merge и ReassignReviewer в одной транзакции блокируют PR, сверяют его версию с expectedVersion
+	// (0 - без проверки), выполняют изменение и увеличивают версию. Несовпадение - ErrPRVersionConflict.
+	// Merge также сообщает, перевел ли именно этот вызов PR из OPEN в MERGED (false - PR уже был смержен).
+	Merge(ctx context.Context, prID string, expectedVersion int64) (*PullRequest, bool, error)
+	ReassignReviewer(ctx context.Context, prID, oldReviewerID, newReviewerID string, expectedVersion int64) error
+	// GetUserAssignedPRs возвращает до limit PR на ревью у пользователя, от новых к старым, начиная после курсора after
+	GetUserAssignedPRs(ctx context.Context, userID string, after *PRCursor, limit int) ([]*PullRequest, error)
 	IsUserReviewer(ctx context.Context, prID, userID string) (bool, error)
 	ExistsPr(ctx context.Context, prID string) (bool, error)
 	GetReviewers(ctx context.Context, prID string) ([]string, error)
+	GetRationale(ctx context.Context, prID string) (*AssignmentRationale, error)
+	List(ctx context.Context, filter *PRListFilter, after *PRCursor, limit int) ([]*PullRequest, error)
+	GetByIDs(ctx context.Context, prIDs []string) ([]*PullRequest, error)
+	// GetAssignedToUsers возвращает PR на ревью у каждого из пользователей, от новых к старым
+	GetAssignedToUsers(ctx context.Context, userIDs []string) (map[string][]*PullRequest, error)
 }
@@ -1,6 +1,9 @@
 package domain
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 // ReviewStat представляет статистику по ревью для конкретного пользователя.
 type ReviewStat struct {
@@ -16,8 +19,59 @@ type PRAssignmentStat struct {
 	ReviewersCount int64
 }
 
+// StatsFilter ограничивает выборку статистики; пустые поля не ограничивают ее.
+// CreatedFrom (включительно), CreatedTo (не включительно) и Status относятся к PR: учитываются
+// только назначения на PR, созданные в этом интервале и находящиеся в этом статусе.
+// TeamName - команда пользователя в статистике по ревьюверам, команда автора в статистике по PR
+// и единственная выводимая команда в статистике по командам.
+type StatsFilter struct {
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+	TeamName    string
+	Status      string
+}
+
+// TeamReviewStat представляет показатели команды по PR, попавшим в фильтр.
+// Полученные ревью - назначения на PR авторов из команды, выполненные - назначения участников команды
+// на любые PR. CrossTeamReviews - полученные ревью, назначенные участникам других команд.
+type TeamReviewStat struct {
+	TeamName         string
+	PullRequests     int64
+	OpenPullRequests int64
+	ReviewsGiven     int64
+	ReviewsReceived  int64
+	CrossTeamReviews int64
+}
+
+// AvgReviewersPerPR возвращает среднее число ревьюверов на PR команды (0, если PR нет).
+func (s *TeamReviewStat) AvgReviewersPerPR() float64 {
+	if s.PullRequests == 0 {
+		return 0
+	}
+	return float64(s.ReviewsReceived) / float64(s.PullRequests)
+}
+
+// CrossTeamShare возвращает долю полученных ревью от участников других команд (0, если ревью нет).
+func (s *TeamReviewStat) CrossTeamShare() float64 {
+	if s.ReviewsReceived == 0 {
+		return 0
+	}
+	return float64(s.CrossTeamReviews) / float64(s.ReviewsReceived)
+}
+
+// TeamReviewLoad - число открытых ревью, назначенных участникам команды.
+type TeamReviewLoad struct {
+	TeamName    string
+	OpenReviews int64
+}
+
 // StatsRepository определяет контракт для работы со статистическими данными.
 type StatsRepository interface {
-	GetStatsReviews(ctx context.Context) ([]*ReviewStat, error)
-	GetStatsPrAssignments(ctx context.Context) ([]*PRAssignmentStat, error)
+	GetStatsReviews(ctx context.Context, filter *StatsFilter) ([]*ReviewStat, error)
+	GetStatsPrAssignments(ctx context.Context, filter *StatsFilter) ([]*PRAssignmentStat, error)
+	GetStatsTeams(ctx context.Context, filter *StatsFilter) ([]*TeamReviewStat, error)
+	GetMemberAssignments(ctx context.Context, teamName string, from, to time.Time) ([]*MemberAssignments, error)
+	GetUserStatusHistory(ctx context.Context, teamName string, before time.Time) ([]*UserStatusChange, error)
+	CountOpenPRs(ctx context.Context) (int64, error)
+	GetTeamReviewLoad(ctx context.Context) ([]*TeamReviewLoad, error)
 }
@@ -4,8 +4,9 @@ import "context"
 
 // Team представляет команду с участниками.
 type Team struct {
-	Name    string
-	Members []*User
+	Name       string
+	ParentName string
+	Members    []*User
 }
 
 // TeamDeactivationResult представляет результат массовой деактивации
@@ -17,6 +18,32 @@ type TeamDeactivationResult struct {
 	DeactivatedUserIDs  []string
 }
 
+// TeamStats представляет агрегированные показатели команды или поддерева команд.
+type TeamStats struct {
+	MembersCount       int64
+	ActiveMembersCount int64
+	OpenPRsCount       int64
+	OpenReviewsCount   int64
+}
+
+// Add добавляет показатели другой команды (используется при свёртке поддерева).
+func (s *TeamStats) Add(other TeamStats) {
+	s.MembersCount += other.MembersCount
+	s.ActiveMembersCount += other.ActiveMembersCount
+	s.OpenPRsCount += other.OpenPRsCount
+	s.OpenReviewsCount += other.OpenReviewsCount
+}
+
+// TeamTreeNode представляет узел иерархии команд.
+// Stats содержит показатели самой команды, SubtreeStats - свёртку по всему поддереву.
+type TeamTreeNode struct {
+	Name         string
+	ParentName   string
+	Stats        TeamStats
+	SubtreeStats TeamStats
+	Children     []*TeamTreeNode
+}
+
 // TeamRepository определяет контракт для работы с хранилищем команд
 type TeamRepository interface {
 	Create(ctx context.Context, team *Team) error
@@ -27,4 +54,33 @@ type TeamRepository interface {
 	GetOpenPRsWithTeamReviewers(ctx context.Context, teamName string) ([]string, error)
 	GetPRReviewersFromTeam(ctx context.Context, prID, teamName string) ([]string, error)
 	GetAllTeams(ctx context.Context) ([]*Team, error)
+	// SetParent в одной транзакции проверяет иерархию и переносит команду: родитель должен существовать
+	// (иначе ErrParentTeamNotFound) и не лежать в поддереве команды (иначе ErrTeamHierarchyCycle)
+	SetParent(ctx context.Context, teamName, parentName string) error
+	GetParent(ctx context.Context, teamName string) (string, error)
+	GetChildren(ctx context.Context, teamName string) ([]string, error)
+	GetTeamTreeStats(ctx context.Context) ([]*TeamTreeNode, error)
+	// GetByNames возвращает найденные команды с родителями, но без участников
+	GetByNames(ctx context.Context, teamNames []string) ([]*Team, error)
+}
+
+// CheckNoCycle поднимается от parentName к корню через getParent и возвращает ErrTeamHierarchyCycle,
+// если по пути встречается teamName, то есть команда оказалась бы в собственном поддереве.
+// Уже существующий цикл среди предков тоже дает ErrTeamHierarchyCycle, а не бесконечный обход.
+func CheckNoCycle(teamName, parentName string, getParent func(teamName string) (string, error)) error {
+	visited := make(map[string]bool)
+	for current := parentName; current != ""; {
+		if current == teamName || visited[current] {
+			return ErrTeamHierarchyCycle
+		}
+		visited[current] = true
+
+		parent, err := getParent(current)
+		if err != nil {
+			return err
+		}
+		current = parent
+	}
+
+	return nil
 }
@@ -0,0 +1,120 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// ExportRecord - запись выгрузки /admin/export: ровно одно из полей Team, User, PullRequest.
+// У пользователя заполнены навыки, отметка отсутствия и история активности, у PR - ревьюверы,
+// время создания и мержа и объяснение выбора ревьюверов (если сохранялось).
+type ExportRecord struct {
+	Type        string
+	Team        *Team
+	User        *User
+	PullRequest *PullRequest
+}
+
+// StatusHistoryEpoch - время единственной записи истории пользователя, загруженного без истории
+// (выгрузка до появления истории): статус считается неизменным с начала эпохи Unix,
+// как для пользователей, существовавших до миграции истории.
+var StatusHistoryEpoch = time.Unix(0, 0).UTC()
+
+// Типы записей выгрузки
+const (
+	RecordTypeTeam        = "team"
+	RecordTypeUser        = "user"
+	RecordTypePullRequest = "pull_request"
+)
+
+// ImportRecord - запись загрузки (строка NDJSON): ровно одно из полей Team, User, PullRequest.
+// Line - номер строки, начиная с 1. Invalid - ошибка разбора строки; такая запись не применяется
+// и сразу попадает в отчет.
+type ImportRecord struct {
+	Line        int
+	Type        string
+	Team        *Team
+	User        *User
+	PullRequest *PullRequest
+	Invalid     error
+}
+
+// ImportMode определяет, что делать с записью, если команда, пользователь или PR уже существует.
+type ImportMode string
+
+const (
+	// ImportModeUpsert - перезаписать существующую сущность.
+	ImportModeUpsert ImportMode = "upsert"
+	// ImportModeSkip - оставить существующую сущность без изменений.
+	ImportModeSkip ImportMode = "skip"
+	// ImportModeFail - считать запись ошибочной (по умолчанию).
+	ImportModeFail ImportMode = "fail"
+)
+
+// ParseImportMode преобразует параметр запроса в ImportMode (пустая строка - ImportModeFail).
+func ParseImportMode(value string) (ImportMode, error) {
+	switch mode := ImportMode(value); mode {
+	case ImportModeUpsert, ImportModeSkip, ImportModeFail:
+		return mode, nil
+	case "":
+		return ImportModeFail, nil
+	default:
+		return "", ErrInvalidImportMode
+	}
+}
+
+// ImportOptions - параметры загрузки. DryRun проверяет все записи, но ничего не сохраняет.
+type ImportOptions struct {
+	Mode   ImportMode
+	DryRun bool
+}
+
+// ImportRecordError - ошибка одной записи загрузки. ID - команда, пользователь или PR записи, если известен.
+type ImportRecordError struct {
+	Line    int
+	Type    string
+	ID      string
+	Code    string
+	Message string
+}
+
+// ImportReport - результат загрузки. Записи применяются в одной транзакции: если хотя бы одна
+// запись ошибочна, не сохраняется ничего (Committed = false), а Errors перечисляет все такие записи.
+// Created, Updated и Skipped считаются и при DryRun - это то, что произошло бы при загрузке.
+type ImportReport struct {
+	Mode      ImportMode
+	DryRun    bool
+	Committed bool
+	Created   int
+	Updated   int
+	Skipped   int
+	Errors    []*ImportRecordError
+}
+
+// ImportStore - операции загрузки внутри одной транзакции.
+// Save-методы создают сущность или полностью перезаписывают существующую.
+type ImportStore interface {
+	ExistsTeam(ctx context.Context, teamName string) (bool, error)
+	// GetParent возвращает родителя команды (пустая строка - корневая) или ErrTeamNotFound
+	GetParent(ctx context.Context, teamName string) (string, error)
+	SaveTeam(ctx context.Context, team *Team) error
+	ExistsUser(ctx context.Context, userID string) (bool, error)
+	// SaveUser сохраняет пользователя вместе с навыками и отметкой отсутствия и заменяет его историю
+	// активности на user.StatusHistory. Триггер истории в транзакции загрузки выключен,
+	// поэтому запись пользователя не добавляет в историю смену статуса на момент загрузки.
+	SaveUser(ctx context.Context, user *User) error
+	ExistsPR(ctx context.Context, prID string) (bool, error)
+	// SavePR сохраняет PR с ревьюверами, временем создания и мержа и объяснением выбора ревьюверов.
+	// Новый PR получает версию 1, у перезаписанного версия увеличивается.
+	SavePR(ctx context.Context, pr *PullRequest) error
+}
+
+// TransferRepository определяет контракт выгрузки и загрузки данных сервиса.
+type TransferRepository interface {
+	// Export читает все данные из одного согласованного снимка и передает их в emit по одной записи:
+	// команды по имени, затем пользователи по ID, затем PR по ID. Базы данных читают записи страницами,
+	// поэтому выгрузка не собирается в памяти целиком. Ошибка emit прерывает выгрузку и возвращается.
+	Export(ctx context.Context, emit func(record *ExportRecord) error) error
+	// Import выполняет fn в транзакции; если fn вернула ошибку, все изменения откатываются
+	Import(ctx context.Context, fn func(store ImportStore) error) error
+}
@@ -1,29 +1,53 @@
 package domain
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 // TeamUseCase определяет бизнес-логику для работы с командами.
 type TeamUseCase interface {
 	CreateTeam(ctx context.Context, team *Team) error
 	GetTeam(ctx context.Context, teamName string) (*Team, error)
 	DeactivateTeamUsers(ctx context.Context, teamName string) (*TeamDeactivationResult, error)
+	SetTeamParent(ctx context.Context, teamName, parentName string) (*Team, error)
+	GetTeamTree(ctx context.Context, rootTeamName string) ([]*TeamTreeNode, error)
 }
 
 // UserUseCase определяет бизнес-логику для работы с пользователями.
 type UserUseCase interface {
 	SetUserActive(ctx context.Context, userID string, isActive bool) (*User, error)
-	GetUserReviewPRs(ctx context.Context, userID string) ([]*PullRequest, error)
+	GetUserReviewPRs(ctx context.Context, userID, cursor string, limit int) (*PRPage, error)
+	SetUserSkills(ctx context.Context, userID string, skills []string) (*User, error)
+	SetUserOutOfOffice(ctx context.Context, userID string, until *time.Time) (*User, error)
 }
 
 // PRUseCase определяет бизнес-логику для работы с Pull Request'ами.
 type PRUseCase interface {
-	CreatePR(ctx context.Context, prID, prName, authorID string) (*PullRequest, error)
-	MergePR(ctx context.Context, prID string) (*PullRequest, error)
-	ReassignReviewer(ctx context.Context, prID, oldReviewerID string) (*PullRequest, string, error)
+	CreatePR(ctx context.Context, req *CreatePRRequest) (*PullRequest, error)
+	GetPR(ctx context.Context, prID string, explain bool) (*PullRequest, error)
+	ListPRs(ctx context.Context, filter *PRListFilter) (*PRPage, error)
+	// MergePR и ReassignReviewer с ifMatch > 0 выполняются, только если версия PR равна ifMatch
+	MergePR(ctx context.Context, prID string, ifMatch int64) (*PullRequest, error)
+	ReassignReviewer(ctx context.Context, prID, oldReviewerID string, ifMatch int64) (*PullRequest, string, error)
 }
 
 // StatsUseCase определяет бизнес-логику для работы со статистикой.
 type StatsUseCase interface {
-	GetStatsReviews(ctx context.Context) ([]*ReviewStat, error)
-	GetStatsPrAssignments(ctx context.Context) ([]*PRAssignmentStat, error)
+	GetStatsReviews(ctx context.Context, filter *StatsFilter) ([]*ReviewStat, error)
+	GetStatsPrAssignments(ctx context.Context, filter *StatsFilter) ([]*PRAssignmentStat, error)
+	GetStatsTeams(ctx context.Context, filter *StatsFilter) ([]*TeamReviewStat, error)
+	GetFairnessReport(ctx context.Context, filter *StatsFilter) (*FairnessReport, error)
+}
+
+// OwnershipUseCase определяет бизнес-логику для управления правилами владения кодом.
+type OwnershipUseCase interface {
+	SetRules(ctx context.Context, repository string, rules []*OwnershipRule) ([]*OwnershipRule, error)
+	GetRules(ctx context.Context, repository string) ([]*OwnershipRule, error)
+}
+
+// TransferUseCase определяет бизнес-логику выгрузки и загрузки данных сервиса.
+type TransferUseCase interface {
+	Export(ctx context.Context, emit func(record *ExportRecord) error) error
+	Import(ctx context.Context, records []*ImportRecord, opts ImportOptions) (*ImportReport, error)
 }
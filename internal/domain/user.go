@@ -1,6 +1,9 @@
 package domain
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 // User представляет сущность пользователя в системе.
 type User struct {
@@ -8,6 +11,16 @@ type User struct {
 	Username string
 	TeamName string
 	IsActive bool
+	Skills   []string
+	// OutOfOfficeUntil - до какого момента пользователь отсутствует (nil - на месте)
+	OutOfOfficeUntil *time.Time
+	// StatusHistory - история активности по возрастанию времени; заполняется только выгрузкой и загрузкой
+	StatusHistory []*UserStatusChange
+}
+
+// IsOutOfOffice сообщает, отсутствует ли пользователь в момент at.
+func (u *User) IsOutOfOffice(at time.Time) bool {
+	return u.OutOfOfficeUntil != nil && at.Before(*u.OutOfOfficeUntil)
 }
 
 // UserRepository определяет контракт для работы с хранилищем пользователей.
@@ -16,4 +29,11 @@ type UserRepository interface {
 	GetActiveUsersByTeam(ctx context.Context, teamName string, excludeUserID string) ([]*User, error)
 	UpdateActiveStatus(ctx context.Context, userID string, isActive bool) (*User, error)
 	GetUserTeam(ctx context.Context, userID string) (string, error)
+	SetSkills(ctx context.Context, userID string, skills []string) error
+	GetSkills(ctx context.Context, userID string) ([]string, error)
+	GetTeamRoster(ctx context.Context, teamName string) ([]*ReviewCandidate, error)
+	GetByIDs(ctx context.Context, userIDs []string) ([]*User, error)
+	GetByTeams(ctx context.Context, teamNames []string) ([]*User, error)
+	SetOutOfOffice(ctx context.Context, userID string, until *time.Time) error
+	GetReviewCandidates(ctx context.Context, userIDs []string) ([]*ReviewCandidate, error)
 }
@@ -0,0 +1,169 @@
+// Package events реализует внутрипроцессный брокер событий назначения ревьюверов.
+package events
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"pr-reviewer-service/internal/domain"
+)
+
+const (
+	// defaultBufferSize - сколько событий может накопиться у подписчика, прежде чем он будет отключен
+	defaultBufferSize = 256
+	// defaultHistorySize - сколько последних событий хранится для переподключения с Last-Event-ID
+	defaultHistorySize = 1024
+)
+
+// Broker раздает события из usecase подписчикам (gRPC-стримам, SSE).
+// Публикация не блокируется: подписчик, не успевающий читать, отключается - его канал закрывается,
+// а Dropped возвращает true. Отключенный подписчик должен подписаться заново;
+// последние события хранятся в истории, поэтому через SubscribeFrom он может их дочитать.
+type Broker struct {
+	mu          sync.Mutex
+	lastID      uint64
+	subscribers map[*Subscription]struct{}
+	bufferSize  int
+	closed      bool
+
+	// history - кольцевой буфер: событие с номером id лежит в history[(id-1) % len(history)]
+	history []domain.AssignmentEvent
+}
+
+// NewBroker создает брокер без подписчиков.
+func NewBroker() *Broker {
+	return &Broker{
+		subscribers: make(map[*Subscription]struct{}),
+		bufferSize:  defaultBufferSize,
+		history:     make([]domain.AssignmentEvent, defaultHistorySize),
+	}
+}
+
+// Subscription - подписка на события. События приходят в C в порядке публикации.
+type Subscription struct {
+	C <-chan domain.AssignmentEvent
+
+	ch      chan domain.AssignmentEvent
+	filter  func(domain.AssignmentEvent) bool
+	broker  *Broker
+	startID uint64
+	dropped atomic.Bool
+	closed  bool
+}
+
+// PublishAssignment присваивает событию порядковый номер и рассылает его подписчикам.
+func (b *Broker) PublishAssignment(event domain.AssignmentEvent) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.lastID++
+	event.ID = b.lastID
+	b.history[(event.ID-1)%uint64(len(b.history))] = event
+
+	for sub := range b.subscribers {
+		if sub.filter != nil && !sub.filter(event) {
+			continue
+		}
+		select {
+		case sub.ch <- event:
+		default:
+			sub.dropped.Store(true)
+			b.removeLocked(sub)
+		}
+	}
+}
+
+// Subscribe подписывает на события, для которых filter возвращает true (nil - на все).
+// Подписку нужно закрыть через Close.
+func (b *Broker) Subscribe(filter func(domain.AssignmentEvent) bool) *Subscription {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.subscribeLocked(filter)
+}
+
+// SubscribeFrom подписывает так же, как Subscribe, и возвращает события из истории с номерами
+// больше afterID, для которых filter возвращает true. Вместе с подпиской они дают поток без пропусков.
+// complete = false, если часть событий после afterID уже вытеснена из истории или afterID брокеру
+// неизвестен (например, выдан до перезапуска сервиса) - тогда подписчику нужен полный снимок состояния.
+func (b *Broker) SubscribeFrom(afterID uint64, filter func(domain.AssignmentEvent) bool) (sub *Subscription, missed []domain.AssignmentEvent, complete bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	sub = b.subscribeLocked(filter)
+
+	size := uint64(len(b.history))
+	if afterID > b.lastID || b.lastID-afterID > size {
+		return sub, nil, false
+	}
+	for id := afterID + 1; id <= b.lastID; id++ {
+		event := b.history[(id-1)%size]
+		if filter == nil || filter(event) {
+			missed = append(missed, event)
+		}
+	}
+	return sub, missed, true
+}
+
+func (b *Broker) subscribeLocked(filter func(domain.AssignmentEvent) bool) *Subscription {
+	ch := make(chan domain.AssignmentEvent, b.bufferSize)
+	sub := &Subscription{C: ch, ch: ch, filter: filter, broker: b, startID: b.lastID}
+
+	if b.closed {
+		sub.closed = true
+		close(ch)
+		return sub
+	}
+	b.subscribers[sub] = struct{}{}
+
+	return sub
+}
+
+// Subscribers возвращает число активных подписок.
+func (b *Broker) Subscribers() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return len(b.subscribers)
+}
+
+// Close закрывает все подписки (при остановке сервера). Новые подписки создаются уже закрытыми.
+func (b *Broker) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.closed = true
+	for sub := range b.subscribers {
+		b.removeLocked(sub)
+	}
+}
+
+// Close отменяет подписку и закрывает канал. Повторный вызов ничего не делает.
+func (s *Subscription) Close() {
+	s.broker.mu.Lock()
+	defer s.broker.mu.Unlock()
+	s.broker.removeLocked(s)
+}
+
+// StartID - номер последнего события, опубликованного до подписки: в C придут только более поздние.
+func (s *Subscription) StartID() uint64 {
+	return s.startID
+}
+
+// Dropped сообщает, что подписка отключена брокером из-за переполнения буфера.
+func (s *Subscription) Dropped() bool {
+	return s.dropped.Load()
+}
+
+func (b *Broker) removeLocked(sub *Subscription) {
+	if sub.closed {
+		return
+	}
+	sub.closed = true
+	delete(b.subscribers, sub)
+	close(sub.ch)
+}
+
+// ForReviewer - фильтр событий одного ревьювера.
+func ForReviewer(userID string) func(domain.AssignmentEvent) bool {
+	return func(event domain.AssignmentEvent) bool {
+		return event.ReviewerID == userID
+	}
+}
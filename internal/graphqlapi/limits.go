@@ -0,0 +1,155 @@
+package graphqlapi
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// listFactor - сколько элементов в среднем ожидается от поля-списка при оценке сложности
+const listFactor = 10
+
+// Limits ограничивает запрос до выполнения.
+// Глубина - число вложенных уровней полей; сложность - число полей с учетом того,
+// что поля внутри списка выполняются listFactor раз на каждый уровень списков.
+// Поля интроспекции (__schema, __type, __typename) не учитываются.
+type Limits struct {
+	MaxDepth      int
+	MaxComplexity int
+}
+
+// Коды ошибок в extensions.code
+const (
+	codeQueryTooDeep    = "QUERY_TOO_DEEP"
+	codeQueryTooComplex = "QUERY_TOO_COMPLEX"
+)
+
+// limitError - превышен один из лимитов
+type limitError struct {
+	code    string
+	message string
+}
+
+func (e *limitError) formatted() gqlerrors.FormattedError {
+	return gqlerrors.FormattedError{
+		Message:    e.message,
+		Extensions: map[string]interface{}{"code": e.code},
+	}
+}
+
+// costWalker обходит выбранную операцию с подстановкой фрагментов
+type costWalker struct {
+	schema     *graphql.Schema
+	fragments  map[string]*ast.FragmentDefinition
+	limits     Limits
+	complexity int
+	err        *limitError
+}
+
+// checkLimits проверяет глубину и сложность операции.
+// Обход прерывается при первом превышении, поэтому его стоимость ограничена самими лимитами.
+func checkLimits(schema *graphql.Schema, doc *ast.Document, operation *ast.OperationDefinition, limits Limits) *limitError {
+	w := &costWalker{
+		schema:    schema,
+		fragments: make(map[string]*ast.FragmentDefinition),
+		limits:    limits,
+	}
+	for _, definition := range doc.Definitions {
+		if fragment, ok := definition.(*ast.FragmentDefinition); ok {
+			w.fragments[fragment.Name.Value] = fragment
+		}
+	}
+
+	w.walk(operation.SelectionSet, schema.QueryType(), 1, 1, map[string]bool{})
+	return w.err
+}
+
+// walk учитывает поля набора на глубине depth; multiplier - сколько раз набор будет выполнен
+func (w *costWalker) walk(set *ast.SelectionSet, parent graphql.Type, depth, multiplier int, visiting map[string]bool) {
+	if set == nil || w.err != nil {
+		return
+	}
+
+	for _, selection := range set.Selections {
+		switch sel := selection.(type) {
+		case *ast.Field:
+			if strings.HasPrefix(sel.Name.Value, "__") {
+				continue
+			}
+			if depth > w.limits.MaxDepth {
+				w.err = &limitError{
+					code:    codeQueryTooDeep,
+					message: fmt.Sprintf("query depth exceeds the limit of %d", w.limits.MaxDepth),
+				}
+				return
+			}
+			w.complexity += multiplier
+			if w.complexity > w.limits.MaxComplexity {
+				w.err = &limitError{
+					code:    codeQueryTooComplex,
+					message: fmt.Sprintf("query complexity exceeds the limit of %d", w.limits.MaxComplexity),
+				}
+				return
+			}
+
+			fieldType, isList := w.fieldType(parent, sel.Name.Value)
+			childMultiplier := multiplier
+			if isList {
+				// Множитель насыщается, чтобы не переполниться при большом max_depth
+				childMultiplier = min(multiplier*listFactor, w.limits.MaxComplexity+1)
+			}
+			w.walk(sel.SelectionSet, fieldType, depth+1, childMultiplier, visiting)
+
+		case *ast.InlineFragment:
+			fragmentType := parent
+			if sel.TypeCondition != nil {
+				fragmentType = w.schema.Type(sel.TypeCondition.Name.Value)
+			}
+			w.walk(sel.SelectionSet, fragmentType, depth, multiplier, visiting)
+
+		case *ast.FragmentSpread:
+			name := sel.Name.Value
+			fragment, ok := w.fragments[name]
+			// Циклы фрагментов отклонит валидация, здесь их достаточно не разворачивать
+			if !ok || visiting[name] {
+				continue
+			}
+			visiting[name] = true
+			w.walk(fragment.SelectionSet, w.schema.Type(fragment.TypeCondition.Name.Value), depth, multiplier, visiting)
+			delete(visiting, name)
+		}
+
+		if w.err != nil {
+			return
+		}
+	}
+}
+
+// fieldType возвращает именованный тип поля и признак списка; для неизвестного поля - nil
+func (w *costWalker) fieldType(parent graphql.Type, name string) (graphql.Type, bool) {
+	object, ok := parent.(*graphql.Object)
+	if !ok {
+		return nil, false
+	}
+	field, ok := object.Fields()[name]
+	if !ok {
+		return nil, false
+	}
+
+	fieldType := field.Type
+	isList := false
+	for {
+		switch t := fieldType.(type) {
+		case *graphql.NonNull:
+			fieldType = t.OfType
+		case *graphql.List:
+			isList = true
+			fieldType = t.OfType
+		default:
+			return fieldType, isList
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package graphqlapi
+
+import (
+	"context"
+	"sync"
+)
+
+// batchFunc загружает значения для набора ключей одним запросом.
+// Ключи, для которых ничего не найдено, в результат не попадают.
+type batchFunc[V any] func(ctx context.Context, keys []string) (map[string]V, error)
+
+// loader откладывает загрузку по ключу до первого обращения к результату и собирает
+// все ключи, запрошенные к этому моменту, в один пакет.
+//
+// graphql-go раскрывает отложенные значения (thunks) в ширину: резолверы одного уровня
+// успевают зарегистрировать ключи до того, как первый из них потребует результат,
+// поэтому на каждый уровень вложенности приходится один запрос на тип данных.
+// Экземпляр живет в рамках одного запроса и кэширует загруженное.
+type loader[V any] struct {
+	fetch batchFunc[V]
+
+	mu      sync.Mutex
+	pending []string
+	queued  map[string]bool
+	loaded  map[string]V
+	failed  map[string]error
+}
+
+func newLoader[V any](fetch batchFunc[V]) *loader[V] {
+	return &loader[V]{
+		fetch:  fetch,
+		queued: make(map[string]bool),
+		loaded: make(map[string]V),
+		failed: make(map[string]error),
+	}
+}
+
+// load регистрирует ключ и возвращает функцию, отдающую значение (нулевое, если не найдено)
+func (l *loader[V]) load(ctx context.Context, key string) func() (V, error) {
+	l.enqueue(key)
+	return func() (V, error) {
+		l.flush(ctx)
+
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		return l.loaded[key], l.failed[key]
+	}
+}
+
+// loadMany регистрирует ключи и возвращает функцию, отдающую найденные значения в порядке ключей
+func (l *loader[V]) loadMany(ctx context.Context, keys []string) func() ([]V, error) {
+	for _, key := range keys {
+		l.enqueue(key)
+	}
+	return func() ([]V, error) {
+		l.flush(ctx)
+
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		values := make([]V, 0, len(keys))
+		for _, key := range keys {
+			if err := l.failed[key]; err != nil {
+				return nil, err
+			}
+			if value, ok := l.loaded[key]; ok {
+				values = append(values, value)
+			}
+		}
+		return values, nil
+	}
+}
+
+func (l *loader[V]) enqueue(key string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.queued[key] {
+		return
+	}
+	l.queued[key] = true
+	l.pending = append(l.pending, key)
+}
+
+// flush загружает накопленные ключи одним вызовом fetch; повторные вызовы без новых ключей ничего не делают
+func (l *loader[V]) flush(ctx context.Context) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if len(l.pending) == 0 {
+		return
+	}
+	keys := l.pending
+	l.pending = nil
+
+	values, err := l.fetch(ctx, keys)
+	for _, key := range keys {
+		if err != nil {
+			l.failed[key] = err
+			continue
+		}
+		if value, ok := values[key]; ok {
+			l.loaded[key] = value
+		}
+	}
+}
@@ -0,0 +1,304 @@
+package graphqlapi
+
+import (
+	"context"
+
+	"pr-reviewer-service/internal/domain"
+
+	"github.com/graphql-go/graphql"
+)
+
+// loaders - пакетные загрузчики одного запроса
+type loaders struct {
+	teamRepo domain.TeamRepository
+
+	teams       *loader[*domain.Team]
+	users       *loader[*domain.User]
+	members     *loader[[]*domain.User]
+	pullRequest *loader[*domain.PullRequest]
+	assigned    *loader[[]*domain.PullRequest]
+}
+
+func newLoaders(teams domain.TeamRepository, users domain.UserRepository, prs domain.PRRepository) *loaders {
+	return &loaders{
+		teamRepo: teams,
+		teams: newLoader(func(ctx context.Context, names []string) (map[string]*domain.Team, error) {
+			found, err := teams.GetByNames(ctx, names)
+			if err != nil {
+				return nil, err
+			}
+			byName := make(map[string]*domain.Team, len(found))
+			for _, team := range found {
+				byName[team.Name] = team
+			}
+			return byName, nil
+		}),
+		users: newLoader(func(ctx context.Context, ids []string) (map[string]*domain.User, error) {
+			found, err := users.GetByIDs(ctx, ids)
+			if err != nil {
+				return nil, err
+			}
+			byID := make(map[string]*domain.User, len(found))
+			for _, user := range found {
+				byID[user.ID] = user
+			}
+			return byID, nil
+		}),
+		members: newLoader(func(ctx context.Context, teamNames []string) (map[string][]*domain.User, error) {
+			found, err := users.GetByTeams(ctx, teamNames)
+			if err != nil {
+				return nil, err
+			}
+			byTeam := make(map[string][]*domain.User, len(teamNames))
+			for _, user := range found {
+				byTeam[user.TeamName] = append(byTeam[user.TeamName], user)
+			}
+			return byTeam, nil
+		}),
+		pullRequest: newLoader(func(ctx context.Context, ids []string) (map[string]*domain.PullRequest, error) {
+			found, err := prs.GetByIDs(ctx, ids)
+			if err != nil {
+				return nil, err
+			}
+			byID := make(map[string]*domain.PullRequest, len(found))
+			for _, pr := range found {
+				byID[pr.ID] = pr
+			}
+			return byID, nil
+		}),
+		assigned: newLoader(prs.GetAssignedToUsers),
+	}
+}
+
+type loadersKey struct{}
+
+func withLoaders(ctx context.Context, l *loaders) context.Context {
+	return context.WithValue(ctx, loadersKey{}, l)
+}
+
+func loadersFrom(ctx context.Context) *loaders {
+	return ctx.Value(loadersKey{}).(*loaders)
+}
+
+// thunk приводит отложенную загрузку к виду, который graphql-go раскрывает после обхода уровня
+func thunk[V any](get func() (V, error)) func() (interface{}, error) {
+	return func() (interface{}, error) {
+		return get()
+	}
+}
+
+// newSchema описывает схему только для чтения:
+//
+//	type Query {
+//	  team(name: String!): Team
+//	  teams: [Team!]!
+//	  user(id: ID!): User
+//	  pullRequest(id: ID!): PullRequest
+//	}
+//
+// Связи между объектами разрешаются через загрузчики из контекста запроса.
+func newSchema() (graphql.Schema, error) {
+	statusEnum := graphql.NewEnum(graphql.EnumConfig{
+		Name: "PullRequestStatus",
+		Values: graphql.EnumValueConfigMap{
+			domain.PRStatusOpen:   &graphql.EnumValueConfig{Value: domain.PRStatusOpen},
+			domain.PRStatusMerged: &graphql.EnumValueConfig{Value: domain.PRStatusMerged},
+		},
+	})
+
+	var teamType, userType, prType *graphql.Object
+
+	teamType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "Team",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"name": &graphql.Field{
+					Type: graphql.NewNonNull(graphql.String),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return p.Source.(*domain.Team).Name, nil
+					},
+				},
+				"parent": &graphql.Field{
+					Type: teamType,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						team := p.Source.(*domain.Team)
+						if team.ParentName == "" {
+							return nil, nil
+						}
+						return thunk(loadersFrom(p.Context).teams.load(p.Context, team.ParentName)), nil
+					},
+				},
+				"members": &graphql.Field{
+					Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(userType))),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						team := p.Source.(*domain.Team)
+						return thunk(loadersFrom(p.Context).members.load(p.Context, team.Name)), nil
+					},
+				},
+			}
+		}),
+	})
+
+	userType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "User",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"id": &graphql.Field{
+					Type: graphql.NewNonNull(graphql.ID),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return p.Source.(*domain.User).ID, nil
+					},
+				},
+				"username": &graphql.Field{
+					Type: graphql.NewNonNull(graphql.String),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return p.Source.(*domain.User).Username, nil
+					},
+				},
+				"isActive": &graphql.Field{
+					Type: graphql.NewNonNull(graphql.Boolean),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return p.Source.(*domain.User).IsActive, nil
+					},
+				},
+				"team": &graphql.Field{
+					Type: teamType,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						user := p.Source.(*domain.User)
+						return thunk(loadersFrom(p.Context).teams.load(p.Context, user.TeamName)), nil
+					},
+				},
+				"assignedPullRequests": &graphql.Field{
+					Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(prType))),
+					Description: "PR, где пользователь назначен ревьювером, от новых к старым",
+					Args: graphql.FieldConfigArgument{
+						"status": &graphql.ArgumentConfig{Type: statusEnum},
+					},
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						user := p.Source.(*domain.User)
+						status, _ := p.Args["status"].(string)
+						load := loadersFrom(p.Context).assigned.load(p.Context, user.ID)
+						return func() (interface{}, error) {
+							prs, err := load()
+							if err != nil || status == "" {
+								return prs, err
+							}
+							filtered := make([]*domain.PullRequest, 0, len(prs))
+							for _, pr := range prs {
+								if pr.Status == status {
+									filtered = append(filtered, pr)
+								}
+							}
+							return filtered, nil
+						}, nil
+					},
+				},
+			}
+		}),
+	})
+
+	prType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "PullRequest",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"id": &graphql.Field{
+					Type: graphql.NewNonNull(graphql.ID),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return p.Source.(*domain.PullRequest).ID, nil
+					},
+				},
+				"name": &graphql.Field{
+					Type: graphql.NewNonNull(graphql.String),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return p.Source.(*domain.PullRequest).Name, nil
+					},
+				},
+				"status": &graphql.Field{
+					Type: graphql.NewNonNull(statusEnum),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return p.Source.(*domain.PullRequest).Status, nil
+					},
+				},
+				"createdAt": &graphql.Field{
+					Type: graphql.DateTime,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return p.Source.(*domain.PullRequest).CreatedAt, nil
+					},
+				},
+				"mergedAt": &graphql.Field{
+					Type: graphql.DateTime,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return p.Source.(*domain.PullRequest).MergedAt, nil
+					},
+				},
+				"author": &graphql.Field{
+					Type: userType,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						pr := p.Source.(*domain.PullRequest)
+						return thunk(loadersFrom(p.Context).users.load(p.Context, pr.AuthorID)), nil
+					},
+				},
+				"reviewers": &graphql.Field{
+					Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(userType))),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						pr := p.Source.(*domain.PullRequest)
+						return thunk(loadersFrom(p.Context).users.loadMany(p.Context, pr.AssignedReviewers)), nil
+					},
+				},
+			}
+		}),
+	})
+
+	queryType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"team": &graphql.Field{
+				Type: teamType,
+				Args: graphql.FieldConfigArgument{
+					"name": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					name, _ := p.Args["name"].(string)
+					return thunk(loadersFrom(p.Context).teams.load(p.Context, name)), nil
+				},
+			},
+			"teams": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(teamType))),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					l := loadersFrom(p.Context)
+					all, err := l.teamRepo.GetAllTeams(p.Context)
+					if err != nil {
+						return nil, err
+					}
+					names := make([]string, 0, len(all))
+					for _, team := range all {
+						names = append(names, team.Name)
+					}
+					return thunk(l.teams.loadMany(p.Context, names)), nil
+				},
+			},
+			"user": &graphql.Field{
+				Type: userType,
+				Args: graphql.FieldConfigArgument{
+					"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					id, _ := p.Args["id"].(string)
+					return thunk(loadersFrom(p.Context).users.load(p.Context, id)), nil
+				},
+			},
+			"pullRequest": &graphql.Field{
+				Type: prType,
+				Args: graphql.FieldConfigArgument{
+					"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					id, _ := p.Args["id"].(string)
+					return thunk(loadersFrom(p.Context).pullRequest.load(p.Context, id)), nil
+				},
+			},
+		},
+	})
+
+	return graphql.NewSchema(graphql.SchemaConfig{Query: queryType})
+}
@@ -0,0 +1,114 @@
+// Package graphqlapi - read-only GraphQL API поверх репозиториев.
+//
+// Связанные объекты (участники команды, их PR, ревьюверы) загружаются пакетами:
+// вложенный запрос выполняет ограниченное число SQL-запросов, не зависящее от числа строк.
+package graphqlapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"pr-reviewer-service/internal/domain"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/graphql-go/graphql/language/source"
+)
+
+// Request - запрос GraphQL в формате GraphQL over HTTP
+type Request struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+// Service разбирает, проверяет и выполняет запросы GraphQL.
+type Service struct {
+	schema graphql.Schema
+	limits Limits
+
+	teams domain.TeamRepository
+	users domain.UserRepository
+	prs   domain.PRRepository
+}
+
+// NewService создает Service; ошибка означает некорректное описание схемы.
+func NewService(teams domain.TeamRepository, users domain.UserRepository, prs domain.PRRepository, limits Limits) (*Service, error) {
+	schema, err := newSchema()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Service{
+		schema: schema,
+		limits: limits,
+		teams:  teams,
+		users:  users,
+		prs:    prs,
+	}, nil
+}
+
+// Execute выполняет запрос. executed = false, если запрос отклонен до выполнения:
+// синтаксическая ошибка, превышение лимитов или ошибка валидации по схеме.
+func (s *Service) Execute(ctx context.Context, req Request) (result *graphql.Result, executed bool) {
+	doc, err := parser.Parse(parser.ParseParams{
+		Source: source.NewSource(&source.Source{Body: []byte(req.Query), Name: "GraphQL request"}),
+	})
+	if err != nil {
+		return &graphql.Result{Errors: []gqlerrors.FormattedError{gqlerrors.FormatError(err)}}, false
+	}
+
+	operation, err := selectOperation(doc, req.OperationName)
+	if err != nil {
+		return &graphql.Result{Errors: []gqlerrors.FormattedError{gqlerrors.FormatError(err)}}, false
+	}
+
+	if limitErr := checkLimits(&s.schema, doc, operation, s.limits); limitErr != nil {
+		return &graphql.Result{Errors: []gqlerrors.FormattedError{limitErr.formatted()}}, false
+	}
+
+	validation := graphql.ValidateDocument(&s.schema, doc, nil)
+	if !validation.IsValid {
+		return &graphql.Result{Errors: validation.Errors}, false
+	}
+
+	return graphql.Execute(graphql.ExecuteParams{
+		Schema:        s.schema,
+		AST:           doc,
+		OperationName: req.OperationName,
+		Args:          req.Variables,
+		Context:       withLoaders(ctx, newLoaders(s.teams, s.users, s.prs)),
+	}), true
+}
+
+// selectOperation выбирает операцию так же, как это сделает graphql-go, и допускает только query
+func selectOperation(doc *ast.Document, operationName string) (*ast.OperationDefinition, error) {
+	var operation *ast.OperationDefinition
+	for _, definition := range doc.Definitions {
+		def, ok := definition.(*ast.OperationDefinition)
+		if !ok {
+			continue
+		}
+		if operationName == "" {
+			if operation != nil {
+				return nil, errors.New("must provide operation name if query contains multiple operations")
+			}
+			operation = def
+		} else if def.Name != nil && def.Name.Value == operationName {
+			operation = def
+		}
+	}
+
+	switch {
+	case operation == nil && operationName != "":
+		return nil, fmt.Errorf("unknown operation named %q", operationName)
+	case operation == nil:
+		return nil, errors.New("must provide an operation")
+	case operation.Operation != ast.OperationTypeQuery:
+		return nil, fmt.Errorf("%s operations are not supported, the API is read-only", operation.Operation)
+	}
+	return operation, nil
+}
@@ -0,0 +1,227 @@
+package grpcserver
+
+import (
+	"time"
+
+	"pr-reviewer-service/api/reviewerpb"
+	"pr-reviewer-service/internal/domain"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Вспомогательные функции преобразования доменных моделей в сообщения protobuf
+
+func toPBTeam(team *domain.Team) *reviewerpb.Team {
+	members := make([]*reviewerpb.TeamMember, len(team.Members))
+	for i, member := range team.Members {
+		members[i] = &reviewerpb.TeamMember{
+			UserId:   member.ID,
+			Username: member.Username,
+			IsActive: member.IsActive,
+		}
+	}
+	return &reviewerpb.Team{
+		TeamName:       team.Name,
+		ParentTeamName: team.ParentName,
+		Members:        members,
+	}
+}
+
+func fromPBTeam(team *reviewerpb.Team) *domain.Team {
+	result := &domain.Team{
+		Name:       team.GetTeamName(),
+		ParentName: team.GetParentTeamName(),
+	}
+	for _, member := range team.GetMembers() {
+		result.Members = append(result.Members, &domain.User{
+			ID:       member.GetUserId(),
+			Username: member.GetUsername(),
+			TeamName: team.GetTeamName(),
+			IsActive: member.GetIsActive(),
+		})
+	}
+	return result
+}
+
+func toPBTeamTree(nodes []*domain.TeamTreeNode) []*reviewerpb.TeamTreeNode {
+	result := make([]*reviewerpb.TeamTreeNode, len(nodes))
+	for i, node := range nodes {
+		result[i] = &reviewerpb.TeamTreeNode{
+			TeamName:       node.Name,
+			ParentTeamName: node.ParentName,
+			Stats:          toPBTeamStats(node.Stats),
+			SubtreeStats:   toPBTeamStats(node.SubtreeStats),
+			Children:       toPBTeamTree(node.Children),
+		}
+	}
+	return result
+}
+
+func toPBTeamStats(stats domain.TeamStats) *reviewerpb.TeamStats {
+	return &reviewerpb.TeamStats{
+		MembersCount:       stats.MembersCount,
+		ActiveMembersCount: stats.ActiveMembersCount,
+		OpenPrsCount:       stats.OpenPRsCount,
+		OpenReviewsCount:   stats.OpenReviewsCount,
+	}
+}
+
+func toPBUser(user *domain.User) *reviewerpb.User {
+	return &reviewerpb.User{
+		UserId:   user.ID,
+		Username: user.Username,
+		TeamName: user.TeamName,
+		IsActive: user.IsActive,
+		Skills:   user.Skills,
+	}
+}
+
+func toPBPullRequest(pr *domain.PullRequest) *reviewerpb.PullRequest {
+	return &reviewerpb.PullRequest{
+		PullRequestId:     pr.ID,
+		PullRequestName:   pr.Name,
+		AuthorId:          pr.AuthorID,
+		Status:            toPBStatus(pr.Status),
+		AssignedReviewers: pr.AssignedReviewers,
+		CreatedAt:         toOptionalTimestamp(pr.CreatedAt),
+		MergedAt:          toOptionalTimestamp(pr.MergedAt),
+	}
+}
+
+func toPBPullRequests(prs []*domain.PullRequest) []*reviewerpb.PullRequest {
+	result := make([]*reviewerpb.PullRequest, len(prs))
+	for i, pr := range prs {
+		result[i] = toPBPullRequest(pr)
+	}
+	return result
+}
+
+func toPBStatus(prStatus string) reviewerpb.PullRequestStatus {
+	switch prStatus {
+	case domain.PRStatusOpen:
+		return reviewerpb.PullRequestStatus_PULL_REQUEST_STATUS_OPEN
+	case domain.PRStatusMerged:
+		return reviewerpb.PullRequestStatus_PULL_REQUEST_STATUS_MERGED
+	default:
+		return reviewerpb.PullRequestStatus_PULL_REQUEST_STATUS_UNSPECIFIED
+	}
+}
+
+// fromPBStatus преобразует статус фильтра; UNSPECIFIED - без фильтра по статусу
+func fromPBStatus(prStatus reviewerpb.PullRequestStatus) string {
+	switch prStatus {
+	case reviewerpb.PullRequestStatus_PULL_REQUEST_STATUS_OPEN:
+		return domain.PRStatusOpen
+	case reviewerpb.PullRequestStatus_PULL_REQUEST_STATUS_MERGED:
+		return domain.PRStatusMerged
+	default:
+		return ""
+	}
+}
+
+func toPBReviewerSelections(selections []*domain.ReviewerSelection) []*reviewerpb.ReviewerSelection {
+	result := make([]*reviewerpb.ReviewerSelection, len(selections))
+	for i, selection := range selections {
+		result[i] = &reviewerpb.ReviewerSelection{
+			UserId:        selection.UserID,
+			Reason:        string(selection.Reason),
+			MatchedTags:   selection.MatchedTags,
+			OpenReviews:   int32(selection.OpenReviews),
+			SkillScore:    selection.SkillScore,
+			WorkloadScore: selection.WorkloadScore,
+			Score:         selection.Score,
+		}
+	}
+	return result
+}
+
+func toPBAssignmentExplanation(rationale *domain.AssignmentRationale) *reviewerpb.AssignmentExplanation {
+	if rationale == nil {
+		return nil
+	}
+
+	excluded := make([]*reviewerpb.ExcludedCandidate, len(rationale.Excluded))
+	for i, candidate := range rationale.Excluded {
+		excluded[i] = &reviewerpb.ExcludedCandidate{
+			UserId: candidate.UserID,
+			Reason: string(candidate.Reason),
+		}
+	}
+
+	return &reviewerpb.AssignmentExplanation{
+		Strategy:          rationale.Strategy,
+		CandidatePoolSize: int32(rationale.CandidatePoolSize),
+		Reviewers:         toPBReviewerSelections(rationale.Reviewers),
+		Excluded:          excluded,
+	}
+}
+
+func toPBAssignmentEvent(event domain.AssignmentEvent) *reviewerpb.AssignmentEvent {
+	eventType := reviewerpb.AssignmentEventType_ASSIGNMENT_EVENT_TYPE_UNSPECIFIED
+	switch event.Type {
+	case domain.AssignmentAssigned:
+		eventType = reviewerpb.AssignmentEventType_ASSIGNMENT_EVENT_TYPE_ASSIGNED
+	case domain.AssignmentUnassigned:
+		eventType = reviewerpb.AssignmentEventType_ASSIGNMENT_EVENT_TYPE_UNASSIGNED
+	case domain.AssignmentMerged:
+		eventType = reviewerpb.AssignmentEventType_ASSIGNMENT_EVENT_TYPE_MERGED
+	}
+
+	return &reviewerpb.AssignmentEvent{
+		Id:              event.ID,
+		Type:            eventType,
+		PullRequestId:   event.PullRequestID,
+		PullRequestName: event.PullRequestName,
+		AuthorId:        event.AuthorID,
+		ReviewerId:      event.ReviewerID,
+		OccurredAt:      timestamppb.New(event.OccurredAt),
+	}
+}
+
+func toPBFairnessReport(report *domain.FairnessReport) *reviewerpb.GetFairnessReportResponse {
+	teams := make([]*reviewerpb.TeamFairness, len(report.Teams))
+	for i, team := range report.Teams {
+		members := make([]*reviewerpb.MemberFairness, len(team.Members))
+		for j, member := range team.Members {
+			members[j] = &reviewerpb.MemberFairness{
+				UserId:              member.UserID,
+				Username:            member.Username,
+				ActiveDays:          member.ActiveDays,
+				Assignments:         member.Assignments,
+				ExpectedAssignments: member.ExpectedAssignments,
+				Deviation:           member.Deviation(),
+				Outlier:             string(member.Outlier),
+			}
+		}
+		teams[i] = &reviewerpb.TeamFairness{
+			TeamName:     team.TeamName,
+			Assignments:  team.Assignments,
+			Gini:         team.Gini,
+			MaxMinSpread: team.MaxMinSpread,
+			Members:      members,
+		}
+	}
+
+	return &reviewerpb.GetFairnessReportResponse{
+		CreatedFrom: timestamppb.New(report.From),
+		CreatedTo:   timestamppb.New(report.To),
+		Teams:       teams,
+	}
+}
+
+// toOptionalTimestamp преобразует nullable время; nil остается неустановленным полем
+func toOptionalTimestamp(value *time.Time) *timestamppb.Timestamp {
+	if value == nil {
+		return nil
+	}
+	return timestamppb.New(*value)
+}
+
+// fromOptionalTimestamp преобразует необязательное поле времени в nullable время
+func fromOptionalTimestamp(value *timestamppb.Timestamp) *time.Time {
+	if value == nil {
+		return nil
+	}
+	t := value.AsTime()
+	return &t
+}
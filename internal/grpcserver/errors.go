@@ -0,0 +1,36 @@
+package grpcserver
+
+import (
+	"pr-reviewer-service/internal/domain"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// toStatus преобразует ошибку usecase в gRPC-статус с сообщением "CODE: message",
+// где CODE и message те же, что в ErrorResponse REST API.
+func toStatus(err error) error {
+	if httpErr, exists := domain.ToHTTPError(err); exists {
+		return status.Errorf(getGRPCCode(err), "%s: %s", httpErr.Code, httpErr.Message)
+	}
+	return status.Errorf(codes.Internal, "INTERNAL_ERROR: %s", err.Error())
+}
+
+// getGRPCCode - аналог getHTTPStatusCode из handler по общей таблице domain.KindOf: 409 делится на
+// AlreadyExists, FailedPrecondition и Aborted, 422 -> InvalidArgument, остальное -> Internal.
+func getGRPCCode(err error) codes.Code {
+	switch domain.KindOf(err) {
+	case domain.ErrorKindInvalidArgument, domain.ErrorKindUnprocessable:
+		return codes.InvalidArgument
+	case domain.ErrorKindNotFound:
+		return codes.NotFound
+	case domain.ErrorKindAlreadyExists:
+		return codes.AlreadyExists
+	case domain.ErrorKindFailedPrecondition:
+		return codes.FailedPrecondition
+	case domain.ErrorKindAborted:
+		return codes.Aborted
+	default:
+		return codes.Internal
+	}
+}
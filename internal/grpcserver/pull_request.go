@@ -0,0 +1,86 @@
+package grpcserver
+
+import (
+	"context"
+
+	"pr-reviewer-service/api/reviewerpb"
+	"pr-reviewer-service/internal/domain"
+)
+
+// CreatePullRequest создает PR и назначает ревьюверов
+func (s *Server) CreatePullRequest(ctx context.Context, req *reviewerpb.CreatePullRequestRequest) (*reviewerpb.CreatePullRequestResponse, error) {
+	pr, err := s.prUseCase.CreatePR(ctx, &domain.CreatePRRequest{
+		ID:           req.GetPullRequestId(),
+		Name:         req.GetPullRequestName(),
+		AuthorID:     req.GetAuthorId(),
+		Repository:   req.GetRepository(),
+		ChangedFiles: req.GetChangedFiles(),
+		RequiredTags: req.GetRequiredTags(),
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	resp := &reviewerpb.CreatePullRequestResponse{PullRequest: toPBPullRequest(pr)}
+	if pr.Rationale != nil {
+		resp.ReviewerSelection = toPBReviewerSelections(pr.Rationale.Reviewers)
+	}
+	return resp, nil
+}
+
+// GetPullRequest возвращает PR, при explain=true - с объяснением выбора ревьюверов
+func (s *Server) GetPullRequest(ctx context.Context, req *reviewerpb.GetPullRequestRequest) (*reviewerpb.GetPullRequestResponse, error) {
+	pr, err := s.prUseCase.GetPR(ctx, req.GetPullRequestId(), req.GetExplain())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	resp := &reviewerpb.GetPullRequestResponse{PullRequest: toPBPullRequest(pr)}
+	if req.GetExplain() {
+		resp.Explanation = toPBAssignmentExplanation(pr.Rationale)
+	}
+	return resp, nil
+}
+
+// ListPullRequests возвращает страницу PR с фильтрами
+func (s *Server) ListPullRequests(ctx context.Context, req *reviewerpb.ListPullRequestsRequest) (*reviewerpb.ListPullRequestsResponse, error) {
+	page, err := s.prUseCase.ListPRs(ctx, &domain.PRListFilter{
+		Status:      fromPBStatus(req.GetStatus()),
+		AuthorID:    req.GetAuthorId(),
+		ReviewerID:  req.GetReviewerId(),
+		TeamName:    req.GetTeamName(),
+		CreatedFrom: fromOptionalTimestamp(req.GetCreatedFrom()),
+		CreatedTo:   fromOptionalTimestamp(req.GetCreatedTo()),
+		Cursor:      req.GetCursor(),
+		Limit:       int(req.GetLimit()),
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &reviewerpb.ListPullRequestsResponse{
+		PullRequests: toPBPullRequests(page.PullRequests),
+		NextCursor:   page.NextCursor,
+	}, nil
+}
+
+// MergePullRequest мержит PR (идемпотентно)
+func (s *Server) MergePullRequest(ctx context.Context, req *reviewerpb.MergePullRequestRequest) (*reviewerpb.PullRequest, error) {
+	pr, err := s.prUseCase.MergePR(ctx, req.GetPullRequestId(), 0)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return toPBPullRequest(pr), nil
+}
+
+// ReassignReviewer заменяет ревьювера PR
+func (s *Server) ReassignReviewer(ctx context.Context, req *reviewerpb.ReassignReviewerRequest) (*reviewerpb.ReassignReviewerResponse, error) {
+	pr, newReviewerID, err := s.prUseCase.ReassignReviewer(ctx, req.GetPullRequestId(), req.GetOldUserId(), 0)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &reviewerpb.ReassignReviewerResponse{
+		PullRequest: toPBPullRequest(pr),
+		ReplacedBy:  newReviewerID,
+	}, nil
+}
@@ -0,0 +1,153 @@
+// Package grpcserver реализует gRPC API (api/reviewerpb) поверх тех же usecase, что и REST API.
+package grpcserver
+
+import (
+	"context"
+	"crypto/subtle"
+	"strings"
+	"time"
+
+	"pr-reviewer-service/api/reviewerpb"
+	"pr-reviewer-service/internal/domain"
+	"pr-reviewer-service/internal/events"
+	"pr-reviewer-service/internal/logging"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Server реализует reviewerpb.ReviewerServiceServer
+type Server struct {
+	reviewerpb.UnimplementedReviewerServiceServer
+
+	teamUseCase  domain.TeamUseCase
+	userUseCase  domain.UserUseCase
+	prUseCase    domain.PRUseCase
+	statsUseCase domain.StatsUseCase
+	broker       *events.Broker
+	logger       *logrus.Logger
+}
+
+// NewServer создает реализацию сервиса. broker - источник событий для WatchAssignments.
+func NewServer(
+	teamUseCase domain.TeamUseCase,
+	userUseCase domain.UserUseCase,
+	prUseCase domain.PRUseCase,
+	statsUseCase domain.StatsUseCase,
+	broker *events.Broker,
+	logger *logrus.Logger,
+) *Server {
+	return &Server{
+		teamUseCase:  teamUseCase,
+		userUseCase:  userUseCase,
+		prUseCase:    prUseCase,
+		statsUseCase: statsUseCase,
+		broker:       broker,
+		logger:       logger,
+	}
+}
+
+// NewGRPCServer создает grpc.Server с зарегистрированным сервисом, логированием вызовов
+// и, если authTokens не пуст, проверкой метаданных "authorization: Bearer <token>".
+func NewGRPCServer(service *Server, authTokens []string) *grpc.Server {
+	unary := []grpc.UnaryServerInterceptor{loggingUnaryInterceptor(service.logger)}
+	stream := []grpc.StreamServerInterceptor{loggingStreamInterceptor(service.logger)}
+	if len(authTokens) > 0 {
+		unary = append(unary, authUnaryInterceptor(authTokens))
+		stream = append(stream, authStreamInterceptor(authTokens))
+	}
+
+	srv := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(unary...),
+		grpc.ChainStreamInterceptor(stream...),
+	)
+	reviewerpb.RegisterReviewerServiceServer(srv, service)
+	return srv
+}
+
+func loggingUnaryInterceptor(logger *logrus.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		logCall(logging.FromContext(ctx, logger), info.FullMethod, start, err)
+		return resp, err
+	}
+}
+
+func loggingStreamInterceptor(logger *logrus.Logger) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		err := handler(srv, ss)
+		logCall(logging.FromContext(ss.Context(), logger), info.FullMethod, start, err)
+		return err
+	}
+}
+
+// logCall пишет итог вызова; уровень выбирается так же, как для HTTP: 5xx-аналоги - Error, ошибки клиента - Warn
+func logCall(entry *logrus.Entry, method string, start time.Time, err error) {
+	code := status.Code(err)
+	entry = entry.WithFields(logrus.Fields{
+		"grpc_method": method,
+		"grpc_code":   code.String(),
+		"latency":     time.Since(start),
+	})
+	if err != nil {
+		entry = entry.WithField("error", err.Error())
+	}
+
+	switch code {
+	case codes.OK:
+		entry.Info("gRPC call processed")
+	case codes.Internal, codes.Unknown, codes.Unavailable, codes.DataLoss:
+		entry.Error("gRPC server error")
+	default:
+		entry.Warn("gRPC client error")
+	}
+}
+
+func authUnaryInterceptor(tokens []string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := authorize(ctx, tokens); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func authStreamInterceptor(tokens []string) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := authorize(ss.Context(), tokens); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
+
+// authorize проверяет Bearer-токен из метаданных, сравнивая со всеми допустимыми за постоянное время
+func authorize(ctx context.Context, tokens []string) error {
+	unauthenticated := status.Error(codes.Unauthenticated, "UNAUTHORIZED: missing or invalid bearer token")
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return unauthenticated
+	}
+	key, found := strings.CutPrefix(values[0], "Bearer ")
+	if !found {
+		return unauthenticated
+	}
+
+	valid := false
+	for _, token := range tokens {
+		if subtle.ConstantTimeCompare([]byte(token), []byte(key)) == 1 {
+			valid = true
+		}
+	}
+	if !valid {
+		return unauthenticated
+	}
+	return nil
+}
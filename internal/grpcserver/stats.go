@@ -0,0 +1,89 @@
+package grpcserver
+
+import (
+	"context"
+
+	"pr-reviewer-service/api/reviewerpb"
+	"pr-reviewer-service/internal/domain"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// GetReviewStats возвращает число назначений на ревью по пользователям
+func (s *Server) GetReviewStats(ctx context.Context, req *reviewerpb.GetReviewStatsRequest) (*reviewerpb.GetReviewStatsResponse, error) {
+	stats, err := s.statsUseCase.GetStatsReviews(ctx, fromPBStatsFilter(req.GetCreatedFrom(), req.GetCreatedTo(), req.GetTeamName(), req.GetStatus()))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	result := make([]*reviewerpb.ReviewStat, len(stats))
+	for i, stat := range stats {
+		result[i] = &reviewerpb.ReviewStat{
+			UserId:      stat.UserID,
+			Username:    stat.Username,
+			ReviewCount: stat.ReviewCount,
+		}
+	}
+	return &reviewerpb.GetReviewStatsResponse{Stats: result}, nil
+}
+
+// GetPRAssignmentStats возвращает число ревьюверов по PR
+func (s *Server) GetPRAssignmentStats(ctx context.Context, req *reviewerpb.GetPRAssignmentStatsRequest) (*reviewerpb.GetPRAssignmentStatsResponse, error) {
+	stats, err := s.statsUseCase.GetStatsPrAssignments(ctx, fromPBStatsFilter(req.GetCreatedFrom(), req.GetCreatedTo(), req.GetTeamName(), req.GetStatus()))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	result := make([]*reviewerpb.PRAssignmentStat, len(stats))
+	for i, stat := range stats {
+		result[i] = &reviewerpb.PRAssignmentStat{
+			PullRequestId:   stat.PRID,
+			PullRequestName: stat.PRName,
+			ReviewersCount:  stat.ReviewersCount,
+		}
+	}
+	return &reviewerpb.GetPRAssignmentStatsResponse{Stats: result}, nil
+}
+
+// GetTeamStats возвращает показатели ревью по командам
+func (s *Server) GetTeamStats(ctx context.Context, req *reviewerpb.GetTeamStatsRequest) (*reviewerpb.GetTeamStatsResponse, error) {
+	stats, err := s.statsUseCase.GetStatsTeams(ctx, fromPBStatsFilter(req.GetCreatedFrom(), req.GetCreatedTo(), req.GetTeamName(), req.GetStatus()))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	result := make([]*reviewerpb.TeamReviewStat, len(stats))
+	for i, stat := range stats {
+		result[i] = &reviewerpb.TeamReviewStat{
+			TeamName:              stat.TeamName,
+			PullRequests:          stat.PullRequests,
+			OpenPullRequests:      stat.OpenPullRequests,
+			AvgReviewersPerPr:     stat.AvgReviewersPerPR(),
+			ReviewsGiven:          stat.ReviewsGiven,
+			ReviewsReceived:       stat.ReviewsReceived,
+			CrossTeamReviewsShare: stat.CrossTeamShare(),
+		}
+	}
+	return &reviewerpb.GetTeamStatsResponse{Stats: result}, nil
+}
+
+// GetFairnessReport возвращает отчет о справедливости распределения ревью внутри команд
+func (s *Server) GetFairnessReport(ctx context.Context, req *reviewerpb.GetFairnessReportRequest) (*reviewerpb.GetFairnessReportResponse, error) {
+	filter := fromPBStatsFilter(req.GetCreatedFrom(), req.GetCreatedTo(), req.GetTeamName(),
+		reviewerpb.PullRequestStatus_PULL_REQUEST_STATUS_UNSPECIFIED)
+	report, err := s.statsUseCase.GetFairnessReport(ctx, filter)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return toPBFairnessReport(report), nil
+}
+
+// fromPBStatsFilter собирает фильтр статистики; параметры у всех RPC статистики одинаковые
+func fromPBStatsFilter(createdFrom, createdTo *timestamppb.Timestamp, teamName string, prStatus reviewerpb.PullRequestStatus) *domain.StatsFilter {
+	return &domain.StatsFilter{
+		CreatedFrom: fromOptionalTimestamp(createdFrom),
+		CreatedTo:   fromOptionalTimestamp(createdTo),
+		TeamName:    teamName,
+		Status:      fromPBStatus(prStatus),
+	}
+}
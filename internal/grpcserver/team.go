@@ -0,0 +1,60 @@
+package grpcserver
+
+import (
+	"context"
+
+	"pr-reviewer-service/api/reviewerpb"
+)
+
+// AddTeam создает команду с участниками
+func (s *Server) AddTeam(ctx context.Context, req *reviewerpb.AddTeamRequest) (*reviewerpb.Team, error) {
+	team := fromPBTeam(req.GetTeam())
+	if err := s.teamUseCase.CreateTeam(ctx, team); err != nil {
+		return nil, toStatus(err)
+	}
+	return toPBTeam(team), nil
+}
+
+// GetTeam возвращает команду с участниками
+func (s *Server) GetTeam(ctx context.Context, req *reviewerpb.GetTeamRequest) (*reviewerpb.Team, error) {
+	team, err := s.teamUseCase.GetTeam(ctx, req.GetTeamName())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return toPBTeam(team), nil
+}
+
+// SetTeamParent перемещает команду в иерархии
+func (s *Server) SetTeamParent(ctx context.Context, req *reviewerpb.SetTeamParentRequest) (*reviewerpb.Team, error) {
+	team, err := s.teamUseCase.SetTeamParent(ctx, req.GetTeamName(), req.GetParentTeamName())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return toPBTeam(team), nil
+}
+
+// GetTeamTree возвращает иерархию команд со статистикой по поддеревьям
+func (s *Server) GetTeamTree(ctx context.Context, req *reviewerpb.GetTeamTreeRequest) (*reviewerpb.GetTeamTreeResponse, error) {
+	tree, err := s.teamUseCase.GetTeamTree(ctx, req.GetTeamName())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &reviewerpb.GetTeamTreeResponse{Teams: toPBTeamTree(tree)}, nil
+}
+
+// DeactivateTeam деактивирует пользователей команды и переназначает их открытые ревью
+func (s *Server) DeactivateTeam(ctx context.Context, req *reviewerpb.DeactivateTeamRequest) (*reviewerpb.DeactivateTeamResponse, error) {
+	result, err := s.teamUseCase.DeactivateTeamUsers(ctx, req.GetTeamName())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	// Как и в REST, частичное переназначение не считается ошибкой
+	return &reviewerpb.DeactivateTeamResponse{
+		TeamName:            result.TeamName,
+		DeactivatedUsers:    int32(result.DeactivatedUsers),
+		ReassignedPrs:       int32(result.ReassignedPRs),
+		FailedReassignments: int32(result.FailedReassignments),
+		DeactivatedUserIds:  result.DeactivatedUserIDs,
+	}, nil
+}
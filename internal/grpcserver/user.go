@@ -0,0 +1,38 @@
+package grpcserver
+
+import (
+	"context"
+
+	"pr-reviewer-service/api/reviewerpb"
+)
+
+// SetUserActive меняет флаг активности пользователя
+func (s *Server) SetUserActive(ctx context.Context, req *reviewerpb.SetUserActiveRequest) (*reviewerpb.User, error) {
+	user, err := s.userUseCase.SetUserActive(ctx, req.GetUserId(), req.GetIsActive())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return toPBUser(user), nil
+}
+
+// SetUserSkills заменяет навыки пользователя
+func (s *Server) SetUserSkills(ctx context.Context, req *reviewerpb.SetUserSkillsRequest) (*reviewerpb.User, error) {
+	user, err := s.userUseCase.SetUserSkills(ctx, req.GetUserId(), req.GetSkills())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return toPBUser(user), nil
+}
+
+// GetUserReviews возвращает страницу PR, на которые пользователь назначен ревьювером
+func (s *Server) GetUserReviews(ctx context.Context, req *reviewerpb.GetUserReviewsRequest) (*reviewerpb.GetUserReviewsResponse, error) {
+	page, err := s.userUseCase.GetUserReviewPRs(ctx, req.GetUserId(), req.GetCursor(), int(req.GetLimit()))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &reviewerpb.GetUserReviewsResponse{
+		UserId:       req.GetUserId(),
+		PullRequests: toPBPullRequests(page.PullRequests),
+		NextCursor:   page.NextCursor,
+	}, nil
+}
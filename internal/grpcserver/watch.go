@@ -0,0 +1,45 @@
+package grpcserver
+
+import (
+	"pr-reviewer-service/api/reviewerpb"
+	"pr-reviewer-service/internal/events"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// WatchAssignments стримит события назначения с момента подписки до отмены вызова клиентом.
+// Подписчик, не успевающий читать, отключается брокером - стрим завершается с RESOURCE_EXHAUSTED.
+func (s *Server) WatchAssignments(req *reviewerpb.WatchAssignmentsRequest, stream grpc.ServerStreamingServer[reviewerpb.AssignmentEvent]) error {
+	var sub *events.Subscription
+	if reviewerID := req.GetReviewerId(); reviewerID != "" {
+		sub = s.broker.Subscribe(events.ForReviewer(reviewerID))
+	} else {
+		sub = s.broker.Subscribe(nil)
+	}
+	defer sub.Close()
+
+	// Заголовки отправляем сразу, чтобы клиент знал, что подписка активна
+	if err := stream.SendHeader(nil); err != nil {
+		return err
+	}
+
+	ctx := stream.Context()
+	for {
+		select {
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		case event, ok := <-sub.C:
+			if !ok {
+				if sub.Dropped() {
+					return status.Error(codes.ResourceExhausted, "SLOW_CONSUMER: subscriber fell behind the event stream")
+				}
+				return status.Error(codes.Unavailable, "UNAVAILABLE: event stream closed")
+			}
+			if err := stream.Send(toPBAssignmentEvent(event)); err != nil {
+				return err
+			}
+		}
+	}
+}
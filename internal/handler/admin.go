@@ -0,0 +1,152 @@
+package handler
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"pr-reviewer-service/api"
+	"pr-reviewer-service/internal/domain"
+
+	"github.com/labstack/echo/v4"
+	"github.com/sirupsen/logrus"
+)
+
+// NDJSONContentType - формат выгрузки и загрузки /admin/export, /admin/import
+const NDJSONContentType = "application/x-ndjson"
+
+// maxImportLineSize ограничивает длину одной строки загрузки
+const maxImportLineSize = 1 << 20
+
+// AdminHandler обрабатывает HTTP-запросы выгрузки и загрузки данных сервиса
+type AdminHandler struct {
+	*BaseHandler
+	transferUseCase domain.TransferUseCase
+}
+
+// NewAdminHandler создает новый экземпляр AdminHandler
+func NewAdminHandler(transferUseCase domain.TransferUseCase, logger *logrus.Logger) *AdminHandler {
+	return &AdminHandler{
+		BaseHandler:     NewBaseHandler(logger),
+		transferUseCase: transferUseCase,
+	}
+}
+
+// GetAdminExport обрабатывает выгрузку всех команд, пользователей и PR в NDJSON.
+// Записи пишутся в ответ по мере чтения, поэтому статус отправляется с первой записью.
+func (h *AdminHandler) GetAdminExport(c echo.Context) error {
+	logEntry := h.logRequest(c, "export")
+	logEntry.Info("Exporting data")
+
+	response := c.Response()
+	writeHeader := func() {
+		if !response.Committed {
+			response.Header().Set(echo.HeaderContentType, NDJSONContentType)
+			response.WriteHeader(http.StatusOK)
+		}
+	}
+
+	counts := make(map[string]int)
+	encoder := json.NewEncoder(response)
+	err := h.transferUseCase.Export(c.Request().Context(), func(record *domain.ExportRecord) error {
+		writeHeader()
+		counts[record.Type]++
+		return encoder.Encode(toAPIExportRecord(record))
+	})
+	if err != nil {
+		if !response.Committed {
+			logEntry.WithError(err).Error("Failed to export data")
+			return c.JSON(http.StatusInternalServerError, toErrorResponse("INTERNAL_ERROR", err.Error()))
+		}
+		// Статус уже отправлен: клиент получит обрезанную выгрузку
+		logEntry.WithError(err).Warn("Export interrupted")
+		return nil
+	}
+	writeHeader()
+	response.Flush()
+
+	logEntry.WithFields(logrus.Fields{
+		"teams": counts[domain.RecordTypeTeam],
+		"users": counts[domain.RecordTypeUser],
+		"prs":   counts[domain.RecordTypePullRequest],
+	}).Info("Data exported")
+	return nil
+}
+
+// PostAdminImport обрабатывает загрузку команд, пользователей и PR из NDJSON
+func (h *AdminHandler) PostAdminImport(c echo.Context, params api.PostAdminImportParams) error {
+	var mode string
+	if params.Mode != nil {
+		mode = string(*params.Mode)
+	}
+	importMode, err := domain.ParseImportMode(mode)
+	if err != nil {
+		h.logRequest(c, "import").WithError(err).Warn("Invalid import mode")
+		httpErr, _ := domain.ToHTTPError(err)
+		return c.JSON(getHTTPStatusCode(err), toAPIErrorResponse(httpErr))
+	}
+	opts := domain.ImportOptions{Mode: importMode, DryRun: params.DryRun != nil && *params.DryRun}
+
+	logEntry := h.logRequest(c, "import").WithFields(logrus.Fields{
+		"mode":    opts.Mode,
+		"dry_run": opts.DryRun,
+	})
+
+	records, err := readImportRecords(c.Request().Body)
+	if err != nil {
+		logEntry.WithError(err).Warn("Failed to read import body")
+		return c.JSON(http.StatusBadRequest, toErrorResponse("INVALID_REQUEST", err.Error()))
+	}
+	logEntry = logEntry.WithField("records", len(records))
+	logEntry.Info("Importing data")
+
+	report, err := h.transferUseCase.Import(c.Request().Context(), records, opts)
+	if err != nil {
+		logEntry.WithError(err).Error("Failed to import data")
+		return c.JSON(http.StatusInternalServerError, toErrorResponse("INTERNAL_ERROR", err.Error()))
+	}
+
+	logEntry = logEntry.WithFields(logrus.Fields{
+		"committed": report.Committed,
+		"created":   report.Created,
+		"updated":   report.Updated,
+		"skipped":   report.Skipped,
+		"errors":    len(report.Errors),
+	})
+	if len(report.Errors) > 0 {
+		logEntry.Warn("Import rejected")
+		return c.JSON(http.StatusUnprocessableEntity, toAPIImportReport(report))
+	}
+
+	logEntry.Info("Data imported")
+	return c.JSON(http.StatusOK, toAPIImportReport(report))
+}
+
+// readImportRecords разбирает тело загрузки построчно. Пустые строки пропускаются, строка
+// не по формату становится записью с ошибкой, чтобы отчет перечислил все такие строки сразу.
+func readImportRecords(body io.Reader) ([]*domain.ImportRecord, error) {
+	scanner := bufio.NewScanner(body)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxImportLineSize)
+
+	var records []*domain.ImportRecord
+	for line := 1; scanner.Scan(); line++ {
+		data := bytes.TrimSpace(scanner.Bytes())
+		if len(data) == 0 {
+			continue
+		}
+
+		var apiRecord api.ExportRecord
+		if err := json.Unmarshal(data, &apiRecord); err != nil {
+			records = append(records, &domain.ImportRecord{Line: line, Invalid: domain.ErrInvalidImportRecord})
+			continue
+		}
+		records = append(records, fromAPIExportRecord(line, apiRecord))
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
@@ -1,8 +1,11 @@
 package handler
 
 import (
+	"time"
+
 	"pr-reviewer-service/api"
 	"pr-reviewer-service/internal/domain"
+	"pr-reviewer-service/internal/events"
 
 	"github.com/sirupsen/logrus"
 )
@@ -12,6 +15,9 @@ type APIHandler struct {
 	*UserHandler
 	*PRHandler
 	*StatsHandler
+	*OwnershipHandler
+	*AdminHandler
+	*ReviewStreamHandler
 }
 
 func NewAPIHandler(
@@ -19,13 +25,20 @@ func NewAPIHandler(
 	userUseCase domain.UserUseCase,
 	prUseCase domain.PRUseCase,
 	statsUseCase domain.StatsUseCase,
+	ownershipUseCase domain.OwnershipUseCase,
+	transferUseCase domain.TransferUseCase,
+	broker *events.Broker,
+	streamHeartbeat time.Duration,
 	logger *logrus.Logger,
 ) api.ServerInterface {
 
 	return &APIHandler{
-		TeamHandler:  NewTeamHandler(teamUseCase, logger),
-		UserHandler:  NewUserHandler(userUseCase, logger),
-		PRHandler:    NewPRHandler(prUseCase, logger),
-		StatsHandler: NewStatsHandler(statsUseCase, logger),
+		TeamHandler:         NewTeamHandler(teamUseCase, logger),
+		UserHandler:         NewUserHandler(userUseCase, logger),
+		PRHandler:           NewPRHandler(prUseCase, logger),
+		StatsHandler:        NewStatsHandler(statsUseCase, logger),
+		OwnershipHandler:    NewOwnershipHandler(ownershipUseCase, logger),
+		AdminHandler:        NewAdminHandler(transferUseCase, logger),
+		ReviewStreamHandler: NewReviewStreamHandler(userUseCase, broker, streamHeartbeat, logger),
 	}
 }
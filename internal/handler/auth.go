@@ -0,0 +1,43 @@
+package handler
+
+import (
+	"crypto/subtle"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+)
+
+// AuthMiddleware требует заголовок "Authorization: Bearer <token>" с одним из допустимых токенов.
+// Запросы к publicPaths (пробы, метрики) пропускаются без проверки.
+func AuthMiddleware(tokens []string, publicPaths ...string) echo.MiddlewareFunc {
+	public := make(map[string]bool, len(publicPaths))
+	for _, path := range publicPaths {
+		public[path] = true
+	}
+
+	return middleware.KeyAuthWithConfig(middleware.KeyAuthConfig{
+		Skipper: func(c echo.Context) bool {
+			return public[c.Request().URL.Path]
+		},
+		KeyLookup:  "header:" + echo.HeaderAuthorization,
+		AuthScheme: "Bearer",
+		Validator: func(key string, c echo.Context) (bool, error) {
+			return validToken(tokens, key), nil
+		},
+		ErrorHandler: func(err error, c echo.Context) error {
+			return c.JSON(http.StatusUnauthorized, toErrorResponse("UNAUTHORIZED", "missing or invalid bearer token"))
+		},
+	})
+}
+
+// validToken сравнивает токен со всеми допустимыми за постоянное время
+func validToken(tokens []string, key string) bool {
+	valid := false
+	for _, token := range tokens {
+		if subtle.ConstantTimeCompare([]byte(token), []byte(key)) == 1 {
+			valid = true
+		}
+	}
+	return valid
+}
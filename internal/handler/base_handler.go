@@ -1,6 +1,8 @@
 package handler
 
 import (
+	"pr-reviewer-service/internal/logging"
+
 	"github.com/labstack/echo/v4"
 	"github.com/sirupsen/logrus"
 )
@@ -16,7 +18,7 @@ func NewBaseHandler(logger *logrus.Logger) *BaseHandler {
 }
 
 func (h *BaseHandler) logRequest(c echo.Context, operation string) *logrus.Entry {
-	return h.logger.WithFields(logrus.Fields{
+	return logging.FromContext(c.Request().Context(), h.logger).WithFields(logrus.Fields{
 		"operation":  operation,
 		"method":     c.Request().Method,
 		"path":       c.Request().URL.Path,
@@ -0,0 +1,70 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"pr-reviewer-service/internal/graphqlapi"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/labstack/echo/v4"
+	"github.com/sirupsen/logrus"
+)
+
+// GraphQLHandler обслуживает /graphql: POST с JSON-телом {query, operationName, variables}
+// или GET с теми же параметрами в строке запроса (variables - JSON).
+type GraphQLHandler struct {
+	*BaseHandler
+	service *graphqlapi.Service
+}
+
+// NewGraphQLHandler создает новый экземпляр GraphQLHandler.
+func NewGraphQLHandler(service *graphqlapi.Service, logger *logrus.Logger) *GraphQLHandler {
+	return &GraphQLHandler{
+		BaseHandler: NewBaseHandler(logger),
+		service:     service,
+	}
+}
+
+// Serve выполняет запрос GraphQL. Отклоненный до выполнения запрос (синтаксис, схема, лимиты)
+// возвращает 400, ошибки отдельных полей - 200 с частичными data и errors.
+func (h *GraphQLHandler) Serve(c echo.Context) error {
+	logEntry := h.logRequest(c, "graphql")
+
+	var req graphqlapi.Request
+	switch c.Request().Method {
+	case http.MethodGet:
+		req.Query = c.QueryParam("query")
+		req.OperationName = c.QueryParam("operationName")
+		if variables := c.QueryParam("variables"); variables != "" {
+			if err := json.Unmarshal([]byte(variables), &req.Variables); err != nil {
+				return c.JSON(http.StatusBadRequest, graphQLError("variables must be a JSON object"))
+			}
+		}
+	default:
+		if err := json.NewDecoder(c.Request().Body).Decode(&req); err != nil {
+			logEntry.WithError(err).Warn("Invalid GraphQL request body")
+			return c.JSON(http.StatusBadRequest, graphQLError("invalid request body: "+err.Error()))
+		}
+	}
+	if req.Query == "" {
+		return c.JSON(http.StatusBadRequest, graphQLError("query is required"))
+	}
+
+	logEntry = logEntry.WithField("graphql_operation", req.OperationName)
+	result, executed := h.service.Execute(c.Request().Context(), req)
+	if !executed {
+		logEntry.WithField("errors", result.Errors).Warn("GraphQL request rejected")
+		return c.JSON(http.StatusBadRequest, result)
+	}
+	if result.HasErrors() {
+		logEntry.WithField("errors", result.Errors).Warn("GraphQL request completed with errors")
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+func graphQLError(message string) *graphql.Result {
+	return &graphql.Result{Errors: []gqlerrors.FormattedError{gqlerrors.NewFormattedError(message)}}
+}
@@ -0,0 +1,109 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/sirupsen/logrus"
+)
+
+// Статусы проверок /readyz
+const (
+	checkStatusOK   = "ok"
+	checkStatusFail = "fail"
+)
+
+// errDraining - сервис завершает работу и не должен получать новый трафик
+var errDraining = errors.New("service is shutting down")
+
+// ReadinessCheck - проверка зависимости, без которой сервис не готов принимать трафик.
+type ReadinessCheck struct {
+	Name  string
+	Check func(ctx context.Context) error
+}
+
+// CheckResult - результат одной проверки в ответе /readyz.
+type CheckResult struct {
+	Status     string `json:"status"`
+	Error      string `json:"error,omitempty"`
+	DurationMs int64  `json:"duration_ms"`
+}
+
+// HealthHandler обслуживает пробы оркестратора: /livez (процесс жив) и /readyz (готов к трафику).
+type HealthHandler struct {
+	*BaseHandler
+	checks   []ReadinessCheck
+	timeout  time.Duration
+	draining atomic.Bool
+}
+
+// NewHealthHandler создает HealthHandler; timeout ограничивает каждую проверку.
+func NewHealthHandler(timeout time.Duration, logger *logrus.Logger, checks ...ReadinessCheck) *HealthHandler {
+	return &HealthHandler{
+		BaseHandler: NewBaseHandler(logger),
+		checks:      checks,
+		timeout:     timeout,
+	}
+}
+
+// SetDraining переводит сервис в режим завершения: /readyz начинает отвечать 503.
+func (h *HealthHandler) SetDraining() {
+	h.draining.Store(true)
+}
+
+// GetLivez сообщает, что процесс жив. Зависимости не проверяются,
+// чтобы сбой базы не приводил к перезапуску всех подов.
+func (h *HealthHandler) GetLivez(c echo.Context) error {
+	return c.JSON(http.StatusOK, map[string]string{"status": checkStatusOK})
+}
+
+// GetReadyz выполняет проверки зависимостей и отвечает 503, если хотя бы одна не прошла
+// или сервис завершает работу.
+func (h *HealthHandler) GetReadyz(c echo.Context) error {
+	ctx := c.Request().Context()
+	results := make(map[string]CheckResult, len(h.checks)+1)
+	ready := true
+
+	if h.draining.Load() {
+		ready = false
+		results["shutdown"] = CheckResult{Status: checkStatusFail, Error: errDraining.Error()}
+	}
+
+	for _, check := range h.checks {
+		result := h.runCheck(ctx, check)
+		if result.Status != checkStatusOK {
+			ready = false
+		}
+		results[check.Name] = result
+	}
+
+	status, code := checkStatusOK, http.StatusOK
+	if !ready {
+		status, code = checkStatusFail, http.StatusServiceUnavailable
+		h.logRequest(c, "readyz").WithField("checks", results).Warn("Service is not ready")
+	}
+
+	return c.JSON(code, map[string]interface{}{
+		"status": status,
+		"checks": results,
+	})
+}
+
+func (h *HealthHandler) runCheck(ctx context.Context, check ReadinessCheck) CheckResult {
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	start := time.Now()
+	err := check.Check(ctx)
+	result := CheckResult{Status: checkStatusOK, DurationMs: time.Since(start).Milliseconds()}
+	if err != nil {
+		result.Status = checkStatusFail
+		result.Error = err.Error()
+	}
+
+	return result
+}
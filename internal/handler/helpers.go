@@ -20,17 +20,64 @@ func toAPITeam(team *domain.Team) api.Team {
 		}
 	}
 	return api.Team{
-		TeamName: team.Name,
-		Members:  members,
+		TeamName:       team.Name,
+		ParentTeamName: toOptionalString(team.ParentName),
+		Members:        members,
 	}
 }
 
+func toAPITeamTree(nodes []*domain.TeamTreeNode) []api.TeamTreeNode {
+	result := make([]api.TeamTreeNode, len(nodes))
+	for i, node := range nodes {
+		result[i] = api.TeamTreeNode{
+			TeamName:       node.Name,
+			ParentTeamName: toOptionalString(node.ParentName),
+			Stats:          toAPITeamStats(node.Stats),
+			SubtreeStats:   toAPITeamStats(node.SubtreeStats),
+			Children:       toAPITeamTree(node.Children),
+		}
+	}
+	return result
+}
+
+func toAPITeamStats(stats domain.TeamStats) api.TeamStats {
+	return api.TeamStats{
+		MembersCount:       int(stats.MembersCount),
+		ActiveMembersCount: int(stats.ActiveMembersCount),
+		OpenPrsCount:       int(stats.OpenPRsCount),
+		OpenReviewsCount:   int(stats.OpenReviewsCount),
+	}
+}
+
+// toOptionalString преобразует пустую строку в nil для nullable полей API
+func toOptionalString(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
+// fromOptionalString преобразует nullable поле API в строку
+func fromOptionalString(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
+
 func toAPIUser(user *domain.User) api.User {
+	var skills *[]string
+	if user.Skills != nil {
+		skills = &user.Skills
+	}
+
 	return api.User{
-		UserId:   user.ID,
-		Username: user.Username,
-		TeamName: user.TeamName,
-		IsActive: user.IsActive,
+		UserId:           user.ID,
+		Username:         user.Username,
+		TeamName:         user.TeamName,
+		IsActive:         user.IsActive,
+		Skills:           skills,
+		OutOfOfficeUntil: user.OutOfOfficeUntil,
 	}
 }
 
@@ -46,10 +93,57 @@ func toAPIPullRequest(pr *domain.PullRequest) api.PullRequest {
 		AuthorId:          pr.AuthorID,
 		Status:            api.PullRequestStatus(pr.Status),
 		AssignedReviewers: pr.AssignedReviewers,
+		CreatedAt:         pr.CreatedAt,
 		MergedAt:          mergedAt,
 	}
 }
 
+func toAPIReviewerSelections(selections []*domain.ReviewerSelection) []api.ReviewerSelection {
+	result := make([]api.ReviewerSelection, len(selections))
+	for i, selection := range selections {
+		result[i] = api.ReviewerSelection{
+			UserId: selection.UserID,
+			Reason: api.ReviewerSelectionReason(selection.Reason),
+		}
+		// Нагрузка известна при выборе из команды автора, детали оценки - только при подборе по навыкам
+		switch selection.Reason {
+		case domain.SelectionSkillMatch, domain.SelectionLowWorkload:
+			matchedTags := selection.MatchedTags
+			skillScore, workloadScore, score := selection.SkillScore, selection.WorkloadScore, selection.Score
+			result[i].MatchedTags = &matchedTags
+			result[i].SkillScore = &skillScore
+			result[i].WorkloadScore = &workloadScore
+			result[i].Score = &score
+			fallthrough
+		case domain.SelectionTeamMember, domain.SelectionFairShare:
+			openReviews := selection.OpenReviews
+			result[i].OpenReviews = &openReviews
+		}
+	}
+	return result
+}
+
+func toAPIAssignmentExplanation(rationale *domain.AssignmentRationale) *api.AssignmentExplanation {
+	if rationale == nil {
+		return nil
+	}
+
+	excluded := make([]api.ExcludedCandidate, len(rationale.Excluded))
+	for i, candidate := range rationale.Excluded {
+		excluded[i] = api.ExcludedCandidate{
+			UserId: candidate.UserID,
+			Reason: api.ExcludedCandidateReason(candidate.Reason),
+		}
+	}
+
+	return &api.AssignmentExplanation{
+		Strategy:          rationale.Strategy,
+		CandidatePoolSize: rationale.CandidatePoolSize,
+		Reviewers:         toAPIReviewerSelections(rationale.Reviewers),
+		Excluded:          excluded,
+	}
+}
+
 func toAPIPRShorts(prs []*domain.PullRequest) []api.PullRequestShort {
 	result := make([]api.PullRequestShort, len(prs))
 	for i, pr := range prs {
@@ -63,6 +157,285 @@ func toAPIPRShorts(prs []*domain.PullRequest) []api.PullRequestShort {
 	return result
 }
 
+func toAPIOwnershipRules(rules []*domain.OwnershipRule) []api.OwnershipRule {
+	result := make([]api.OwnershipRule, len(rules))
+	for i, rule := range rules {
+		result[i] = api.OwnershipRule{
+			Pattern:   rule.Pattern,
+			UserIds:   rule.UserIDs,
+			TeamNames: rule.TeamNames,
+		}
+	}
+	return result
+}
+
+func fromAPIOwnershipRules(rules []api.OwnershipRule) []*domain.OwnershipRule {
+	result := make([]*domain.OwnershipRule, len(rules))
+	for i, rule := range rules {
+		result[i] = &domain.OwnershipRule{
+			Pattern:   rule.Pattern,
+			UserIDs:   rule.UserIds,
+			TeamNames: rule.TeamNames,
+		}
+	}
+	return result
+}
+
+func toAPIReviewStats(stats []*domain.ReviewStat) []api.ReviewStat {
+	result := make([]api.ReviewStat, len(stats))
+	for i, stat := range stats {
+		result[i] = api.ReviewStat{
+			UserId:      stat.UserID,
+			Username:    stat.Username,
+			ReviewCount: int(stat.ReviewCount),
+		}
+	}
+	return result
+}
+
+func toAPIPRAssignmentStats(stats []*domain.PRAssignmentStat) []api.PRAssignmentStat {
+	result := make([]api.PRAssignmentStat, len(stats))
+	for i, stat := range stats {
+		result[i] = api.PRAssignmentStat{
+			PullRequestId:   stat.PRID,
+			PullRequestName: stat.PRName,
+			ReviewersCount:  int(stat.ReviewersCount),
+		}
+	}
+	return result
+}
+
+func toAPITeamReviewStats(stats []*domain.TeamReviewStat) []api.TeamReviewStat {
+	result := make([]api.TeamReviewStat, len(stats))
+	for i, stat := range stats {
+		result[i] = api.TeamReviewStat{
+			TeamName:              stat.TeamName,
+			PullRequests:          int(stat.PullRequests),
+			OpenPullRequests:      int(stat.OpenPullRequests),
+			AvgReviewersPerPr:     stat.AvgReviewersPerPR(),
+			ReviewsGiven:          int(stat.ReviewsGiven),
+			ReviewsReceived:       int(stat.ReviewsReceived),
+			CrossTeamReviewsShare: stat.CrossTeamShare(),
+		}
+	}
+	return result
+}
+
+func toAPIFairnessReport(report *domain.FairnessReport) api.FairnessReport {
+	result := api.FairnessReport{
+		CreatedFrom: report.From,
+		CreatedTo:   report.To,
+		Teams:       make([]api.TeamFairness, len(report.Teams)),
+	}
+	for i, team := range report.Teams {
+		members := make([]api.MemberFairness, len(team.Members))
+		for j, member := range team.Members {
+			members[j] = api.MemberFairness{
+				UserId:              member.UserID,
+				Username:            member.Username,
+				ActiveDays:          member.ActiveDays,
+				Assignments:         int(member.Assignments),
+				ExpectedAssignments: member.ExpectedAssignments,
+				Deviation:           member.Deviation(),
+			}
+			if member.Outlier != "" {
+				outlier := api.MemberFairnessOutlier(member.Outlier)
+				members[j].Outlier = &outlier
+			}
+		}
+		result.Teams[i] = api.TeamFairness{
+			TeamName:     team.TeamName,
+			Assignments:  int(team.Assignments),
+			Gini:         team.Gini,
+			MaxMinSpread: team.MaxMinSpread,
+			Members:      members,
+		}
+	}
+	return result
+}
+
+// toStatsFilter собирает фильтр статистики из параметров запроса; параметры у всех эндпоинтов статистики одинаковые
+func toStatsFilter(createdFrom, createdTo *time.Time, teamName *string, status *api.StatsStatus) *domain.StatsFilter {
+	filter := &domain.StatsFilter{
+		CreatedFrom: createdFrom,
+		CreatedTo:   createdTo,
+		TeamName:    fromOptionalString(teamName),
+	}
+	if status != nil {
+		filter.Status = string(*status)
+	}
+	return filter
+}
+
+func toAPIExportRecord(record *domain.ExportRecord) api.ExportRecord {
+	switch {
+	case record.Team != nil:
+		return api.ExportRecord{
+			Type: api.ExportRecordTypeTeam,
+			Team: &api.ExportTeam{
+				TeamName:       record.Team.Name,
+				ParentTeamName: toOptionalString(record.Team.ParentName),
+			},
+		}
+	case record.User != nil:
+		return api.ExportRecord{Type: api.ExportRecordTypeUser, User: toAPIExportUser(record.User)}
+	default:
+		pr := record.PullRequest
+		exportPR := &api.ExportPullRequest{
+			PullRequestId:     pr.ID,
+			PullRequestName:   pr.Name,
+			AuthorId:          pr.AuthorID,
+			Status:            api.ExportPullRequestStatus(pr.Status),
+			AssignedReviewers: pr.AssignedReviewers,
+			MergedAt:          pr.MergedAt,
+			Rationale:         toAPIAssignmentExplanation(pr.Rationale),
+		}
+		if pr.CreatedAt != nil {
+			exportPR.CreatedAt = *pr.CreatedAt
+		}
+		return api.ExportRecord{Type: api.ExportRecordTypePullRequest, PullRequest: exportPR}
+	}
+}
+
+func toAPIExportUser(user *domain.User) *api.ExportUser {
+	skills := user.Skills
+	if skills == nil {
+		skills = []string{}
+	}
+	history := make([]api.UserStatusChange, len(user.StatusHistory))
+	for i, change := range user.StatusHistory {
+		history[i] = api.UserStatusChange{IsActive: change.IsActive, ChangedAt: change.ChangedAt}
+	}
+
+	return &api.ExportUser{
+		UserId:           user.ID,
+		Username:         user.Username,
+		TeamName:         user.TeamName,
+		IsActive:         user.IsActive,
+		Skills:           &skills,
+		OutOfOfficeUntil: user.OutOfOfficeUntil,
+		StatusHistory:    &history,
+	}
+}
+
+// fromAPIExportRecord преобразует строку загрузки; запись без данных своего типа помечается ошибочной
+func fromAPIExportRecord(line int, record api.ExportRecord) *domain.ImportRecord {
+	result := &domain.ImportRecord{Line: line, Type: string(record.Type)}
+	switch {
+	case record.Type == api.ExportRecordTypeTeam && record.Team != nil:
+		result.Team = &domain.Team{
+			Name:       record.Team.TeamName,
+			ParentName: fromOptionalString(record.Team.ParentTeamName),
+		}
+	case record.Type == api.ExportRecordTypeUser && record.User != nil:
+		result.User = &domain.User{
+			ID:               record.User.UserId,
+			Username:         record.User.Username,
+			TeamName:         record.User.TeamName,
+			IsActive:         record.User.IsActive,
+			OutOfOfficeUntil: record.User.OutOfOfficeUntil,
+		}
+		if record.User.Skills != nil {
+			result.User.Skills = *record.User.Skills
+		}
+		if record.User.StatusHistory != nil {
+			for _, change := range *record.User.StatusHistory {
+				result.User.StatusHistory = append(result.User.StatusHistory, &domain.UserStatusChange{
+					UserID:    record.User.UserId,
+					IsActive:  change.IsActive,
+					ChangedAt: change.ChangedAt,
+				})
+			}
+		}
+	case record.Type == api.ExportRecordTypePullRequest && record.PullRequest != nil:
+		pr := record.PullRequest
+		result.PullRequest = &domain.PullRequest{
+			ID:                pr.PullRequestId,
+			Name:              pr.PullRequestName,
+			AuthorID:          pr.AuthorId,
+			Status:            string(pr.Status),
+			AssignedReviewers: pr.AssignedReviewers,
+			MergedAt:          pr.MergedAt,
+			Rationale:         fromAPIAssignmentExplanation(pr.Rationale),
+		}
+		if result.PullRequest.AssignedReviewers == nil {
+			result.PullRequest.AssignedReviewers = []string{}
+		}
+		if !pr.CreatedAt.IsZero() {
+			createdAt := pr.CreatedAt
+			result.PullRequest.CreatedAt = &createdAt
+		}
+	default:
+		result.Invalid = domain.ErrInvalidImportRecord
+	}
+	return result
+}
+
+func fromAPIAssignmentExplanation(explanation *api.AssignmentExplanation) *domain.AssignmentRationale {
+	if explanation == nil {
+		return nil
+	}
+
+	reviewers := make([]*domain.ReviewerSelection, len(explanation.Reviewers))
+	for i, selection := range explanation.Reviewers {
+		reviewers[i] = &domain.ReviewerSelection{
+			UserID:      selection.UserId,
+			Reason:      domain.SelectionReason(selection.Reason),
+			MatchedTags: []string{},
+		}
+		if selection.MatchedTags != nil {
+			reviewers[i].MatchedTags = *selection.MatchedTags
+		}
+		if selection.OpenReviews != nil {
+			reviewers[i].OpenReviews = *selection.OpenReviews
+		}
+		if selection.SkillScore != nil {
+			reviewers[i].SkillScore = *selection.SkillScore
+		}
+		if selection.WorkloadScore != nil {
+			reviewers[i].WorkloadScore = *selection.WorkloadScore
+		}
+		reviewers[i].Score = reviewers[i].SkillScore + reviewers[i].WorkloadScore
+	}
+
+	excluded := make([]*domain.ExcludedCandidate, len(explanation.Excluded))
+	for i, candidate := range explanation.Excluded {
+		excluded[i] = &domain.ExcludedCandidate{
+			UserID: candidate.UserId,
+			Reason: domain.ExclusionReason(candidate.Reason),
+		}
+	}
+
+	return &domain.AssignmentRationale{
+		Strategy:          explanation.Strategy,
+		CandidatePoolSize: explanation.CandidatePoolSize,
+		Reviewers:         reviewers,
+		Excluded:          excluded,
+	}
+}
+
+func toAPIImportReport(report *domain.ImportReport) api.ImportReport {
+	errs := make([]api.ImportRecordError, len(report.Errors))
+	for i, recordErr := range report.Errors {
+		errs[i] = api.ImportRecordError{
+			Line:    recordErr.Line,
+			Type:    toOptionalString(recordErr.Type),
+			Id:      toOptionalString(recordErr.ID),
+			Code:    recordErr.Code,
+			Message: recordErr.Message,
+		}
+	}
+	return api.ImportReport{
+		Mode:      api.ImportReportMode(report.Mode),
+		DryRun:    report.DryRun,
+		Committed: report.Committed,
+		Created:   report.Created,
+		Updated:   report.Updated,
+		Skipped:   report.Skipped,
+		Errors:    errs,
+	}
+}
+
 func toErrorResponse(code, message string) api.ErrorResponse {
 	return api.ErrorResponse{
 		Error: struct {
@@ -87,30 +460,17 @@ func toAPIErrorResponse(httpErr domain.HTTPError) api.ErrorResponse {
 	}
 }
 
+// getHTTPStatusCode выбирает HTTP-статус по классу ошибки из общей таблицы domain.KindOf
 func getHTTPStatusCode(err error) int {
-	switch err {
-	// Conflict errors (409)
-	case domain.ErrTeamAlreadyExists, domain.ErrPRAlreadyExists,
-		domain.ErrPRAlreadyMerged, domain.ErrReviewerNotAssigned,
-		domain.ErrNoReviewerCandidate, domain.ErrPartialReassignment,
-		domain.ErrNoActiveUsersInTeam:
-		return http.StatusConflict
-
-	// Not Found errors (404)
-	case domain.ErrUserNotFound, domain.ErrTeamNotFound,
-		domain.ErrPRNotFound, domain.ErrPRAuthorNotFound:
-		return http.StatusNotFound
-
-	// Bad Request errors (400) - валидация
-	case domain.ErrInvalidPRID, domain.ErrInvalidPRName,
-		domain.ErrInvalidUserID, domain.ErrInvalidTeamName,
-		domain.ErrTeamMustHaveMembers:
+	switch domain.KindOf(err) {
+	case domain.ErrorKindInvalidArgument:
 		return http.StatusBadRequest
-
-	// Internal Server Error with specific codes (500)
-	case domain.ErrTeamDeactivationFailed, domain.ErrPRReassignmentFailed:
-		return http.StatusInternalServerError
-
+	case domain.ErrorKindNotFound:
+		return http.StatusNotFound
+	case domain.ErrorKindAlreadyExists, domain.ErrorKindFailedPrecondition, domain.ErrorKindAborted:
+		return http.StatusConflict
+	case domain.ErrorKindUnprocessable:
+		return http.StatusUnprocessableEntity
 	default:
 		return http.StatusInternalServerError
 	}
@@ -0,0 +1,190 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"time"
+
+	"pr-reviewer-service/internal/domain"
+	"pr-reviewer-service/internal/logging"
+
+	"github.com/labstack/echo/v4"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// IdempotencyKeyHeader - заголовок с ключом идемпотентности запроса
+	IdempotencyKeyHeader = "Idempotency-Key"
+
+	// IdempotentReplayedHeader отмечает ответ, повторенный из сохраненного по ключу
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+
+	// maxIdempotencyKeyLength - ограничение длины ключа (VARCHAR(255) в таблице idempotency_keys)
+	maxIdempotencyKeyLength = 255
+
+	// idempotencyPurgeInterval - период удаления истекших ключей
+	idempotencyPurgeInterval = time.Hour
+)
+
+// IdempotencyMiddleware делает POST-запросы к paths с заголовком Idempotency-Key повторяемыми.
+// Первый запрос с ключом выполняется, и его ответ (вместе с ETag) хранится ttl; повтор с тем же методом,
+// путем, If-Match и телом получает сохраненный ответ (с заголовком Idempotent-Replayed: true), не выполняясь заново.
+// Ключ от другого запроса - 422 IDEMPOTENCY_KEY_REUSED, повтор до завершения первого запроса -
+// 409 IDEMPOTENCY_KEY_IN_PROGRESS. Выполняющийся запрос держит ключ в аренде на lease: если он не ответил
+// за это время (например, процесс упал), ключ занимает повтор. Ответы 5xx не сохраняются: повтор выполнит запрос снова.
+// Запросы без заголовка обрабатываются как обычно.
+func IdempotencyMiddleware(repo domain.IdempotencyRepository, ttl, lease time.Duration, logger *logrus.Logger, paths ...string) echo.MiddlewareFunc {
+	guarded := make(map[string]bool, len(paths))
+	for _, path := range paths {
+		guarded[path] = true
+	}
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+			key := req.Header.Get(IdempotencyKeyHeader)
+			if key == "" || req.Method != http.MethodPost || !guarded[req.URL.Path] {
+				return next(c)
+			}
+			if !validIdempotencyKey(key) {
+				return idempotencyError(c, domain.ErrInvalidIdempotencyKey)
+			}
+
+			body, err := io.ReadAll(req.Body)
+			if err != nil {
+				return c.JSON(http.StatusBadRequest, toErrorResponse("INVALID_REQUEST", "failed to read request body"))
+			}
+			req.Body = io.NopCloser(bytes.NewReader(body))
+
+			ctx := req.Context()
+			logEntry := logging.FromContext(ctx, logger).WithField("idempotency_key", key)
+			fingerprint := requestFingerprint(req, body)
+
+			now := time.Now()
+			record, reserved, err := repo.Reserve(ctx, key, fingerprint, now.Add(lease), now.Add(ttl))
+			if err != nil {
+				logEntry.WithError(err).Error("Failed to reserve idempotency key")
+				return c.JSON(http.StatusInternalServerError, toErrorResponse("INTERNAL_ERROR", err.Error()))
+			}
+			if !reserved {
+				return replayResponse(c, record, fingerprint)
+			}
+
+			// Сохранение ответа не должно срываться из-за отмены запроса клиентом
+			storeCtx := context.WithoutCancel(ctx)
+			finished := false
+			defer func() {
+				// Ключ запроса без окончательного ответа (ошибка или паника обработчика) освобождаем для повтора
+				if !finished {
+					if err := repo.Release(storeCtx, key, fingerprint); err != nil {
+						logEntry.WithError(err).Error("Failed to release idempotency key")
+					}
+				}
+			}()
+
+			recorder := &bodyRecorder{ResponseWriter: c.Response().Writer}
+			c.Response().Writer = recorder
+
+			err = next(c)
+			status := c.Response().Status
+			if err != nil || !c.Response().Committed || status >= http.StatusInternalServerError {
+				return err
+			}
+
+			// Запрос уже выполнен: даже если ответ не сохранится, ключ не освобождается,
+			// чтобы повтор сразу не выполнил изменение второй раз (до истечения аренды - 409)
+			finished = true
+			header := c.Response().Header()
+			err = repo.Complete(storeCtx, key, fingerprint, status, header.Get(echo.HeaderContentType), header.Get(ETagHeader), recorder.body.Bytes())
+			if err != nil {
+				logEntry.WithError(err).Error("Failed to save idempotent response")
+			}
+
+			return nil
+		}
+	}
+}
+
+// PurgeExpiredIdempotencyKeys периодически удаляет истекшие ключи идемпотентности, пока не отменен ctx.
+func PurgeExpiredIdempotencyKeys(ctx context.Context, repo domain.IdempotencyRepository, logger *logrus.Logger) {
+	ticker := time.NewTicker(idempotencyPurgeInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			deleted, err := repo.DeleteExpired(ctx)
+			if err != nil {
+				logger.WithError(err).Warn("Failed to delete expired idempotency keys")
+				continue
+			}
+			logger.WithField("deleted", deleted).Debug("Expired idempotency keys deleted")
+		}
+	}
+}
+
+// replayResponse возвращает сохраненный ответ, если ключ занят тем же запросом
+func replayResponse(c echo.Context, record *domain.IdempotencyRecord, fingerprint string) error {
+	switch {
+	case record.Fingerprint != fingerprint:
+		return idempotencyError(c, domain.ErrIdempotencyKeyReused)
+	case record.StatusCode == 0:
+		return idempotencyError(c, domain.ErrIdempotencyKeyInProgress)
+	}
+
+	c.Response().Header().Set(IdempotentReplayedHeader, "true")
+	if record.ETag != "" {
+		c.Response().Header().Set(ETagHeader, record.ETag)
+	}
+	return c.Blob(record.StatusCode, record.ContentType, record.Body)
+}
+
+func idempotencyError(c echo.Context, err error) error {
+	httpErr, _ := domain.ToHTTPError(err)
+	return c.JSON(getHTTPStatusCode(err), toAPIErrorResponse(httpErr))
+}
+
+// validIdempotencyKey - от 1 до 255 печатных ASCII-символов без пробелов, как X-Request-ID
+func validIdempotencyKey(key string) bool {
+	if len(key) > maxIdempotencyKeyLength {
+		return false
+	}
+	for i := 0; i < len(key); i++ {
+		if key[i] < '!' || key[i] > '~' {
+			return false
+		}
+	}
+	return true
+}
+
+// requestFingerprint - хеш метода, пути, If-Match и тела запроса: повтор с другой ожидаемой версией PR -
+// другой запрос
+func requestFingerprint(req *http.Request, body []byte) string {
+	hash := sha256.New()
+	hash.Write([]byte(req.Method + " " + req.URL.Path + "\n"))
+	hash.Write([]byte(req.Header.Get(IfMatchHeader) + "\n"))
+	hash.Write(body)
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// bodyRecorder копирует тело ответа, чтобы сохранить его для повторов
+type bodyRecorder struct {
+	http.ResponseWriter
+	body bytes.Buffer
+}
+
+func (r *bodyRecorder) Write(b []byte) (int, error) {
+	r.body.Write(b)
+	return r.ResponseWriter.Write(b)
+}
+
+// Unwrap дает http.ResponseController доступ к исходному ResponseWriter
+func (r *bodyRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
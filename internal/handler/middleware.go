@@ -1,14 +1,26 @@
 package handler
 
 import (
+	"errors"
 	"time"
 
+	"pr-reviewer-service/internal/logging"
+
 	"github.com/labstack/echo/v4"
 	"github.com/sirupsen/logrus"
 )
 
+// RequestObserver получает длительность каждого обработанного запроса (например, для метрик).
+type RequestObserver interface {
+	ObserveRequest(method, operation string, status int, latency time.Duration)
+}
+
+// unmatchedOperation - операция для запросов, не совпавших ни с одним маршрутом
+const unmatchedOperation = "unmatched"
+
 // LoggingMiddleware добавляет структурированное логирование
-func LoggingMiddleware(logger *logrus.Logger) echo.MiddlewareFunc {
+// и передает длительность запросов наблюдателям
+func LoggingMiddleware(logger *logrus.Logger, observers ...RequestObserver) echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
 			start := time.Now()
@@ -20,7 +32,22 @@ func LoggingMiddleware(logger *logrus.Logger) echo.MiddlewareFunc {
 			latency := time.Since(start)
 			status := c.Response().Status
 
-			entry := logger.WithFields(logrus.Fields{
+			// Ошибку echo запишет в ответ уже после middleware - берем код из нее
+			var httpErr *echo.HTTPError
+			if !c.Response().Committed && errors.As(err, &httpErr) {
+				status = httpErr.Code
+			}
+
+			// Шаблон маршрута вместо пути, чтобы не плодить серии метрик
+			operation := c.Path()
+			if operation == "" {
+				operation = unmatchedOperation
+			}
+			for _, observer := range observers {
+				observer.ObserveRequest(c.Request().Method, operation, status, latency)
+			}
+
+			entry := logging.FromContext(c.Request().Context(), logger).WithFields(logrus.Fields{
 				"method":     c.Request().Method,
 				"uri":        c.Request().URL.Path,
 				"status":     status,
@@ -0,0 +1,76 @@
+package handler
+
+import (
+	"net/http"
+
+	"pr-reviewer-service/api"
+	"pr-reviewer-service/internal/domain"
+
+	"github.com/labstack/echo/v4"
+	"github.com/sirupsen/logrus"
+)
+
+// OwnershipHandler обрабатывает HTTP-запросы для управления правилами владения кодом
+type OwnershipHandler struct {
+	*BaseHandler
+	ownershipUseCase domain.OwnershipUseCase
+}
+
+// NewOwnershipHandler создает новый экземпляр OwnershipHandler
+func NewOwnershipHandler(ownershipUseCase domain.OwnershipUseCase, logger *logrus.Logger) *OwnershipHandler {
+	return &OwnershipHandler{
+		BaseHandler:      NewBaseHandler(logger),
+		ownershipUseCase: ownershipUseCase,
+	}
+}
+
+// PostCodeOwnersSet обрабатывает замену правил владения кодом репозитория
+func (h *OwnershipHandler) PostCodeOwnersSet(c echo.Context) error {
+	var req api.PostCodeOwnersSetJSONBody
+	if err := c.Bind(&req); err != nil {
+		h.logRequest(c, "set_code_owners").WithError(err).Warn("Failed to bind set code owners request")
+		return c.JSON(http.StatusBadRequest, toErrorResponse("INVALID_REQUEST", err.Error()))
+	}
+
+	logEntry := h.logRequest(c, "set_code_owners").WithFields(logrus.Fields{
+		"repository":  req.Repository,
+		"rules_count": len(req.Rules),
+	})
+	logEntry.Info("Setting code ownership rules")
+
+	rules, err := h.ownershipUseCase.SetRules(c.Request().Context(), req.Repository, fromAPIOwnershipRules(req.Rules))
+	if err != nil {
+		logEntry.WithError(err).Error("Failed to set code ownership rules")
+		if httpErr, exists := domain.ToHTTPError(err); exists {
+			return c.JSON(getHTTPStatusCode(err), toAPIErrorResponse(httpErr))
+		}
+		return c.JSON(http.StatusInternalServerError, toErrorResponse("INTERNAL_ERROR", err.Error()))
+	}
+
+	logEntry.Info("Code ownership rules updated")
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"repository": req.Repository,
+		"rules":      toAPIOwnershipRules(rules),
+	})
+}
+
+// GetCodeOwnersGet обрабатывает получение правил владения кодом репозитория
+func (h *OwnershipHandler) GetCodeOwnersGet(c echo.Context, params api.GetCodeOwnersGetParams) error {
+	logEntry := h.logRequest(c, "get_code_owners").WithField("repository", params.Repository)
+	logEntry.Info("Getting code ownership rules")
+
+	rules, err := h.ownershipUseCase.GetRules(c.Request().Context(), params.Repository)
+	if err != nil {
+		logEntry.WithError(err).Error("Failed to get code ownership rules")
+		if httpErr, exists := domain.ToHTTPError(err); exists {
+			return c.JSON(getHTTPStatusCode(err), toAPIErrorResponse(httpErr))
+		}
+		return c.JSON(http.StatusInternalServerError, toErrorResponse("INTERNAL_ERROR", err.Error()))
+	}
+
+	logEntry.WithField("rules_count", len(rules)).Info("Code ownership rules retrieved")
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"repository": params.Repository,
+		"rules":      toAPIOwnershipRules(rules),
+	})
+}
@@ -2,6 +2,8 @@ package handler
 
 import (
 	"net/http"
+	"strconv"
+	"strings"
 
 	"pr-reviewer-service/api"
 	"pr-reviewer-service/internal/domain"
@@ -10,6 +12,14 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
+const (
+	// ETagHeader - версия PR в ответах на получение и изменение PR
+	ETagHeader = "ETag"
+
+	// IfMatchHeader - ожидаемая версия PR в запросах на изменение
+	IfMatchHeader = "If-Match"
+)
+
 // PRHandler обрабатывает HTTP-запросы связанные с пул-реквестами
 type PRHandler struct {
 	*BaseHandler
@@ -24,22 +34,39 @@ func NewPRHandler(prUseCase domain.PRUseCase, logger *logrus.Logger) *PRHandler
 	}
 }
 
-// PostPullRequestCreate обрабатывает создание нового пул-реквеста
-func (h *PRHandler) PostPullRequestCreate(c echo.Context) error {
+// PostPullRequestCreate обрабатывает создание нового пул-реквеста.
+// Idempotency-Key обрабатывает IdempotencyMiddleware.
+func (h *PRHandler) PostPullRequestCreate(c echo.Context, _ api.PostPullRequestCreateParams) error {
 	var req api.PostPullRequestCreateJSONBody
 	if err := c.Bind(&req); err != nil {
-		h.logger.WithError(err).Warn("Failed to bind create PR request")
+		h.logRequest(c, "create_pr").WithError(err).Warn("Failed to bind create PR request")
 		return c.JSON(http.StatusBadRequest, toErrorResponse("INVALID_REQUEST", err.Error()))
 	}
 
+	createReq := &domain.CreatePRRequest{
+		ID:         req.PullRequestId,
+		Name:       req.PullRequestName,
+		AuthorID:   req.AuthorId,
+		Repository: fromOptionalString(req.Repository),
+	}
+	if req.ChangedFiles != nil {
+		createReq.ChangedFiles = *req.ChangedFiles
+	}
+	if req.RequiredTags != nil {
+		createReq.RequiredTags = *req.RequiredTags
+	}
+
 	logEntry := h.logRequest(c, "create_pr").WithFields(logrus.Fields{
-		"pr_id":   req.PullRequestId,
-		"pr_name": req.PullRequestName,
-		"author":  req.AuthorId,
+		"pr_id":         req.PullRequestId,
+		"pr_name":       req.PullRequestName,
+		"author":        req.AuthorId,
+		"repository":    createReq.Repository,
+		"changed_files": len(createReq.ChangedFiles),
+		"required_tags": createReq.RequiredTags,
 	})
 	logEntry.Info("Creating pull request")
 
-	pr, err := h.prUseCase.CreatePR(c.Request().Context(), req.PullRequestId, req.PullRequestName, req.AuthorId)
+	pr, err := h.prUseCase.CreatePR(c.Request().Context(), createReq)
 	if err != nil {
 		logEntry.WithError(err).Error("Failed to create PR")
 		if httpErr, exists := domain.ToHTTPError(err); exists {
@@ -49,23 +76,117 @@ func (h *PRHandler) PostPullRequestCreate(c echo.Context) error {
 	}
 
 	logEntry.WithField("reviewers_count", len(pr.AssignedReviewers)).Info("PR created successfully")
+	setETag(c, pr)
 	return c.JSON(http.StatusCreated, map[string]interface{}{
+		"pr":                 toAPIPullRequest(pr),
+		"reviewer_selection": toAPIReviewerSelections(pr.Rationale.Reviewers),
+	})
+}
+
+// GetPullRequestGet обрабатывает получение пул-реквеста, при explain=true - с объяснением выбора ревьюверов
+func (h *PRHandler) GetPullRequestGet(c echo.Context, params api.GetPullRequestGetParams) error {
+	explain := params.Explain != nil && *params.Explain
+
+	logEntry := h.logRequest(c, "get_pr").WithFields(logrus.Fields{
+		"pr_id":   params.PullRequestId,
+		"explain": explain,
+	})
+	logEntry.Info("Getting pull request")
+
+	pr, err := h.prUseCase.GetPR(c.Request().Context(), params.PullRequestId, explain)
+	if err != nil {
+		logEntry.WithError(err).Error("Failed to get PR")
+		if httpErr, exists := domain.ToHTTPError(err); exists {
+			return c.JSON(getHTTPStatusCode(err), toAPIErrorResponse(httpErr))
+		}
+		return c.JSON(http.StatusInternalServerError, toErrorResponse("INTERNAL_ERROR", err.Error()))
+	}
+
+	response := map[string]interface{}{
 		"pr": toAPIPullRequest(pr),
+	}
+	if explain {
+		response["explanation"] = toAPIAssignmentExplanation(pr.Rationale)
+	}
+
+	logEntry.Info("PR retrieved successfully")
+	setETag(c, pr)
+	return c.JSON(http.StatusOK, response)
+}
+
+// GetPullRequestList обрабатывает запрос списка PR с фильтрами и постраничной выдачей.
+func (h *PRHandler) GetPullRequestList(c echo.Context, params api.GetPullRequestListParams) error {
+	filter := &domain.PRListFilter{
+		CreatedFrom: params.CreatedFrom,
+		CreatedTo:   params.CreatedTo,
+	}
+	if params.Status != nil {
+		filter.Status = string(*params.Status)
+	}
+	if params.AuthorId != nil {
+		filter.AuthorID = *params.AuthorId
+	}
+	if params.ReviewerId != nil {
+		filter.ReviewerID = *params.ReviewerId
+	}
+	if params.TeamName != nil {
+		filter.TeamName = *params.TeamName
+	}
+	if params.Limit != nil {
+		filter.Limit = *params.Limit
+	}
+	if params.Cursor != nil {
+		filter.Cursor = *params.Cursor
+	}
+
+	logEntry := h.logRequest(c, "list_prs").WithFields(logrus.Fields{
+		"status":      filter.Status,
+		"author_id":   filter.AuthorID,
+		"reviewer_id": filter.ReviewerID,
+		"team_name":   filter.TeamName,
+		"limit":       filter.Limit,
+	})
+	logEntry.Info("Listing pull requests")
+
+	page, err := h.prUseCase.ListPRs(c.Request().Context(), filter)
+	if err != nil {
+		logEntry.WithError(err).Warn("Failed to list PRs")
+		if httpErr, exists := domain.ToHTTPError(err); exists {
+			return c.JSON(getHTTPStatusCode(err), toAPIErrorResponse(httpErr))
+		}
+		return c.JSON(http.StatusInternalServerError, toErrorResponse("INTERNAL_ERROR", err.Error()))
+	}
+
+	prs := make([]api.PullRequest, len(page.PullRequests))
+	for i, pr := range page.PullRequests {
+		prs[i] = toAPIPullRequest(pr)
+	}
+
+	logEntry.WithField("prs_count", len(prs)).Info("PRs listed successfully")
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"pull_requests": prs,
+		"next_cursor":   toOptionalString(page.NextCursor),
 	})
 }
 
-// PostPullRequestMerge обрабатывает мерж существующего пул-реквеста
-func (h *PRHandler) PostPullRequestMerge(c echo.Context) error {
+// PostPullRequestMerge обрабатывает мерж существующего пул-реквеста (с If-Match - при совпадении версии)
+func (h *PRHandler) PostPullRequestMerge(c echo.Context, params api.PostPullRequestMergeParams) error {
 	var req api.PostPullRequestMergeJSONBody
 	if err := c.Bind(&req); err != nil {
-		h.logger.WithError(err).Warn("Failed to bind merge PR request")
+		h.logRequest(c, "merge_pr").WithError(err).Warn("Failed to bind merge PR request")
 		return c.JSON(http.StatusBadRequest, toErrorResponse("INVALID_REQUEST", err.Error()))
 	}
 
 	logEntry := h.logRequest(c, "merge_pr").WithField("pr_id", req.PullRequestId)
+	ifMatch, err := parseIfMatch(params.IfMatch)
+	if err != nil {
+		logEntry.WithError(err).Warn("Invalid If-Match header")
+		httpErr, _ := domain.ToHTTPError(err)
+		return c.JSON(getHTTPStatusCode(err), toAPIErrorResponse(httpErr))
+	}
 	logEntry.Info("Merging pull request")
 
-	pr, err := h.prUseCase.MergePR(c.Request().Context(), req.PullRequestId)
+	pr, err := h.prUseCase.MergePR(c.Request().Context(), req.PullRequestId, ifMatch)
 	if err != nil {
 		logEntry.WithError(err).Error("Failed to merge PR")
 		if httpErr, exists := domain.ToHTTPError(err); exists {
@@ -75,16 +196,18 @@ func (h *PRHandler) PostPullRequestMerge(c echo.Context) error {
 	}
 
 	logEntry.Info("PR merged successfully")
+	setETag(c, pr)
 	return c.JSON(http.StatusOK, map[string]interface{}{
 		"pr": toAPIPullRequest(pr),
 	})
 }
 
-// PostPullRequestReassign обрабатывает переназначение ревьювера пул-реквеста
-func (h *PRHandler) PostPullRequestReassign(c echo.Context) error {
+// PostPullRequestReassign обрабатывает переназначение ревьювера пул-реквеста (с If-Match - при совпадении версии).
+// Idempotency-Key обрабатывает IdempotencyMiddleware.
+func (h *PRHandler) PostPullRequestReassign(c echo.Context, params api.PostPullRequestReassignParams) error {
 	var req api.PostPullRequestReassignJSONBody
 	if err := c.Bind(&req); err != nil {
-		h.logger.WithError(err).Warn("Failed to bind reassign reviewer request")
+		h.logRequest(c, "reassign_reviewer").WithError(err).Warn("Failed to bind reassign reviewer request")
 		return c.JSON(http.StatusBadRequest, toErrorResponse("INVALID_REQUEST", err.Error()))
 	}
 
@@ -92,9 +215,15 @@ func (h *PRHandler) PostPullRequestReassign(c echo.Context) error {
 		"pr_id":        req.PullRequestId,
 		"old_reviewer": req.OldUserId,
 	})
+	ifMatch, err := parseIfMatch(params.IfMatch)
+	if err != nil {
+		logEntry.WithError(err).Warn("Invalid If-Match header")
+		httpErr, _ := domain.ToHTTPError(err)
+		return c.JSON(getHTTPStatusCode(err), toAPIErrorResponse(httpErr))
+	}
 	logEntry.Info("Reassigning reviewer")
 
-	pr, newReviewerID, err := h.prUseCase.ReassignReviewer(c.Request().Context(), req.PullRequestId, req.OldUserId)
+	pr, newReviewerID, err := h.prUseCase.ReassignReviewer(c.Request().Context(), req.PullRequestId, req.OldUserId, ifMatch)
 	if err != nil {
 		logEntry.WithError(err).Error("Failed to reassign reviewer")
 		if httpErr, exists := domain.ToHTTPError(err); exists {
@@ -104,8 +233,41 @@ func (h *PRHandler) PostPullRequestReassign(c echo.Context) error {
 	}
 
 	logEntry.WithField("new_reviewer", newReviewerID).Info("Reviewer reassigned successfully")
+	setETag(c, pr)
 	return c.JSON(http.StatusOK, map[string]interface{}{
 		"pr":          toAPIPullRequest(pr),
 		"replaced_by": newReviewerID,
 	})
 }
+
+// setETag отдает версию PR сильным ETag: "<version>"
+func setETag(c echo.Context, pr *domain.PullRequest) {
+	c.Response().Header().Set(ETagHeader, `"`+strconv.FormatInt(pr.Version, 10)+`"`)
+}
+
+// parseIfMatch разбирает If-Match: ETag из setETag или * (0 - версию не проверять).
+// Слабые ETag (W/"...") и списки не поддерживаются: версия PR сравнивается точно.
+func parseIfMatch(value *api.IfMatch) (int64, error) {
+	if value == nil {
+		return 0, nil
+	}
+
+	tag := strings.TrimSpace(*value)
+	if tag == "*" {
+		return 0, nil
+	}
+	unquoted, ok := strings.CutPrefix(tag, `"`)
+	if !ok {
+		return 0, domain.ErrInvalidIfMatch
+	}
+	unquoted, ok = strings.CutSuffix(unquoted, `"`)
+	if !ok {
+		return 0, domain.ErrInvalidIfMatch
+	}
+
+	version, err := strconv.ParseInt(unquoted, 10, 64)
+	if err != nil || version <= 0 {
+		return 0, domain.ErrInvalidIfMatch
+	}
+	return version, nil
+}
@@ -0,0 +1,55 @@
+package handler
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+
+	"pr-reviewer-service/internal/logging"
+
+	"github.com/labstack/echo/v4"
+)
+
+// maxRequestIDLength - ограничение длины входящего X-Request-ID
+const maxRequestIDLength = 128
+
+// RequestIDMiddleware принимает X-Request-ID клиента или генерирует новый,
+// кладет его в контекст запроса и возвращает в заголовке ответа.
+// Должен стоять перед трассировкой и логированием, чтобы идентификатор попал в их записи.
+func RequestIDMiddleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+
+			requestID := req.Header.Get(logging.RequestIDHeader)
+			if !isValidRequestID(requestID) {
+				requestID = newRequestID()
+			}
+
+			c.Response().Header().Set(logging.RequestIDHeader, requestID)
+			c.SetRequest(req.WithContext(logging.ContextWithRequestID(req.Context(), requestID)))
+
+			return next(c)
+		}
+	}
+}
+
+// isValidRequestID допускает только непустые печатные ASCII-идентификаторы разумной длины,
+// чтобы клиент не мог подмешать в логи переводы строк или мегабайтные значения
+func isValidRequestID(requestID string) bool {
+	if requestID == "" || len(requestID) > maxRequestIDLength {
+		return false
+	}
+	for i := 0; i < len(requestID); i++ {
+		if requestID[i] < '!' || requestID[i] > '~' {
+			return false
+		}
+	}
+	return true
+}
+
+// newRequestID генерирует случайный идентификатор из 32 hex-символов
+func newRequestID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,170 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"pr-reviewer-service/api"
+	"pr-reviewer-service/internal/domain"
+	"pr-reviewer-service/internal/events"
+
+	"github.com/labstack/echo/v4"
+	"github.com/sirupsen/logrus"
+)
+
+// ReviewStreamHandler отдает изменения назначений пользователя через Server-Sent Events
+type ReviewStreamHandler struct {
+	*BaseHandler
+	userUseCase domain.UserUseCase
+	broker      *events.Broker
+	heartbeat   time.Duration
+}
+
+// NewReviewStreamHandler создает новый экземпляр ReviewStreamHandler.
+// heartbeat - период комментариев, которые не дают прокси закрыть простаивающее соединение.
+func NewReviewStreamHandler(userUseCase domain.UserUseCase, broker *events.Broker, heartbeat time.Duration, logger *logrus.Logger) *ReviewStreamHandler {
+	return &ReviewStreamHandler{
+		BaseHandler: NewBaseHandler(logger),
+		userUseCase: userUseCase,
+		broker:      broker,
+		heartbeat:   heartbeat,
+	}
+}
+
+// reviewStreamEvent - данные событий assigned, unassigned и merged (схема ReviewStreamEvent)
+type reviewStreamEvent struct {
+	Type            string    `json:"type"`
+	PullRequestID   string    `json:"pull_request_id"`
+	PullRequestName string    `json:"pull_request_name"`
+	AuthorID        string    `json:"author_id"`
+	OccurredAt      time.Time `json:"occurred_at"`
+}
+
+// GetUsersReviewStream обрабатывает подписку на изменения назначений пользователя.
+// Сначала отправляется снимок (или пропущенные события при переподключении с Last-Event-ID),
+// затем события по мере публикации; поток закрывается при отключении клиента.
+func (h *ReviewStreamHandler) GetUsersReviewStream(c echo.Context, params api.GetUsersReviewStreamParams) error {
+	logEntry := h.logRequest(c, "review_stream").WithField("user_id", params.UserId)
+	ctx := c.Request().Context()
+
+	// Подписываемся до чтения снимка, чтобы не потерять события между ними
+	filter := events.ForReviewer(params.UserId)
+	var (
+		sub     *events.Subscription
+		missed  []domain.AssignmentEvent
+		resumed bool
+	)
+	if lastEventID, ok := parseLastEventID(params.LastEventID); ok {
+		sub, missed, resumed = h.broker.SubscribeFrom(lastEventID, filter)
+	} else {
+		sub = h.broker.Subscribe(filter)
+	}
+	defer sub.Close()
+
+	// Снимок - первая страница /users/getReview; остальные клиент дочитывает по next_cursor
+	var page *domain.PRPage
+	if !resumed {
+		var err error
+		page, err = h.userUseCase.GetUserReviewPRs(ctx, params.UserId, "", 0)
+		if err != nil {
+			logEntry.WithError(err).Warn("Failed to get user review PRs")
+			if httpErr, exists := domain.ToHTTPError(err); exists {
+				return c.JSON(getHTTPStatusCode(err), toAPIErrorResponse(httpErr))
+			}
+			return c.JSON(http.StatusInternalServerError, toErrorResponse("INTERNAL_ERROR", err.Error()))
+		}
+	}
+
+	// Поток живет дольше server.write_timeout - снимаем дедлайн записи для этого соединения
+	_ = http.NewResponseController(c.Response().Writer).SetWriteDeadline(time.Time{})
+
+	header := c.Response().Header()
+	header.Set(echo.HeaderContentType, "text/event-stream")
+	header.Set("Cache-Control", "no-cache")
+	header.Set("Connection", "keep-alive")
+	header.Set("X-Accel-Buffering", "no")
+	c.Response().WriteHeader(http.StatusOK)
+
+	logEntry.WithFields(logrus.Fields{
+		"resumed":       resumed,
+		"missed_events": len(missed),
+	}).Info("Review stream opened")
+
+	if resumed {
+		for _, event := range missed {
+			if err := writeAssignmentEvent(c.Response(), event); err != nil {
+				return nil
+			}
+		}
+	} else {
+		snapshot := map[string]interface{}{
+			"user_id":       params.UserId,
+			"pull_requests": toAPIPRShorts(page.PullRequests),
+			"next_cursor":   toOptionalString(page.NextCursor),
+		}
+		if err := writeSSE(c.Response(), sub.StartID(), "snapshot", snapshot); err != nil {
+			return nil
+		}
+	}
+	c.Response().Flush()
+
+	heartbeat := time.NewTicker(h.heartbeat)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			logEntry.Info("Review stream closed by client")
+			return nil
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(c.Response(), ": heartbeat\n\n"); err != nil {
+				return nil
+			}
+		case event, ok := <-sub.C:
+			if !ok {
+				// Клиент отстал или сервер останавливается: переподключение с Last-Event-ID дочитает историю
+				logEntry.WithField("dropped", sub.Dropped()).Warn("Review stream closed by server")
+				return nil
+			}
+			if err := writeAssignmentEvent(c.Response(), event); err != nil {
+				return nil
+			}
+		}
+		c.Response().Flush()
+	}
+}
+
+// parseLastEventID разбирает заголовок Last-Event-ID; некорректное значение игнорируется
+func parseLastEventID(value *string) (uint64, bool) {
+	if value == nil || *value == "" {
+		return 0, false
+	}
+	id, err := strconv.ParseUint(*value, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return id, true
+}
+
+func writeAssignmentEvent(w *echo.Response, event domain.AssignmentEvent) error {
+	return writeSSE(w, event.ID, event.Type, reviewStreamEvent{
+		Type:            event.Type,
+		PullRequestID:   event.PullRequestID,
+		PullRequestName: event.PullRequestName,
+		AuthorID:        event.AuthorID,
+		OccurredAt:      event.OccurredAt,
+	})
+}
+
+// writeSSE записывает одно событие; JSON не содержит переводов строк, поэтому data умещается в одну строку
+func writeSSE(w *echo.Response, id uint64, eventType string, data interface{}) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", id, eventType, payload)
+	return err
+}
@@ -1,14 +1,23 @@
 package handler
 
 import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
 
+	"pr-reviewer-service/api"
 	"pr-reviewer-service/internal/domain"
 
 	"github.com/labstack/echo/v4"
 	"github.com/sirupsen/logrus"
 )
 
+// CSVContentType - формат статистики для электронных таблиц
+const CSVContentType = "text/csv; charset=utf-8"
+
 // StatsHandler обрабатывает HTTP-запросы для получения статистических данных.
 type StatsHandler struct {
 	*BaseHandler
@@ -24,35 +33,256 @@ func NewStatsHandler(statsUseCase domain.StatsUseCase, logger *logrus.Logger) *S
 }
 
 // GetReviewStats обрабатывает GET запрос для получения статистики по ревью пользователей.
-func (h *StatsHandler) GetStatsReviews(c echo.Context) error {
+func (h *StatsHandler) GetStatsReviews(c echo.Context, params api.GetStatsReviewsParams) error {
 	logEntry := h.logRequest(c, "get_review_stats")
+	format, err := negotiateStatsFormat(c, params.Format)
+	if err != nil {
+		logEntry.WithError(err).Warn("Invalid stats format")
+		return c.JSON(getHTTPStatusCode(err), toAPIErrorResponse(domain.ErrorMapping[err]))
+	}
+	filter := toStatsFilter(params.CreatedFrom, params.CreatedTo, params.TeamName, params.Status)
+	logEntry = withStatsFilter(logEntry, format, filter)
 	logEntry.Info("Getting review statistics")
 
-	stats, err := h.statsUseCase.GetStatsReviews(c.Request().Context())
+	stats, err := h.statsUseCase.GetStatsReviews(c.Request().Context(), filter)
 	if err != nil {
-		logEntry.WithError(err).Error("Failed to get review stats")
-		return c.JSON(http.StatusInternalServerError, toErrorResponse("INTERNAL_ERROR", err.Error()))
+		return statsError(c, logEntry.WithError(err), err, "Failed to get review stats")
 	}
 
 	logEntry.WithField("stats_count", len(stats)).Info("Review stats retrieved")
-	return c.JSON(http.StatusOK, map[string]interface{}{
-		"stats": stats,
-	})
+	return writeStats(c, logEntry, format, "review-stats", toAPIReviewStats(stats),
+		[]string{"user_id", "username", "review_count"},
+		func(stat api.ReviewStat) []string {
+			return []string{csvText(stat.UserId), csvText(stat.Username), strconv.Itoa(stat.ReviewCount)}
+		})
 }
 
 // GetPRAssignmentStats обрабатывает GET запрос для получения статистики по назначению ревьюверов на PR.
-func (h *StatsHandler) GetStatsPrAssignments(c echo.Context) error {
+func (h *StatsHandler) GetStatsPrAssignments(c echo.Context, params api.GetStatsPrAssignmentsParams) error {
 	logEntry := h.logRequest(c, "get_pr_assignment_stats")
+	format, err := negotiateStatsFormat(c, params.Format)
+	if err != nil {
+		logEntry.WithError(err).Warn("Invalid stats format")
+		return c.JSON(getHTTPStatusCode(err), toAPIErrorResponse(domain.ErrorMapping[err]))
+	}
+	filter := toStatsFilter(params.CreatedFrom, params.CreatedTo, params.TeamName, params.Status)
+	logEntry = withStatsFilter(logEntry, format, filter)
 	logEntry.Info("Getting PR assignment statistics")
 
-	stats, err := h.statsUseCase.GetStatsPrAssignments(c.Request().Context())
+	stats, err := h.statsUseCase.GetStatsPrAssignments(c.Request().Context(), filter)
 	if err != nil {
-		logEntry.WithError(err).Error("Failed to get PR assignment stats")
-		return c.JSON(http.StatusInternalServerError, toErrorResponse("INTERNAL_ERROR", err.Error()))
+		return statsError(c, logEntry.WithError(err), err, "Failed to get PR assignment stats")
 	}
 
 	logEntry.WithField("stats_count", len(stats)).Info("PR assignment stats retrieved")
-	return c.JSON(http.StatusOK, map[string]interface{}{
-		"stats": stats,
+	return writeStats(c, logEntry, format, "pr-assignment-stats", toAPIPRAssignmentStats(stats),
+		[]string{"pull_request_id", "pull_request_name", "reviewers_count"},
+		func(stat api.PRAssignmentStat) []string {
+			return []string{csvText(stat.PullRequestId), csvText(stat.PullRequestName), strconv.Itoa(stat.ReviewersCount)}
+		})
+}
+
+// GetStatsTeams обрабатывает GET запрос для получения показателей ревью по командам.
+func (h *StatsHandler) GetStatsTeams(c echo.Context, params api.GetStatsTeamsParams) error {
+	logEntry := h.logRequest(c, "get_team_stats")
+	format, err := negotiateStatsFormat(c, params.Format)
+	if err != nil {
+		logEntry.WithError(err).Warn("Invalid stats format")
+		return c.JSON(getHTTPStatusCode(err), toAPIErrorResponse(domain.ErrorMapping[err]))
+	}
+	filter := toStatsFilter(params.CreatedFrom, params.CreatedTo, params.TeamName, params.Status)
+	logEntry = withStatsFilter(logEntry, format, filter)
+	logEntry.Info("Getting team statistics")
+
+	stats, err := h.statsUseCase.GetStatsTeams(c.Request().Context(), filter)
+	if err != nil {
+		return statsError(c, logEntry.WithError(err), err, "Failed to get team stats")
+	}
+
+	logEntry.WithField("stats_count", len(stats)).Info("Team stats retrieved")
+	return writeStats(c, logEntry, format, "team-stats", toAPITeamReviewStats(stats),
+		[]string{"team_name", "pull_requests", "open_pull_requests", "avg_reviewers_per_pr",
+			"reviews_given", "reviews_received", "cross_team_reviews_share"},
+		func(stat api.TeamReviewStat) []string {
+			return []string{csvText(stat.TeamName), strconv.Itoa(stat.PullRequests), strconv.Itoa(stat.OpenPullRequests),
+				formatStatsFloat(stat.AvgReviewersPerPr), strconv.Itoa(stat.ReviewsGiven),
+				strconv.Itoa(stat.ReviewsReceived), formatStatsFloat(stat.CrossTeamReviewsShare)}
+		})
+}
+
+// GetStatsFairness обрабатывает GET запрос для получения отчета о справедливости распределения ревью.
+// JSON содержит отчет целиком, CSV и NDJSON - по строке на участника с показателями его команды.
+func (h *StatsHandler) GetStatsFairness(c echo.Context, params api.GetStatsFairnessParams) error {
+	logEntry := h.logRequest(c, "get_fairness_report")
+	format, err := negotiateStatsFormat(c, params.Format)
+	if err != nil {
+		logEntry.WithError(err).Warn("Invalid stats format")
+		return c.JSON(getHTTPStatusCode(err), toAPIErrorResponse(domain.ErrorMapping[err]))
+	}
+	filter := toStatsFilter(params.CreatedFrom, params.CreatedTo, params.TeamName, nil)
+	logEntry = withStatsFilter(logEntry, format, filter)
+	logEntry.Info("Getting fairness report")
+
+	report, err := h.statsUseCase.GetFairnessReport(c.Request().Context(), filter)
+	if err != nil {
+		return statsError(c, logEntry.WithError(err), err, "Failed to get fairness report")
+	}
+
+	logEntry.WithField("teams_count", len(report.Teams)).Info("Fairness report retrieved")
+	apiReport := toAPIFairnessReport(report)
+	if format == api.Json {
+		return c.JSON(http.StatusOK, apiReport)
+	}
+	return writeStats(c, logEntry, format, "fairness-report", toFairnessRows(apiReport),
+		[]string{"team_name", "team_gini", "team_max_min_spread", "user_id", "username", "active_days",
+			"assignments", "expected_assignments", "deviation", "outlier"},
+		func(row fairnessRow) []string {
+			return []string{csvText(row.TeamName), formatStatsFloat(row.TeamGini), formatStatsFloat(row.TeamMaxMinSpread),
+				csvText(row.UserID), csvText(row.Username), formatStatsFloat(row.ActiveDays), strconv.Itoa(row.Assignments),
+				formatStatsFloat(row.ExpectedAssignments), formatStatsFloat(row.Deviation), row.Outlier}
+		})
+}
+
+// fairnessRow - строка отчета о справедливости в CSV и NDJSON: участник и показатели его команды
+type fairnessRow struct {
+	TeamName            string  `json:"team_name"`
+	TeamGini            float64 `json:"team_gini"`
+	TeamMaxMinSpread    float64 `json:"team_max_min_spread"`
+	UserID              string  `json:"user_id"`
+	Username            string  `json:"username"`
+	ActiveDays          float64 `json:"active_days"`
+	Assignments         int     `json:"assignments"`
+	ExpectedAssignments float64 `json:"expected_assignments"`
+	Deviation           float64 `json:"deviation"`
+	Outlier             string  `json:"outlier,omitempty"`
+}
+
+// toFairnessRows разворачивает отчет в строки по участникам
+func toFairnessRows(report api.FairnessReport) []fairnessRow {
+	var rows []fairnessRow
+	for _, team := range report.Teams {
+		for _, member := range team.Members {
+			row := fairnessRow{
+				TeamName:            team.TeamName,
+				TeamGini:            team.Gini,
+				TeamMaxMinSpread:    team.MaxMinSpread,
+				UserID:              member.UserId,
+				Username:            member.Username,
+				ActiveDays:          member.ActiveDays,
+				Assignments:         member.Assignments,
+				ExpectedAssignments: member.ExpectedAssignments,
+				Deviation:           member.Deviation,
+			}
+			if member.Outlier != nil {
+				row.Outlier = string(*member.Outlier)
+			}
+			rows = append(rows, row)
+		}
+	}
+	return rows
+}
+
+// csvText экранирует текстовую ячейку CSV: значение, начинающееся с =, +, -, @, табуляции или
+// перевода каретки, табличный редактор выполнит как формулу, поэтому перед ним ставится апостроф.
+// Числовые ячейки через csvText не проходят, иначе отрицательные значения станут текстом.
+func csvText(value string) string {
+	if value != "" && strings.ContainsRune("=+-@\t\r", rune(value[0])) {
+		return "'" + value
+	}
+	return value
+}
+
+// formatStatsFloat записывает дробный показатель в CSV без лишних нулей
+func formatStatsFloat(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
+
+// withStatsFilter добавляет в лог формат ответа и заданные поля фильтра
+func withStatsFilter(logEntry *logrus.Entry, format api.StatsFormat, filter *domain.StatsFilter) *logrus.Entry {
+	return logEntry.WithFields(logrus.Fields{
+		"format":       format,
+		"team_name":    filter.TeamName,
+		"status":       filter.Status,
+		"created_from": filter.CreatedFrom,
+		"created_to":   filter.CreatedTo,
 	})
 }
+
+// statsError отвечает ошибкой проверки фильтра (400) или внутренней ошибкой
+func statsError(c echo.Context, logEntry *logrus.Entry, err error, message string) error {
+	if httpErr, exists := domain.ToHTTPError(err); exists {
+		logEntry.Warn(message)
+		return c.JSON(getHTTPStatusCode(err), toAPIErrorResponse(httpErr))
+	}
+	logEntry.Error(message)
+	return c.JSON(http.StatusInternalServerError, toErrorResponse("INTERNAL_ERROR", err.Error()))
+}
+
+// negotiateStatsFormat выбирает формат ответа: параметр format важнее заголовка Accept,
+// из Accept берется первый поддерживаемый тип, по умолчанию - JSON
+func negotiateStatsFormat(c echo.Context, format *api.StatsFormat) (api.StatsFormat, error) {
+	if format != nil {
+		switch *format {
+		case api.Csv, api.Json, api.Ndjson:
+			return *format, nil
+		default:
+			return "", domain.ErrInvalidStatsFormat
+		}
+	}
+
+	for _, accepted := range strings.Split(c.Request().Header.Get(echo.HeaderAccept), ",") {
+		mediaType, _, _ := strings.Cut(accepted, ";")
+		switch strings.ToLower(strings.TrimSpace(mediaType)) {
+		case "text/csv":
+			return api.Csv, nil
+		case NDJSONContentType:
+			return api.Ndjson, nil
+		case echo.MIMEApplicationJSON:
+			return api.Json, nil
+		}
+	}
+	return api.Json, nil
+}
+
+// writeStats отдает статистику в выбранном формате. CSV начинается со строки columns
+// (имена полей JSON), row преобразует запись статистики в строку CSV.
+func writeStats[T any](c echo.Context, logEntry *logrus.Entry, format api.StatsFormat, name string,
+	stats []T, columns []string, row func(T) []string) error {
+	if format == api.Json {
+		return c.JSON(http.StatusOK, map[string]interface{}{
+			"stats": stats,
+		})
+	}
+
+	header := c.Response().Header()
+	if format == api.Csv {
+		header.Set(echo.HeaderContentType, CSVContentType)
+		header.Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", name+".csv"))
+	} else {
+		header.Set(echo.HeaderContentType, NDJSONContentType)
+	}
+	c.Response().WriteHeader(http.StatusOK)
+
+	// Статус уже отправлен: при ошибке записи клиент получит обрезанный ответ
+	var err error
+	if format == api.Csv {
+		writer := csv.NewWriter(c.Response())
+		_ = writer.Write(columns)
+		for _, stat := range stats {
+			_ = writer.Write(row(stat))
+		}
+		writer.Flush()
+		err = writer.Error()
+	} else {
+		encoder := json.NewEncoder(c.Response())
+		for _, stat := range stats {
+			if err = encoder.Encode(stat); err != nil {
+				break
+			}
+		}
+	}
+	if err != nil {
+		logEntry.WithError(err).Warn("Failed to write stats")
+	}
+	return nil
+}
@@ -38,7 +38,8 @@ func (h *TeamHandler) PostTeamAdd(c echo.Context) error {
 	logEntry = logEntry.WithField("team_name", req.TeamName)
 
 	team := &domain.Team{
-		Name: req.TeamName,
+		Name:       req.TeamName,
+		ParentName: fromOptionalString(req.ParentTeamName),
 	}
 
 	for _, member := range req.Members {
@@ -82,6 +83,57 @@ func (h *TeamHandler) GetTeamGet(c echo.Context, params api.GetTeamGetParams) er
 	return c.JSON(http.StatusOK, toAPITeam(team))
 }
 
+// PostTeamSetParent обрабатывает перемещение команды в иерархии
+func (h *TeamHandler) PostTeamSetParent(c echo.Context) error {
+	var req api.PostTeamSetParentJSONBody
+	if err := c.Bind(&req); err != nil {
+		h.logRequest(c, "set_team_parent").WithError(err).Warn("Failed to bind set parent request")
+		return c.JSON(http.StatusBadRequest, toErrorResponse("INVALID_REQUEST", err.Error()))
+	}
+
+	parentName := fromOptionalString(req.ParentTeamName)
+	logEntry := h.logRequest(c, "set_team_parent").WithFields(logrus.Fields{
+		"team_name":   req.TeamName,
+		"parent_team": parentName,
+	})
+	logEntry.Info("Setting team parent")
+
+	team, err := h.teamUseCase.SetTeamParent(c.Request().Context(), req.TeamName, parentName)
+	if err != nil {
+		logEntry.WithError(err).Error("Failed to set team parent")
+		if httpErr, exists := domain.ToHTTPError(err); exists {
+			return c.JSON(getHTTPStatusCode(err), toAPIErrorResponse(httpErr))
+		}
+		return c.JSON(http.StatusInternalServerError, toErrorResponse("INTERNAL_ERROR", err.Error()))
+	}
+
+	logEntry.Info("Team parent updated successfully")
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"team": toAPITeam(team),
+	})
+}
+
+// GetTeamTree обрабатывает получение иерархии команд со статистикой по поддеревьям
+func (h *TeamHandler) GetTeamTree(c echo.Context, params api.GetTeamTreeParams) error {
+	rootName := fromOptionalString(params.TeamName)
+	logEntry := h.logRequest(c, "get_team_tree").WithField("team_name", rootName)
+	logEntry.Info("Getting team tree")
+
+	tree, err := h.teamUseCase.GetTeamTree(c.Request().Context(), rootName)
+	if err != nil {
+		logEntry.WithError(err).Warn("Failed to get team tree")
+		if httpErr, exists := domain.ToHTTPError(err); exists {
+			return c.JSON(getHTTPStatusCode(err), toAPIErrorResponse(httpErr))
+		}
+		return c.JSON(http.StatusInternalServerError, toErrorResponse("INTERNAL_ERROR", err.Error()))
+	}
+
+	logEntry.WithField("roots_count", len(tree)).Info("Team tree retrieved successfully")
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"teams": toAPITeamTree(tree),
+	})
+}
+
 // PostTeamDeactivate обрабатывает массовую деактивацию пользователей команды
 func (h *TeamHandler) PostTeamDeactivate(c echo.Context) error {
 	var req struct {
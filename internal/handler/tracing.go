@@ -0,0 +1,52 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"pr-reviewer-service/internal/logging"
+	"pr-reviewer-service/internal/tracing"
+
+	"github.com/labstack/echo/v4"
+)
+
+// TracingMiddleware открывает серверный спан на каждый запрос, продолжая трассу
+// из заголовка traceparent (W3C Trace Context), и кладет его в контекст запроса.
+func TracingMiddleware(tracer *tracing.Tracer) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+			parent, _ := tracing.ParseTraceparent(req.Header.Get(tracing.TraceparentHeader))
+
+			route := c.Path()
+			if route == "" {
+				route = unmatchedOperation
+			}
+
+			ctx, span := tracer.StartRemote(req.Context(), parent, req.Method+" "+route, tracing.KindServer)
+			defer span.End()
+			span.SetAttribute("http.method", req.Method)
+			span.SetAttribute("http.route", route)
+			if requestID := logging.RequestIDFromContext(ctx); requestID != "" {
+				span.SetAttribute("http.request_id", requestID)
+			}
+			c.SetRequest(req.WithContext(ctx))
+
+			err := next(c)
+
+			status := c.Response().Status
+			var httpErr *echo.HTTPError
+			if !c.Response().Committed && errors.As(err, &httpErr) {
+				status = httpErr.Code
+			}
+			span.SetAttribute("http.status_code", status)
+			if err != nil {
+				span.RecordError(err)
+			} else if status >= http.StatusInternalServerError {
+				span.RecordError(errors.New(http.StatusText(status)))
+			}
+
+			return err
+		}
+	}
+}
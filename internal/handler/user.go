@@ -28,7 +28,7 @@ func NewUserHandler(userUseCase domain.UserUseCase, logger *logrus.Logger) *User
 func (h *UserHandler) PostUsersSetIsActive(c echo.Context) error {
 	var req api.PostUsersSetIsActiveJSONBody
 	if err := c.Bind(&req); err != nil {
-		h.logger.WithError(err).Warn("Failed to bind set active request")
+		h.logRequest(c, "set_user_active").WithError(err).Warn("Failed to bind set active request")
 		return c.JSON(http.StatusBadRequest, toErrorResponse("INVALID_REQUEST", err.Error()))
 	}
 
@@ -53,12 +53,20 @@ func (h *UserHandler) PostUsersSetIsActive(c echo.Context) error {
 	})
 }
 
-// GetUsersGetReview обрабатывает запрос для получения списка PR, назначенных пользователю на ревью.
+// GetUsersGetReview обрабатывает запрос для получения страницы PR, назначенных пользователю на ревью.
 func (h *UserHandler) GetUsersGetReview(c echo.Context, params api.GetUsersGetReviewParams) error {
-	logEntry := h.logRequest(c, "get_user_reviews").WithField("user_id", params.UserId)
+	limit := 0
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+
+	logEntry := h.logRequest(c, "get_user_reviews").WithFields(logrus.Fields{
+		"user_id": params.UserId,
+		"limit":   limit,
+	})
 	logEntry.Info("Getting user review PRs")
 
-	prs, err := h.userUseCase.GetUserReviewPRs(c.Request().Context(), params.UserId)
+	page, err := h.userUseCase.GetUserReviewPRs(c.Request().Context(), params.UserId, fromOptionalString(params.Cursor), limit)
 	if err != nil {
 		logEntry.WithError(err).Warn("Failed to get user review PRs")
 		if httpErr, exists := domain.ToHTTPError(err); exists {
@@ -67,9 +75,68 @@ func (h *UserHandler) GetUsersGetReview(c echo.Context, params api.GetUsersGetRe
 		return c.JSON(http.StatusInternalServerError, toErrorResponse("INTERNAL_ERROR", err.Error()))
 	}
 
-	logEntry.WithField("prs_count", len(prs)).Info("User review PRs retrieved successfully")
+	logEntry.WithField("prs_count", len(page.PullRequests)).Info("User review PRs retrieved successfully")
 	return c.JSON(http.StatusOK, map[string]interface{}{
 		"user_id":       params.UserId,
-		"pull_requests": toAPIPRShorts(prs),
+		"pull_requests": toAPIPRShorts(page.PullRequests),
+		"next_cursor":   toOptionalString(page.NextCursor),
+	})
+}
+
+// PostUsersSetSkills обрабатывает запрос для замены тегов навыков пользователя.
+func (h *UserHandler) PostUsersSetSkills(c echo.Context) error {
+	var req api.PostUsersSetSkillsJSONBody
+	if err := c.Bind(&req); err != nil {
+		h.logRequest(c, "set_user_skills").WithError(err).Warn("Failed to bind set skills request")
+		return c.JSON(http.StatusBadRequest, toErrorResponse("INVALID_REQUEST", err.Error()))
+	}
+
+	logEntry := h.logRequest(c, "set_user_skills").WithFields(logrus.Fields{
+		"user_id": req.UserId,
+		"skills":  req.Skills,
+	})
+	logEntry.Info("Setting user skills")
+
+	user, err := h.userUseCase.SetUserSkills(c.Request().Context(), req.UserId, req.Skills)
+	if err != nil {
+		logEntry.WithError(err).Error("Failed to set user skills")
+		if httpErr, exists := domain.ToHTTPError(err); exists {
+			return c.JSON(getHTTPStatusCode(err), toAPIErrorResponse(httpErr))
+		}
+		return c.JSON(http.StatusInternalServerError, toErrorResponse("INTERNAL_ERROR", err.Error()))
+	}
+
+	logEntry.Info("User skills updated successfully")
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"user": toAPIUser(user),
+	})
+}
+
+// PostUsersSetOutOfOffice обрабатывает запрос для отметки отсутствия пользователя.
+func (h *UserHandler) PostUsersSetOutOfOffice(c echo.Context) error {
+	var req api.PostUsersSetOutOfOfficeJSONBody
+	if err := c.Bind(&req); err != nil {
+		h.logRequest(c, "set_user_out_of_office").WithError(err).Warn("Failed to bind set out of office request")
+		return c.JSON(http.StatusBadRequest, toErrorResponse("INVALID_REQUEST", err.Error()))
+	}
+
+	logEntry := h.logRequest(c, "set_user_out_of_office").WithFields(logrus.Fields{
+		"user_id": req.UserId,
+		"until":   req.Until,
+	})
+	logEntry.Info("Setting user out of office")
+
+	user, err := h.userUseCase.SetUserOutOfOffice(c.Request().Context(), req.UserId, req.Until)
+	if err != nil {
+		logEntry.WithError(err).Error("Failed to set user out of office")
+		if httpErr, exists := domain.ToHTTPError(err); exists {
+			return c.JSON(getHTTPStatusCode(err), toAPIErrorResponse(httpErr))
+		}
+		return c.JSON(http.StatusInternalServerError, toErrorResponse("INTERNAL_ERROR", err.Error()))
+	}
+
+	logEntry.Info("User out of office updated successfully")
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"user": toAPIUser(user),
 	})
 }
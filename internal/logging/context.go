@@ -0,0 +1,38 @@
+package logging
+
+import (
+	"context"
+
+	"pr-reviewer-service/internal/tracing"
+
+	"github.com/sirupsen/logrus"
+)
+
+// RequestIDHeader - заголовок с идентификатором запроса
+const RequestIDHeader = "X-Request-ID"
+
+type requestIDKey struct{}
+
+// ContextWithRequestID кладет идентификатор запроса в контекст.
+func ContextWithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, requestID)
+}
+
+// RequestIDFromContext возвращает идентификатор запроса или пустую строку.
+func RequestIDFromContext(ctx context.Context) string {
+	requestID, _ := ctx.Value(requestIDKey{}).(string)
+	return requestID
+}
+
+// FromContext возвращает запись лога с идентификаторами запроса и трассы из контекста,
+// чтобы все записи одного запроса можно было связать между собой.
+func FromContext(ctx context.Context, logger *logrus.Logger) *logrus.Entry {
+	fields := logrus.Fields{}
+	if requestID := RequestIDFromContext(ctx); requestID != "" {
+		fields["request_id"] = requestID
+	}
+	if traceID := tracing.TraceIDFromContext(ctx); traceID != "" {
+		fields["trace_id"] = traceID
+	}
+	return logger.WithFields(fields)
+}
@@ -0,0 +1,37 @@
+package logging
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Форматы вывода логов
+const (
+	FormatJSON = "json"
+	FormatText = "text"
+)
+
+// Configure выставляет логгеру уровень (trace, debug, info, warn, error, fatal, panic)
+// и формат вывода (json или text).
+func Configure(logger *logrus.Logger, level, format string) error {
+	parsedLevel, err := logrus.ParseLevel(strings.TrimSpace(level))
+	if err != nil {
+		return fmt.Errorf("invalid log level %q: %w", level, err)
+	}
+
+	var formatter logrus.Formatter
+	switch strings.ToLower(strings.TrimSpace(format)) {
+	case FormatJSON:
+		formatter = &logrus.JSONFormatter{}
+	case FormatText:
+		formatter = &logrus.TextFormatter{FullTimestamp: true}
+	default:
+		return fmt.Errorf("invalid log format %q: expected %s or %s", format, FormatJSON, FormatText)
+	}
+
+	logger.SetLevel(parsedLevel)
+	logger.SetFormatter(formatter)
+	return nil
+}
@@ -0,0 +1,149 @@
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"pr-reviewer-service/internal/domain"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Metrics - метрики сервиса: HTTP-запросы, доменные события и текущая нагрузка.
+// Реализует domain.MetricsRecorder.
+type Metrics struct {
+	registry *Registry
+
+	httpRequestDuration  *HistogramVec
+	prsCreated           *CounterVec
+	prsMerged            *CounterVec
+	reassignments        *CounterVec
+	noReviewerCandidate  *CounterVec
+	deactivationFailures *CounterVec
+	openPRs              *GaugeVec
+	teamOpenReviews      *GaugeVec
+
+	// workloadTeams - команды, чья нагрузка выставлена последним обновлением из базы
+	workloadMu    sync.Mutex
+	workloadTeams map[string]bool
+}
+
+// New создает и регистрирует все метрики сервиса.
+func New() *Metrics {
+	r := NewRegistry()
+
+	return &Metrics{
+		registry: r,
+		httpRequestDuration: r.NewHistogramVec("pr_reviewer_http_request_duration_seconds",
+			"HTTP request latency by operation (route template).", DefaultBuckets,
+			"method", "operation", "status"),
+		prsCreated: r.NewCounterVec("pr_reviewer_prs_created_total",
+			"Pull requests created."),
+		prsMerged: r.NewCounterVec("pr_reviewer_prs_merged_total",
+			"Pull requests merged; idempotent repeats for already merged PRs are not counted."),
+		reassignments: r.NewCounterVec("pr_reviewer_reassignments_total",
+			"Reviewer replacements, including replacements during team deactivation."),
+		noReviewerCandidate: r.NewCounterVec("pr_reviewer_no_reviewer_candidate_total",
+			"Operations rejected because no reviewer candidate was available."),
+		deactivationFailures: r.NewCounterVec("pr_reviewer_deactivation_failures_total",
+			"Team deactivation failures by stage.", "stage"),
+		openPRs: r.NewGaugeVec("pr_reviewer_open_prs",
+			"Open pull requests."),
+		teamOpenReviews: r.NewGaugeVec("pr_reviewer_team_open_reviews",
+			"Open reviews assigned to members of the team.", "team"),
+	}
+}
+
+// Handler возвращает HTTP-обработчик /metrics.
+func (m *Metrics) Handler() http.Handler {
+	return m.registry.Handler()
+}
+
+// ObserveRequest учитывает длительность HTTP-запроса.
+// operation - шаблон маршрута, чтобы число серий не зависело от параметров запроса.
+func (m *Metrics) ObserveRequest(method, operation string, status int, latency time.Duration) {
+	m.httpRequestDuration.Observe(latency.Seconds(), method, operation, strconv.Itoa(status))
+}
+
+// PRCreated учитывает созданный PR.
+func (m *Metrics) PRCreated() {
+	m.prsCreated.Inc()
+}
+
+// PRMerged учитывает успешный мерж PR.
+func (m *Metrics) PRMerged() {
+	m.prsMerged.Inc()
+}
+
+// ReviewerReassigned учитывает замену ревьювера.
+func (m *Metrics) ReviewerReassigned() {
+	m.reassignments.Inc()
+}
+
+// NoReviewerCandidate учитывает отказ из-за отсутствия кандидатов в ревьюверы.
+func (m *Metrics) NoReviewerCandidate() {
+	m.noReviewerCandidate.Inc()
+}
+
+// DeactivationFailed учитывает сбой деактивации команды на указанном этапе.
+func (m *Metrics) DeactivationFailed(stage string) {
+	m.deactivationFailures.Inc(stage)
+}
+
+// RefreshWorkload обновляет gauge открытых PR и нагрузки по командам из базы.
+func (m *Metrics) RefreshWorkload(ctx context.Context, statsRepo domain.StatsRepository) error {
+	openPRs, err := statsRepo.CountOpenPRs(ctx)
+	if err != nil {
+		return err
+	}
+
+	loads, err := statsRepo.GetTeamReviewLoad(ctx)
+	if err != nil {
+		return err
+	}
+
+	teams := make(map[string]bool, len(loads))
+	for _, load := range loads {
+		teams[load.TeamName] = true
+	}
+
+	m.workloadMu.Lock()
+	defer m.workloadMu.Unlock()
+
+	// Удаляются только серии исчезнувших команд, остальные обновляются на месте:
+	// сбор метрик во время обновления не видит пустую нагрузку
+	m.openPRs.Set(float64(openPRs))
+	for team := range m.workloadTeams {
+		if !teams[team] {
+			m.teamOpenReviews.DeleteLabelValues(team)
+		}
+	}
+	for _, load := range loads {
+		m.teamOpenReviews.Set(float64(load.OpenReviews), load.TeamName)
+	}
+	m.workloadTeams = teams
+
+	return nil
+}
+
+// RunWorkloadRefresher периодически обновляет gauge нагрузки до отмены ctx.
+// Первое обновление выполняется сразу при запуске.
+func (m *Metrics) RunWorkloadRefresher(ctx context.Context, statsRepo domain.StatsRepository, interval time.Duration, logger *logrus.Logger) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := m.RefreshWorkload(ctx, statsRepo); err != nil && ctx.Err() == nil {
+			logger.WithError(err).Warn("Failed to refresh workload metrics")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,310 @@
+package metrics
+
+import (
+	"bufio"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Типы метрик в текстовом формате Prometheus
+const (
+	typeCounter   = "counter"
+	typeGauge     = "gauge"
+	typeHistogram = "histogram"
+)
+
+// collector - метрика, которая умеет записать себя в текстовом формате Prometheus
+type collector interface {
+	write(w *bufio.Writer)
+}
+
+// Registry хранит метрики и отдает их в текстовом формате Prometheus (version 0.0.4).
+// Реализация минимальная: counter, gauge и histogram с метками, без внешних зависимостей.
+type Registry struct {
+	mu         sync.Mutex
+	collectors []collector
+}
+
+// NewRegistry создает пустой реестр метрик.
+func NewRegistry() *Registry {
+	return &Registry{}
+}
+
+func (r *Registry) register(c collector) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.collectors = append(r.collectors, c)
+}
+
+// Write записывает все метрики реестра в порядке регистрации.
+func (r *Registry) Write(w io.Writer) error {
+	r.mu.Lock()
+	collectors := append([]collector(nil), r.collectors...)
+	r.mu.Unlock()
+
+	bw := bufio.NewWriter(w)
+	for _, c := range collectors {
+		c.write(bw)
+	}
+	return bw.Flush()
+}
+
+// Handler возвращает HTTP-обработчик для эндпоинта /metrics.
+func (r *Registry) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+		_ = r.Write(w)
+	})
+}
+
+// desc - общее описание метрики: имя, подсказка, тип и имена меток
+type desc struct {
+	name   string
+	help   string
+	kind   string
+	labels []string
+}
+
+func (d *desc) writeHeader(w *bufio.Writer) {
+	w.WriteString("# HELP " + d.name + " " + escapeHelp(d.help) + "\n")
+	w.WriteString("# TYPE " + d.name + " " + d.kind + "\n")
+}
+
+// labelPairs форматирует метки серии; extra добавляется в конец (например, le у гистограммы)
+func (d *desc) labelPairs(values []string, extra ...string) string {
+	if len(d.labels) == 0 && len(extra) == 0 {
+		return ""
+	}
+
+	pairs := make([]string, 0, len(d.labels)+len(extra)/2)
+	for i, label := range d.labels {
+		pairs = append(pairs, label+`="`+escapeLabelValue(values[i])+`"`)
+	}
+	for i := 0; i+1 < len(extra); i += 2 {
+		pairs = append(pairs, extra[i]+`="`+escapeLabelValue(extra[i+1])+`"`)
+	}
+	return "{" + strings.Join(pairs, ",") + "}"
+}
+
+// seriesKey склеивает значения меток в ключ серии; паникует при неверном числе значений
+func (d *desc) seriesKey(values []string) string {
+	if len(values) != len(d.labels) {
+		panic("metrics: " + d.name + ": expected " + strconv.Itoa(len(d.labels)) + " label values")
+	}
+	return strings.Join(values, "\xff")
+}
+
+// valueVec - набор серий с одним числовым значением (counter и gauge)
+type valueVec struct {
+	desc
+	mu     sync.Mutex
+	series map[string]*valueSeries
+}
+
+type valueSeries struct {
+	labels []string
+	value  float64
+}
+
+func newValueVec(r *Registry, kind, name, help string, labels []string) *valueVec {
+	v := &valueVec{
+		desc:   desc{name: name, help: help, kind: kind, labels: labels},
+		series: make(map[string]*valueSeries),
+	}
+	r.register(v)
+	return v
+}
+
+func (v *valueVec) update(values []string, fn func(current float64) float64) {
+	key := v.seriesKey(values)
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	s, ok := v.series[key]
+	if !ok {
+		s = &valueSeries{labels: append([]string(nil), values...)}
+		v.series[key] = s
+	}
+	s.value = fn(s.value)
+}
+
+func (v *valueVec) write(w *bufio.Writer) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	v.writeHeader(w)
+	// Метрика без меток выводится и до первого обновления - со значением 0
+	if len(v.labels) == 0 && len(v.series) == 0 {
+		w.WriteString(v.name + " 0\n")
+		return
+	}
+	for _, key := range sortedKeys(v.series) {
+		s := v.series[key]
+		w.WriteString(v.name + v.labelPairs(s.labels) + " " + formatFloat(s.value) + "\n")
+	}
+}
+
+// CounterVec - монотонно растущий счетчик с метками.
+type CounterVec struct {
+	vec *valueVec
+}
+
+// NewCounterVec регистрирует счетчик; labels - имена меток (могут отсутствовать).
+func (r *Registry) NewCounterVec(name, help string, labels ...string) *CounterVec {
+	return &CounterVec{vec: newValueVec(r, typeCounter, name, help, labels)}
+}
+
+// Inc увеличивает счетчик серии на 1.
+func (c *CounterVec) Inc(labelValues ...string) {
+	c.Add(1, labelValues...)
+}
+
+// Add увеличивает счетчик серии на delta (отрицательные значения игнорируются).
+func (c *CounterVec) Add(delta float64, labelValues ...string) {
+	if delta < 0 {
+		return
+	}
+	c.vec.update(labelValues, func(current float64) float64 { return current + delta })
+}
+
+// GaugeVec - произвольно меняющееся значение с метками.
+type GaugeVec struct {
+	vec *valueVec
+}
+
+// NewGaugeVec регистрирует gauge; labels - имена меток (могут отсутствовать).
+func (r *Registry) NewGaugeVec(name, help string, labels ...string) *GaugeVec {
+	return &GaugeVec{vec: newValueVec(r, typeGauge, name, help, labels)}
+}
+
+// Set устанавливает значение серии.
+func (g *GaugeVec) Set(value float64, labelValues ...string) {
+	g.vec.update(labelValues, func(float64) float64 { return value })
+}
+
+// DeleteLabelValues удаляет серию с указанными значениями меток; false - такой серии не было.
+func (g *GaugeVec) DeleteLabelValues(labelValues ...string) bool {
+	key := g.vec.seriesKey(labelValues)
+
+	g.vec.mu.Lock()
+	defer g.vec.mu.Unlock()
+
+	if _, ok := g.vec.series[key]; !ok {
+		return false
+	}
+	delete(g.vec.series, key)
+	return true
+}
+
+// DefaultBuckets - границы корзин гистограммы по умолчанию (секунды), как в клиенте Prometheus.
+var DefaultBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+// HistogramVec - гистограмма распределения значений с метками.
+type HistogramVec struct {
+	desc
+	buckets []float64
+	mu      sync.Mutex
+	series  map[string]*histogramSeries
+}
+
+type histogramSeries struct {
+	labels []string
+	counts []uint64 // по корзинам, не накопительно
+	count  uint64
+	sum    float64
+}
+
+// NewHistogramVec регистрирует гистограмму; buckets должны быть отсортированы по возрастанию.
+func (r *Registry) NewHistogramVec(name, help string, buckets []float64, labels ...string) *HistogramVec {
+	h := &HistogramVec{
+		desc:    desc{name: name, help: help, kind: typeHistogram, labels: labels},
+		buckets: buckets,
+		series:  make(map[string]*histogramSeries),
+	}
+	r.register(h)
+	return h
+}
+
+// Observe добавляет наблюдение в серию.
+func (h *HistogramVec) Observe(value float64, labelValues ...string) {
+	key := h.seriesKey(labelValues)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	s, ok := h.series[key]
+	if !ok {
+		s = &histogramSeries{
+			labels: append([]string(nil), labelValues...),
+			counts: make([]uint64, len(h.buckets)),
+		}
+		h.series[key] = s
+	}
+
+	if i := sort.SearchFloat64s(h.buckets, value); i < len(h.buckets) {
+		s.counts[i]++
+	}
+	s.count++
+	s.sum += value
+}
+
+func (h *HistogramVec) write(w *bufio.Writer) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.writeHeader(w)
+	for _, key := range sortedKeys(h.series) {
+		s := h.series[key]
+		var cumulative uint64
+		for i, upper := range h.buckets {
+			cumulative += s.counts[i]
+			w.WriteString(h.name + "_bucket" + h.labelPairs(s.labels, "le", formatFloat(upper)) +
+				" " + strconv.FormatUint(cumulative, 10) + "\n")
+		}
+		w.WriteString(h.name + "_bucket" + h.labelPairs(s.labels, "le", "+Inf") +
+			" " + strconv.FormatUint(s.count, 10) + "\n")
+		w.WriteString(h.name + "_sum" + h.labelPairs(s.labels) + " " + formatFloat(s.sum) + "\n")
+		w.WriteString(h.name + "_count" + h.labelPairs(s.labels) + " " + strconv.FormatUint(s.count, 10) + "\n")
+	}
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func formatFloat(value float64) string {
+	switch {
+	case math.IsInf(value, 1):
+		return "+Inf"
+	case math.IsInf(value, -1):
+		return "-Inf"
+	case math.IsNaN(value):
+		return "NaN"
+	default:
+		return strconv.FormatFloat(value, 'g', -1, 64)
+	}
+}
+
+var (
+	helpEscaper  = strings.NewReplacer(`\`, `\\`, "\n", `\n`)
+	labelEscaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`)
+)
+
+func escapeHelp(help string) string {
+	return helpEscaper.Replace(help)
+}
+
+func escapeLabelValue(value string) string {
+	return labelEscaper.Replace(value)
+}
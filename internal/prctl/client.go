@@ -0,0 +1,106 @@
+package prctl
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"pr-reviewer-service/api"
+)
+
+// APIError - ошибка, которую вернул сервис в формате ErrorResponse.
+type APIError struct {
+	StatusCode int
+	Code       string
+	Message    string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Code, e.Message)
+}
+
+// client выполняет запросы к HTTP API сервиса
+type client struct {
+	baseURL string
+	token   string
+	http    *http.Client
+}
+
+func newClient(profile Profile, httpClient *http.Client) *client {
+	return &client{
+		baseURL: strings.TrimRight(profile.BaseURL, "/"),
+		token:   profile.Token,
+		http:    httpClient,
+	}
+}
+
+// get выполняет GET-запрос и возвращает тело ответа
+func (c *client) get(ctx context.Context, path string, query url.Values) ([]byte, error) {
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+	return c.do(ctx, http.MethodGet, path, nil)
+}
+
+// post выполняет POST-запрос с JSON-телом и возвращает тело ответа
+func (c *client) post(ctx context.Context, path string, body interface{}) ([]byte, error) {
+	return c.do(ctx, http.MethodPost, path, body)
+}
+
+func (c *client) do(ctx context.Context, method, path string, body interface{}) ([]byte, error) {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode request: %w", err)
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, reader)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return nil, decodeAPIError(resp.StatusCode, data)
+	}
+	return data, nil
+}
+
+// decodeAPIError разбирает ErrorResponse; если тело в другом формате, код берется из HTTP-статуса
+func decodeAPIError(status int, data []byte) error {
+	var errResp api.ErrorResponse
+	if err := json.Unmarshal(data, &errResp); err == nil && errResp.Error.Code != "" {
+		return &APIError{StatusCode: status, Code: string(errResp.Error.Code), Message: errResp.Error.Message}
+	}
+
+	message := strings.TrimSpace(string(data))
+	if message == "" {
+		message = http.StatusText(status)
+	}
+	return &APIError{StatusCode: status, Code: fmt.Sprintf("HTTP_%d", status), Message: message}
+}
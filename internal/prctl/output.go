@@ -0,0 +1,75 @@
+package prctl
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+)
+
+// printer выводит результат команды таблицей или JSON (для скриптов)
+type printer struct {
+	w    io.Writer
+	json bool
+}
+
+// raw печатает тело ответа API с отступами
+func (p *printer) raw(data []byte) error {
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, data, "", "  "); err != nil {
+		return fmt.Errorf("failed to format response: %w", err)
+	}
+	buf.WriteByte('\n')
+	_, err := buf.WriteTo(p.w)
+	return err
+}
+
+// value печатает значение как JSON с отступами
+func (p *printer) value(v interface{}) error {
+	enc := json.NewEncoder(p.w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+// table печатает строки с выровненными колонками
+func (p *printer) table(header []string, rows [][]string) error {
+	tw := tabwriter.NewWriter(p.w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, strings.Join(header, "\t"))
+	for _, row := range rows {
+		fmt.Fprintln(tw, strings.Join(row, "\t"))
+	}
+	return tw.Flush()
+}
+
+// fields печатает пары "ключ: значение" в заданном порядке
+func (p *printer) fields(pairs ...[2]string) error {
+	tw := tabwriter.NewWriter(p.w, 0, 0, 1, ' ', 0)
+	for _, pair := range pairs {
+		fmt.Fprintf(tw, "%s:\t%s\n", pair[0], pair[1])
+	}
+	return tw.Flush()
+}
+
+// decode разбирает тело ответа для табличного вывода
+func decode(data []byte, v interface{}) error {
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
+
+func formatBool(b bool) string {
+	if b {
+		return "yes"
+	}
+	return "no"
+}
+
+func formatList(items []string) string {
+	if len(items) == 0 {
+		return "-"
+	}
+	return strings.Join(items, ", ")
+}
@@ -0,0 +1,185 @@
+// Package prctl реализует административную утилиту командной строки для HTTP API сервиса.
+package prctl
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	outputTable = "table"
+	outputJSON  = "json"
+)
+
+// errUsage - неверные аргументы; справка уже выведена
+var errUsage = errors.New("usage error")
+
+// app - состояние одного запуска утилиты
+type app struct {
+	client *client
+	out    *printer
+	stdin  io.Reader
+	stderr io.Writer
+}
+
+// command - обработчик подкоманды: разбирает свои флаги и выполняет запросы
+type command func(ctx context.Context, a *app, args []string) error
+
+// commands - подкоманды в виде "<ресурс> <действие>"
+var commands = map[string]command{
+	"team create":       teamCreate,
+	"team get":          teamGet,
+	"team deactivate":   teamDeactivate,
+	"user activate":     userSetActive(true),
+	"user deactivate":   userSetActive(false),
+	"pr create":         prCreate,
+	"pr merge":          prMerge,
+	"pr reassign":       prReassign,
+	"stats reviews":     statsReviews,
+	"stats assignments": statsAssignments,
+	"stats teams":       statsTeams,
+	"stats fairness":    statsFairness,
+}
+
+// Run разбирает аргументы, выполняет команду и возвращает код завершения:
+// 0 - успех, 1 - ошибка выполнения или ответ API с ошибкой, 2 - неверные аргументы.
+func Run(ctx context.Context, args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("prctl", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Usage = func() { usage(stderr) }
+
+	configPath := fs.String("config", DefaultConfigPath(), "путь к файлу профилей")
+	profileName := fs.String("profile", "", "имя профиля (по умолчанию $PRCTL_PROFILE или current из файла)")
+	output := fs.String("output", outputTable, "формат вывода: table или json")
+	fs.StringVar(output, "o", outputTable, "сокращение для --output")
+	timeout := fs.Duration("timeout", 30*time.Second, "таймаут всей команды")
+
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+		return 2
+	}
+
+	rest := fs.Args()
+	if len(rest) == 0 || rest[0] == "help" {
+		usage(stdout)
+		return 0
+	}
+	if len(rest) < 2 {
+		fmt.Fprintf(stderr, "prctl: missing action for %q\n\n", rest[0])
+		usage(stderr)
+		return 2
+	}
+
+	cmd, ok := commands[rest[0]+" "+rest[1]]
+	if !ok {
+		fmt.Fprintf(stderr, "prctl: unknown command %q\n\n", rest[0]+" "+rest[1])
+		usage(stderr)
+		return 2
+	}
+	if *output != outputTable && *output != outputJSON {
+		fmt.Fprintf(stderr, "prctl: unknown output format %q (want table or json)\n", *output)
+		return 2
+	}
+
+	profile, err := LoadProfile(*configPath, *profileName)
+	if err != nil {
+		fmt.Fprintf(stderr, "prctl: %v\n", err)
+		return 1
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, *timeout)
+	defer cancel()
+
+	a := &app{
+		client: newClient(profile, &http.Client{}),
+		out:    &printer{w: stdout, json: *output == outputJSON},
+		stdin:  stdin,
+		stderr: stderr,
+	}
+	err = cmd(ctx, a, rest[2:])
+	switch {
+	case err == nil:
+		return 0
+	case errors.Is(err, flag.ErrHelp):
+		return 0
+	case errors.Is(err, errUsage):
+		return 2
+	default:
+		fmt.Fprintf(stderr, "prctl: %v\n", err)
+		return 1
+	}
+}
+
+func usage(w io.Writer) {
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	fmt.Fprintf(w, `Usage: prctl [--profile name] [--config path] [-o table|json] [--timeout 30s] <command> [flags]
+
+Commands:
+  %s
+
+Подключение берется из профиля (%s), PRCTL_BASE_URL и PRCTL_TOKEN переопределяют его.
+Справка по флагам команды: prctl <command> --help.
+`, strings.Join(names, "\n  "), DefaultConfigPath())
+}
+
+// parseFlags разбирает флаги команды, допуская их вперемешку с позиционными аргументами
+// ("pr reassign pr-1 --old u1"), и проверяет число позиционных аргументов.
+func parseFlags(a *app, fs *flag.FlagSet, args []string, positional ...string) ([]string, error) {
+	fs.SetOutput(a.stderr)
+	if len(positional) > 0 {
+		fs.Usage = func() {
+			fmt.Fprintf(a.stderr, "Usage: prctl %s <%s> [flags]\n", fs.Name(), strings.Join(positional, "> <"))
+			fs.PrintDefaults()
+		}
+	}
+
+	var values []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			if errors.Is(err, flag.ErrHelp) {
+				return nil, err
+			}
+			return nil, errUsage
+		}
+		args = fs.Args()
+		if len(args) == 0 {
+			break
+		}
+		values = append(values, args[0])
+		args = args[1:]
+	}
+
+	if len(values) != len(positional) {
+		fs.Usage()
+		return nil, errUsage
+	}
+	return values, nil
+}
+
+// stringList - повторяемый флаг со списком значений, допускает и "a,b"
+type stringList []string
+
+func (l *stringList) String() string { return strings.Join(*l, ",") }
+
+func (l *stringList) Set(value string) error {
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			*l = append(*l, item)
+		}
+	}
+	return nil
+}
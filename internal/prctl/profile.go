@@ -0,0 +1,107 @@
+package prctl
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// defaultBaseURL - адрес сервиса, если он не задан ни в профиле, ни в окружении
+	defaultBaseURL = "http://localhost:8080"
+	// defaultProfileName - профиль, который используется без --profile и current
+	defaultProfileName = "default"
+)
+
+// Profile - параметры подключения к одному экземпляру сервиса.
+type Profile struct {
+	BaseURL string `yaml:"base_url"`
+	Token   string `yaml:"token"`
+}
+
+// profileFile - формат файла профилей:
+//
+//	current: prod
+//	profiles:
+//	  prod:
+//	    base_url: https://pr-reviewer.example.com
+//	    token: secret
+type profileFile struct {
+	Current  string             `yaml:"current"`
+	Profiles map[string]Profile `yaml:"profiles"`
+}
+
+// DefaultConfigPath возвращает путь к файлу профилей: $PRCTL_CONFIG или <UserConfigDir>/prctl/config.yaml.
+func DefaultConfigPath() string {
+	if path := os.Getenv("PRCTL_CONFIG"); path != "" {
+		return path
+	}
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "prctl", "config.yaml")
+}
+
+// LoadProfile читает профиль name из файла path. Пустое имя - профиль из PRCTL_PROFILE,
+// затем из поля current, затем "default". Переменные PRCTL_BASE_URL и PRCTL_TOKEN
+// переопределяют значения профиля. Отсутствующий файл не ошибка, если профиль не указан явно.
+func LoadProfile(path, name string) (Profile, error) {
+	if name == "" {
+		name = os.Getenv("PRCTL_PROFILE")
+	}
+	explicit := name != ""
+
+	file, err := readProfileFile(path)
+	if err != nil {
+		return Profile{}, err
+	}
+
+	if name == "" {
+		name = file.Current
+	}
+	if name == "" {
+		name = defaultProfileName
+	}
+
+	profile, ok := file.Profiles[name]
+	if !ok && explicit {
+		return Profile{}, fmt.Errorf("profile %q not found in %s", name, path)
+	}
+
+	if baseURL := os.Getenv("PRCTL_BASE_URL"); baseURL != "" {
+		profile.BaseURL = baseURL
+	}
+	if token := os.Getenv("PRCTL_TOKEN"); token != "" {
+		profile.Token = token
+	}
+	if profile.BaseURL == "" {
+		profile.BaseURL = defaultBaseURL
+	}
+
+	return profile, nil
+}
+
+func readProfileFile(path string) (profileFile, error) {
+	var file profileFile
+	if path == "" {
+		return file, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return file, nil
+	}
+	if err != nil {
+		return file, fmt.Errorf("failed to read profiles: %w", err)
+	}
+
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return file, fmt.Errorf("failed to parse profiles %s: %w", path, err)
+	}
+	return file, nil
+}
@@ -0,0 +1,153 @@
+package prctl
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strconv"
+
+	"pr-reviewer-service/api"
+)
+
+// prCreate создает PR; ревьюверы назначаются сервисом
+func prCreate(ctx context.Context, a *app, args []string) error {
+	fs := flag.NewFlagSet("pr create", flag.ContinueOnError)
+	id := fs.String("id", "", "идентификатор PR")
+	name := fs.String("name", "", "название PR")
+	author := fs.String("author", "", "идентификатор автора")
+	repository := fs.String("repo", "", "репозиторий (для правил владения кодом)")
+	var changedFiles, tags stringList
+	fs.Var(&changedFiles, "changed-file", "измененный путь (можно повторять или перечислить через запятую)")
+	fs.Var(&tags, "tag", "требуемый навык ревьювера (можно повторять)")
+	if _, err := parseFlags(a, fs, args); err != nil {
+		return err
+	}
+	if *id == "" || *name == "" || *author == "" {
+		fmt.Fprintln(a.stderr, "pr create: --id, --name and --author are required")
+		fs.Usage()
+		return errUsage
+	}
+
+	body := api.PostPullRequestCreateJSONBody{
+		PullRequestId:   *id,
+		PullRequestName: *name,
+		AuthorId:        *author,
+	}
+	if *repository != "" {
+		body.Repository = repository
+	}
+	if len(changedFiles) > 0 {
+		files := []string(changedFiles)
+		body.ChangedFiles = &files
+	}
+	if len(tags) > 0 {
+		required := []string(tags)
+		body.RequiredTags = &required
+	}
+
+	data, err := a.client.post(ctx, "/pullRequest/create", body)
+	if err != nil {
+		return err
+	}
+	if a.out.json {
+		return a.out.raw(data)
+	}
+
+	var resp struct {
+		PR                api.PullRequest         `json:"pr"`
+		ReviewerSelection []api.ReviewerSelection `json:"reviewer_selection"`
+	}
+	if err := decode(data, &resp); err != nil {
+		return err
+	}
+	if err := printPullRequest(a.out, resp.PR); err != nil {
+		return err
+	}
+	if len(resp.ReviewerSelection) == 0 {
+		return nil
+	}
+
+	fmt.Fprintln(a.out.w)
+	rows := make([][]string, 0, len(resp.ReviewerSelection))
+	for _, selection := range resp.ReviewerSelection {
+		openReviews := "-"
+		if selection.OpenReviews != nil {
+			openReviews = strconv.Itoa(*selection.OpenReviews)
+		}
+		rows = append(rows, []string{selection.UserId, string(selection.Reason), openReviews})
+	}
+	return a.out.table([]string{"REVIEWER", "REASON", "OPEN_REVIEWS"}, rows)
+}
+
+// prMerge переводит PR в MERGED
+func prMerge(ctx context.Context, a *app, args []string) error {
+	fs := flag.NewFlagSet("pr merge", flag.ContinueOnError)
+	values, err := parseFlags(a, fs, args, "pull_request_id")
+	if err != nil {
+		return err
+	}
+
+	data, err := a.client.post(ctx, "/pullRequest/merge", api.PostPullRequestMergeJSONBody{PullRequestId: values[0]})
+	if err != nil {
+		return err
+	}
+	if a.out.json {
+		return a.out.raw(data)
+	}
+
+	var resp struct {
+		PR api.PullRequest `json:"pr"`
+	}
+	if err := decode(data, &resp); err != nil {
+		return err
+	}
+	return printPullRequest(a.out, resp.PR)
+}
+
+// prReassign заменяет ревьювера --old на другого участника его команды
+func prReassign(ctx context.Context, a *app, args []string) error {
+	fs := flag.NewFlagSet("pr reassign", flag.ContinueOnError)
+	oldUserID := fs.String("old", "", "идентификатор заменяемого ревьювера")
+	values, err := parseFlags(a, fs, args, "pull_request_id")
+	if err != nil {
+		return err
+	}
+	if *oldUserID == "" {
+		fmt.Fprintln(a.stderr, "pr reassign: --old is required")
+		fs.Usage()
+		return errUsage
+	}
+
+	data, err := a.client.post(ctx, "/pullRequest/reassign", api.PostPullRequestReassignJSONBody{
+		PullRequestId: values[0],
+		OldUserId:     *oldUserID,
+	})
+	if err != nil {
+		return err
+	}
+	if a.out.json {
+		return a.out.raw(data)
+	}
+
+	var resp struct {
+		PR         api.PullRequest `json:"pr"`
+		ReplacedBy string          `json:"replaced_by"`
+	}
+	if err := decode(data, &resp); err != nil {
+		return err
+	}
+	if err := printPullRequest(a.out, resp.PR); err != nil {
+		return err
+	}
+	return a.out.fields([2]string{"Replaced", *oldUserID + " -> " + resp.ReplacedBy})
+}
+
+func printPullRequest(out *printer, pr api.PullRequest) error {
+	return out.fields(
+		[2]string{"Pull request", pr.PullRequestId},
+		[2]string{"Name", pr.PullRequestName},
+		[2]string{"Author", pr.AuthorId},
+		[2]string{"Status", string(pr.Status)},
+		[2]string{"Reviewers", formatList(pr.AssignedReviewers)},
+	)
+}
@@ -0,0 +1,204 @@
+package prctl
+
+import (
+	"context"
+	"flag"
+	"net/url"
+	"strconv"
+)
+
+// statsFilter - общие флаги фильтрации статистики
+type statsFilter struct {
+	from, to, team, status string
+}
+
+// addStatsFilterFlags регистрирует флаги --from, --to, --team и, если withStatus, --status
+func addStatsFilterFlags(fs *flag.FlagSet, withStatus bool) *statsFilter {
+	f := &statsFilter{}
+	fs.StringVar(&f.from, "from", "", "учитывать PR, созданные начиная с момента (RFC 3339)")
+	fs.StringVar(&f.to, "to", "", "учитывать PR, созданные до момента (RFC 3339, не включая)")
+	fs.StringVar(&f.team, "team", "", "только указанная команда")
+	if withStatus {
+		fs.StringVar(&f.status, "status", "", "только PR в статусе OPEN или MERGED")
+	}
+	return f
+}
+
+// query возвращает заданные фильтры как параметры запроса
+func (f *statsFilter) query() url.Values {
+	query := url.Values{}
+	for name, value := range map[string]string{
+		"created_from": f.from, "created_to": f.to, "team_name": f.team, "status": f.status,
+	} {
+		if value != "" {
+			query.Set(name, value)
+		}
+	}
+	return query
+}
+
+// statsReviews выводит число ревью по пользователям
+func statsReviews(ctx context.Context, a *app, args []string) error {
+	fs := flag.NewFlagSet("stats reviews", flag.ContinueOnError)
+	filter := addStatsFilterFlags(fs, true)
+	if _, err := parseFlags(a, fs, args); err != nil {
+		return err
+	}
+
+	data, err := a.client.get(ctx, "/stats/reviews", filter.query())
+	if err != nil {
+		return err
+	}
+	if a.out.json {
+		return a.out.raw(data)
+	}
+
+	var resp struct {
+		Stats []struct {
+			UserID      string `json:"user_id"`
+			Username    string `json:"username"`
+			ReviewCount int64  `json:"review_count"`
+		} `json:"stats"`
+	}
+	if err := decode(data, &resp); err != nil {
+		return err
+	}
+
+	rows := make([][]string, 0, len(resp.Stats))
+	for _, stat := range resp.Stats {
+		rows = append(rows, []string{stat.UserID, stat.Username, strconv.FormatInt(stat.ReviewCount, 10)})
+	}
+	return a.out.table([]string{"USER_ID", "USERNAME", "REVIEWS"}, rows)
+}
+
+// statsAssignments выводит число назначенных ревьюверов по PR
+func statsAssignments(ctx context.Context, a *app, args []string) error {
+	fs := flag.NewFlagSet("stats assignments", flag.ContinueOnError)
+	filter := addStatsFilterFlags(fs, true)
+	if _, err := parseFlags(a, fs, args); err != nil {
+		return err
+	}
+
+	data, err := a.client.get(ctx, "/stats/pr-assignments", filter.query())
+	if err != nil {
+		return err
+	}
+	if a.out.json {
+		return a.out.raw(data)
+	}
+
+	var resp struct {
+		Stats []struct {
+			PRID           string `json:"pull_request_id"`
+			PRName         string `json:"pull_request_name"`
+			ReviewersCount int64  `json:"reviewers_count"`
+		} `json:"stats"`
+	}
+	if err := decode(data, &resp); err != nil {
+		return err
+	}
+
+	rows := make([][]string, 0, len(resp.Stats))
+	for _, stat := range resp.Stats {
+		rows = append(rows, []string{stat.PRID, stat.PRName, strconv.FormatInt(stat.ReviewersCount, 10)})
+	}
+	return a.out.table([]string{"PULL_REQUEST_ID", "NAME", "REVIEWERS"}, rows)
+}
+
+// statsTeams выводит показатели ревью по командам
+func statsTeams(ctx context.Context, a *app, args []string) error {
+	fs := flag.NewFlagSet("stats teams", flag.ContinueOnError)
+	filter := addStatsFilterFlags(fs, true)
+	if _, err := parseFlags(a, fs, args); err != nil {
+		return err
+	}
+
+	data, err := a.client.get(ctx, "/stats/teams", filter.query())
+	if err != nil {
+		return err
+	}
+	if a.out.json {
+		return a.out.raw(data)
+	}
+
+	var resp struct {
+		Stats []struct {
+			TeamName              string  `json:"team_name"`
+			PullRequests          int64   `json:"pull_requests"`
+			OpenPullRequests      int64   `json:"open_pull_requests"`
+			AvgReviewersPerPR     float64 `json:"avg_reviewers_per_pr"`
+			ReviewsGiven          int64   `json:"reviews_given"`
+			ReviewsReceived       int64   `json:"reviews_received"`
+			CrossTeamReviewsShare float64 `json:"cross_team_reviews_share"`
+		} `json:"stats"`
+	}
+	if err := decode(data, &resp); err != nil {
+		return err
+	}
+
+	rows := make([][]string, 0, len(resp.Stats))
+	for _, stat := range resp.Stats {
+		rows = append(rows, []string{
+			stat.TeamName,
+			strconv.FormatInt(stat.PullRequests, 10),
+			strconv.FormatInt(stat.OpenPullRequests, 10),
+			strconv.FormatFloat(stat.AvgReviewersPerPR, 'f', 2, 64),
+			strconv.FormatInt(stat.ReviewsGiven, 10),
+			strconv.FormatInt(stat.ReviewsReceived, 10),
+			strconv.FormatFloat(stat.CrossTeamReviewsShare*100, 'f', 0, 64) + "%",
+		})
+	}
+	return a.out.table([]string{"TEAM", "PRS", "OPEN", "AVG_REVIEWERS", "GIVEN", "RECEIVED", "CROSS_TEAM"}, rows)
+}
+
+// statsFairness выводит отчет о справедливости: строку на участника с показателями его команды
+func statsFairness(ctx context.Context, a *app, args []string) error {
+	fs := flag.NewFlagSet("stats fairness", flag.ContinueOnError)
+	filter := addStatsFilterFlags(fs, false)
+	if _, err := parseFlags(a, fs, args); err != nil {
+		return err
+	}
+
+	data, err := a.client.get(ctx, "/stats/fairness", filter.query())
+	if err != nil {
+		return err
+	}
+	if a.out.json {
+		return a.out.raw(data)
+	}
+
+	var resp struct {
+		Teams []struct {
+			TeamName string  `json:"team_name"`
+			Gini     float64 `json:"gini"`
+			Members  []struct {
+				UserID              string  `json:"user_id"`
+				ActiveDays          float64 `json:"active_days"`
+				Assignments         int64   `json:"assignments"`
+				ExpectedAssignments float64 `json:"expected_assignments"`
+				Deviation           float64 `json:"deviation"`
+				Outlier             string  `json:"outlier"`
+			} `json:"members"`
+		} `json:"teams"`
+	}
+	if err := decode(data, &resp); err != nil {
+		return err
+	}
+
+	var rows [][]string
+	for _, team := range resp.Teams {
+		for _, member := range team.Members {
+			rows = append(rows, []string{
+				team.TeamName,
+				strconv.FormatFloat(team.Gini, 'f', 2, 64),
+				member.UserID,
+				strconv.FormatFloat(member.ActiveDays, 'f', 1, 64),
+				strconv.FormatInt(member.Assignments, 10),
+				strconv.FormatFloat(member.ExpectedAssignments, 'f', 1, 64),
+				strconv.FormatFloat(member.Deviation, 'f', 1, 64),
+				member.Outlier,
+			})
+		}
+	}
+	return a.out.table([]string{"TEAM", "GINI", "USER_ID", "ACTIVE_DAYS", "ASSIGNED", "EXPECTED", "DEVIATION", "OUTLIER"}, rows)
+}
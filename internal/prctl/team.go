@@ -0,0 +1,257 @@
+package prctl
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+
+	"pr-reviewer-service/api"
+)
+
+// teamCreate создает команду из флагов (--name, --member id=username) или из JSON-файла (--file)
+func teamCreate(ctx context.Context, a *app, args []string) error {
+	fs := flag.NewFlagSet("team create", flag.ContinueOnError)
+	name := fs.String("name", "", "название команды")
+	parent := fs.String("parent", "", "родительская команда")
+	file := fs.String("file", "", "JSON-файл с командой в формате /team/add (\"-\" - stdin)")
+	var members stringList
+	fs.Var(&members, "member", "участник в виде user_id=username (можно повторять)")
+	if _, err := parseFlags(a, fs, args); err != nil {
+		return err
+	}
+
+	var team api.Team
+	switch {
+	case *file != "":
+		if err := readTeamFile(*file, a.stdin, &team); err != nil {
+			return err
+		}
+	case *name != "":
+		team.TeamName = *name
+		team.Members = make([]api.TeamMember, 0, len(members))
+		for _, member := range members {
+			userID, username, ok := strings.Cut(member, "=")
+			if !ok || userID == "" || username == "" {
+				return fmt.Errorf("invalid --member %q: want user_id=username", member)
+			}
+			team.Members = append(team.Members, api.TeamMember{UserId: userID, Username: username, IsActive: true})
+		}
+	default:
+		fmt.Fprintln(a.stderr, "team create: --name or --file is required")
+		fs.Usage()
+		return errUsage
+	}
+	if *parent != "" {
+		team.ParentTeamName = parent
+	}
+
+	data, err := a.client.post(ctx, "/team/add", team)
+	if err != nil {
+		return err
+	}
+	if a.out.json {
+		return a.out.raw(data)
+	}
+
+	var resp struct {
+		Team api.Team `json:"team"`
+	}
+	if err := decode(data, &resp); err != nil {
+		return err
+	}
+	return printTeam(a.out, resp.Team)
+}
+
+func readTeamFile(path string, stdin io.Reader, team *api.Team) error {
+	var (
+		data []byte
+		err  error
+	)
+	if path == "-" {
+		data, err = io.ReadAll(stdin)
+	} else {
+		data, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read team file: %w", err)
+	}
+	if err := json.Unmarshal(data, team); err != nil {
+		return fmt.Errorf("failed to parse team file: %w", err)
+	}
+	return nil
+}
+
+// teamGet выводит команду и ее участников
+func teamGet(ctx context.Context, a *app, args []string) error {
+	fs := flag.NewFlagSet("team get", flag.ContinueOnError)
+	values, err := parseFlags(a, fs, args, "team_name")
+	if err != nil {
+		return err
+	}
+
+	data, err := a.client.get(ctx, "/team/get", url.Values{"team_name": {values[0]}})
+	if err != nil {
+		return err
+	}
+	if a.out.json {
+		return a.out.raw(data)
+	}
+
+	var team api.Team
+	if err := decode(data, &team); err != nil {
+		return err
+	}
+	return printTeam(a.out, team)
+}
+
+func printTeam(out *printer, team api.Team) error {
+	parent := "-"
+	if team.ParentTeamName != nil {
+		parent = *team.ParentTeamName
+	}
+	if err := out.fields([2]string{"Team", team.TeamName}, [2]string{"Parent", parent}); err != nil {
+		return err
+	}
+	fmt.Fprintln(out.w)
+
+	rows := make([][]string, 0, len(team.Members))
+	for _, member := range team.Members {
+		rows = append(rows, []string{member.UserId, member.Username, formatBool(member.IsActive)})
+	}
+	return out.table([]string{"USER_ID", "USERNAME", "ACTIVE"}, rows)
+}
+
+// deactivationPlan - что сделает деактивация команды; выводится при --dry-run
+type deactivationPlan struct {
+	DryRun      bool                  `json:"dry_run"`
+	TeamName    string                `json:"team_name"`
+	Users       []deactivationPlanRow `json:"users"`
+	AffectedPRs []string              `json:"affected_prs"`
+}
+
+type deactivationPlanRow struct {
+	UserID      string   `json:"user_id"`
+	Username    string   `json:"username"`
+	OpenReviews []string `json:"open_reviews"`
+}
+
+// teamDeactivate деактивирует всех участников команды; с --dry-run только показывает,
+// кого затронет деактивация и какие открытые PR потребуют переназначения
+func teamDeactivate(ctx context.Context, a *app, args []string) error {
+	fs := flag.NewFlagSet("team deactivate", flag.ContinueOnError)
+	dryRun := fs.Bool("dry-run", false, "показать план, ничего не меняя")
+	values, err := parseFlags(a, fs, args, "team_name")
+	if err != nil {
+		return err
+	}
+	teamName := values[0]
+
+	if *dryRun {
+		plan, err := planDeactivation(ctx, a.client, teamName)
+		if err != nil {
+			return err
+		}
+		return printDeactivationPlan(a.out, plan)
+	}
+
+	data, err := a.client.post(ctx, "/team/deactivate", api.PostTeamDeactivateJSONBody{TeamName: teamName})
+	if err != nil {
+		return err
+	}
+	if a.out.json {
+		return a.out.raw(data)
+	}
+
+	var resp struct {
+		TeamName            string   `json:"team_name"`
+		DeactivatedUsers    int      `json:"deactivated_users"`
+		ReassignedPRs       int      `json:"reassigned_prs"`
+		FailedReassignments int      `json:"failed_reassignments"`
+		DeactivatedUserIDs  []string `json:"deactivated_user_ids"`
+	}
+	if err := decode(data, &resp); err != nil {
+		return err
+	}
+	return a.out.fields(
+		[2]string{"Team", resp.TeamName},
+		[2]string{"Deactivated users", strconv.Itoa(resp.DeactivatedUsers)},
+		[2]string{"Reassigned PRs", strconv.Itoa(resp.ReassignedPRs)},
+		[2]string{"Failed reassignments", strconv.Itoa(resp.FailedReassignments)},
+		[2]string{"User IDs", formatList(resp.DeactivatedUserIDs)},
+	)
+}
+
+// planDeactivation собирает план по данным API: активные участники и их открытые ревью
+func planDeactivation(ctx context.Context, c *client, teamName string) (*deactivationPlan, error) {
+	data, err := c.get(ctx, "/team/get", url.Values{"team_name": {teamName}})
+	if err != nil {
+		return nil, err
+	}
+	var team api.Team
+	if err := decode(data, &team); err != nil {
+		return nil, err
+	}
+
+	plan := &deactivationPlan{
+		DryRun:      true,
+		TeamName:    team.TeamName,
+		Users:       []deactivationPlanRow{},
+		AffectedPRs: []string{},
+	}
+	seenPRs := make(map[string]bool)
+	for _, member := range team.Members {
+		if !member.IsActive {
+			continue
+		}
+
+		data, err := c.get(ctx, "/users/getReview", url.Values{"user_id": {member.UserId}})
+		if err != nil {
+			return nil, err
+		}
+		var reviews struct {
+			PullRequests []api.PullRequestShort `json:"pull_requests"`
+		}
+		if err := decode(data, &reviews); err != nil {
+			return nil, err
+		}
+
+		row := deactivationPlanRow{UserID: member.UserId, Username: member.Username, OpenReviews: []string{}}
+		for _, pr := range reviews.PullRequests {
+			if pr.Status != api.PullRequestShortStatusOPEN {
+				continue
+			}
+			row.OpenReviews = append(row.OpenReviews, pr.PullRequestId)
+			if !seenPRs[pr.PullRequestId] {
+				seenPRs[pr.PullRequestId] = true
+				plan.AffectedPRs = append(plan.AffectedPRs, pr.PullRequestId)
+			}
+		}
+		plan.Users = append(plan.Users, row)
+	}
+
+	return plan, nil
+}
+
+func printDeactivationPlan(out *printer, plan *deactivationPlan) error {
+	if out.json {
+		return out.value(plan)
+	}
+
+	rows := make([][]string, 0, len(plan.Users))
+	for _, user := range plan.Users {
+		rows = append(rows, []string{user.UserID, user.Username, formatList(user.OpenReviews)})
+	}
+	if err := out.table([]string{"USER_ID", "USERNAME", "OPEN_REVIEWS"}, rows); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(out.w, "\nDry run: would deactivate %d user(s) of team %s and reassign reviewers on %d open PR(s).\n",
+		len(plan.Users), plan.TeamName, len(plan.AffectedPRs))
+	return nil
+}
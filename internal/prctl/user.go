@@ -0,0 +1,48 @@
+package prctl
+
+import (
+	"context"
+	"flag"
+
+	"pr-reviewer-service/api"
+)
+
+// userSetActive возвращает команду, включающую или выключающую пользователя
+func userSetActive(active bool) command {
+	name := "user deactivate"
+	if active {
+		name = "user activate"
+	}
+
+	return func(ctx context.Context, a *app, args []string) error {
+		fs := flag.NewFlagSet(name, flag.ContinueOnError)
+		values, err := parseFlags(a, fs, args, "user_id")
+		if err != nil {
+			return err
+		}
+
+		data, err := a.client.post(ctx, "/users/setIsActive", api.PostUsersSetIsActiveJSONBody{
+			UserId:   values[0],
+			IsActive: active,
+		})
+		if err != nil {
+			return err
+		}
+		if a.out.json {
+			return a.out.raw(data)
+		}
+
+		var resp struct {
+			User api.User `json:"user"`
+		}
+		if err := decode(data, &resp); err != nil {
+			return err
+		}
+		return a.out.fields(
+			[2]string{"User", resp.User.UserId},
+			[2]string{"Username", resp.User.Username},
+			[2]string{"Team", resp.User.TeamName},
+			[2]string{"Active", formatBool(resp.User.IsActive)},
+		)
+	}
+}
@@ -0,0 +1,110 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"pr-reviewer-service/internal/database"
+	"pr-reviewer-service/internal/domain"
+)
+
+// reserveAttempts - попытки занять ключ: между неудачной попыткой и чтением
+// записи истекший ключ может быть удален очисткой
+const reserveAttempts = 2
+
+// IdempotencyRepository реализует domain.IdempotencyRepository в PostgreSQL.
+type IdempotencyRepository struct {
+	queries *database.Queries
+}
+
+// NewIdempotencyRepository создает новый экземпляр IdempotencyRepository.
+func NewIdempotencyRepository(queries *database.Queries) domain.IdempotencyRepository {
+	return &IdempotencyRepository{
+		queries: queries,
+	}
+}
+
+// Reserve занимает свободный, истекший или брошенный ключ; для действующего ключа возвращает его запись.
+func (r *IdempotencyRepository) Reserve(ctx context.Context, key, fingerprint string, leaseExpiresAt, expiresAt time.Time) (*domain.IdempotencyRecord, bool, error) {
+	for range reserveAttempts {
+		reserved, err := r.queries.ReserveIdempotencyKey(ctx, database.ReserveIdempotencyKeyParams{
+			IdempotencyKey: key,
+			Fingerprint:    fingerprint,
+			LeaseExpiresAt: leaseExpiresAt,
+			ExpiresAt:      expiresAt,
+		})
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to reserve idempotency key: %w", err)
+		}
+		if reserved > 0 {
+			return nil, true, nil
+		}
+
+		row, err := r.queries.GetIdempotencyKey(ctx, key)
+		if errors.Is(err, sql.ErrNoRows) {
+			continue
+		}
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to get idempotency key: %w", err)
+		}
+
+		return &domain.IdempotencyRecord{
+			Key:            row.IdempotencyKey,
+			Fingerprint:    row.Fingerprint,
+			StatusCode:     int(row.StatusCode.Int32),
+			ContentType:    row.ContentType.String,
+			ETag:           row.Etag.String,
+			Body:           row.ResponseBody,
+			ExpiresAt:      row.ExpiresAt,
+			LeaseExpiresAt: row.LeaseExpiresAt,
+		}, false, nil
+	}
+
+	return nil, false, errors.New("failed to reserve idempotency key: key is concurrently modified")
+}
+
+// Complete сохраняет ответ на запрос с ключом, если ключ все еще занят этим запросом.
+func (r *IdempotencyRepository) Complete(ctx context.Context, key, fingerprint string, statusCode int, contentType, etag string, body []byte) error {
+	completed, err := r.queries.CompleteIdempotencyKey(ctx, database.CompleteIdempotencyKeyParams{
+		IdempotencyKey: key,
+		Fingerprint:    fingerprint,
+		StatusCode:     sql.NullInt32{Int32: int32(statusCode), Valid: true}, //nolint:gosec // HTTP-статус
+		ContentType:    sql.NullString{String: contentType, Valid: true},
+		ResponseBody:   body,
+		Etag:           toNullString(etag),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save idempotent response: %w", err)
+	}
+	if completed == 0 {
+		return domain.ErrIdempotencyLeaseLost
+	}
+
+	return nil
+}
+
+// Release удаляет ключ, если он все еще занят этим запросом и ответ на него не сохранен.
+func (r *IdempotencyRepository) Release(ctx context.Context, key, fingerprint string) error {
+	err := r.queries.DeleteIdempotencyKey(ctx, database.DeleteIdempotencyKeyParams{
+		IdempotencyKey: key,
+		Fingerprint:    fingerprint,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to release idempotency key: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteExpired удаляет истекшие ключи.
+func (r *IdempotencyRepository) DeleteExpired(ctx context.Context) (int64, error) {
+	deleted, err := r.queries.DeleteExpiredIdempotencyKeys(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete expired idempotency keys: %w", err)
+	}
+
+	return deleted, nil
+}
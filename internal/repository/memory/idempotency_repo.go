@@ -0,0 +1,94 @@
+package memory
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"time"
+
+	"pr-reviewer-service/internal/domain"
+)
+
+// IdempotencyRepository реализует domain.IdempotencyRepository поверх Store.
+type IdempotencyRepository struct {
+	store *Store
+}
+
+// NewIdempotencyRepository создает новый экземпляр IdempotencyRepository.
+func NewIdempotencyRepository(store *Store) domain.IdempotencyRepository {
+	return &IdempotencyRepository{store: store}
+}
+
+// Reserve занимает свободный, истекший или брошенный ключ; для действующего ключа возвращает копию его записи.
+func (r *IdempotencyRepository) Reserve(_ context.Context, key, fingerprint string, leaseExpiresAt, expiresAt time.Time) (*domain.IdempotencyRecord, bool, error) {
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	if record, ok := s.idempotency[key]; ok && record.ExpiresAt.After(now) &&
+		(record.StatusCode != 0 || record.LeaseExpiresAt.After(now)) {
+		copied := *record
+		copied.Body = slices.Clone(record.Body)
+		return &copied, false, nil
+	}
+
+	s.idempotency[key] = &domain.IdempotencyRecord{
+		Key:            key,
+		Fingerprint:    fingerprint,
+		ExpiresAt:      expiresAt,
+		LeaseExpiresAt: leaseExpiresAt,
+	}
+	return nil, true, nil
+}
+
+// Complete сохраняет ответ на запрос с ключом, если ключ все еще занят этим запросом.
+func (r *IdempotencyRepository) Complete(_ context.Context, key, fingerprint string, statusCode int, contentType, etag string, body []byte) error {
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	record, ok := s.idempotency[key]
+	if !ok || !heldBy(record, fingerprint) {
+		return domain.ErrIdempotencyLeaseLost
+	}
+	record.StatusCode = statusCode
+	record.ContentType = contentType
+	record.ETag = etag
+	record.Body = slices.Clone(body)
+
+	return nil
+}
+
+// Release удаляет ключ, если он все еще занят этим запросом и ответ на него не сохранен.
+func (r *IdempotencyRepository) Release(_ context.Context, key, fingerprint string) error {
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if record, ok := s.idempotency[key]; ok && heldBy(record, fingerprint) {
+		delete(s.idempotency, key)
+	}
+
+	return nil
+}
+
+// heldBy сообщает, занят ли ключ запросом с этим fingerprint, еще не получившим ответа
+func heldBy(record *domain.IdempotencyRecord, fingerprint string) bool {
+	return record.Fingerprint == fingerprint && record.StatusCode == 0
+}
+
+// DeleteExpired удаляет истекшие ключи.
+func (r *IdempotencyRepository) DeleteExpired(_ context.Context) (int64, error) {
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	before := len(s.idempotency)
+	now := time.Now()
+	maps.DeleteFunc(s.idempotency, func(_ string, record *domain.IdempotencyRecord) bool {
+		return !record.ExpiresAt.After(now)
+	})
+
+	return int64(before - len(s.idempotency)), nil
+}
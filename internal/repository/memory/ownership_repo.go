@@ -0,0 +1,58 @@
+package memory
+
+import (
+	"context"
+	"slices"
+
+	"pr-reviewer-service/internal/domain"
+)
+
+// OwnershipRepository реализует domain.OwnershipRepository поверх Store.
+type OwnershipRepository struct {
+	store *Store
+}
+
+// NewOwnershipRepository создает новый экземпляр OwnershipRepository.
+func NewOwnershipRepository(store *Store) domain.OwnershipRepository {
+	return &OwnershipRepository{store: store}
+}
+
+// ReplaceRules полностью заменяет правила владения репозитория, сохраняя их порядок.
+func (r *OwnershipRepository) ReplaceRules(_ context.Context, repository string, rules []*domain.OwnershipRule) error {
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(rules) == 0 {
+		delete(s.ownership, repository)
+		return nil
+	}
+	s.ownership[repository] = copyRules(rules)
+
+	return nil
+}
+
+// GetRules возвращает правила владения репозитория в порядке их применения;
+// владельцы каждого правила упорядочены по ID.
+func (r *OwnershipRepository) GetRules(_ context.Context, repository string) ([]*domain.OwnershipRule, error) {
+	s := r.store
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return copyRules(s.ownership[repository]), nil
+}
+
+func copyRules(rules []*domain.OwnershipRule) []*domain.OwnershipRule {
+	result := make([]*domain.OwnershipRule, 0, len(rules))
+	for _, rule := range rules {
+		copied := &domain.OwnershipRule{
+			Pattern:   rule.Pattern,
+			UserIDs:   append([]string{}, rule.UserIDs...),
+			TeamNames: append([]string{}, rule.TeamNames...),
+		}
+		slices.Sort(copied.UserIDs)
+		slices.Sort(copied.TeamNames)
+		result = append(result, copied)
+	}
+	return result
+}
@@ -0,0 +1,320 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	"pr-reviewer-service/internal/domain"
+)
+
+// PRRepository реализует domain.PRRepository поверх Store.
+type PRRepository struct {
+	store *Store
+}
+
+// NewPRRepository создает новый экземпляр PRRepository.
+func NewPRRepository(store *Store) domain.PRRepository {
+	return &PRRepository{store: store}
+}
+
+// CreateWithReviewers создает PR с ревьюверами и объяснением выбора.
+// Все ограничения проверяются до изменения данных, поэтому при ошибке ничего не сохраняется.
+func (r *PRRepository) CreateWithReviewers(_ context.Context, pr *domain.PullRequest, reviewerIDs []string) error {
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.prs[pr.ID]; ok {
+		return domain.ErrPRAlreadyExists
+	}
+	if _, ok := s.users[pr.AuthorID]; !ok {
+		return fmt.Errorf("failed to create PR: %w", domain.ErrPRAuthorNotFound)
+	}
+	for i, reviewerID := range reviewerIDs {
+		if _, ok := s.users[reviewerID]; !ok {
+			return fmt.Errorf("failed to assign reviewer %s: %w", reviewerID, domain.ErrUserNotFound)
+		}
+		if slices.Contains(reviewerIDs[:i], reviewerID) {
+			return fmt.Errorf("failed to assign reviewer %s: already assigned", reviewerID)
+		}
+	}
+
+	createdAt := now()
+	s.prs[pr.ID] = &prRecord{
+		id:        pr.ID,
+		name:      pr.Name,
+		authorID:  pr.AuthorID,
+		status:    domain.PRStatusOpen,
+		createdAt: createdAt,
+		reviewers: slices.Clone(reviewerIDs),
+		version:   1,
+	}
+	if pr.Rationale != nil {
+		s.rationales[pr.ID] = copyRationale(pr.Rationale)
+	}
+	pr.CreatedAt = &createdAt
+	pr.Version = 1
+
+	return nil
+}
+
+// GetByID возвращает PR по ID.
+func (r *PRRepository) GetByID(_ context.Context, prID string) (*domain.PullRequest, error) {
+	s := r.store
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	pr, ok := s.prs[prID]
+	if !ok {
+		return nil, domain.ErrPRNotFound
+	}
+
+	return pr.toDomain(false), nil
+}
+
+// Merge изменяет статус PR на MERGED. Повторный merge не меняет время слияния и версию.
+func (r *PRRepository) Merge(_ context.Context, prID string, expectedVersion int64) (*domain.PullRequest, bool, error) {
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	pr, err := s.checkedPR(prID, expectedVersion)
+	if err != nil {
+		return nil, false, err
+	}
+	changed := pr.status == domain.PRStatusOpen
+	if changed {
+		mergedAt := now()
+		pr.status = domain.PRStatusMerged
+		pr.mergedAt = &mergedAt
+		pr.version++
+	}
+
+	return pr.toDomain(false), changed, nil
+}
+
+// ReassignReviewer заменяет ревьювера на нового и отмечает это в объяснении выбора.
+// Версия, статус PR и назначение старого ревьювера проверяются под той же блокировкой, что и замена.
+func (r *PRRepository) ReassignReviewer(_ context.Context, prID, oldReviewerID, newReviewerID string, expectedVersion int64) error {
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	pr, err := s.checkedPR(prID, expectedVersion)
+	if err != nil {
+		return err
+	}
+	if pr.status == domain.PRStatusMerged {
+		return domain.ErrPRAlreadyMerged
+	}
+	if _, ok := s.users[newReviewerID]; !ok {
+		return fmt.Errorf("failed to assign new reviewer: %w", domain.ErrUserNotFound)
+	}
+
+	reviewers := slices.DeleteFunc(slices.Clone(pr.reviewers), func(id string) bool {
+		return id == oldReviewerID
+	})
+	if len(reviewers) == len(pr.reviewers) {
+		return domain.ErrReviewerNotAssigned
+	}
+	if slices.Contains(reviewers, newReviewerID) {
+		return fmt.Errorf("failed to assign new reviewer %s: already assigned", newReviewerID)
+	}
+	pr.reviewers = append(reviewers, newReviewerID)
+	pr.version++
+
+	if rationale, ok := s.rationales[prID]; ok {
+		for _, selection := range rationale.Reviewers {
+			if selection.UserID == oldReviewerID {
+				*selection = domain.ReviewerSelection{
+					UserID: newReviewerID,
+					Reason: domain.SelectionReassignment,
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// GetUserAssignedPRs возвращает до limit PR, где пользователь назначен ревьювером, от новых к старым, начиная после курсора after.
+func (r *PRRepository) GetUserAssignedPRs(ctx context.Context, userID string, after *domain.PRCursor, limit int) ([]*domain.PullRequest, error) {
+	return r.List(ctx, &domain.PRListFilter{ReviewerID: userID}, after, limit)
+}
+
+// IsUserReviewer проверяет, является ли пользователь ревьювером PR.
+func (r *PRRepository) IsUserReviewer(_ context.Context, prID, userID string) (bool, error) {
+	s := r.store
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	pr, ok := s.prs[prID]
+	return ok && slices.Contains(pr.reviewers, userID), nil
+}
+
+// ExistsPr проверяет существование PR.
+func (r *PRRepository) ExistsPr(_ context.Context, prID string) (bool, error) {
+	s := r.store
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	_, ok := s.prs[prID]
+	return ok, nil
+}
+
+// GetReviewers возвращает список ревьюверов PR.
+func (r *PRRepository) GetReviewers(_ context.Context, prID string) ([]string, error) {
+	s := r.store
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	reviewers := make([]string, 0)
+	if pr, ok := s.prs[prID]; ok {
+		reviewers = append(reviewers, pr.reviewers...)
+	}
+
+	return reviewers, nil
+}
+
+// GetRationale возвращает объяснение выбора ревьюверов PR или nil, если оно не сохранялось.
+func (r *PRRepository) GetRationale(_ context.Context, prID string) (*domain.AssignmentRationale, error) {
+	s := r.store
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	rationale, ok := s.rationales[prID]
+	if !ok {
+		return nil, nil
+	}
+
+	result := copyRationale(rationale)
+	for _, selection := range result.Reviewers {
+		selection.Score = selection.SkillScore + selection.WorkloadScore
+	}
+	slices.SortFunc(result.Excluded, func(a, b *domain.ExcludedCandidate) int {
+		return cmp.Compare(a.UserID, b.UserID)
+	})
+
+	return result, nil
+}
+
+// List возвращает до limit PR, подходящих под фильтр, от новых к старым, начиная после курсора after.
+func (r *PRRepository) List(_ context.Context, filter *domain.PRListFilter, after *domain.PRCursor, limit int) ([]*domain.PullRequest, error) {
+	s := r.store
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	prs := make([]*domain.PullRequest, 0, limit)
+	for _, pr := range s.sortedPRs() {
+		if len(prs) == limit {
+			break
+		}
+		if after != nil && newerFirst(pr, &prRecord{id: after.ID, createdAt: after.CreatedAt}) <= 0 {
+			continue
+		}
+		if s.matches(pr, filter) {
+			prs = append(prs, pr.toDomain(true))
+		}
+	}
+
+	return prs, nil
+}
+
+// GetByIDs возвращает найденные PR с ревьюверами по списку ID
+func (r *PRRepository) GetByIDs(_ context.Context, prIDs []string) ([]*domain.PullRequest, error) {
+	s := r.store
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	prs := make([]*domain.PullRequest, 0, len(prIDs))
+	for _, pr := range s.prs {
+		if slices.Contains(prIDs, pr.id) {
+			prs = append(prs, pr.toDomain(true))
+		}
+	}
+	slices.SortFunc(prs, func(a, b *domain.PullRequest) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+
+	return prs, nil
+}
+
+// GetAssignedToUsers возвращает PR на ревью у каждого из пользователей, от новых к старым.
+// Пользователи без назначений в результат не попадают.
+func (r *PRRepository) GetAssignedToUsers(_ context.Context, userIDs []string) (map[string][]*domain.PullRequest, error) {
+	s := r.store
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	assigned := make(map[string][]*domain.PullRequest)
+	for _, pr := range s.sortedPRs() {
+		for _, reviewerID := range pr.reviewers {
+			if slices.Contains(userIDs, reviewerID) {
+				assigned[reviewerID] = append(assigned[reviewerID], pr.toDomain(true))
+			}
+		}
+	}
+
+	return assigned, nil
+}
+
+// sortedPRs возвращает PR от новых к старым
+func (s *Store) sortedPRs() []*prRecord {
+	prs := make([]*prRecord, 0, len(s.prs))
+	for _, pr := range s.prs {
+		prs = append(prs, pr)
+	}
+	slices.SortFunc(prs, newerFirst)
+	return prs
+}
+
+// matches проверяет PR по фильтру списка; TeamName - команда автора
+func (s *Store) matches(pr *prRecord, filter *domain.PRListFilter) bool {
+	switch {
+	case filter.Status != "" && pr.status != filter.Status,
+		filter.AuthorID != "" && pr.authorID != filter.AuthorID,
+		filter.ReviewerID != "" && !slices.Contains(pr.reviewers, filter.ReviewerID),
+		filter.TeamName != "" && s.users[pr.authorID].TeamName != filter.TeamName,
+		filter.CreatedFrom != nil && pr.createdAt.Before(*filter.CreatedFrom),
+		filter.CreatedTo != nil && !pr.createdAt.Before(*filter.CreatedTo):
+		return false
+	}
+	return true
+}
+
+// copyRationale возвращает независимую копию объяснения
+func copyRationale(rationale *domain.AssignmentRationale) *domain.AssignmentRationale {
+	result := &domain.AssignmentRationale{
+		Strategy:          rationale.Strategy,
+		CandidatePoolSize: rationale.CandidatePoolSize,
+		Reviewers:         make([]*domain.ReviewerSelection, 0, len(rationale.Reviewers)),
+		Excluded:          make([]*domain.ExcludedCandidate, 0, len(rationale.Excluded)),
+	}
+	for _, selection := range rationale.Reviewers {
+		copied := *selection
+		copied.MatchedTags = slices.Clone(selection.MatchedTags)
+		if copied.MatchedTags == nil {
+			copied.MatchedTags = []string{}
+		}
+		result.Reviewers = append(result.Reviewers, &copied)
+	}
+	for _, excluded := range rationale.Excluded {
+		copied := *excluded
+		result.Excluded = append(result.Excluded, &copied)
+	}
+	return result
+}
+
+// checkedPR возвращает PR и сверяет его версию с ожидаемой (0 - без проверки). Вызывается под s.mu.
+func (s *Store) checkedPR(prID string, expectedVersion int64) (*prRecord, error) {
+	pr, ok := s.prs[prID]
+	if !ok {
+		return nil, domain.ErrPRNotFound
+	}
+	if expectedVersion != 0 && pr.version != expectedVersion {
+		return nil, domain.ErrPRVersionConflict
+	}
+	return pr, nil
+}
@@ -0,0 +1,220 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"pr-reviewer-service/internal/domain"
+)
+
+// StatsRepository реализует domain.StatsRepository поверх Store.
+type StatsRepository struct {
+	store *Store
+}
+
+// NewStatsRepository создает новый экземпляр StatsRepository.
+func NewStatsRepository(store *Store) domain.StatsRepository {
+	return &StatsRepository{store: store}
+}
+
+// GetStatsReviews возвращает число назначений на ревью для каждого пользователя,
+// от самых загруженных к наименее загруженным. Учитываются только PR, подходящие под фильтр.
+func (r *StatsRepository) GetStatsReviews(_ context.Context, filter *domain.StatsFilter) ([]*domain.ReviewStat, error) {
+	s := r.store
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	counts := make(map[string]int64)
+	for _, pr := range s.statsPRs(filter) {
+		for _, reviewerID := range pr.reviewers {
+			counts[reviewerID]++
+		}
+	}
+
+	users := s.usersWhere(func(u *domain.User) bool { return filter.TeamName == "" || u.TeamName == filter.TeamName })
+	result := make([]*domain.ReviewStat, 0, len(users))
+	for _, u := range users {
+		result = append(result, &domain.ReviewStat{
+			UserID:      u.ID,
+			Username:    u.Username,
+			ReviewCount: counts[u.ID],
+		})
+	}
+	slices.SortStableFunc(result, func(a, b *domain.ReviewStat) int {
+		return cmp.Compare(b.ReviewCount, a.ReviewCount)
+	})
+
+	return result, nil
+}
+
+// GetStatsPrAssignments возвращает число ревьюверов на каждом PR, подходящем под фильтр, от большего к меньшему.
+func (r *StatsRepository) GetStatsPrAssignments(_ context.Context, filter *domain.StatsFilter) ([]*domain.PRAssignmentStat, error) {
+	s := r.store
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	result := make([]*domain.PRAssignmentStat, 0, len(s.prs))
+	for _, pr := range s.statsPRs(filter) {
+		if filter.TeamName != "" && s.users[pr.authorID].TeamName != filter.TeamName {
+			continue
+		}
+		result = append(result, &domain.PRAssignmentStat{
+			PRID:           pr.id,
+			PRName:         pr.name,
+			ReviewersCount: int64(len(pr.reviewers)),
+		})
+	}
+	slices.SortFunc(result, func(a, b *domain.PRAssignmentStat) int {
+		if c := cmp.Compare(b.ReviewersCount, a.ReviewersCount); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.PRID, b.PRID)
+	})
+
+	return result, nil
+}
+
+// GetStatsTeams возвращает показатели ревью каждой команды по PR, подходящим под фильтр, в порядке имен.
+func (r *StatsRepository) GetStatsTeams(_ context.Context, filter *domain.StatsFilter) ([]*domain.TeamReviewStat, error) {
+	s := r.store
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	stats := make(map[string]*domain.TeamReviewStat, len(s.teams))
+	result := make([]*domain.TeamReviewStat, 0, len(s.teams))
+	for _, name := range s.sortedTeamNames() {
+		if filter.TeamName != "" && name != filter.TeamName {
+			continue
+		}
+		stats[name] = &domain.TeamReviewStat{TeamName: name}
+		result = append(result, stats[name])
+	}
+
+	for _, pr := range s.statsPRs(filter) {
+		authorTeam := s.users[pr.authorID].TeamName
+		if stat, ok := stats[authorTeam]; ok {
+			stat.PullRequests++
+			if pr.status == domain.PRStatusOpen {
+				stat.OpenPullRequests++
+			}
+		}
+		for _, reviewerID := range pr.reviewers {
+			reviewerTeam := s.users[reviewerID].TeamName
+			if stat, ok := stats[reviewerTeam]; ok {
+				stat.ReviewsGiven++
+			}
+			if stat, ok := stats[authorTeam]; ok {
+				stat.ReviewsReceived++
+				if reviewerTeam != authorTeam {
+					stat.CrossTeamReviews++
+				}
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// GetMemberAssignments возвращает назначения пользователей команды (пустое имя - всех) на PR,
+// созданные в [from, to), в порядке команды и ID.
+func (r *StatsRepository) GetMemberAssignments(_ context.Context, teamName string, from, to time.Time) ([]*domain.MemberAssignments, error) {
+	s := r.store
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	users := s.usersWhere(func(u *domain.User) bool {
+		return teamName == "" || u.TeamName == teamName
+	})
+	slices.SortStableFunc(users, func(a, b *domain.User) int {
+		return cmp.Compare(a.TeamName, b.TeamName)
+	})
+
+	prs := s.statsPRs(&domain.StatsFilter{CreatedFrom: &from, CreatedTo: &to})
+	result := make([]*domain.MemberAssignments, 0, len(users))
+	for _, u := range users {
+		member := &domain.MemberAssignments{UserID: u.ID, Username: u.Username, TeamName: u.TeamName}
+		for _, pr := range prs {
+			if slices.Contains(pr.reviewers, u.ID) {
+				member.Assignments++
+			}
+		}
+		result = append(result, member)
+	}
+
+	return result, nil
+}
+
+// GetUserStatusHistory возвращает смены активности пользователей команды (пустое имя - всех) до before,
+// в порядке ID пользователя и времени.
+func (r *StatsRepository) GetUserStatusHistory(_ context.Context, teamName string, before time.Time) ([]*domain.UserStatusChange, error) {
+	s := r.store
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	users := s.usersWhere(func(u *domain.User) bool {
+		return teamName == "" || u.TeamName == teamName
+	})
+
+	result := make([]*domain.UserStatusChange, 0, len(users))
+	for _, u := range users {
+		for _, change := range s.statusHistory[u.ID] {
+			if change.ChangedAt.Before(before) {
+				copied := *change
+				result = append(result, &copied)
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// CountOpenPRs возвращает количество открытых PR.
+func (r *StatsRepository) CountOpenPRs(_ context.Context) (int64, error) {
+	s := r.store
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var count int64
+	for _, pr := range s.prs {
+		if pr.status == domain.PRStatusOpen {
+			count++
+		}
+	}
+
+	return count, nil
+}
+
+// GetTeamReviewLoad возвращает число открытых ревью на участниках каждой команды.
+func (r *StatsRepository) GetTeamReviewLoad(_ context.Context) ([]*domain.TeamReviewLoad, error) {
+	s := r.store
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	result := make([]*domain.TeamReviewLoad, 0, len(s.teams))
+	for _, name := range s.sortedTeamNames() {
+		load := &domain.TeamReviewLoad{TeamName: name}
+		for _, u := range s.users {
+			if u.TeamName == name {
+				load.OpenReviews += s.openReviews(u.ID)
+			}
+		}
+		result = append(result, load)
+	}
+
+	return result, nil
+}
+
+// statsPRs возвращает PR, подходящие под статус и интервал фильтра статистики. Вызывается под s.mu.
+func (s *Store) statsPRs(filter *domain.StatsFilter) []*prRecord {
+	listFilter := &domain.PRListFilter{Status: filter.Status, CreatedFrom: filter.CreatedFrom, CreatedTo: filter.CreatedTo}
+
+	prs := make([]*prRecord, 0, len(s.prs))
+	for _, pr := range s.prs {
+		if s.matches(pr, listFilter) {
+			prs = append(prs, pr)
+		}
+	}
+	return prs
+}
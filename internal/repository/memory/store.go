@@ -0,0 +1,165 @@
+// Package memory - хранилище в памяти процесса для тестов и демонстраций.
+//
+// Репозитории повторяют семантику PostgreSQL-реализаций из пакета repository: те же доменные
+// ошибки, порядок выдачи, случайный выбор ревьюверов и идемпотентный merge. Все репозитории
+// одного Store работают с общими данными под одной блокировкой, поэтому каждая операция
+// атомарна, как транзакция в базе. Данные теряются при остановке процесса.
+package memory
+
+import (
+	"cmp"
+	"slices"
+	"sync"
+	"time"
+
+	"pr-reviewer-service/internal/domain"
+)
+
+// Store - общие данные репозиториев в памяти.
+type Store struct {
+	mu sync.RWMutex
+
+	teams       map[string]*teamRecord
+	users       map[string]*domain.User
+	skills      map[string][]string
+	outOfOffice map[string]time.Time
+	prs         map[string]*prRecord
+	rationales  map[string]*domain.AssignmentRationale
+	ownership   map[string][]*domain.OwnershipRule
+
+	// statusHistory - смены активности каждого пользователя в порядке времени (как user_status_history)
+	statusHistory map[string][]*domain.UserStatusChange
+
+	idempotency map[string]*domain.IdempotencyRecord
+}
+
+// teamRecord - строка таблицы teams
+type teamRecord struct {
+	name   string
+	parent string
+}
+
+// prRecord - PR вместе с ревьюверами в порядке назначения
+type prRecord struct {
+	id        string
+	name      string
+	authorID  string
+	status    string
+	createdAt time.Time
+	mergedAt  *time.Time
+	reviewers []string
+	version   int64
+}
+
+// NewStore создает пустое хранилище.
+func NewStore() *Store {
+	return &Store{
+		teams:       make(map[string]*teamRecord),
+		users:       make(map[string]*domain.User),
+		skills:      make(map[string][]string),
+		outOfOffice: make(map[string]time.Time),
+		prs:         make(map[string]*prRecord),
+		rationales:  make(map[string]*domain.AssignmentRationale),
+		ownership:   make(map[string][]*domain.OwnershipRule),
+
+		statusHistory: make(map[string][]*domain.UserStatusChange),
+
+		idempotency: make(map[string]*domain.IdempotencyRecord),
+	}
+}
+
+// now возвращает текущее время с точностью timestamptz (микросекунды),
+// чтобы курсоры пагинации совпадали с PostgreSQL
+func now() time.Time {
+	return time.Now().Truncate(time.Microsecond)
+}
+
+// recordStatus добавляет запись в историю активности, если статус пользователя изменился
+// (для нового пользователя - всегда), как триггер на таблице users
+func (s *Store) recordStatus(userID string, isActive bool) {
+	history := s.statusHistory[userID]
+	if n := len(history); n > 0 && history[n-1].IsActive == isActive {
+		return
+	}
+	s.statusHistory[userID] = append(history, &domain.UserStatusChange{UserID: userID, IsActive: isActive, ChangedAt: now()})
+}
+
+// copyUser возвращает копию пользователя без навыков
+func copyUser(u *domain.User) *domain.User {
+	return &domain.User{
+		ID:       u.ID,
+		Username: u.Username,
+		TeamName: u.TeamName,
+		IsActive: u.IsActive,
+	}
+}
+
+// usersWhere возвращает копии подходящих пользователей, отсортированные по ID
+func (s *Store) usersWhere(match func(u *domain.User) bool) []*domain.User {
+	users := make([]*domain.User, 0)
+	for _, u := range s.users {
+		if match(u) {
+			users = append(users, copyUser(u))
+		}
+	}
+	slices.SortFunc(users, func(a, b *domain.User) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+	return users
+}
+
+// openReviews считает открытые PR, где пользователь назначен ревьювером
+func (s *Store) openReviews(userID string) int64 {
+	var count int64
+	for _, pr := range s.prs {
+		if pr.status == domain.PRStatusOpen && slices.Contains(pr.reviewers, userID) {
+			count++
+		}
+	}
+	return count
+}
+
+// reviewCandidate дополняет копию пользователя отсутствием и количеством открытых PR на ревью
+func (s *Store) reviewCandidate(user *domain.User) *domain.ReviewCandidate {
+	if until, ok := s.outOfOffice[user.ID]; ok {
+		user.OutOfOfficeUntil = &until
+	}
+	return &domain.ReviewCandidate{
+		User:        user,
+		OpenReviews: int(s.openReviews(user.ID)),
+	}
+}
+
+// toDomain возвращает копию PR; sortReviewers упорядочивает ревьюверов по ID,
+// как это делают выборки списков в PostgreSQL
+func (pr *prRecord) toDomain(sortReviewers bool) *domain.PullRequest {
+	createdAt := pr.createdAt
+	result := &domain.PullRequest{
+		ID:                pr.id,
+		Name:              pr.name,
+		AuthorID:          pr.authorID,
+		Status:            pr.status,
+		CreatedAt:         &createdAt,
+		AssignedReviewers: slices.Clone(pr.reviewers),
+		Version:           pr.version,
+	}
+	if result.AssignedReviewers == nil {
+		result.AssignedReviewers = []string{}
+	}
+	if sortReviewers {
+		slices.Sort(result.AssignedReviewers)
+	}
+	if pr.mergedAt != nil {
+		mergedAt := *pr.mergedAt
+		result.MergedAt = &mergedAt
+	}
+	return result
+}
+
+// newerFirst упорядочивает PR от новых к старым, при равном времени - по убыванию ID
+func newerFirst(a, b *prRecord) int {
+	if c := b.createdAt.Compare(a.createdAt); c != 0 {
+		return c
+	}
+	return cmp.Compare(b.id, a.id)
+}
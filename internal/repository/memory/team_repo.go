@@ -0,0 +1,291 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"pr-reviewer-service/internal/domain"
+)
+
+// TeamRepository реализует domain.TeamRepository поверх Store.
+type TeamRepository struct {
+	store *Store
+}
+
+// NewTeamRepository создает новый экземпляр TeamRepository.
+func NewTeamRepository(store *Store) domain.TeamRepository {
+	return &TeamRepository{store: store}
+}
+
+// Create создает команду и обновляет/создает пользователей.
+// У существующих пользователей меняется только команда, как при UpsertUser.
+func (r *TeamRepository) Create(_ context.Context, team *domain.Team) error {
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.teams[team.Name]; ok {
+		return domain.ErrTeamAlreadyExists
+	}
+	if team.ParentName != "" {
+		if err := s.checkParent(team.Name, team.ParentName); err != nil {
+			return fmt.Errorf("failed to set parent team: %w", err)
+		}
+	}
+
+	s.teams[team.Name] = &teamRecord{name: team.Name, parent: team.ParentName}
+	for _, member := range team.Members {
+		if existing, ok := s.users[member.ID]; ok {
+			existing.TeamName = team.Name
+			continue
+		}
+		s.users[member.ID] = &domain.User{
+			ID:       member.ID,
+			Username: member.Username,
+			TeamName: team.Name,
+			IsActive: member.IsActive,
+		}
+		s.recordStatus(member.ID, member.IsActive)
+	}
+
+	return nil
+}
+
+// GetByName возвращает команду по названию; для несуществующей команды - пустую команду без ошибки.
+func (r *TeamRepository) GetByName(_ context.Context, teamName string) (*domain.Team, error) {
+	s := r.store
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	team := &domain.Team{
+		Name: teamName,
+		Members: s.usersWhere(func(u *domain.User) bool {
+			return u.TeamName == teamName
+		}),
+	}
+	if record, ok := s.teams[teamName]; ok {
+		team.ParentName = record.parent
+	}
+
+	return team, nil
+}
+
+// ExistsTeam проверяет существование команды.
+func (r *TeamRepository) ExistsTeam(_ context.Context, teamName string) (bool, error) {
+	s := r.store
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	_, ok := s.teams[teamName]
+	return ok, nil
+}
+
+// GetAllUsersByTeam возвращает всех пользователей команды.
+func (r *TeamRepository) GetAllUsersByTeam(_ context.Context, teamName string) ([]*domain.User, error) {
+	s := r.store
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.usersWhere(func(u *domain.User) bool {
+		return u.TeamName == teamName
+	}), nil
+}
+
+// GetActiveUsersFromTeam возвращает активных пользователей команды
+func (r *TeamRepository) GetActiveUsersFromTeam(_ context.Context, teamName string) ([]*domain.User, error) {
+	s := r.store
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.usersWhere(func(u *domain.User) bool {
+		return u.TeamName == teamName && u.IsActive
+	}), nil
+}
+
+// GetOpenPRsWithTeamReviewers возвращает ID открытых PR с активными ревьюверами из указанной команды
+func (r *TeamRepository) GetOpenPRsWithTeamReviewers(_ context.Context, teamName string) ([]string, error) {
+	s := r.store
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	prIDs := make([]string, 0)
+	for _, pr := range s.prs {
+		if pr.status != domain.PRStatusOpen {
+			continue
+		}
+		if slices.ContainsFunc(pr.reviewers, func(id string) bool {
+			return s.isActiveMember(id, teamName)
+		}) {
+			prIDs = append(prIDs, pr.id)
+		}
+	}
+	slices.Sort(prIDs)
+
+	return prIDs, nil
+}
+
+// GetPRReviewersFromTeam возвращает активных ревьюверов из указанной команды для конкретного PR
+func (r *TeamRepository) GetPRReviewersFromTeam(_ context.Context, prID, teamName string) ([]string, error) {
+	s := r.store
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	reviewerIDs := make([]string, 0)
+	if pr, ok := s.prs[prID]; ok {
+		for _, id := range pr.reviewers {
+			if s.isActiveMember(id, teamName) {
+				reviewerIDs = append(reviewerIDs, id)
+			}
+		}
+	}
+
+	return reviewerIDs, nil
+}
+
+// GetAllTeams возвращает все команды
+func (r *TeamRepository) GetAllTeams(_ context.Context) ([]*domain.Team, error) {
+	s := r.store
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	teams := make([]*domain.Team, 0, len(s.teams))
+	for _, name := range s.sortedTeamNames() {
+		teams = append(teams, &domain.Team{Name: name})
+	}
+
+	return teams, nil
+}
+
+// SetParent привязывает команду к родительской. Пустой parentName делает команду корневой.
+// Для несуществующей команды ничего не делает, как UPDATE без подходящих строк.
+func (r *TeamRepository) SetParent(_ context.Context, teamName, parentName string) error {
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	record, ok := s.teams[teamName]
+	if !ok {
+		return nil
+	}
+	err := domain.CheckNoCycle(teamName, parentName, func(current string) (string, error) {
+		parent, ok := s.teams[current]
+		if !ok {
+			return "", domain.ErrParentTeamNotFound
+		}
+		return parent.parent, nil
+	})
+	if err != nil {
+		return err
+	}
+	record.parent = parentName
+
+	return nil
+}
+
+// GetParent возвращает название родительской команды или пустую строку для корневой команды.
+func (r *TeamRepository) GetParent(_ context.Context, teamName string) (string, error) {
+	s := r.store
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	record, ok := s.teams[teamName]
+	if !ok {
+		return "", domain.ErrTeamNotFound
+	}
+
+	return record.parent, nil
+}
+
+// GetChildren возвращает названия дочерних команд
+func (r *TeamRepository) GetChildren(_ context.Context, teamName string) ([]string, error) {
+	s := r.store
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	children := make([]string, 0)
+	for _, name := range s.sortedTeamNames() {
+		if s.teams[name].parent == teamName {
+			children = append(children, name)
+		}
+	}
+
+	return children, nil
+}
+
+// GetTeamTreeStats возвращает плоский список команд с родителями и собственными показателями
+func (r *TeamRepository) GetTeamTreeStats(_ context.Context) ([]*domain.TeamTreeNode, error) {
+	s := r.store
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	nodes := make([]*domain.TeamTreeNode, 0, len(s.teams))
+	for _, name := range s.sortedTeamNames() {
+		var stats domain.TeamStats
+		for _, u := range s.users {
+			if u.TeamName != name {
+				continue
+			}
+			stats.MembersCount++
+			if u.IsActive {
+				stats.ActiveMembersCount++
+			}
+			stats.OpenReviewsCount += s.openReviews(u.ID)
+		}
+		for _, pr := range s.prs {
+			if pr.status == domain.PRStatusOpen && s.users[pr.authorID].TeamName == name {
+				stats.OpenPRsCount++
+			}
+		}
+
+		nodes = append(nodes, &domain.TeamTreeNode{
+			Name:       name,
+			ParentName: s.teams[name].parent,
+			Stats:      stats,
+		})
+	}
+
+	return nodes, nil
+}
+
+// GetByNames возвращает команды по списку названий; участники не заполняются
+func (r *TeamRepository) GetByNames(_ context.Context, teamNames []string) ([]*domain.Team, error) {
+	s := r.store
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	teams := make([]*domain.Team, 0, len(teamNames))
+	for _, name := range s.sortedTeamNames() {
+		if slices.Contains(teamNames, name) {
+			teams = append(teams, &domain.Team{Name: name, ParentName: s.teams[name].parent})
+		}
+	}
+
+	return teams, nil
+}
+
+// checkParent повторяет ограничения таблицы teams: родитель существует и не совпадает с командой
+func (s *Store) checkParent(teamName, parentName string) error {
+	if parentName == teamName {
+		return domain.ErrTeamHierarchyCycle
+	}
+	if _, ok := s.teams[parentName]; !ok {
+		return domain.ErrParentTeamNotFound
+	}
+	return nil
+}
+
+// isActiveMember проверяет, что пользователь активен и состоит в команде
+func (s *Store) isActiveMember(userID, teamName string) bool {
+	u, ok := s.users[userID]
+	return ok && u.IsActive && u.TeamName == teamName
+}
+
+func (s *Store) sortedTeamNames() []string {
+	names := make([]string, 0, len(s.teams))
+	for name := range s.teams {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
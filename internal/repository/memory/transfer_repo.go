@@ -0,0 +1,172 @@
+package memory
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"time"
+
+	"pr-reviewer-service/internal/domain"
+)
+
+// TransferRepository реализует domain.TransferRepository поверх Store.
+type TransferRepository struct {
+	store *Store
+}
+
+// NewTransferRepository создает новый экземпляр TransferRepository.
+func NewTransferRepository(store *Store) domain.TransferRepository {
+	return &TransferRepository{store: store}
+}
+
+// Export копирует данные под блокировкой в порядке выгрузки PostgreSQL и передает их в emit
+// уже без блокировки, чтобы медленный клиент выгрузки не задерживал запись.
+func (r *TransferRepository) Export(_ context.Context, emit func(record *domain.ExportRecord) error) error {
+	for _, record := range r.snapshot() {
+		if err := emit(record); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// snapshot возвращает копии всех записей выгрузки
+func (r *TransferRepository) snapshot() []*domain.ExportRecord {
+	s := r.store
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var records []*domain.ExportRecord
+	for _, name := range s.sortedTeamNames() {
+		records = append(records, &domain.ExportRecord{
+			Type: domain.RecordTypeTeam,
+			Team: &domain.Team{Name: name, ParentName: s.teams[name].parent},
+		})
+	}
+
+	for _, user := range s.usersWhere(func(*domain.User) bool { return true }) {
+		user.Skills = slices.Clone(s.skills[user.ID])
+		if user.Skills == nil {
+			user.Skills = []string{}
+		}
+		if until, ok := s.outOfOffice[user.ID]; ok {
+			user.OutOfOfficeUntil = &until
+		}
+		for _, change := range s.statusHistory[user.ID] {
+			copied := *change
+			user.StatusHistory = append(user.StatusHistory, &copied)
+		}
+		records = append(records, &domain.ExportRecord{Type: domain.RecordTypeUser, User: user})
+	}
+
+	prIDs := slices.Sorted(maps.Keys(s.prs))
+	for _, prID := range prIDs {
+		pr := s.prs[prID].toDomain(true)
+		if rationale, ok := s.rationales[prID]; ok {
+			pr.Rationale = copyRationale(rationale)
+		}
+		records = append(records, &domain.ExportRecord{Type: domain.RecordTypePullRequest, PullRequest: pr})
+	}
+
+	return records
+}
+
+// Import выполняет fn под блокировкой хранилища. Если fn вернула ошибку, данные возвращаются
+// к состоянию до загрузки: importStore только заменяет записи, не изменяя прежние, поэтому
+// достаточно сохранить сами map.
+func (r *TransferRepository) Import(_ context.Context, fn func(store domain.ImportStore) error) error {
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	teams, users, skills := maps.Clone(s.teams), maps.Clone(s.users), maps.Clone(s.skills)
+	outOfOffice, statusHistory := maps.Clone(s.outOfOffice), maps.Clone(s.statusHistory)
+	prs, rationales := maps.Clone(s.prs), maps.Clone(s.rationales)
+
+	if err := fn(&importStore{s: s}); err != nil {
+		s.teams, s.users, s.skills = teams, users, skills
+		s.outOfOffice, s.statusHistory = outOfOffice, statusHistory
+		s.prs, s.rationales = prs, rationales
+		return err
+	}
+
+	return nil
+}
+
+// importStore - операции загрузки; вызываются под s.mu
+type importStore struct {
+	s *Store
+}
+
+func (i *importStore) ExistsTeam(_ context.Context, teamName string) (bool, error) {
+	_, ok := i.s.teams[teamName]
+	return ok, nil
+}
+
+func (i *importStore) GetParent(_ context.Context, teamName string) (string, error) {
+	record, ok := i.s.teams[teamName]
+	if !ok {
+		return "", domain.ErrTeamNotFound
+	}
+	return record.parent, nil
+}
+
+func (i *importStore) SaveTeam(_ context.Context, team *domain.Team) error {
+	i.s.teams[team.Name] = &teamRecord{name: team.Name, parent: team.ParentName}
+	return nil
+}
+
+func (i *importStore) ExistsUser(_ context.Context, userID string) (bool, error) {
+	_, ok := i.s.users[userID]
+	return ok, nil
+}
+
+// SaveUser заменяет историю активности пользователя целиком, не вызывая recordStatus
+func (i *importStore) SaveUser(_ context.Context, user *domain.User) error {
+	i.s.users[user.ID] = copyUser(user)
+	i.s.skills[user.ID] = slices.Clone(user.Skills)
+
+	delete(i.s.outOfOffice, user.ID)
+	if user.OutOfOfficeUntil != nil {
+		i.s.outOfOffice[user.ID] = user.OutOfOfficeUntil.Truncate(time.Microsecond)
+	}
+
+	history := make([]*domain.UserStatusChange, len(user.StatusHistory))
+	for j, change := range user.StatusHistory {
+		history[j] = &domain.UserStatusChange{UserID: user.ID, IsActive: change.IsActive, ChangedAt: change.ChangedAt.Truncate(time.Microsecond)}
+	}
+	i.s.statusHistory[user.ID] = history
+	return nil
+}
+
+func (i *importStore) ExistsPR(_ context.Context, prID string) (bool, error) {
+	_, ok := i.s.prs[prID]
+	return ok, nil
+}
+
+func (i *importStore) SavePR(_ context.Context, pr *domain.PullRequest) error {
+	record := &prRecord{
+		id:        pr.ID,
+		name:      pr.Name,
+		authorID:  pr.AuthorID,
+		status:    pr.Status,
+		createdAt: pr.CreatedAt.Truncate(time.Microsecond),
+		reviewers: slices.Clone(pr.AssignedReviewers),
+		version:   1,
+	}
+	if pr.MergedAt != nil {
+		mergedAt := pr.MergedAt.Truncate(time.Microsecond)
+		record.mergedAt = &mergedAt
+	}
+	if existing, ok := i.s.prs[pr.ID]; ok {
+		record.version = existing.version + 1
+	}
+	i.s.prs[pr.ID] = record
+
+	delete(i.s.rationales, pr.ID)
+	if pr.Rationale != nil {
+		i.s.rationales[pr.ID] = copyRationale(pr.Rationale)
+	}
+
+	return nil
+}
@@ -0,0 +1,205 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"time"
+
+	"pr-reviewer-service/internal/domain"
+)
+
+// maxRandomCandidates - сколько кандидатов возвращает GetActiveUsersByTeam (LIMIT 2 в PostgreSQL)
+const maxRandomCandidates = 2
+
+// UserRepository реализует domain.UserRepository поверх Store.
+type UserRepository struct {
+	store *Store
+}
+
+// NewUserRepository создает новый экземпляр UserRepository.
+func NewUserRepository(store *Store) domain.UserRepository {
+	return &UserRepository{store: store}
+}
+
+// GetByID возвращает пользователя по ID.
+func (r *UserRepository) GetByID(_ context.Context, userID string) (*domain.User, error) {
+	s := r.store
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	u, ok := s.users[userID]
+	if !ok {
+		return nil, domain.ErrUserNotFound
+	}
+
+	return copyUser(u), nil
+}
+
+// GetActiveUsersByTeam возвращает до двух случайных активных пользователей команды, кроме excludeUserID.
+func (r *UserRepository) GetActiveUsersByTeam(_ context.Context, teamName string, excludeUserID string) ([]*domain.User, error) {
+	s := r.store
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	candidates := s.usersWhere(func(u *domain.User) bool {
+		return u.TeamName == teamName && u.IsActive && u.ID != excludeUserID
+	})
+	rand.Shuffle(len(candidates), func(i, j int) { //nolint:gosec // не криптография
+		candidates[i], candidates[j] = candidates[j], candidates[i]
+	})
+
+	return candidates[:min(len(candidates), maxRandomCandidates)], nil
+}
+
+// UpdateActiveStatus обновляет статус активности пользователя.
+func (r *UserRepository) UpdateActiveStatus(_ context.Context, userID string, isActive bool) (*domain.User, error) {
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u, ok := s.users[userID]
+	if !ok {
+		return nil, domain.ErrUserNotFound
+	}
+	u.IsActive = isActive
+	s.recordStatus(userID, isActive)
+
+	return copyUser(u), nil
+}
+
+// GetUserTeam возвращает название команды пользователя.
+func (r *UserRepository) GetUserTeam(_ context.Context, userID string) (string, error) {
+	s := r.store
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	u, ok := s.users[userID]
+	if !ok {
+		return "", domain.ErrUserNotFound
+	}
+
+	return u.TeamName, nil
+}
+
+// SetSkills полностью заменяет теги навыков пользователя.
+func (r *UserRepository) SetSkills(_ context.Context, userID string, skills []string) error {
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.users[userID]; !ok {
+		return fmt.Errorf("failed to add user skills: %w", domain.ErrUserNotFound)
+	}
+
+	tags := slices.Clone(skills)
+	slices.Sort(tags)
+	if len(slices.Compact(slices.Clone(tags))) != len(tags) {
+		return errors.New("failed to add user skills: duplicate tag")
+	}
+	s.skills[userID] = tags
+
+	return nil
+}
+
+// GetSkills возвращает теги навыков пользователя в алфавитном порядке.
+func (r *UserRepository) GetSkills(_ context.Context, userID string) ([]string, error) {
+	s := r.store
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	skills := slices.Clone(s.skills[userID])
+	if skills == nil {
+		return []string{}, nil
+	}
+	return skills, nil
+}
+
+// GetTeamRoster возвращает всех участников команды (включая неактивных)
+// с их навыками, отсутствием и количеством открытых PR на ревью.
+func (r *UserRepository) GetTeamRoster(_ context.Context, teamName string) ([]*domain.ReviewCandidate, error) {
+	s := r.store
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	members := s.usersWhere(func(u *domain.User) bool {
+		return u.TeamName == teamName
+	})
+
+	candidates := make([]*domain.ReviewCandidate, 0, len(members))
+	for _, member := range members {
+		member.Skills = slices.Clone(s.skills[member.ID])
+		candidates = append(candidates, s.reviewCandidate(member))
+	}
+
+	return candidates, nil
+}
+
+// SetOutOfOffice отмечает пользователя отсутствующим до until; nil снимает отметку.
+func (r *UserRepository) SetOutOfOffice(_ context.Context, userID string, until *time.Time) error {
+	s := r.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.users[userID]; !ok {
+		return fmt.Errorf("failed to set user out of office: %w", domain.ErrUserNotFound)
+	}
+
+	if until == nil {
+		delete(s.outOfOffice, userID)
+	} else {
+		s.outOfOffice[userID] = until.Truncate(time.Microsecond)
+	}
+
+	return nil
+}
+
+// GetReviewCandidates возвращает найденных пользователей по списку ID (без навыков)
+// с отсутствием и количеством открытых PR на ревью.
+func (r *UserRepository) GetReviewCandidates(_ context.Context, userIDs []string) ([]*domain.ReviewCandidate, error) {
+	s := r.store
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	users := s.usersWhere(func(u *domain.User) bool {
+		return slices.Contains(userIDs, u.ID)
+	})
+
+	candidates := make([]*domain.ReviewCandidate, 0, len(users))
+	for _, user := range users {
+		candidates = append(candidates, s.reviewCandidate(user))
+	}
+
+	return candidates, nil
+}
+
+// GetByIDs возвращает найденных пользователей по списку ID
+func (r *UserRepository) GetByIDs(_ context.Context, userIDs []string) ([]*domain.User, error) {
+	s := r.store
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.usersWhere(func(u *domain.User) bool {
+		return slices.Contains(userIDs, u.ID)
+	}), nil
+}
+
+// GetByTeams возвращает всех участников (включая неактивных) перечисленных команд,
+// упорядоченных по команде и ID
+func (r *UserRepository) GetByTeams(_ context.Context, teamNames []string) ([]*domain.User, error) {
+	s := r.store
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	users := s.usersWhere(func(u *domain.User) bool {
+		return slices.Contains(teamNames, u.TeamName)
+	})
+	slices.SortStableFunc(users, func(a, b *domain.User) int {
+		return cmp.Compare(a.TeamName, b.TeamName)
+	})
+
+	return users, nil
+}
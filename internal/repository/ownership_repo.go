@@ -0,0 +1,122 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"pr-reviewer-service/internal/database"
+	"pr-reviewer-service/internal/domain"
+)
+
+const (
+	ownerTypeUser = "user"
+	ownerTypeTeam = "team"
+)
+
+// OwnershipRepository реализует хранение правил владения кодом в PostgreSQL.
+type OwnershipRepository struct {
+	db      *sql.DB
+	queries *database.Queries
+	retry   database.RetryPolicy
+}
+
+// NewOwnershipRepository создает новый экземпляр OwnershipRepository.
+func NewOwnershipRepository(db *sql.DB, queries *database.Queries, opts ...Option) domain.OwnershipRepository {
+	o := newOptions(opts)
+	return &OwnershipRepository{
+		db:      db,
+		queries: queries,
+		retry:   o.retry,
+	}
+}
+
+// ReplaceRules полностью заменяет правила владения репозитория, сохраняя их порядок.
+func (r *OwnershipRepository) ReplaceRules(ctx context.Context, repository string, rules []*domain.OwnershipRule) error {
+	return inTx(ctx, r.db, r.queries, r.retry, func(txQueries *database.Queries) error {
+		// 1. Удаляем старые правила (владельцы удаляются каскадно)
+		err := txQueries.DeleteOwnershipRules(ctx, repository)
+		if err != nil {
+			return fmt.Errorf("failed to delete ownership rules: %w", err)
+		}
+
+		// 2. Сохраняем новые правила в исходном порядке
+		for i, rule := range rules {
+			position := int32(i)
+			err = txQueries.CreateOwnershipRule(ctx, database.CreateOwnershipRuleParams{
+				Repository: repository,
+				Position:   position,
+				Pattern:    rule.Pattern,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create ownership rule %q: %w", rule.Pattern, err)
+			}
+
+			err = r.addOwners(ctx, txQueries, repository, position, ownerTypeUser, rule.UserIDs)
+			if err != nil {
+				return err
+			}
+
+			err = r.addOwners(ctx, txQueries, repository, position, ownerTypeTeam, rule.TeamNames)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// addOwners сохраняет владельцев правила указанного типа
+func (r *OwnershipRepository) addOwners(ctx context.Context, q *database.Queries, repository string, position int32, ownerType string, ownerIDs []string) error {
+	for _, ownerID := range ownerIDs {
+		err := q.AddOwnershipRuleOwner(ctx, database.AddOwnershipRuleOwnerParams{
+			Repository: repository,
+			Position:   position,
+			OwnerType:  ownerType,
+			OwnerID:    ownerID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to add %s owner %s: %w", ownerType, ownerID, err)
+		}
+	}
+
+	return nil
+}
+
+// GetRules возвращает правила владения репозитория в порядке их применения.
+func (r *OwnershipRepository) GetRules(ctx context.Context, repository string) ([]*domain.OwnershipRule, error) {
+	rows, err := r.queries.GetOwnershipRules(ctx, repository)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ownership rules: %w", err)
+	}
+
+	rules := make([]*domain.OwnershipRule, 0)
+	var current *domain.OwnershipRule
+	currentPosition := int32(-1)
+
+	for _, row := range rows {
+		if current == nil || row.Position != currentPosition {
+			current = &domain.OwnershipRule{
+				Pattern:   row.Pattern,
+				UserIDs:   []string{},
+				TeamNames: []string{},
+			}
+			currentPosition = row.Position
+			rules = append(rules, current)
+		}
+
+		if !row.OwnerID.Valid {
+			continue
+		}
+
+		switch row.OwnerType.String {
+		case ownerTypeUser:
+			current.UserIDs = append(current.UserIDs, row.OwnerID.String)
+		case ownerTypeTeam:
+			current.TeamNames = append(current.TeamNames, row.OwnerID.String)
+		}
+	}
+
+	return rules, nil
+}
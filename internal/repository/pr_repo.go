@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"pr-reviewer-service/internal/database"
@@ -15,59 +16,59 @@ import (
 type PRRepository struct {
 	db      *sql.DB
 	queries *database.Queries
+	retry   database.RetryPolicy
 }
 
 // NewPRRepository создает новый экземпляр PRRepository.
-func NewPRRepository(db *sql.DB, queries *database.Queries) domain.PRRepository {
+func NewPRRepository(db *sql.DB, queries *database.Queries, opts ...Option) domain.PRRepository {
+	o := newOptions(opts)
 	return &PRRepository{
 		db:      db,
 		queries: queries,
+		retry:   o.retry,
 	}
 }
 
 // CreateWithReviewers создает PR и назначает до 2 ревьюверов.
 func (r *PRRepository) CreateWithReviewers(ctx context.Context, pr *domain.PullRequest, reviewerIDs []string) error {
-	tx, err := r.db.BeginTx(ctx, nil)
-	if err != nil {
-		return fmt.Errorf("failed to begin transaction: %w", err)
-	}
-
-	defer func() {
-		if err != nil {
-			_ = tx.Rollback()
-		}
-	}()
-
-	txQueries := r.queries.WithTx(tx)
-
-	// 1. Создаем PR
-	_, err = txQueries.CreatePullRequest(ctx, database.CreatePullRequestParams{
-		PullRequestID:   pr.ID,
-		PullRequestName: pr.Name,
-		AuthorID:        pr.AuthorID,
-	})
-	if err != nil {
-		return fmt.Errorf("failed to create PR: %w", err)
-	}
-
-	// 2. Назначаем ревьюверов
-	for i := 0; i < len(reviewerIDs); i++ {
-		reviewerID := reviewerIDs[i]
-		err = txQueries.AssignReviewer(ctx, database.AssignReviewerParams{
-			PullRequestID: pr.ID,
-			UserID:        reviewerID,
+	return inTx(ctx, r.db, r.queries, r.retry, func(txQueries *database.Queries) error {
+		// 1. Создаем PR
+		created, err := txQueries.CreatePullRequest(ctx, database.CreatePullRequestParams{
+			PullRequestID:   pr.ID,
+			PullRequestName: pr.Name,
+			AuthorID:        pr.AuthorID,
 		})
 		if err != nil {
-			return fmt.Errorf("failed to assign reviewer %s: %w", reviewerID, err)
+			if database.IsUniqueViolation(err) {
+				return domain.ErrPRAlreadyExists
+			}
+			return fmt.Errorf("failed to create PR: %w", err)
+		}
+		pr.CreatedAt = &created.CreatedAt
+		pr.Version = created.Version
+
+		// 2. Назначаем ревьюверов
+		for i := 0; i < len(reviewerIDs); i++ {
+			reviewerID := reviewerIDs[i]
+			err = txQueries.AssignReviewer(ctx, database.AssignReviewerParams{
+				PullRequestID: pr.ID,
+				UserID:        reviewerID,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to assign reviewer %s: %w", reviewerID, err)
+			}
 		}
-	}
 
-	// 5. Коммитим транзакцию
-	if err := tx.Commit(); err != nil {
-		return fmt.Errorf("failed to commit transaction: %w", err)
-	}
+		// 3. Сохраняем объяснение выбора ревьюверов
+		if pr.Rationale != nil {
+			err = saveRationale(ctx, txQueries, pr.ID, pr.Rationale)
+			if err != nil {
+				return err
+			}
+		}
 
-	return nil
+		return nil
+	})
 }
 
 // GetByID возвращает PR по ID.
@@ -96,98 +97,131 @@ func (r *PRRepository) GetByID(ctx context.Context, prID string) (*domain.PullRe
 		Name:              dbPR.PullRequestName,
 		AuthorID:          dbPR.AuthorID,
 		Status:            dbPR.Status,
+		CreatedAt:         &dbPR.CreatedAt,
 		MergedAt:          mergedAt,
 		AssignedReviewers: reviewers,
+		Version:           dbPR.Version,
 	}, nil
 }
 
-// Merge изменяет статус PR на MERGED.
-func (r *PRRepository) Merge(ctx context.Context, prID string) (*domain.PullRequest, error) {
-	dbPR, err := r.queries.MergePullRequest(ctx, prID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to merge PR: %w", err)
-	}
+// Merge изменяет статус PR на MERGED. Проверка версии и мерж выполняются под блокировкой строки PR.
+func (r *PRRepository) Merge(ctx context.Context, prID string, expectedVersion int64) (*domain.PullRequest, bool, error) {
+	var merged *domain.PullRequest
+	var changed bool
+	err := inTx(ctx, r.db, r.queries, r.retry, func(txQueries *database.Queries) error {
+		locked, err := lockPR(ctx, txQueries, prID, expectedVersion)
+		if err != nil {
+			return err
+		}
+		changed = locked.Status == domain.PRStatusOpen
 
-	reviewers, err := r.GetReviewers(ctx, prID)
-	if err != nil {
-		return nil, err
-	}
+		dbPR, err := txQueries.MergePullRequest(ctx, prID)
+		if err != nil {
+			return fmt.Errorf("failed to merge PR: %w", err)
+		}
 
-	// Конвертируем NullTime → *time.Time
-	var mergedAt *time.Time
-	if dbPR.MergedAt.Valid {
-		mergedAt = &dbPR.MergedAt.Time
-	}
+		reviewers, err := txQueries.GetPRReviewers(ctx, prID)
+		if err != nil {
+			return fmt.Errorf("failed to get reviewers: %w", err)
+		}
 
-	return &domain.PullRequest{
-		ID:                dbPR.PullRequestID,
-		Name:              dbPR.PullRequestName,
-		AuthorID:          dbPR.AuthorID,
-		Status:            dbPR.Status,
-		MergedAt:          mergedAt,
-		AssignedReviewers: reviewers,
-	}, nil
-}
+		// Конвертируем NullTime → *time.Time
+		var mergedAt *time.Time
+		if dbPR.MergedAt.Valid {
+			mergedAt = &dbPR.MergedAt.Time
+		}
 
-// ReassignReviewer заменяет ревьювера на нового из той же команды.
-func (r *PRRepository) ReassignReviewer(ctx context.Context, prID, oldReviewerID, newReviewerID string) error {
-	tx, err := r.db.BeginTx(ctx, nil)
+		merged = &domain.PullRequest{
+			ID:                dbPR.PullRequestID,
+			Name:              dbPR.PullRequestName,
+			AuthorID:          dbPR.AuthorID,
+			Status:            dbPR.Status,
+			CreatedAt:         &dbPR.CreatedAt,
+			MergedAt:          mergedAt,
+			AssignedReviewers: append([]string{}, reviewers...),
+			Version:           dbPR.Version,
+		}
+		return nil
+	})
 	if err != nil {
-		return fmt.Errorf("failed to begin transaction: %w", err)
+		return nil, false, err
 	}
 
-	defer func() {
+	return merged, changed, nil
+}
+
+// ReassignReviewer заменяет ревьювера на нового из той же команды.
+// Версия, статус PR и назначение старого ревьювера проверяются под блокировкой строки PR,
+// поэтому конкурирующие переназначения не снимают одного ревьювера дважды.
+func (r *PRRepository) ReassignReviewer(ctx context.Context, prID, oldReviewerID, newReviewerID string, expectedVersion int64) error {
+	return inTx(ctx, r.db, r.queries, r.retry, func(txQueries *database.Queries) error {
+		// 1. Блокируем PR и сверяем версию
+		locked, err := lockPR(ctx, txQueries, prID, expectedVersion)
 		if err != nil {
-			_ = tx.Rollback()
+			return err
+		}
+		if locked.Status == domain.PRStatusMerged {
+			return domain.ErrPRAlreadyMerged
 		}
-	}()
 
-	txQueries := r.queries.WithTx(tx)
+		// 2. Удаляем старого ревьювера
+		removed, err := txQueries.RemoveReviewer(ctx, database.RemoveReviewerParams{
+			PullRequestID: prID,
+			UserID:        oldReviewerID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to remove reviewer: %w", err)
+		}
+		if removed == 0 {
+			return domain.ErrReviewerNotAssigned
+		}
 
-	// 1. Удаляем старого ревьювера
-	err = txQueries.RemoveReviewer(ctx, database.RemoveReviewerParams{
-		PullRequestID: prID,
-		UserID:        oldReviewerID,
-	})
-	if err != nil {
-		return fmt.Errorf("failed to remove reviewer: %w", err)
-	}
+		// 3. Добавляем нового ревьювера
+		err = txQueries.AssignReviewer(ctx, database.AssignReviewerParams{
+			PullRequestID: prID,
+			UserID:        newReviewerID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to assign new reviewer: %w", err)
+		}
 
-	// 2. Добавляем нового ревьювера
-	err = txQueries.AssignReviewer(ctx, database.AssignReviewerParams{
-		PullRequestID: prID,
-		UserID:        newReviewerID,
-	})
-	if err != nil {
-		return fmt.Errorf("failed to assign new reviewer: %w", err)
-	}
+		// 4. Отмечаем в объяснении, что новый ревьювер назначен переназначением
+		err = txQueries.ReplaceReviewerSelection(ctx, database.ReplaceReviewerSelectionParams{
+			PullRequestID: prID,
+			UserID:        oldReviewerID,
+			UserID_2:      newReviewerID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to update reviewer selection: %w", err)
+		}
 
-	// 5. Коммитим транзакцию
-	if err := tx.Commit(); err != nil {
-		return fmt.Errorf("failed to commit transaction: %w", err)
-	}
+		// 5. Увеличиваем версию PR
+		if err := txQueries.BumpPullRequestVersion(ctx, prID); err != nil {
+			return fmt.Errorf("failed to bump PR version: %w", err)
+		}
 
-	return nil
+		return nil
+	})
 }
 
-// GetUserAssignedPRs возвращает PR, где пользователь назначен ревьювером.
-func (r *PRRepository) GetUserAssignedPRs(ctx context.Context, userID string) ([]*domain.PullRequest, error) {
-	dbPRs, err := r.queries.GetUserAssignedPRs(ctx, userID)
-	if err != nil && !errors.Is(err, sql.ErrNoRows) {
-		return nil, fmt.Errorf("failed to get user assigned PRs: %w", err)
+// lockPR блокирует строку PR до конца транзакции и сверяет версию с ожидаемой (0 - без проверки)
+func lockPR(ctx context.Context, q *database.Queries, prID string, expectedVersion int64) (database.LockPullRequestRow, error) {
+	locked, err := q.LockPullRequest(ctx, prID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return locked, domain.ErrPRNotFound
+		}
+		return locked, fmt.Errorf("failed to lock PR: %w", err)
 	}
-
-	prs := make([]*domain.PullRequest, 0, len(dbPRs))
-	for _, dbPR := range dbPRs {
-		prs = append(prs, &domain.PullRequest{
-			ID:       dbPR.PullRequestID,
-			Name:     dbPR.PullRequestName,
-			AuthorID: dbPR.AuthorID,
-			Status:   dbPR.Status,
-		})
+	if expectedVersion != 0 && locked.Version != expectedVersion {
+		return locked, domain.ErrPRVersionConflict
 	}
+	return locked, nil
+}
 
-	return prs, nil
+// GetUserAssignedPRs возвращает до limit PR, где пользователь назначен ревьювером, от новых к старым, начиная после курсора after.
+func (r *PRRepository) GetUserAssignedPRs(ctx context.Context, userID string, after *domain.PRCursor, limit int) ([]*domain.PullRequest, error) {
+	return r.List(ctx, &domain.PRListFilter{ReviewerID: userID}, after, limit)
 }
 
 // prExists проверяет существование PR.
@@ -223,3 +257,195 @@ func (r *PRRepository) IsUserReviewer(ctx context.Context, prID, userID string)
 	}
 	return count > 0, nil
 }
+
+// saveRationale сохраняет объяснение выбора ревьюверов в рамках транзакции
+func saveRationale(ctx context.Context, q *database.Queries, prID string, rationale *domain.AssignmentRationale) error {
+	err := q.CreateAssignmentRationale(ctx, database.CreateAssignmentRationaleParams{
+		PullRequestID:     prID,
+		Strategy:          rationale.Strategy,
+		CandidatePoolSize: int32(rationale.CandidatePoolSize),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create assignment rationale: %w", err)
+	}
+
+	for i, selection := range rationale.Reviewers {
+		err = q.AddReviewerSelection(ctx, database.AddReviewerSelectionParams{
+			PullRequestID: prID,
+			UserID:        selection.UserID,
+			Position:      int32(i),
+			Reason:        string(selection.Reason),
+			MatchedTags:   strings.Join(selection.MatchedTags, ","),
+			OpenReviews:   int32(selection.OpenReviews),
+			SkillScore:    selection.SkillScore,
+			WorkloadScore: selection.WorkloadScore,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to add reviewer selection %s: %w", selection.UserID, err)
+		}
+	}
+
+	for _, excluded := range rationale.Excluded {
+		err = q.AddReviewerExclusion(ctx, database.AddReviewerExclusionParams{
+			PullRequestID: prID,
+			UserID:        excluded.UserID,
+			Reason:        string(excluded.Reason),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to add reviewer exclusion %s: %w", excluded.UserID, err)
+		}
+	}
+
+	return nil
+}
+
+// GetRationale возвращает объяснение выбора ревьюверов PR или nil, если оно не сохранялось
+// (PR создан до появления объяснений).
+func (r *PRRepository) GetRationale(ctx context.Context, prID string) (*domain.AssignmentRationale, error) {
+	row, err := r.queries.GetAssignmentRationale(ctx, prID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get assignment rationale: %w", err)
+	}
+
+	selections, err := r.queries.GetReviewerSelections(ctx, prID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reviewer selections: %w", err)
+	}
+
+	exclusions, err := r.queries.GetReviewerExclusions(ctx, prID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reviewer exclusions: %w", err)
+	}
+
+	rationale := &domain.AssignmentRationale{
+		Strategy:          row.Strategy,
+		CandidatePoolSize: int(row.CandidatePoolSize),
+		Reviewers:         make([]*domain.ReviewerSelection, 0, len(selections)),
+		Excluded:          make([]*domain.ExcludedCandidate, 0, len(exclusions)),
+	}
+
+	for _, selection := range selections {
+		rationale.Reviewers = append(rationale.Reviewers, &domain.ReviewerSelection{
+			UserID:        selection.UserID,
+			Reason:        domain.SelectionReason(selection.Reason),
+			MatchedTags:   splitList(selection.MatchedTags),
+			OpenReviews:   int(selection.OpenReviews),
+			SkillScore:    selection.SkillScore,
+			WorkloadScore: selection.WorkloadScore,
+			Score:         selection.SkillScore + selection.WorkloadScore,
+		})
+	}
+
+	for _, exclusion := range exclusions {
+		rationale.Excluded = append(rationale.Excluded, &domain.ExcludedCandidate{
+			UserID: exclusion.UserID,
+			Reason: domain.ExclusionReason(exclusion.Reason),
+		})
+	}
+
+	return rationale, nil
+}
+
+// List возвращает до limit PR, подходящих под фильтр, от новых к старым, начиная после курсора after.
+func (r *PRRepository) List(ctx context.Context, filter *domain.PRListFilter, after *domain.PRCursor, limit int) ([]*domain.PullRequest, error) {
+	params := database.ListPullRequestsParams{
+		Status:     toNullString(filter.Status),
+		AuthorID:   toNullString(filter.AuthorID),
+		ReviewerID: toNullString(filter.ReviewerID),
+		TeamName:   toNullString(filter.TeamName),
+		PageLimit:  int32(limit), //nolint:gosec // лимит ограничен в usecase
+	}
+	if filter.CreatedFrom != nil {
+		params.CreatedFrom = sql.NullTime{Time: *filter.CreatedFrom, Valid: true}
+	}
+	if filter.CreatedTo != nil {
+		params.CreatedTo = sql.NullTime{Time: *filter.CreatedTo, Valid: true}
+	}
+	if after != nil {
+		params.CursorCreatedAt = sql.NullTime{Time: after.CreatedAt, Valid: true}
+		params.CursorID = sql.NullString{String: after.ID, Valid: true}
+	}
+
+	rows, err := r.queries.ListPullRequests(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list PRs: %w", err)
+	}
+
+	prs := make([]*domain.PullRequest, 0, len(rows))
+	for _, row := range rows {
+		pr := &domain.PullRequest{
+			ID:                row.PullRequestID,
+			Name:              row.PullRequestName,
+			AuthorID:          row.AuthorID,
+			Status:            row.Status,
+			CreatedAt:         &row.CreatedAt,
+			AssignedReviewers: row.ReviewerIds,
+			Version:           row.Version,
+		}
+		if row.MergedAt.Valid {
+			pr.MergedAt = &row.MergedAt.Time
+		}
+		prs = append(prs, pr)
+	}
+
+	return prs, nil
+}
+
+// GetByIDs возвращает найденные PR с ревьюверами по списку ID одним запросом
+func (r *PRRepository) GetByIDs(ctx context.Context, prIDs []string) ([]*domain.PullRequest, error) {
+	rows, err := r.queries.GetPullRequestsByIDs(ctx, prIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get PRs by ids: %w", err)
+	}
+
+	prs := make([]*domain.PullRequest, 0, len(rows))
+	for _, row := range rows {
+		prs = append(prs, toDomainPR(row.PullRequestID, row.PullRequestName, row.AuthorID, row.Status,
+			row.CreatedAt, row.MergedAt, row.ReviewerIds))
+	}
+
+	return prs, nil
+}
+
+// GetAssignedToUsers возвращает PR на ревью у каждого из пользователей одним запросом.
+// Пользователи без назначений в результат не попадают.
+func (r *PRRepository) GetAssignedToUsers(ctx context.Context, userIDs []string) (map[string][]*domain.PullRequest, error) {
+	rows, err := r.queries.GetAssignedPRsByReviewers(ctx, userIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get PRs assigned to users: %w", err)
+	}
+
+	assigned := make(map[string][]*domain.PullRequest)
+	for _, row := range rows {
+		assigned[row.ReviewerID] = append(assigned[row.ReviewerID], toDomainPR(row.PullRequestID, row.PullRequestName,
+			row.AuthorID, row.Status, row.CreatedAt, row.MergedAt, row.ReviewerIds))
+	}
+
+	return assigned, nil
+}
+
+func toDomainPR(id, name, authorID, status string, createdAt time.Time, mergedAt sql.NullTime, reviewerIDs []string) *domain.PullRequest {
+	pr := &domain.PullRequest{
+		ID:                id,
+		Name:              name,
+		AuthorID:          authorID,
+		Status:            status,
+		CreatedAt:         &createdAt,
+		AssignedReviewers: reviewerIDs,
+	}
+	if mergedAt.Valid {
+		pr.MergedAt = &mergedAt.Time
+	}
+	return pr
+}
+
+// splitList разбирает список, сохраненный через запятую (пустая строка - пустой список)
+func splitList(value string) []string {
+	if value == "" {
+		return []string{}
+	}
+	return strings.Split(value, ",")
+}
@@ -0,0 +1,109 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"pr-reviewer-service/internal/database/sqlitedb"
+	"pr-reviewer-service/internal/domain"
+)
+
+// IdempotencyRepository реализует domain.IdempotencyRepository в SQLite.
+type IdempotencyRepository struct {
+	db      *sql.DB
+	queries *sqlitedb.Queries
+}
+
+// NewIdempotencyRepository создает новый экземпляр IdempotencyRepository.
+func NewIdempotencyRepository(db *sql.DB, queries *sqlitedb.Queries) domain.IdempotencyRepository {
+	return &IdempotencyRepository{
+		db:      db,
+		queries: queries,
+	}
+}
+
+// Reserve занимает свободный, истекший или брошенный ключ; для действующего ключа возвращает его запись.
+// Попытка и чтение записи выполняются под одной блокировкой записи.
+func (r *IdempotencyRepository) Reserve(ctx context.Context, key, fingerprint string, leaseExpiresAt, expiresAt time.Time) (*domain.IdempotencyRecord, bool, error) {
+	var record *domain.IdempotencyRecord
+	err := inTx(ctx, r.db, r.queries, func(txQueries *sqlitedb.Queries) error {
+		reserved, err := txQueries.ReserveIdempotencyKey(ctx, sqlitedb.ReserveIdempotencyKeyParams{
+			IdempotencyKey: key,
+			Fingerprint:    fingerprint,
+			LeaseExpiresAt: leaseExpiresAt.UnixMicro(),
+			ExpiresAt:      expiresAt.UnixMicro(),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to reserve idempotency key: %w", err)
+		}
+		if reserved > 0 {
+			return nil
+		}
+
+		row, err := txQueries.GetIdempotencyKey(ctx, key)
+		if err != nil {
+			return fmt.Errorf("failed to get idempotency key: %w", err)
+		}
+		record = &domain.IdempotencyRecord{
+			Key:            row.IdempotencyKey,
+			Fingerprint:    row.Fingerprint,
+			StatusCode:     int(row.StatusCode.Int64),
+			ContentType:    row.ContentType.String,
+			ETag:           row.Etag.String,
+			Body:           row.ResponseBody,
+			ExpiresAt:      *fromMicros(row.ExpiresAt),
+			LeaseExpiresAt: *fromMicros(row.LeaseExpiresAt),
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	return record, record == nil, nil
+}
+
+// Complete сохраняет ответ на запрос с ключом, если ключ все еще занят этим запросом.
+func (r *IdempotencyRepository) Complete(ctx context.Context, key, fingerprint string, statusCode int, contentType, etag string, body []byte) error {
+	completed, err := r.queries.CompleteIdempotencyKey(ctx, sqlitedb.CompleteIdempotencyKeyParams{
+		IdempotencyKey: key,
+		Fingerprint:    fingerprint,
+		StatusCode:     sql.NullInt64{Int64: int64(statusCode), Valid: true},
+		ContentType:    sql.NullString{String: contentType, Valid: true},
+		ResponseBody:   body,
+		Etag:           toNullString(etag),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save idempotent response: %w", err)
+	}
+	if completed == 0 {
+		return domain.ErrIdempotencyLeaseLost
+	}
+
+	return nil
+}
+
+// Release удаляет ключ, если он все еще занят этим запросом и ответ на него не сохранен.
+func (r *IdempotencyRepository) Release(ctx context.Context, key, fingerprint string) error {
+	err := r.queries.DeleteIdempotencyKey(ctx, sqlitedb.DeleteIdempotencyKeyParams{
+		IdempotencyKey: key,
+		Fingerprint:    fingerprint,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to release idempotency key: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteExpired удаляет истекшие ключи.
+func (r *IdempotencyRepository) DeleteExpired(ctx context.Context) (int64, error) {
+	deleted, err := r.queries.DeleteExpiredIdempotencyKeys(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete expired idempotency keys: %w", err)
+	}
+
+	return deleted, nil
+}
@@ -0,0 +1,415 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"pr-reviewer-service/internal/database/sqlitedb"
+	"pr-reviewer-service/internal/domain"
+)
+
+// PRRepository реализует взаимодействие с данными Pull Request'ов в SQLite.
+type PRRepository struct {
+	db      *sql.DB
+	queries *sqlitedb.Queries
+}
+
+// NewPRRepository создает новый экземпляр PRRepository.
+func NewPRRepository(db *sql.DB, queries *sqlitedb.Queries) domain.PRRepository {
+	return &PRRepository{
+		db:      db,
+		queries: queries,
+	}
+}
+
+// CreateWithReviewers создает PR и назначает до 2 ревьюверов.
+func (r *PRRepository) CreateWithReviewers(ctx context.Context, pr *domain.PullRequest, reviewerIDs []string) error {
+	createdAt := now()
+
+	err := inTx(ctx, r.db, r.queries, func(txQueries *sqlitedb.Queries) error {
+		// 1. Создаем PR
+		err := txQueries.CreatePullRequest(ctx, sqlitedb.CreatePullRequestParams{
+			PullRequestID:   pr.ID,
+			PullRequestName: pr.Name,
+			AuthorID:        pr.AuthorID,
+			CreatedAt:       createdAt.UnixMicro(),
+		})
+		if err != nil {
+			if sqlitedb.IsUniqueViolation(err) {
+				return domain.ErrPRAlreadyExists
+			}
+			return fmt.Errorf("failed to create PR: %w", err)
+		}
+
+		// 2. Назначаем ревьюверов
+		for _, reviewerID := range reviewerIDs {
+			err = txQueries.AssignReviewer(ctx, sqlitedb.AssignReviewerParams{
+				PullRequestID: pr.ID,
+				UserID:        reviewerID,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to assign reviewer %s: %w", reviewerID, err)
+			}
+		}
+
+		// 3. Сохраняем объяснение выбора ревьюверов
+		if pr.Rationale != nil {
+			return saveRationale(ctx, txQueries, pr.ID, pr.Rationale)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	pr.CreatedAt = &createdAt
+	// Новый PR получает версию 1 (DEFAULT столбца version)
+	pr.Version = 1
+	return nil
+}
+
+// GetByID возвращает PR по ID.
+func (r *PRRepository) GetByID(ctx context.Context, prID string) (*domain.PullRequest, error) {
+	dbPR, err := r.queries.GetPullRequestByID(ctx, prID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrPRNotFound
+		}
+		return nil, fmt.Errorf("failed to get PR: %w", err)
+	}
+
+	return withReviewers(ctx, r.queries, dbPR)
+}
+
+// Merge изменяет статус PR на MERGED. Повторный merge не меняет время слияния и версию.
+func (r *PRRepository) Merge(ctx context.Context, prID string, expectedVersion int64) (*domain.PullRequest, bool, error) {
+	mergedAt := now()
+
+	var merged *domain.PullRequest
+	var changed bool
+	err := inTx(ctx, r.db, r.queries, func(txQueries *sqlitedb.Queries) error {
+		current, err := checkVersion(ctx, txQueries, prID, expectedVersion)
+		if err != nil {
+			return err
+		}
+		changed = current.Status == domain.PRStatusOpen
+
+		dbPR, err := txQueries.MergePullRequest(ctx, sqlitedb.MergePullRequestParams{
+			PullRequestID: prID,
+			MergedAt:      toNullMicros(&mergedAt),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to merge PR: %w", err)
+		}
+
+		merged, err = withReviewers(ctx, txQueries, dbPR)
+		return err
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	return merged, changed, nil
+}
+
+// withReviewers дополняет PR списком ревьюверов
+func withReviewers(ctx context.Context, q *sqlitedb.Queries, dbPR sqlitedb.PullRequest) (*domain.PullRequest, error) {
+	reviewers, err := q.GetPRReviewers(ctx, dbPR.PullRequestID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reviewers: %w", err)
+	}
+	if reviewers == nil {
+		reviewers = []string{}
+	}
+
+	return &domain.PullRequest{
+		ID:                dbPR.PullRequestID,
+		Name:              dbPR.PullRequestName,
+		AuthorID:          dbPR.AuthorID,
+		Status:            dbPR.Status,
+		CreatedAt:         fromMicros(dbPR.CreatedAt),
+		MergedAt:          fromNullMicros(dbPR.MergedAt),
+		AssignedReviewers: reviewers,
+		Version:           dbPR.Version,
+	}, nil
+}
+
+// ReassignReviewer заменяет ревьювера на нового из той же команды.
+// Версия, статус PR и назначение старого ревьювера проверяются в той же транзакции
+// с блокировкой записи, поэтому конкурирующие переназначения не снимают одного ревьювера дважды.
+func (r *PRRepository) ReassignReviewer(ctx context.Context, prID, oldReviewerID, newReviewerID string, expectedVersion int64) error {
+	return inTx(ctx, r.db, r.queries, func(txQueries *sqlitedb.Queries) error {
+		// 1. Сверяем версию PR
+		current, err := checkVersion(ctx, txQueries, prID, expectedVersion)
+		if err != nil {
+			return err
+		}
+		if current.Status == domain.PRStatusMerged {
+			return domain.ErrPRAlreadyMerged
+		}
+
+		// 2. Удаляем старого ревьювера
+		removed, err := txQueries.RemoveReviewer(ctx, sqlitedb.RemoveReviewerParams{
+			PullRequestID: prID,
+			UserID:        oldReviewerID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to remove reviewer: %w", err)
+		}
+		if removed == 0 {
+			return domain.ErrReviewerNotAssigned
+		}
+
+		// 3. Добавляем нового ревьювера
+		err = txQueries.AssignReviewer(ctx, sqlitedb.AssignReviewerParams{
+			PullRequestID: prID,
+			UserID:        newReviewerID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to assign new reviewer: %w", err)
+		}
+
+		// 4. Отмечаем в объяснении, что новый ревьювер назначен переназначением
+		err = txQueries.ReplaceReviewerSelection(ctx, sqlitedb.ReplaceReviewerSelectionParams{
+			PullRequestID: prID,
+			UserID:        oldReviewerID,
+			UserID_2:      newReviewerID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to update reviewer selection: %w", err)
+		}
+
+		// 5. Увеличиваем версию PR
+		if err := txQueries.BumpPullRequestVersion(ctx, prID); err != nil {
+			return fmt.Errorf("failed to bump PR version: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// checkVersion сверяет версию PR с ожидаемой (0 - без проверки). Вызывается внутри inTx:
+// транзакция уже держит блокировку записи, поэтому версия не изменится до коммита.
+func checkVersion(ctx context.Context, q *sqlitedb.Queries, prID string, expectedVersion int64) (sqlitedb.GetPullRequestVersionRow, error) {
+	current, err := q.GetPullRequestVersion(ctx, prID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return current, domain.ErrPRNotFound
+		}
+		return current, fmt.Errorf("failed to get PR version: %w", err)
+	}
+	if expectedVersion != 0 && current.Version != expectedVersion {
+		return current, domain.ErrPRVersionConflict
+	}
+	return current, nil
+}
+
+// GetUserAssignedPRs возвращает до limit PR, где пользователь назначен ревьювером, от новых к старым, начиная после курсора after.
+func (r *PRRepository) GetUserAssignedPRs(ctx context.Context, userID string, after *domain.PRCursor, limit int) ([]*domain.PullRequest, error) {
+	return r.List(ctx, &domain.PRListFilter{ReviewerID: userID}, after, limit)
+}
+
+// ExistsPr проверяет существование PR.
+func (r *PRRepository) ExistsPr(ctx context.Context, prID string) (bool, error) {
+	count, err := r.queries.PRExists(ctx, prID)
+	if err != nil {
+		return false, fmt.Errorf("failed to check pr exists: %w", err)
+	}
+	return count > 0, nil
+}
+
+// GetReviewers возвращает список ревьюверов PR.
+func (r *PRRepository) GetReviewers(ctx context.Context, prID string) ([]string, error) {
+	reviewers, err := r.queries.GetPRReviewers(ctx, prID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reviewers: %w", err)
+	}
+
+	if reviewers == nil {
+		return []string{}, nil
+	}
+	return reviewers, nil
+}
+
+// IsUserReviewer проверяет, является ли пользователь ревьювером PR.
+func (r *PRRepository) IsUserReviewer(ctx context.Context, prID, userID string) (bool, error) {
+	count, err := r.queries.IsUserReviewer(ctx, sqlitedb.IsUserReviewerParams{
+		PullRequestID: prID,
+		UserID:        userID,
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to check reviewer assignment: %w", err)
+	}
+	return count > 0, nil
+}
+
+// saveRationale сохраняет объяснение выбора ревьюверов в рамках транзакции
+func saveRationale(ctx context.Context, q *sqlitedb.Queries, prID string, rationale *domain.AssignmentRationale) error {
+	err := q.CreateAssignmentRationale(ctx, sqlitedb.CreateAssignmentRationaleParams{
+		PullRequestID:     prID,
+		Strategy:          rationale.Strategy,
+		CandidatePoolSize: int64(rationale.CandidatePoolSize),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create assignment rationale: %w", err)
+	}
+
+	for i, selection := range rationale.Reviewers {
+		err = q.AddReviewerSelection(ctx, sqlitedb.AddReviewerSelectionParams{
+			PullRequestID: prID,
+			UserID:        selection.UserID,
+			Position:      int64(i),
+			Reason:        string(selection.Reason),
+			MatchedTags:   strings.Join(selection.MatchedTags, ","),
+			OpenReviews:   int64(selection.OpenReviews),
+			SkillScore:    selection.SkillScore,
+			WorkloadScore: selection.WorkloadScore,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to add reviewer selection %s: %w", selection.UserID, err)
+		}
+	}
+
+	for _, excluded := range rationale.Excluded {
+		err = q.AddReviewerExclusion(ctx, sqlitedb.AddReviewerExclusionParams{
+			PullRequestID: prID,
+			UserID:        excluded.UserID,
+			Reason:        string(excluded.Reason),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to add reviewer exclusion %s: %w", excluded.UserID, err)
+		}
+	}
+
+	return nil
+}
+
+// GetRationale возвращает объяснение выбора ревьюверов PR или nil, если оно не сохранялось.
+func (r *PRRepository) GetRationale(ctx context.Context, prID string) (*domain.AssignmentRationale, error) {
+	row, err := r.queries.GetAssignmentRationale(ctx, prID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get assignment rationale: %w", err)
+	}
+
+	selections, err := r.queries.GetReviewerSelections(ctx, prID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reviewer selections: %w", err)
+	}
+
+	exclusions, err := r.queries.GetReviewerExclusions(ctx, prID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reviewer exclusions: %w", err)
+	}
+
+	rationale := &domain.AssignmentRationale{
+		Strategy:          row.Strategy,
+		CandidatePoolSize: int(row.CandidatePoolSize),
+		Reviewers:         make([]*domain.ReviewerSelection, 0, len(selections)),
+		Excluded:          make([]*domain.ExcludedCandidate, 0, len(exclusions)),
+	}
+
+	for _, selection := range selections {
+		rationale.Reviewers = append(rationale.Reviewers, &domain.ReviewerSelection{
+			UserID:        selection.UserID,
+			Reason:        domain.SelectionReason(selection.Reason),
+			MatchedTags:   splitList(selection.MatchedTags),
+			OpenReviews:   int(selection.OpenReviews),
+			SkillScore:    selection.SkillScore,
+			WorkloadScore: selection.WorkloadScore,
+			Score:         selection.SkillScore + selection.WorkloadScore,
+		})
+	}
+
+	for _, exclusion := range exclusions {
+		rationale.Excluded = append(rationale.Excluded, &domain.ExcludedCandidate{
+			UserID: exclusion.UserID,
+			Reason: domain.ExclusionReason(exclusion.Reason),
+		})
+	}
+
+	return rationale, nil
+}
+
+// List возвращает до limit PR, подходящих под фильтр, от новых к старым, начиная после курсора after.
+func (r *PRRepository) List(ctx context.Context, filter *domain.PRListFilter, after *domain.PRCursor, limit int) ([]*domain.PullRequest, error) {
+	params := sqlitedb.ListPullRequestsParams{
+		Status:      toNullString(filter.Status),
+		AuthorID:    toNullString(filter.AuthorID),
+		ReviewerID:  toNullString(filter.ReviewerID),
+		TeamName:    toNullString(filter.TeamName),
+		CreatedFrom: toNullMicros(filter.CreatedFrom),
+		CreatedTo:   toNullMicros(filter.CreatedTo),
+		PageLimit:   int64(limit),
+	}
+	if after != nil {
+		params.CursorCreatedAt = toNullMicros(&after.CreatedAt)
+		params.CursorID = sql.NullString{String: after.ID, Valid: true}
+	}
+
+	rows, err := r.queries.ListPullRequests(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list PRs: %w", err)
+	}
+
+	prs := make([]*domain.PullRequest, 0, len(rows))
+	for _, row := range rows {
+		pr := toDomainPR(row.PullRequestID, row.PullRequestName, row.AuthorID, row.Status,
+			row.CreatedAt, row.MergedAt, row.ReviewerIds)
+		pr.Version = row.Version
+		prs = append(prs, pr)
+	}
+
+	return prs, nil
+}
+
+// GetByIDs возвращает найденные PR с ревьюверами по списку ID одним запросом
+func (r *PRRepository) GetByIDs(ctx context.Context, prIDs []string) ([]*domain.PullRequest, error) {
+	rows, err := r.queries.GetPullRequestsByIDs(ctx, jsonList(prIDs))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get PRs by ids: %w", err)
+	}
+
+	prs := make([]*domain.PullRequest, 0, len(rows))
+	for _, row := range rows {
+		prs = append(prs, toDomainPR(row.PullRequestID, row.PullRequestName, row.AuthorID, row.Status,
+			row.CreatedAt, row.MergedAt, row.ReviewerIds))
+	}
+
+	return prs, nil
+}
+
+// GetAssignedToUsers возвращает PR на ревью у каждого из пользователей одним запросом.
+// Пользователи без назначений в результат не попадают.
+func (r *PRRepository) GetAssignedToUsers(ctx context.Context, userIDs []string) (map[string][]*domain.PullRequest, error) {
+	rows, err := r.queries.GetAssignedPRsByReviewers(ctx, jsonList(userIDs))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get PRs assigned to users: %w", err)
+	}
+
+	assigned := make(map[string][]*domain.PullRequest)
+	for _, row := range rows {
+		assigned[row.ReviewerID] = append(assigned[row.ReviewerID], toDomainPR(row.PullRequestID, row.PullRequestName,
+			row.AuthorID, row.Status, row.CreatedAt, row.MergedAt, row.ReviewerIds))
+	}
+
+	return assigned, nil
+}
+
+func toDomainPR(id, name, authorID, status string, createdAt int64, mergedAt sql.NullInt64, reviewerIDs string) *domain.PullRequest {
+	return &domain.PullRequest{
+		ID:                id,
+		Name:              name,
+		AuthorID:          authorID,
+		Status:            status,
+		CreatedAt:         fromMicros(createdAt),
+		MergedAt:          fromNullMicros(mergedAt),
+		AssignedReviewers: splitList(reviewerIDs),
+	}
+}
@@ -0,0 +1,258 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"pr-reviewer-service/internal/database/sqlitedb"
+	"pr-reviewer-service/internal/domain"
+)
+
+// TeamRepository реализует взаимодействие с данными команд в SQLite.
+type TeamRepository struct {
+	db      *sql.DB
+	queries *sqlitedb.Queries
+}
+
+// NewTeamRepository создает новый экземпляр TeamRepository.
+func NewTeamRepository(db *sql.DB, queries *sqlitedb.Queries) domain.TeamRepository {
+	return &TeamRepository{
+		db:      db,
+		queries: queries,
+	}
+}
+
+// Create создает команду и обновляет/создает пользователей.
+func (r *TeamRepository) Create(ctx context.Context, team *domain.Team) error {
+	return inTx(ctx, r.db, r.queries, func(txQueries *sqlitedb.Queries) error {
+		// 1. Создаем команду
+		_, err := txQueries.CreateTeam(ctx, team.Name)
+		if err != nil {
+			if sqlitedb.IsUniqueViolation(err) {
+				return domain.ErrTeamAlreadyExists
+			}
+			return fmt.Errorf("failed to create team: %w", err)
+		}
+
+		// 2. Привязываем к родительской команде
+		if team.ParentName != "" {
+			err = txQueries.SetTeamParent(ctx, sqlitedb.SetTeamParentParams{
+				TeamName:       team.Name,
+				ParentTeamName: toNullString(team.ParentName),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to set parent team: %w", err)
+			}
+		}
+
+		// 3. Создаем/обновляем пользователей команды
+		for _, member := range team.Members {
+			_, err := txQueries.UpsertUser(ctx, sqlitedb.UpsertUserParams{
+				UserID:   member.ID,
+				Username: member.Username,
+				TeamName: team.Name,
+				IsActive: member.IsActive,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to upsert user %s: %w", member.ID, err)
+			}
+		}
+
+		return nil
+	})
+}
+
+// GetByName возвращает команду по названию.
+func (r *TeamRepository) GetByName(ctx context.Context, teamName string) (*domain.Team, error) {
+	users, err := r.GetAllUsersByTeam(ctx, teamName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get team members: %w", err)
+	}
+
+	parent, err := r.queries.GetTeamParent(ctx, teamName)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("failed to get parent team: %w", err)
+	}
+
+	return &domain.Team{
+		Name:       teamName,
+		ParentName: parent.String,
+		Members:    users,
+	}, nil
+}
+
+// ExistsTeam проверяет существование команды.
+func (r *TeamRepository) ExistsTeam(ctx context.Context, teamName string) (bool, error) {
+	count, err := r.queries.TeamExists(ctx, teamName)
+	if err != nil {
+		return false, fmt.Errorf("failed to check team existence: %w", err)
+	}
+	return count > 0, nil
+}
+
+// GetAllUsersByTeam возвращает всех пользователей команды.
+func (r *TeamRepository) GetAllUsersByTeam(ctx context.Context, teamName string) ([]*domain.User, error) {
+	dbUsers, err := r.queries.GetAllUsersByTeam(ctx, teamName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get all team users: %w", err)
+	}
+
+	return toDomainUsers(dbUsers), nil
+}
+
+// GetActiveUsersFromTeam возвращает активных пользователей команды
+func (r *TeamRepository) GetActiveUsersFromTeam(ctx context.Context, teamName string) ([]*domain.User, error) {
+	dbUsers, err := r.queries.GetActiveUsersFromTeam(ctx, teamName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get active users from team: %w", err)
+	}
+
+	return toDomainUsers(dbUsers), nil
+}
+
+// GetOpenPRsWithTeamReviewers возвращает ID открытых PR с ревьюверами из указанной команды
+func (r *TeamRepository) GetOpenPRsWithTeamReviewers(ctx context.Context, teamName string) ([]string, error) {
+	prIDs, err := r.queries.GetOpenPRsWithTeamReviewers(ctx, teamName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get open PRs with team reviewers: %w", err)
+	}
+
+	if prIDs == nil {
+		return []string{}, nil
+	}
+	return prIDs, nil
+}
+
+// GetPRReviewersFromTeam возвращает ревьюверов из указанной команды для конкретного PR
+func (r *TeamRepository) GetPRReviewersFromTeam(ctx context.Context, prID, teamName string) ([]string, error) {
+	reviewerIDs, err := r.queries.GetPRReviewersFromTeam(ctx, sqlitedb.GetPRReviewersFromTeamParams{
+		PullRequestID: prID,
+		TeamName:      teamName,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get PR reviewers from team: %w", err)
+	}
+
+	if reviewerIDs == nil {
+		return []string{}, nil
+	}
+	return reviewerIDs, nil
+}
+
+// GetAllTeams возвращает все команды
+func (r *TeamRepository) GetAllTeams(ctx context.Context) ([]*domain.Team, error) {
+	teamNames, err := r.queries.GetAllTeams(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get all teams: %w", err)
+	}
+
+	teams := make([]*domain.Team, 0, len(teamNames))
+	for _, teamName := range teamNames {
+		teams = append(teams, &domain.Team{
+			Name: teamName,
+		})
+	}
+
+	return teams, nil
+}
+
+// SetParent привязывает команду к родительской. Пустой parentName делает команду корневой.
+// Проверка иерархии и перенос выполняются в одной транзакции с блокировкой записи.
+// Для несуществующей команды ничего не делает.
+func (r *TeamRepository) SetParent(ctx context.Context, teamName, parentName string) error {
+	return inTx(ctx, r.db, r.queries, func(txQueries *sqlitedb.Queries) error {
+		err := domain.CheckNoCycle(teamName, parentName, func(current string) (string, error) {
+			parent, err := txQueries.GetTeamParent(ctx, current)
+			if errors.Is(err, sql.ErrNoRows) {
+				return "", domain.ErrParentTeamNotFound
+			}
+			if err != nil {
+				return "", fmt.Errorf("failed to get parent team: %w", err)
+			}
+			return parent.String, nil
+		})
+		if err != nil {
+			return err
+		}
+
+		err = txQueries.SetTeamParent(ctx, sqlitedb.SetTeamParentParams{
+			TeamName:       teamName,
+			ParentTeamName: toNullString(parentName),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to set parent team: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// GetParent возвращает название родительской команды или пустую строку для корневой команды.
+func (r *TeamRepository) GetParent(ctx context.Context, teamName string) (string, error) {
+	parent, err := r.queries.GetTeamParent(ctx, teamName)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", domain.ErrTeamNotFound
+		}
+		return "", fmt.Errorf("failed to get parent team: %w", err)
+	}
+
+	return parent.String, nil
+}
+
+// GetChildren возвращает названия дочерних команд
+func (r *TeamRepository) GetChildren(ctx context.Context, teamName string) ([]string, error) {
+	children, err := r.queries.GetChildTeams(ctx, toNullString(teamName))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get child teams: %w", err)
+	}
+
+	if children == nil {
+		return []string{}, nil
+	}
+	return children, nil
+}
+
+// GetTeamTreeStats возвращает плоский список команд с родителями и собственными показателями
+func (r *TeamRepository) GetTeamTreeStats(ctx context.Context) ([]*domain.TeamTreeNode, error) {
+	rows, err := r.queries.GetTeamTreeStats(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get team tree stats: %w", err)
+	}
+
+	nodes := make([]*domain.TeamTreeNode, 0, len(rows))
+	for _, row := range rows {
+		nodes = append(nodes, &domain.TeamTreeNode{
+			Name:       row.TeamName,
+			ParentName: row.ParentTeamName.String,
+			Stats: domain.TeamStats{
+				MembersCount:       row.MembersCount,
+				ActiveMembersCount: row.ActiveMembersCount,
+				OpenPRsCount:       row.OpenPrsCount,
+				OpenReviewsCount:   row.OpenReviewsCount,
+			},
+		})
+	}
+
+	return nodes, nil
+}
+
+// GetByNames возвращает команды по списку названий одним запросом; участники не заполняются
+func (r *TeamRepository) GetByNames(ctx context.Context, teamNames []string) ([]*domain.Team, error) {
+	rows, err := r.queries.GetTeamsByNames(ctx, jsonList(teamNames))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get teams by names: %w", err)
+	}
+
+	teams := make([]*domain.Team, 0, len(rows))
+	for _, row := range rows {
+		teams = append(teams, &domain.Team{
+			Name:       row.TeamName,
+			ParentName: row.ParentTeamName.String,
+		})
+	}
+
+	return teams, nil
+}
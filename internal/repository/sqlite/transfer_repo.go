@@ -0,0 +1,394 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"pr-reviewer-service/internal/database/sqlitedb"
+	"pr-reviewer-service/internal/domain"
+)
+
+// TransferRepository реализует выгрузку и загрузку данных сервиса в SQLite.
+type TransferRepository struct {
+	db      *sql.DB
+	queries *sqlitedb.Queries
+}
+
+// NewTransferRepository создает новый экземпляр TransferRepository.
+func NewTransferRepository(db *sql.DB, queries *sqlitedb.Queries) domain.TransferRepository {
+	return &TransferRepository{
+		db:      db,
+		queries: queries,
+	}
+}
+
+// exportPageSize - сколько пользователей или PR выгрузка читает одним запросом
+const exportPageSize = 500
+
+// Export читает данные страницами в одной читающей транзакции: все страницы относятся к одному
+// снимку (WAL), а в памяти одновременно держится только одна страница. ReadOnly начинает
+// транзакцию без блокировки записи, поэтому медленный клиент выгрузки не задерживает запись.
+func (r *TransferRepository) Export(ctx context.Context, emit func(record *domain.ExportRecord) error) error {
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	// Транзакция только читает, поэтому всегда откатывается
+	defer func() { _ = tx.Rollback() }()
+
+	q := r.queries.InTx(tx)
+
+	teams, err := q.ExportTeams(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to export teams: %w", err)
+	}
+	for _, team := range teams {
+		record := &domain.ExportRecord{
+			Type: domain.RecordTypeTeam,
+			Team: &domain.Team{Name: team.TeamName, ParentName: team.ParentTeamName.String},
+		}
+		if err := emit(record); err != nil {
+			return err
+		}
+	}
+
+	for after := ""; ; {
+		users, err := exportUsersPage(ctx, q, after)
+		if err != nil {
+			return err
+		}
+		for _, user := range users {
+			if err := emit(&domain.ExportRecord{Type: domain.RecordTypeUser, User: user}); err != nil {
+				return err
+			}
+		}
+		if len(users) < exportPageSize {
+			break
+		}
+		after = users[len(users)-1].ID
+	}
+
+	for after := ""; ; {
+		prs, err := exportPRsPage(ctx, q, after)
+		if err != nil {
+			return err
+		}
+		for _, pr := range prs {
+			if err := emit(&domain.ExportRecord{Type: domain.RecordTypePullRequest, PullRequest: pr}); err != nil {
+				return err
+			}
+		}
+		if len(prs) < exportPageSize {
+			break
+		}
+		after = prs[len(prs)-1].ID
+	}
+
+	return nil
+}
+
+// exportUsersPage читает страницу пользователей после after с навыками, отсутствием и историей активности
+func exportUsersPage(ctx context.Context, q *sqlitedb.Queries, after string) ([]*domain.User, error) {
+	rows, err := q.ExportUsers(ctx, sqlitedb.ExportUsersParams{AfterUserID: after, PageLimit: exportPageSize})
+	if err != nil {
+		return nil, fmt.Errorf("failed to export users: %w", err)
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	users := make([]*domain.User, len(rows))
+	userIDs := make([]string, len(rows))
+	byID := make(map[string]*domain.User, len(rows))
+	for i, row := range rows {
+		users[i] = &domain.User{
+			ID:               row.UserID,
+			Username:         row.Username,
+			TeamName:         row.TeamName,
+			IsActive:         row.IsActive,
+			Skills:           []string{},
+			OutOfOfficeUntil: fromNullMicros(row.OutOfOfficeUntil),
+		}
+		userIDs[i] = row.UserID
+		byID[row.UserID] = users[i]
+	}
+
+	skills, err := q.ExportUserSkills(ctx, jsonList(userIDs))
+	if err != nil {
+		return nil, fmt.Errorf("failed to export user skills: %w", err)
+	}
+	for _, skill := range skills {
+		user := byID[skill.UserID]
+		user.Skills = append(user.Skills, skill.Tag)
+	}
+
+	history, err := q.ExportUserStatusHistory(ctx, jsonList(userIDs))
+	if err != nil {
+		return nil, fmt.Errorf("failed to export user status history: %w", err)
+	}
+	for _, change := range history {
+		user := byID[change.UserID]
+		user.StatusHistory = append(user.StatusHistory, &domain.UserStatusChange{
+			UserID:    change.UserID,
+			IsActive:  change.IsActive,
+			ChangedAt: *fromMicros(change.ChangedAt),
+		})
+	}
+
+	return users, nil
+}
+
+// exportPRsPage читает страницу PR после after с ревьюверами и объяснениями выбора ревьюверов
+func exportPRsPage(ctx context.Context, q *sqlitedb.Queries, after string) ([]*domain.PullRequest, error) {
+	rows, err := q.ExportPullRequests(ctx, sqlitedb.ExportPullRequestsParams{AfterPullRequestID: after, PageLimit: exportPageSize})
+	if err != nil {
+		return nil, fmt.Errorf("failed to export PRs: %w", err)
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	prIDs := make([]string, len(rows))
+	for i, row := range rows {
+		prIDs[i] = row.PullRequestID
+	}
+
+	reviewers, err := q.ExportReviewers(ctx, jsonList(prIDs))
+	if err != nil {
+		return nil, fmt.Errorf("failed to export reviewers: %w", err)
+	}
+	prReviewers := make(map[string][]string)
+	for _, reviewer := range reviewers {
+		prReviewers[reviewer.PullRequestID] = append(prReviewers[reviewer.PullRequestID], reviewer.UserID)
+	}
+	rationales, err := exportRationales(ctx, q, jsonList(prIDs))
+	if err != nil {
+		return nil, err
+	}
+
+	prs := make([]*domain.PullRequest, len(rows))
+	for i, row := range rows {
+		pr := &domain.PullRequest{
+			ID:                row.PullRequestID,
+			Name:              row.PullRequestName,
+			AuthorID:          row.AuthorID,
+			Status:            row.Status,
+			CreatedAt:         fromMicros(row.CreatedAt),
+			MergedAt:          fromNullMicros(row.MergedAt),
+			AssignedReviewers: prReviewers[row.PullRequestID],
+			Version:           row.Version,
+			Rationale:         rationales[row.PullRequestID],
+		}
+		if pr.AssignedReviewers == nil {
+			pr.AssignedReviewers = []string{}
+		}
+		prs[i] = pr
+	}
+
+	return prs, nil
+}
+
+// exportRationales собирает объяснения выбора ревьюверов PR страницы тремя запросами;
+// prIDs - JSON-массив идентификаторов
+func exportRationales(ctx context.Context, q *sqlitedb.Queries, prIDs string) (map[string]*domain.AssignmentRationale, error) {
+	rows, err := q.ExportAssignmentRationales(ctx, prIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to export assignment rationales: %w", err)
+	}
+	selections, err := q.ExportReviewerSelections(ctx, prIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to export reviewer selections: %w", err)
+	}
+	exclusions, err := q.ExportReviewerExclusions(ctx, prIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to export reviewer exclusions: %w", err)
+	}
+
+	rationales := make(map[string]*domain.AssignmentRationale, len(rows))
+	for _, row := range rows {
+		rationales[row.PullRequestID] = &domain.AssignmentRationale{
+			Strategy:          row.Strategy,
+			CandidatePoolSize: int(row.CandidatePoolSize),
+			Reviewers:         []*domain.ReviewerSelection{},
+			Excluded:          []*domain.ExcludedCandidate{},
+		}
+	}
+	for _, selection := range selections {
+		rationale := rationales[selection.PullRequestID]
+		rationale.Reviewers = append(rationale.Reviewers, &domain.ReviewerSelection{
+			UserID:        selection.UserID,
+			Reason:        domain.SelectionReason(selection.Reason),
+			MatchedTags:   splitList(selection.MatchedTags),
+			OpenReviews:   int(selection.OpenReviews),
+			SkillScore:    selection.SkillScore,
+			WorkloadScore: selection.WorkloadScore,
+			Score:         selection.SkillScore + selection.WorkloadScore,
+		})
+	}
+	for _, exclusion := range exclusions {
+		rationale := rationales[exclusion.PullRequestID]
+		rationale.Excluded = append(rationale.Excluded, &domain.ExcludedCandidate{
+			UserID: exclusion.UserID,
+			Reason: domain.ExclusionReason(exclusion.Reason),
+		})
+	}
+
+	return rationales, nil
+}
+
+// Import выполняет fn в транзакции с выключенными триггерами истории активности: историю
+// пользователей записывает SaveUser. Отметка паузы снимается до коммита, а при ошибке
+// откатывается вместе с транзакцией.
+func (r *TransferRepository) Import(ctx context.Context, fn func(store domain.ImportStore) error) error {
+	return inTx(ctx, r.db, r.queries, func(txQueries *sqlitedb.Queries) error {
+		if err := txQueries.PauseStatusHistory(ctx); err != nil {
+			return fmt.Errorf("failed to pause status history: %w", err)
+		}
+		if err := fn(&importStore{q: txQueries}); err != nil {
+			return err
+		}
+		if err := txQueries.ResumeStatusHistory(ctx); err != nil {
+			return fmt.Errorf("failed to resume status history: %w", err)
+		}
+		return nil
+	})
+}
+
+// importStore - операции загрузки в рамках транзакции
+type importStore struct {
+	q *sqlitedb.Queries
+}
+
+func (s *importStore) ExistsTeam(ctx context.Context, teamName string) (bool, error) {
+	count, err := s.q.TeamExists(ctx, teamName)
+	if err != nil {
+		return false, fmt.Errorf("failed to check team existence: %w", err)
+	}
+	return count > 0, nil
+}
+
+func (s *importStore) GetParent(ctx context.Context, teamName string) (string, error) {
+	parent, err := s.q.GetTeamParent(ctx, teamName)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", domain.ErrTeamNotFound
+		}
+		return "", fmt.Errorf("failed to get parent team: %w", err)
+	}
+	return parent.String, nil
+}
+
+func (s *importStore) SaveTeam(ctx context.Context, team *domain.Team) error {
+	err := s.q.UpsertTeam(ctx, sqlitedb.UpsertTeamParams{
+		TeamName:       team.Name,
+		ParentTeamName: toNullString(team.ParentName),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save team %s: %w", team.Name, err)
+	}
+	return nil
+}
+
+func (s *importStore) ExistsUser(ctx context.Context, userID string) (bool, error) {
+	_, err := s.q.GetUserByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to get user: %w", err)
+	}
+	return true, nil
+}
+
+func (s *importStore) SaveUser(ctx context.Context, user *domain.User) error {
+	err := s.q.ReplaceUser(ctx, sqlitedb.ReplaceUserParams{
+		UserID:   user.ID,
+		Username: user.Username,
+		TeamName: user.TeamName,
+		IsActive: user.IsActive,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save user %s: %w", user.ID, err)
+	}
+
+	if err := s.q.DeleteUserSkills(ctx, user.ID); err != nil {
+		return fmt.Errorf("failed to delete user skills: %w", err)
+	}
+	for _, tag := range user.Skills {
+		err := s.q.AddUserSkill(ctx, sqlitedb.AddUserSkillParams{UserID: user.ID, Tag: tag})
+		if err != nil {
+			return fmt.Errorf("failed to add user skill %s: %w", tag, err)
+		}
+	}
+
+	if user.OutOfOfficeUntil != nil {
+		err = s.q.SetUserOutOfOffice(ctx, sqlitedb.SetUserOutOfOfficeParams{
+			UserID: user.ID,
+			Until:  user.OutOfOfficeUntil.UnixMicro(),
+		})
+	} else {
+		err = s.q.DeleteUserOutOfOffice(ctx, user.ID)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to save user out of office: %w", err)
+	}
+
+	if err := s.q.DeleteUserStatusHistory(ctx, user.ID); err != nil {
+		return fmt.Errorf("failed to delete user status history: %w", err)
+	}
+	for _, change := range user.StatusHistory {
+		err := s.q.AddUserStatusChange(ctx, sqlitedb.AddUserStatusChangeParams{
+			UserID:    user.ID,
+			IsActive:  change.IsActive,
+			ChangedAt: change.ChangedAt.UnixMicro(),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to add user status change: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (s *importStore) ExistsPR(ctx context.Context, prID string) (bool, error) {
+	count, err := s.q.PRExists(ctx, prID)
+	if err != nil {
+		return false, fmt.Errorf("failed to check PR existence: %w", err)
+	}
+	return count > 0, nil
+}
+
+func (s *importStore) SavePR(ctx context.Context, pr *domain.PullRequest) error {
+	params := sqlitedb.UpsertPullRequestParams{
+		PullRequestID:   pr.ID,
+		PullRequestName: pr.Name,
+		AuthorID:        pr.AuthorID,
+		Status:          pr.Status,
+		MergedAt:        toNullMicros(pr.MergedAt),
+		CreatedAt:       pr.CreatedAt.UnixMicro(),
+	}
+	if err := s.q.UpsertPullRequest(ctx, params); err != nil {
+		return fmt.Errorf("failed to save PR %s: %w", pr.ID, err)
+	}
+
+	if err := s.q.DeletePRReviewers(ctx, pr.ID); err != nil {
+		return fmt.Errorf("failed to delete reviewers: %w", err)
+	}
+	for _, reviewerID := range pr.AssignedReviewers {
+		err := s.q.AssignReviewer(ctx, sqlitedb.AssignReviewerParams{PullRequestID: pr.ID, UserID: reviewerID})
+		if err != nil {
+			return fmt.Errorf("failed to assign reviewer %s: %w", reviewerID, err)
+		}
+	}
+
+	if err := s.q.DeleteAssignmentRationale(ctx, pr.ID); err != nil {
+		return fmt.Errorf("failed to delete assignment rationale: %w", err)
+	}
+	if pr.Rationale != nil {
+		return saveRationale(ctx, s.q, pr.ID, pr.Rationale)
+	}
+
+	return nil
+}
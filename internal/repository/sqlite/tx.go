@@ -0,0 +1,84 @@
+// Package sqlite реализует репозитории domain поверх SQLite (storage=sqlite) для небольших
+// команд и локальной разработки. Поведение совпадает с репозиториями PostgreSQL.
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"pr-reviewer-service/internal/database/sqlitedb"
+)
+
+// inTx выполняет fn в транзакции и коммитит ее, а при ошибке откатывает.
+// Транзакция сразу берет блокировку записи (_txlock=immediate), поэтому
+// конкурирующие записи ждут друг друга, а не завершаются с SQLITE_BUSY при коммите.
+func inTx(ctx context.Context, db *sql.DB, queries *sqlitedb.Queries, fn func(q *sqlitedb.Queries) error) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	if err := fn(queries.InTx(tx)); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// now возвращает текущее время с точностью хранения (микросекунды)
+func now() time.Time {
+	return time.Now().Truncate(time.Microsecond)
+}
+
+// fromMicros переводит время из микросекунд Unix
+func fromMicros(micros int64) *time.Time {
+	t := time.UnixMicro(micros)
+	return &t
+}
+
+// fromNullMicros переводит необязательное время из микросекунд Unix (NULL - nil)
+func fromNullMicros(micros sql.NullInt64) *time.Time {
+	if !micros.Valid {
+		return nil
+	}
+	return fromMicros(micros.Int64)
+}
+
+// toNullMicros переводит необязательное время в микросекунды Unix (nil - NULL)
+func toNullMicros(t *time.Time) sql.NullInt64 {
+	if t == nil {
+		return sql.NullInt64{}
+	}
+	return sql.NullInt64{Int64: t.UnixMicro(), Valid: true}
+}
+
+// toNullString преобразует пустую строку в NULL
+func toNullString(value string) sql.NullString {
+	return sql.NullString{String: value, Valid: value != ""}
+}
+
+// jsonList передает список ключей пакетной выборки JSON-массивом (разворачивается json_each)
+func jsonList(values []string) string {
+	if values == nil {
+		return "[]"
+	}
+	data, _ := json.Marshal(values) //nolint:errchkjson // срез строк всегда сериализуется
+	return string(data)
+}
+
+// splitList разбирает список, сохраненный через запятую (пустая строка - пустой список)
+func splitList(value string) []string {
+	if value == "" {
+		return []string{}
+	}
+	return strings.Split(value, ",")
+}
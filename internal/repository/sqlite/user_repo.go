@@ -0,0 +1,232 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"pr-reviewer-service/internal/database/sqlitedb"
+	"pr-reviewer-service/internal/domain"
+)
+
+// UserRepository реализует взаимодействие с данными пользователей в SQLite.
+type UserRepository struct {
+	db      *sql.DB
+	queries *sqlitedb.Queries
+}
+
+// NewUserRepository создает новый экземпляр UserRepository.
+func NewUserRepository(db *sql.DB, queries *sqlitedb.Queries) domain.UserRepository {
+	return &UserRepository{
+		db:      db,
+		queries: queries,
+	}
+}
+
+// GetByID возвращает пользователя по ID.
+func (r *UserRepository) GetByID(ctx context.Context, userID string) (*domain.User, error) {
+	dbUser, err := r.queries.GetUserByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrUserNotFound
+		}
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	return toDomainUser(dbUser), nil
+}
+
+// GetActiveUsersByTeam возвращает активных пользователей команды для назначения ревьюверов.
+func (r *UserRepository) GetActiveUsersByTeam(ctx context.Context, teamName string, excludeUserID string) ([]*domain.User, error) {
+	candidates, err := r.queries.GetActiveUsersByTeam(ctx, sqlitedb.GetActiveUsersByTeamParams{
+		TeamName: teamName,
+		UserID:   excludeUserID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get active users: %w", err)
+	}
+
+	return toDomainUsers(candidates), nil
+}
+
+// UpdateActiveStatus обновляет статус активности пользователя.
+func (r *UserRepository) UpdateActiveStatus(ctx context.Context, userID string, isActive bool) (*domain.User, error) {
+	dbUser, err := r.queries.UpdateUserActiveStatus(ctx, sqlitedb.UpdateUserActiveStatusParams{
+		UserID:   userID,
+		IsActive: isActive,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrUserNotFound
+		}
+		return nil, fmt.Errorf("failed to update user status: %w", err)
+	}
+
+	return toDomainUser(dbUser), nil
+}
+
+// GetUserTeam возвращает название команды пользователя.
+func (r *UserRepository) GetUserTeam(ctx context.Context, userID string) (string, error) {
+	team, err := r.queries.GetUserTeam(ctx, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", domain.ErrUserNotFound
+		}
+		return "", fmt.Errorf("failed to get user team: %w", err)
+	}
+
+	return team, nil
+}
+
+// SetSkills полностью заменяет теги навыков пользователя.
+func (r *UserRepository) SetSkills(ctx context.Context, userID string, skills []string) error {
+	return inTx(ctx, r.db, r.queries, func(txQueries *sqlitedb.Queries) error {
+		err := txQueries.DeleteUserSkills(ctx, userID)
+		if err != nil {
+			return fmt.Errorf("failed to delete user skills: %w", err)
+		}
+
+		for _, tag := range skills {
+			err = txQueries.AddUserSkill(ctx, sqlitedb.AddUserSkillParams{
+				UserID: userID,
+				Tag:    tag,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to add user skill %s: %w", tag, err)
+			}
+		}
+
+		return nil
+	})
+}
+
+// GetSkills возвращает теги навыков пользователя в алфавитном порядке.
+func (r *UserRepository) GetSkills(ctx context.Context, userID string) ([]string, error) {
+	skills, err := r.queries.GetUserSkills(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user skills: %w", err)
+	}
+
+	if skills == nil {
+		return []string{}, nil
+	}
+	return skills, nil
+}
+
+// GetTeamRoster возвращает всех участников команды (включая неактивных)
+// с их навыками, отсутствием и количеством открытых PR на ревью.
+func (r *UserRepository) GetTeamRoster(ctx context.Context, teamName string) ([]*domain.ReviewCandidate, error) {
+	rows, err := r.queries.GetTeamRoster(ctx, teamName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get team roster: %w", err)
+	}
+
+	skillRows, err := r.queries.GetTeamSkills(ctx, teamName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get team skills: %w", err)
+	}
+
+	skills := make(map[string][]string)
+	for _, row := range skillRows {
+		skills[row.UserID] = append(skills[row.UserID], row.Tag)
+	}
+
+	candidates := make([]*domain.ReviewCandidate, 0, len(rows))
+	for _, row := range rows {
+		candidates = append(candidates, &domain.ReviewCandidate{
+			User: &domain.User{
+				ID:               row.UserID,
+				Username:         row.Username,
+				TeamName:         row.TeamName,
+				IsActive:         row.IsActive,
+				Skills:           skills[row.UserID],
+				OutOfOfficeUntil: fromNullMicros(row.OutOfOfficeUntil),
+			},
+			OpenReviews: int(row.OpenReviewsCount),
+		})
+	}
+
+	return candidates, nil
+}
+
+// GetByIDs возвращает найденных пользователей по списку ID одним запросом
+func (r *UserRepository) GetByIDs(ctx context.Context, userIDs []string) ([]*domain.User, error) {
+	dbUsers, err := r.queries.GetUsersByIDs(ctx, jsonList(userIDs))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get users by ids: %w", err)
+	}
+
+	return toDomainUsers(dbUsers), nil
+}
+
+// GetByTeams возвращает всех участников (включая неактивных) перечисленных команд одним запросом
+func (r *UserRepository) GetByTeams(ctx context.Context, teamNames []string) ([]*domain.User, error) {
+	dbUsers, err := r.queries.GetUsersByTeams(ctx, jsonList(teamNames))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get users by teams: %w", err)
+	}
+
+	return toDomainUsers(dbUsers), nil
+}
+
+// SetOutOfOffice отмечает пользователя отсутствующим до until; nil снимает отметку.
+func (r *UserRepository) SetOutOfOffice(ctx context.Context, userID string, until *time.Time) error {
+	var err error
+	if until == nil {
+		err = r.queries.DeleteUserOutOfOffice(ctx, userID)
+	} else {
+		err = r.queries.SetUserOutOfOffice(ctx, sqlitedb.SetUserOutOfOfficeParams{
+			UserID: userID,
+			Until:  until.UnixMicro(),
+		})
+	}
+	if err != nil {
+		return fmt.Errorf("failed to set user out of office: %w", err)
+	}
+
+	return nil
+}
+
+// GetReviewCandidates возвращает найденных пользователей по списку ID (без навыков)
+// с отсутствием и количеством открытых PR на ревью одним запросом.
+func (r *UserRepository) GetReviewCandidates(ctx context.Context, userIDs []string) ([]*domain.ReviewCandidate, error) {
+	rows, err := r.queries.GetReviewCandidates(ctx, jsonList(userIDs))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get review candidates: %w", err)
+	}
+
+	candidates := make([]*domain.ReviewCandidate, 0, len(rows))
+	for _, row := range rows {
+		candidates = append(candidates, &domain.ReviewCandidate{
+			User: &domain.User{
+				ID:               row.UserID,
+				Username:         row.Username,
+				TeamName:         row.TeamName,
+				IsActive:         row.IsActive,
+				OutOfOfficeUntil: fromNullMicros(row.OutOfOfficeUntil),
+			},
+			OpenReviews: int(row.OpenReviewsCount),
+		})
+	}
+
+	return candidates, nil
+}
+
+func toDomainUser(dbUser sqlitedb.User) *domain.User {
+	return &domain.User{
+		ID:       dbUser.UserID,
+		Username: dbUser.Username,
+		TeamName: dbUser.TeamName,
+		IsActive: dbUser.IsActive,
+	}
+}
+
+func toDomainUsers(dbUsers []sqlitedb.User) []*domain.User {
+	users := make([]*domain.User, 0, len(dbUsers))
+	for _, dbUser := range dbUsers {
+		users = append(users, toDomainUser(dbUser))
+	}
+	return users
+}
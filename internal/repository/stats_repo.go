@@ -2,7 +2,9 @@ package repository
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
+	"time"
 
 	"pr-reviewer-service/internal/database"
 	"pr-reviewer-service/internal/domain"
@@ -20,9 +22,9 @@ func NewStatsRepository(queries *database.Queries) domain.StatsRepository {
 	}
 }
 
-// GetStatsReviews возвращает статистику по количеству ревью для каждого пользователя.
-func (r *StatsRepository) GetStatsReviews(ctx context.Context) ([]*domain.ReviewStat, error) {
-	stats, err := r.queries.GetReviewStats(ctx)
+// GetStatsReviews возвращает статистику по количеству ревью для каждого пользователя с учетом фильтра.
+func (r *StatsRepository) GetStatsReviews(ctx context.Context, filter *domain.StatsFilter) ([]*domain.ReviewStat, error) {
+	stats, err := r.queries.GetReviewStats(ctx, statsParams(filter))
 	if err != nil {
 		return nil, fmt.Errorf("failed to get users stats: %w", err)
 	}
@@ -39,9 +41,9 @@ func (r *StatsRepository) GetStatsReviews(ctx context.Context) ([]*domain.Review
 	return result, nil
 }
 
-// GetStatsPrAssignments возвращает статистику по количеству назначенных ревьюверов на каждый PR.
-func (r *StatsRepository) GetStatsPrAssignments(ctx context.Context) ([]*domain.PRAssignmentStat, error) {
-	stats, err := r.queries.GetPRAssignmentStats(ctx)
+// GetStatsPrAssignments возвращает статистику по количеству назначенных ревьюверов на каждый PR с учетом фильтра.
+func (r *StatsRepository) GetStatsPrAssignments(ctx context.Context, filter *domain.StatsFilter) ([]*domain.PRAssignmentStat, error) {
+	stats, err := r.queries.GetPRAssignmentStats(ctx, database.GetPRAssignmentStatsParams(statsParams(filter)))
 	if err != nil {
 		return nil, fmt.Errorf("failed to pull request stats: %w", err)
 	}
@@ -57,3 +59,116 @@ func (r *StatsRepository) GetStatsPrAssignments(ctx context.Context) ([]*domain.
 
 	return result, nil
 }
+
+// GetStatsTeams возвращает показатели ревью каждой команды с учетом фильтра.
+func (r *StatsRepository) GetStatsTeams(ctx context.Context, filter *domain.StatsFilter) ([]*domain.TeamReviewStat, error) {
+	rows, err := r.queries.GetTeamReviewStats(ctx, database.GetTeamReviewStatsParams(statsParams(filter)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get team review stats: %w", err)
+	}
+
+	result := make([]*domain.TeamReviewStat, len(rows))
+	for i, row := range rows {
+		result[i] = &domain.TeamReviewStat{
+			TeamName:         row.TeamName,
+			PullRequests:     row.PullRequestsCount,
+			OpenPullRequests: row.OpenPullRequestsCount,
+			ReviewsGiven:     row.ReviewsGivenCount,
+			ReviewsReceived:  row.ReviewsReceivedCount,
+			CrossTeamReviews: row.CrossTeamReviewsCount,
+		}
+	}
+
+	return result, nil
+}
+
+// GetMemberAssignments возвращает назначения пользователей команды (пустое имя - всех) на PR,
+// созданные в [from, to), в порядке команды и ID.
+func (r *StatsRepository) GetMemberAssignments(ctx context.Context, teamName string, from, to time.Time) ([]*domain.MemberAssignments, error) {
+	rows, err := r.queries.GetMemberAssignments(ctx, database.GetMemberAssignmentsParams{
+		CreatedFrom: from,
+		CreatedTo:   to,
+		TeamName:    toNullString(teamName),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get member assignments: %w", err)
+	}
+
+	result := make([]*domain.MemberAssignments, len(rows))
+	for i, row := range rows {
+		result[i] = &domain.MemberAssignments{
+			UserID:      row.UserID,
+			Username:    row.Username,
+			TeamName:    row.TeamName,
+			Assignments: row.AssignmentsCount,
+		}
+	}
+
+	return result, nil
+}
+
+// GetUserStatusHistory возвращает смены активности пользователей команды (пустое имя - всех) до before,
+// в порядке ID пользователя и времени.
+func (r *StatsRepository) GetUserStatusHistory(ctx context.Context, teamName string, before time.Time) ([]*domain.UserStatusChange, error) {
+	rows, err := r.queries.GetUserStatusHistory(ctx, database.GetUserStatusHistoryParams{
+		TeamName:      toNullString(teamName),
+		ChangedBefore: before,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user status history: %w", err)
+	}
+
+	result := make([]*domain.UserStatusChange, len(rows))
+	for i, row := range rows {
+		result[i] = &domain.UserStatusChange{
+			UserID:    row.UserID,
+			IsActive:  row.IsActive,
+			ChangedAt: row.ChangedAt,
+		}
+	}
+
+	return result, nil
+}
+
+// CountOpenPRs возвращает количество открытых PR.
+func (r *StatsRepository) CountOpenPRs(ctx context.Context) (int64, error) {
+	count, err := r.queries.CountOpenPRs(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count open PRs: %w", err)
+	}
+
+	return count, nil
+}
+
+// GetTeamReviewLoad возвращает число открытых ревью на участниках каждой команды.
+func (r *StatsRepository) GetTeamReviewLoad(ctx context.Context) ([]*domain.TeamReviewLoad, error) {
+	rows, err := r.queries.GetTeamReviewLoad(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get team review load: %w", err)
+	}
+
+	result := make([]*domain.TeamReviewLoad, len(rows))
+	for i, row := range rows {
+		result[i] = &domain.TeamReviewLoad{
+			TeamName:    row.TeamName,
+			OpenReviews: row.OpenReviewsCount,
+		}
+	}
+
+	return result, nil
+}
+
+// statsParams переводит фильтр статистики в параметры запросов; у всех запросов статистики они одинаковые
+func statsParams(filter *domain.StatsFilter) database.GetReviewStatsParams {
+	params := database.GetReviewStatsParams{
+		Status:   toNullString(filter.Status),
+		TeamName: toNullString(filter.TeamName),
+	}
+	if filter.CreatedFrom != nil {
+		params.CreatedFrom = sql.NullTime{Time: *filter.CreatedFrom, Valid: true}
+	}
+	if filter.CreatedTo != nil {
+		params.CreatedTo = sql.NullTime{Time: *filter.CreatedTo, Valid: true}
+	}
+	return params
+}
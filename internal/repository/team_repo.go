@@ -14,56 +14,57 @@ import (
 type TeamRepository struct {
 	db      *sql.DB
 	queries *database.Queries
+	retry   database.RetryPolicy
 }
 
 // NewTeamRepository создает новый экземпляр TeamRepository.
-func NewTeamRepository(db *sql.DB, queries *database.Queries) domain.TeamRepository {
+func NewTeamRepository(db *sql.DB, queries *database.Queries, opts ...Option) domain.TeamRepository {
+	o := newOptions(opts)
 	return &TeamRepository{
 		db:      db,
 		queries: queries,
+		retry:   o.retry,
 	}
 }
 
 // Create создает команду и обновляет/создает пользователей.
 func (r *TeamRepository) Create(ctx context.Context, team *domain.Team) error {
-	tx, err := r.db.BeginTx(ctx, nil)
-	if err != nil {
-		return fmt.Errorf("failed to begin transaction: %w", err)
-	}
-
-	defer func() {
+	return inTx(ctx, r.db, r.queries, r.retry, func(txQueries *database.Queries) error {
+		// 1. Создаем команду
+		_, err := txQueries.CreateTeam(ctx, team.Name)
 		if err != nil {
-			_ = tx.Rollback()
+			if database.IsUniqueViolation(err) {
+				return domain.ErrTeamAlreadyExists
+			}
+			return fmt.Errorf("failed to create team: %w", err)
 		}
-	}()
-
-	txQueries := r.queries.WithTx(tx)
 
-	// 1. Создаем команду
-	_, err = txQueries.CreateTeam(ctx, team.Name)
-	if err != nil {
-		return fmt.Errorf("failed to create team: %w", err)
-	}
-
-	// 2. Создаем/обновляем пользователей команды
-	for _, member := range team.Members {
-		_, err := txQueries.UpsertUser(ctx, database.UpsertUserParams{
-			UserID:   member.ID,
-			Username: member.Username,
-			TeamName: team.Name,
-			IsActive: member.IsActive,
-		})
-		if err != nil {
-			return fmt.Errorf("failed to upsert user %s: %w", member.ID, err)
+		// 2. Привязываем к родительской команде
+		if team.ParentName != "" {
+			err = txQueries.SetTeamParent(ctx, database.SetTeamParentParams{
+				TeamName:       team.Name,
+				ParentTeamName: toNullString(team.ParentName),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to set parent team: %w", err)
+			}
 		}
-	}
 
-	// 3. Коммитим транзакцию
-	if err := tx.Commit(); err != nil {
-		return fmt.Errorf("failed to commit transaction: %w", err)
-	}
+		// 3. Создаем/обновляем пользователей команды
+		for _, member := range team.Members {
+			_, err := txQueries.UpsertUser(ctx, database.UpsertUserParams{
+				UserID:   member.ID,
+				Username: member.Username,
+				TeamName: team.Name,
+				IsActive: member.IsActive,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to upsert user %s: %w", member.ID, err)
+			}
+		}
 
-	return nil
+		return nil
+	})
 }
 
 // GetByName возвращает команду по названию.
@@ -74,9 +75,15 @@ func (r *TeamRepository) GetByName(ctx context.Context, teamName string) (*domai
 		return nil, fmt.Errorf("failed to get team members: %w", err)
 	}
 
+	parent, err := r.queries.GetTeamParent(ctx, teamName)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("failed to get parent team: %w", err)
+	}
+
 	return &domain.Team{
-		Name:    teamName,
-		Members: users,
+		Name:       teamName,
+		ParentName: parent.String,
+		Members:    users,
 	}, nil
 }
 
@@ -183,3 +190,115 @@ func (r *TeamRepository) GetAllTeams(ctx context.Context) ([]*domain.Team, error
 
 	return teams, nil
 }
+
+// SetParent привязывает команду к родительской. Пустой parentName делает команду корневой.
+// Строки команды и предков нового родителя блокируются до коммита, поэтому встречный перенос
+// ждет транзакцию (или получает взаимоблокировку и повторяется) и проверяет уже новую иерархию.
+// Для несуществующей команды ничего не делает.
+func (r *TeamRepository) SetParent(ctx context.Context, teamName, parentName string) error {
+	return inTx(ctx, r.db, r.queries, r.retry, func(txQueries *database.Queries) error {
+		if _, err := txQueries.LockTeamParent(ctx, teamName); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil
+			}
+			return fmt.Errorf("failed to lock team: %w", err)
+		}
+
+		err := domain.CheckNoCycle(teamName, parentName, func(current string) (string, error) {
+			parent, err := txQueries.LockTeamParent(ctx, current)
+			if errors.Is(err, sql.ErrNoRows) {
+				return "", domain.ErrParentTeamNotFound
+			}
+			if err != nil {
+				return "", fmt.Errorf("failed to lock parent team: %w", err)
+			}
+			return parent.String, nil
+		})
+		if err != nil {
+			return err
+		}
+
+		err = txQueries.SetTeamParent(ctx, database.SetTeamParentParams{
+			TeamName:       teamName,
+			ParentTeamName: toNullString(parentName),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to set parent team: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// GetParent возвращает название родительской команды или пустую строку для корневой команды.
+func (r *TeamRepository) GetParent(ctx context.Context, teamName string) (string, error) {
+	parent, err := r.queries.GetTeamParent(ctx, teamName)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", domain.ErrTeamNotFound
+		}
+		return "", fmt.Errorf("failed to get parent team: %w", err)
+	}
+
+	return parent.String, nil
+}
+
+// GetChildren возвращает названия дочерних команд
+func (r *TeamRepository) GetChildren(ctx context.Context, teamName string) ([]string, error) {
+	children, err := r.queries.GetChildTeams(ctx, toNullString(teamName))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return []string{}, nil
+		}
+		return nil, fmt.Errorf("failed to get child teams: %w", err)
+	}
+
+	return children, nil
+}
+
+// GetTeamTreeStats возвращает плоский список команд с родителями и собственными показателями
+func (r *TeamRepository) GetTeamTreeStats(ctx context.Context) ([]*domain.TeamTreeNode, error) {
+	rows, err := r.queries.GetTeamTreeStats(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get team tree stats: %w", err)
+	}
+
+	nodes := make([]*domain.TeamTreeNode, 0, len(rows))
+	for _, row := range rows {
+		nodes = append(nodes, &domain.TeamTreeNode{
+			Name:       row.TeamName,
+			ParentName: row.ParentTeamName.String,
+			Stats: domain.TeamStats{
+				MembersCount:       row.MembersCount,
+				ActiveMembersCount: row.ActiveMembersCount,
+				OpenPRsCount:       row.OpenPrsCount,
+				OpenReviewsCount:   row.OpenReviewsCount,
+			},
+		})
+	}
+
+	return nodes, nil
+}
+
+// toNullString преобразует пустую строку в NULL
+func toNullString(value string) sql.NullString {
+	return sql.NullString{String: value, Valid: value != ""}
+}
+
+// GetByNames возвращает команды по списку названий одним запросом; участники не заполняются
+func (r *TeamRepository) GetByNames(ctx context.Context, teamNames []string) ([]*domain.Team, error) {
+	rows, err := r.queries.GetTeamsByNames(ctx, teamNames)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get teams by names: %w", err)
+	}
+
+	teams := make([]*domain.Team, 0, len(rows))
+	for _, row := range rows {
+		teams = append(teams, &domain.Team{
+			Name:       row.TeamName,
+			ParentName: row.ParentTeamName.String,
+		})
+	}
+
+	return teams, nil
+}
@@ -0,0 +1,393 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"pr-reviewer-service/internal/database"
+	"pr-reviewer-service/internal/domain"
+)
+
+// TransferRepository реализует выгрузку и загрузку данных сервиса в PostgreSQL.
+type TransferRepository struct {
+	db      *sql.DB
+	queries *database.Queries
+	retry   database.RetryPolicy
+}
+
+// NewTransferRepository создает новый экземпляр TransferRepository.
+func NewTransferRepository(db *sql.DB, queries *database.Queries, opts ...Option) domain.TransferRepository {
+	o := newOptions(opts)
+	return &TransferRepository{
+		db:      db,
+		queries: queries,
+		retry:   o.retry,
+	}
+}
+
+// exportPageSize - сколько пользователей или PR выгрузка читает одним запросом
+const exportPageSize = 500
+
+// Export читает данные страницами в одной транзакции REPEATABLE READ: все страницы относятся
+// к одному снимку, а в памяти одновременно держится только одна страница.
+func (r *TransferRepository) Export(ctx context.Context, emit func(record *domain.ExportRecord) error) error {
+	tx, err := r.db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	// Транзакция только читает, поэтому всегда откатывается
+	defer func() { _ = tx.Rollback() }()
+
+	q := r.queries.InTx(tx)
+
+	teams, err := q.ExportTeams(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to export teams: %w", err)
+	}
+	for _, team := range teams {
+		record := &domain.ExportRecord{
+			Type: domain.RecordTypeTeam,
+			Team: &domain.Team{Name: team.TeamName, ParentName: team.ParentTeamName.String},
+		}
+		if err := emit(record); err != nil {
+			return err
+		}
+	}
+
+	for after := ""; ; {
+		users, err := exportUsersPage(ctx, q, after)
+		if err != nil {
+			return err
+		}
+		for _, user := range users {
+			if err := emit(&domain.ExportRecord{Type: domain.RecordTypeUser, User: user}); err != nil {
+				return err
+			}
+		}
+		if len(users) < exportPageSize {
+			break
+		}
+		after = users[len(users)-1].ID
+	}
+
+	for after := ""; ; {
+		prs, err := exportPRsPage(ctx, q, after)
+		if err != nil {
+			return err
+		}
+		for _, pr := range prs {
+			if err := emit(&domain.ExportRecord{Type: domain.RecordTypePullRequest, PullRequest: pr}); err != nil {
+				return err
+			}
+		}
+		if len(prs) < exportPageSize {
+			break
+		}
+		after = prs[len(prs)-1].ID
+	}
+
+	return nil
+}
+
+// exportUsersPage читает страницу пользователей после after с навыками, отсутствием и историей активности
+func exportUsersPage(ctx context.Context, q *database.Queries, after string) ([]*domain.User, error) {
+	rows, err := q.ExportUsers(ctx, database.ExportUsersParams{AfterUserID: after, PageLimit: exportPageSize})
+	if err != nil {
+		return nil, fmt.Errorf("failed to export users: %w", err)
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	users := make([]*domain.User, len(rows))
+	userIDs := make([]string, len(rows))
+	byID := make(map[string]*domain.User, len(rows))
+	for i, row := range rows {
+		users[i] = &domain.User{
+			ID:               row.UserID,
+			Username:         row.Username,
+			TeamName:         row.TeamName,
+			IsActive:         row.IsActive,
+			Skills:           []string{},
+			OutOfOfficeUntil: fromNullTime(row.OutOfOfficeUntil),
+		}
+		userIDs[i] = row.UserID
+		byID[row.UserID] = users[i]
+	}
+
+	skills, err := q.ExportUserSkills(ctx, userIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to export user skills: %w", err)
+	}
+	for _, skill := range skills {
+		user := byID[skill.UserID]
+		user.Skills = append(user.Skills, skill.Tag)
+	}
+
+	history, err := q.ExportUserStatusHistory(ctx, userIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to export user status history: %w", err)
+	}
+	for _, change := range history {
+		user := byID[change.UserID]
+		user.StatusHistory = append(user.StatusHistory, &domain.UserStatusChange{
+			UserID:    change.UserID,
+			IsActive:  change.IsActive,
+			ChangedAt: change.ChangedAt,
+		})
+	}
+
+	return users, nil
+}
+
+// exportPRsPage читает страницу PR после after с ревьюверами и объяснениями выбора ревьюверов
+func exportPRsPage(ctx context.Context, q *database.Queries, after string) ([]*domain.PullRequest, error) {
+	rows, err := q.ExportPullRequests(ctx, database.ExportPullRequestsParams{AfterPullRequestID: after, PageLimit: exportPageSize})
+	if err != nil {
+		return nil, fmt.Errorf("failed to export PRs: %w", err)
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	prIDs := make([]string, len(rows))
+	for i, row := range rows {
+		prIDs[i] = row.PullRequestID
+	}
+
+	reviewers, err := q.ExportReviewers(ctx, prIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to export reviewers: %w", err)
+	}
+	prReviewers := make(map[string][]string)
+	for _, reviewer := range reviewers {
+		prReviewers[reviewer.PullRequestID] = append(prReviewers[reviewer.PullRequestID], reviewer.UserID)
+	}
+	rationales, err := exportRationales(ctx, q, prIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	prs := make([]*domain.PullRequest, len(rows))
+	for i, row := range rows {
+		pr := &domain.PullRequest{
+			ID:                row.PullRequestID,
+			Name:              row.PullRequestName,
+			AuthorID:          row.AuthorID,
+			Status:            row.Status,
+			CreatedAt:         &row.CreatedAt,
+			AssignedReviewers: prReviewers[row.PullRequestID],
+			Version:           row.Version,
+			Rationale:         rationales[row.PullRequestID],
+		}
+		if pr.AssignedReviewers == nil {
+			pr.AssignedReviewers = []string{}
+		}
+		if row.MergedAt.Valid {
+			pr.MergedAt = &row.MergedAt.Time
+		}
+		prs[i] = pr
+	}
+
+	return prs, nil
+}
+
+// exportRationales собирает объяснения выбора ревьюверов PR страницы тремя запросами
+func exportRationales(ctx context.Context, q *database.Queries, prIDs []string) (map[string]*domain.AssignmentRationale, error) {
+	rows, err := q.ExportAssignmentRationales(ctx, prIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to export assignment rationales: %w", err)
+	}
+	selections, err := q.ExportReviewerSelections(ctx, prIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to export reviewer selections: %w", err)
+	}
+	exclusions, err := q.ExportReviewerExclusions(ctx, prIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to export reviewer exclusions: %w", err)
+	}
+	rationales := make(map[string]*domain.AssignmentRationale, len(rows))
+	for _, row := range rows {
+		rationales[row.PullRequestID] = &domain.AssignmentRationale{
+			Strategy:          row.Strategy,
+			CandidatePoolSize: int(row.CandidatePoolSize),
+			Reviewers:         []*domain.ReviewerSelection{},
+			Excluded:          []*domain.ExcludedCandidate{},
+		}
+	}
+	for _, selection := range selections {
+		rationale := rationales[selection.PullRequestID]
+		rationale.Reviewers = append(rationale.Reviewers, &domain.ReviewerSelection{
+			UserID:        selection.UserID,
+			Reason:        domain.SelectionReason(selection.Reason),
+			MatchedTags:   splitList(selection.MatchedTags),
+			OpenReviews:   int(selection.OpenReviews),
+			SkillScore:    selection.SkillScore,
+			WorkloadScore: selection.WorkloadScore,
+			Score:         selection.SkillScore + selection.WorkloadScore,
+		})
+	}
+	for _, exclusion := range exclusions {
+		rationale := rationales[exclusion.PullRequestID]
+		rationale.Excluded = append(rationale.Excluded, &domain.ExcludedCandidate{
+			UserID: exclusion.UserID,
+			Reason: domain.ExclusionReason(exclusion.Reason),
+		})
+	}
+
+	return rationales, nil
+}
+
+// Import выполняет fn в транзакции с выключенным триггером истории активности: историю
+// пользователей записывает SaveUser. При временной ошибке базы транзакция повторяется целиком.
+func (r *TransferRepository) Import(ctx context.Context, fn func(store domain.ImportStore) error) error {
+	return inTx(ctx, r.db, r.queries, r.retry, func(txQueries *database.Queries) error {
+		if err := txQueries.PauseStatusHistory(ctx); err != nil {
+			return fmt.Errorf("failed to pause status history: %w", err)
+		}
+		return fn(&importStore{q: txQueries})
+	})
+}
+
+// importStore - операции загрузки в рамках транзакции
+type importStore struct {
+	q *database.Queries
+}
+
+func (s *importStore) ExistsTeam(ctx context.Context, teamName string) (bool, error) {
+	count, err := s.q.TeamExists(ctx, teamName)
+	if err != nil {
+		return false, fmt.Errorf("failed to check team existence: %w", err)
+	}
+	return count > 0, nil
+}
+
+// GetParent блокирует строку команды до конца загрузки, как TeamRepository.SetParent,
+// чтобы проверка иерархии не пересеклась со встречным переносом
+func (s *importStore) GetParent(ctx context.Context, teamName string) (string, error) {
+	parent, err := s.q.LockTeamParent(ctx, teamName)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", domain.ErrTeamNotFound
+		}
+		return "", fmt.Errorf("failed to get parent team: %w", err)
+	}
+	return parent.String, nil
+}
+
+func (s *importStore) SaveTeam(ctx context.Context, team *domain.Team) error {
+	err := s.q.UpsertTeam(ctx, database.UpsertTeamParams{
+		TeamName:       team.Name,
+		ParentTeamName: toNullString(team.ParentName),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save team %s: %w", team.Name, err)
+	}
+	return nil
+}
+
+func (s *importStore) ExistsUser(ctx context.Context, userID string) (bool, error) {
+	_, err := s.q.GetUserByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to get user: %w", err)
+	}
+	return true, nil
+}
+
+func (s *importStore) SaveUser(ctx context.Context, user *domain.User) error {
+	err := s.q.ReplaceUser(ctx, database.ReplaceUserParams{
+		UserID:   user.ID,
+		Username: user.Username,
+		TeamName: user.TeamName,
+		IsActive: user.IsActive,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save user %s: %w", user.ID, err)
+	}
+
+	if err := s.q.DeleteUserSkills(ctx, user.ID); err != nil {
+		return fmt.Errorf("failed to delete user skills: %w", err)
+	}
+	for _, tag := range user.Skills {
+		err := s.q.AddUserSkill(ctx, database.AddUserSkillParams{UserID: user.ID, Tag: tag})
+		if err != nil {
+			return fmt.Errorf("failed to add user skill %s: %w", tag, err)
+		}
+	}
+
+	if user.OutOfOfficeUntil != nil {
+		err = s.q.SetUserOutOfOffice(ctx, database.SetUserOutOfOfficeParams{
+			UserID: user.ID,
+			Until:  *user.OutOfOfficeUntil,
+		})
+	} else {
+		err = s.q.DeleteUserOutOfOffice(ctx, user.ID)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to save user out of office: %w", err)
+	}
+
+	if err := s.q.DeleteUserStatusHistory(ctx, user.ID); err != nil {
+		return fmt.Errorf("failed to delete user status history: %w", err)
+	}
+	for _, change := range user.StatusHistory {
+		err := s.q.AddUserStatusChange(ctx, database.AddUserStatusChangeParams{
+			UserID:    user.ID,
+			IsActive:  change.IsActive,
+			ChangedAt: change.ChangedAt,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to add user status change: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (s *importStore) ExistsPR(ctx context.Context, prID string) (bool, error) {
+	count, err := s.q.PRExists(ctx, prID)
+	if err != nil {
+		return false, fmt.Errorf("failed to check PR existence: %w", err)
+	}
+	return count > 0, nil
+}
+
+func (s *importStore) SavePR(ctx context.Context, pr *domain.PullRequest) error {
+	params := database.UpsertPullRequestParams{
+		PullRequestID:   pr.ID,
+		PullRequestName: pr.Name,
+		AuthorID:        pr.AuthorID,
+		Status:          pr.Status,
+		CreatedAt:       *pr.CreatedAt,
+	}
+	if pr.MergedAt != nil {
+		params.MergedAt = sql.NullTime{Time: *pr.MergedAt, Valid: true}
+	}
+	if err := s.q.UpsertPullRequest(ctx, params); err != nil {
+		return fmt.Errorf("failed to save PR %s: %w", pr.ID, err)
+	}
+
+	if err := s.q.DeletePRReviewers(ctx, pr.ID); err != nil {
+		return fmt.Errorf("failed to delete reviewers: %w", err)
+	}
+	for _, reviewerID := range pr.AssignedReviewers {
+		err := s.q.AssignReviewer(ctx, database.AssignReviewerParams{PullRequestID: pr.ID, UserID: reviewerID})
+		if err != nil {
+			return fmt.Errorf("failed to assign reviewer %s: %w", reviewerID, err)
+		}
+	}
+
+	if err := s.q.DeleteAssignmentRationale(ctx, pr.ID); err != nil {
+		return fmt.Errorf("failed to delete assignment rationale: %w", err)
+	}
+	if pr.Rationale != nil {
+		return saveRationale(ctx, s.q, pr.ID, pr.Rationale)
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"pr-reviewer-service/internal/database"
+)
+
+// Option настраивает репозиторий.
+type Option func(*options)
+
+type options struct {
+	retry database.RetryPolicy
+}
+
+// WithRetryPolicy задает повторы транзакций при временных ошибках базы
+// (по умолчанию database.DefaultRetryPolicy).
+func WithRetryPolicy(policy database.RetryPolicy) Option {
+	return func(o *options) {
+		o.retry = policy
+	}
+}
+
+func newOptions(opts []Option) options {
+	o := options{retry: database.DefaultRetryPolicy}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// inTx выполняет fn в транзакции и коммитит ее, а при ошибке откатывает.
+// Если ошибка временная (конфликт сериализации, взаимоблокировка, сбой соединения),
+// транзакция повторяется целиком, поэтому fn не должна иметь побочных эффектов вне базы.
+func inTx(ctx context.Context, db *sql.DB, queries *database.Queries, policy database.RetryPolicy, fn func(q *database.Queries) error) error {
+	return database.Retry(ctx, policy, func() error {
+		tx, err := db.BeginTx(ctx, nil)
+		if err != nil {
+			return fmt.Errorf("failed to begin transaction: %w", err)
+		}
+
+		if err := fn(queries.InTx(tx)); err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+
+		if err := tx.Commit(); err != nil {
+			return fmt.Errorf("failed to commit transaction: %w", err)
+		}
+
+		return nil
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"time"
 
 	"pr-reviewer-service/internal/database"
 	"pr-reviewer-service/internal/domain"
@@ -14,13 +15,16 @@ import (
 type UserRepository struct {
 	db      *sql.DB
 	queries *database.Queries
+	retry   database.RetryPolicy
 }
 
 // NewUserRepository создает новый экземпляр UserRepository.
-func NewUserRepository(db *sql.DB, queries *database.Queries) domain.UserRepository {
+func NewUserRepository(db *sql.DB, queries *database.Queries, opts ...Option) domain.UserRepository {
+	o := newOptions(opts)
 	return &UserRepository{
 		db:      db,
 		queries: queries,
+		retry:   o.retry,
 	}
 }
 
@@ -75,6 +79,9 @@ func (r *UserRepository) UpdateActiveStatus(ctx context.Context, userID string,
 		IsActive: isActive,
 	})
 	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrUserNotFound
+		}
 		return nil, fmt.Errorf("failed to update user status: %w", err)
 	}
 
@@ -98,3 +105,158 @@ func (r *UserRepository) GetUserTeam(ctx context.Context, userID string) (string
 
 	return team, nil
 }
+
+// SetSkills полностью заменяет теги навыков пользователя.
+func (r *UserRepository) SetSkills(ctx context.Context, userID string, skills []string) error {
+	return inTx(ctx, r.db, r.queries, r.retry, func(txQueries *database.Queries) error {
+		err := txQueries.DeleteUserSkills(ctx, userID)
+		if err != nil {
+			return fmt.Errorf("failed to delete user skills: %w", err)
+		}
+
+		for _, tag := range skills {
+			err = txQueries.AddUserSkill(ctx, database.AddUserSkillParams{
+				UserID: userID,
+				Tag:    tag,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to add user skill %s: %w", tag, err)
+			}
+		}
+
+		return nil
+	})
+}
+
+// GetSkills возвращает теги навыков пользователя в алфавитном порядке.
+func (r *UserRepository) GetSkills(ctx context.Context, userID string) ([]string, error) {
+	skills, err := r.queries.GetUserSkills(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user skills: %w", err)
+	}
+
+	if skills == nil {
+		return []string{}, nil
+	}
+	return skills, nil
+}
+
+// GetTeamRoster возвращает всех участников команды (включая неактивных)
+// с их навыками, отсутствием и количеством открытых PR на ревью.
+func (r *UserRepository) GetTeamRoster(ctx context.Context, teamName string) ([]*domain.ReviewCandidate, error) {
+	rows, err := r.queries.GetTeamRoster(ctx, teamName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get team roster: %w", err)
+	}
+
+	skillRows, err := r.queries.GetTeamSkills(ctx, teamName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get team skills: %w", err)
+	}
+
+	skills := make(map[string][]string)
+	for _, row := range skillRows {
+		skills[row.UserID] = append(skills[row.UserID], row.Tag)
+	}
+
+	candidates := make([]*domain.ReviewCandidate, 0, len(rows))
+	for _, row := range rows {
+		candidates = append(candidates, &domain.ReviewCandidate{
+			User: &domain.User{
+				ID:               row.UserID,
+				Username:         row.Username,
+				TeamName:         row.TeamName,
+				IsActive:         row.IsActive,
+				Skills:           skills[row.UserID],
+				OutOfOfficeUntil: fromNullTime(row.OutOfOfficeUntil),
+			},
+			OpenReviews: int(row.OpenReviewsCount),
+		})
+	}
+
+	return candidates, nil
+}
+
+// GetByIDs возвращает найденных пользователей по списку ID одним запросом
+func (r *UserRepository) GetByIDs(ctx context.Context, userIDs []string) ([]*domain.User, error) {
+	dbUsers, err := r.queries.GetUsersByIDs(ctx, userIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get users by ids: %w", err)
+	}
+
+	return toDomainUsers(dbUsers), nil
+}
+
+// GetByTeams возвращает всех участников (включая неактивных) перечисленных команд одним запросом
+func (r *UserRepository) GetByTeams(ctx context.Context, teamNames []string) ([]*domain.User, error) {
+	dbUsers, err := r.queries.GetUsersByTeams(ctx, teamNames)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get users by teams: %w", err)
+	}
+
+	return toDomainUsers(dbUsers), nil
+}
+
+// SetOutOfOffice отмечает пользователя отсутствующим до until; nil снимает отметку.
+func (r *UserRepository) SetOutOfOffice(ctx context.Context, userID string, until *time.Time) error {
+	var err error
+	if until == nil {
+		err = r.queries.DeleteUserOutOfOffice(ctx, userID)
+	} else {
+		err = r.queries.SetUserOutOfOffice(ctx, database.SetUserOutOfOfficeParams{
+			UserID: userID,
+			Until:  *until,
+		})
+	}
+	if err != nil {
+		return fmt.Errorf("failed to set user out of office: %w", err)
+	}
+
+	return nil
+}
+
+// GetReviewCandidates возвращает найденных пользователей по списку ID (без навыков)
+// с отсутствием и количеством открытых PR на ревью одним запросом.
+func (r *UserRepository) GetReviewCandidates(ctx context.Context, userIDs []string) ([]*domain.ReviewCandidate, error) {
+	rows, err := r.queries.GetReviewCandidates(ctx, userIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get review candidates: %w", err)
+	}
+
+	candidates := make([]*domain.ReviewCandidate, 0, len(rows))
+	for _, row := range rows {
+		candidates = append(candidates, &domain.ReviewCandidate{
+			User: &domain.User{
+				ID:               row.UserID,
+				Username:         row.Username,
+				TeamName:         row.TeamName,
+				IsActive:         row.IsActive,
+				OutOfOfficeUntil: fromNullTime(row.OutOfOfficeUntil),
+			},
+			OpenReviews: int(row.OpenReviewsCount),
+		})
+	}
+
+	return candidates, nil
+}
+
+// fromNullTime переводит необязательное время в указатель (NULL - nil)
+func fromNullTime(t sql.NullTime) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
+
+func toDomainUsers(dbUsers []database.User) []*domain.User {
+	users := make([]*domain.User, 0, len(dbUsers))
+	for _, dbUser := range dbUsers {
+		users = append(users, &domain.User{
+			ID:       dbUser.UserID,
+			Username: dbUser.Username,
+			TeamName: dbUser.TeamName,
+			IsActive: dbUser.IsActive,
+		})
+	}
+	return users
+}
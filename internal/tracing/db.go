@@ -0,0 +1,95 @@
+package tracing
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"pr-reviewer-service/internal/database"
+)
+
+// queryNamePrefix - sqlc начинает текст каждого запроса с "-- name: <Name> :<kind>"
+const queryNamePrefix = "-- name: "
+
+// DB оборачивает database.DBTX и создает клиентский спан на каждый sqlc-запрос.
+type DB struct {
+	db     database.DBTX
+	system string
+}
+
+// WrapDB оборачивает соединение PostgreSQL (или транзакцию) трассировкой.
+func WrapDB(db database.DBTX) *DB {
+	return WrapDBSystem(db, "postgresql")
+}
+
+// WrapDBSystem оборачивает соединение другой СУБД; system попадает в атрибут db.system.
+func WrapDBSystem(db database.DBTX, system string) *DB {
+	return &DB{db: db, system: system}
+}
+
+// WithTx оборачивает транзакцию той же трассировкой (см. database.Queries.InTx).
+func (d *DB) WithTx(tx *sql.Tx) database.DBTX {
+	return &DB{db: tx, system: d.system}
+}
+
+// ExecContext выполняет запрос без результата.
+func (d *DB) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	ctx, span := d.startQuery(ctx, query)
+	defer span.End()
+
+	result, err := d.db.ExecContext(ctx, query, args...)
+	span.RecordError(err)
+	return result, err
+}
+
+// PrepareContext подготавливает запрос.
+func (d *DB) PrepareContext(ctx context.Context, query string) (*sql.Stmt, error) {
+	ctx, span := d.startQuery(ctx, query)
+	defer span.End()
+
+	stmt, err := d.db.PrepareContext(ctx, query)
+	span.RecordError(err)
+	return stmt, err
+}
+
+// QueryContext выполняет запрос, возвращающий строки. Спан покрывает выполнение запроса,
+// но не чтение строк вызывающим кодом.
+func (d *DB) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	ctx, span := d.startQuery(ctx, query)
+	defer span.End()
+
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	span.RecordError(err)
+	return rows, err
+}
+
+// QueryRowContext выполняет запрос, возвращающий одну строку.
+func (d *DB) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	ctx, span := d.startQuery(ctx, query)
+	defer span.End()
+
+	row := d.db.QueryRowContext(ctx, query, args...)
+	span.RecordError(row.Err())
+	return row
+}
+
+func (d *DB) startQuery(ctx context.Context, query string) (context.Context, *Span) {
+	name := queryName(query)
+	ctx, span := StartKind(ctx, "db."+name, KindClient)
+	span.SetAttribute("db.system", d.system)
+	span.SetAttribute("db.operation", name)
+	return ctx, span
+}
+
+// queryName извлекает имя sqlc-запроса; для произвольного SQL возвращает "query"
+func queryName(query string) string {
+	if !strings.HasPrefix(query, queryNamePrefix) {
+		return "query"
+	}
+
+	fields := strings.Fields(strings.TrimPrefix(query, queryNamePrefix))
+	if len(fields) == 0 {
+		return "query"
+	}
+	return fields[0]
+}
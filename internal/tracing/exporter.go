@@ -0,0 +1,68 @@
+package tracing
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+)
+
+// Exporter получает завершенные спаны.
+type Exporter interface {
+	Export(span *SpanData)
+}
+
+// Поддерживаемые экспортеры (TRACING_EXPORTER)
+const (
+	ExporterStdout = "stdout"
+	ExporterFile   = "file"
+	ExporterNone   = "none"
+)
+
+// JSONExporter пишет каждый спан отдельной JSON-строкой (JSON Lines).
+type JSONExporter struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// NewJSONExporter создает экспортер, пишущий в w.
+func NewJSONExporter(w io.Writer) *JSONExporter {
+	return &JSONExporter{w: w}
+}
+
+// Export сериализует спан; ошибки записи игнорируются, чтобы трассировка не ломала запросы.
+func (e *JSONExporter) Export(span *SpanData) {
+	line, err := json.Marshal(span)
+	if err != nil {
+		return
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	_, _ = e.w.Write(append(line, '\n'))
+}
+
+// noopExporter отбрасывает спаны
+type noopExporter struct{}
+
+func (noopExporter) Export(*SpanData) {}
+
+// NewExporter создает экспортер по имени: stdout, file (JSON Lines в path) или none.
+// Возвращаемая функция закрывает ресурсы экспортера.
+func NewExporter(name, path string) (Exporter, func() error, error) {
+	switch name {
+	case ExporterStdout, "":
+		return NewJSONExporter(os.Stdout), func() error { return nil }, nil
+	case ExporterFile:
+		file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644) //nolint:gosec // путь из конфигурации
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to open trace file: %w", err)
+		}
+		return NewJSONExporter(file), file.Close, nil
+	case ExporterNone:
+		return noopExporter{}, func() error { return nil }, nil
+	default:
+		return nil, nil, fmt.Errorf("unknown tracing exporter %q", name)
+	}
+}
@@ -0,0 +1,58 @@
+package tracing
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// TraceparentHeader - заголовок W3C Trace Context.
+const TraceparentHeader = "traceparent"
+
+// flagSampled - флаг "трасса записывается"
+const flagSampled byte = 0x01
+
+// ParseTraceparent разбирает заголовок traceparent вида
+// "00-<trace-id 32 hex>-<parent-id 16 hex>-<flags 2 hex>".
+// Возвращает false для некорректного значения или нулевых идентификаторов.
+func ParseTraceparent(value string) (SpanContext, bool) {
+	parts := strings.Split(strings.TrimSpace(value), "-")
+	if len(parts) < 4 || len(parts[0]) != 2 || parts[0] == "ff" {
+		return SpanContext{}, false
+	}
+	// Версия 00 состоит ровно из четырех полей; будущие версии могут добавить поля в конец
+	if parts[0] == "00" && len(parts) != 4 {
+		return SpanContext{}, false
+	}
+
+	var sc SpanContext
+	if !decodeHex(parts[1], sc.TraceID[:]) || !decodeHex(parts[2], sc.SpanID[:]) {
+		return SpanContext{}, false
+	}
+
+	var flags [1]byte
+	if !decodeHex(parts[3], flags[:]) {
+		return SpanContext{}, false
+	}
+	sc.Flags = flags[0]
+
+	if !sc.TraceID.IsValid() || !sc.SpanID.IsValid() {
+		return SpanContext{}, false
+	}
+
+	return sc, true
+}
+
+// Traceparent форматирует SpanContext в значение заголовка traceparent.
+func (sc SpanContext) Traceparent() string {
+	return fmt.Sprintf("00-%s-%s-%02x", sc.TraceID, sc.SpanID, sc.Flags)
+}
+
+// decodeHex декодирует строчный hex ровно в len(dst) байт
+func decodeHex(value string, dst []byte) bool {
+	if len(value) != hex.EncodedLen(len(dst)) || strings.ToLower(value) != value {
+		return false
+	}
+	_, err := hex.Decode(dst, []byte(value))
+	return err == nil
+}
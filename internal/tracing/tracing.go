@@ -0,0 +1,230 @@
+// Package tracing - минимальная трассировка в духе OpenTelemetry: спаны с родительскими связями,
+// распространение контекста по W3C Trace Context (заголовок traceparent) и подключаемый экспортер.
+package tracing
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"sync"
+	"time"
+)
+
+// TraceID - идентификатор трассы (16 байт, W3C Trace Context).
+type TraceID [16]byte
+
+// String возвращает идентификатор в hex.
+func (t TraceID) String() string {
+	return hex.EncodeToString(t[:])
+}
+
+// IsValid сообщает, что идентификатор не нулевой.
+func (t TraceID) IsValid() bool {
+	return t != TraceID{}
+}
+
+// SpanID - идентификатор спана (8 байт).
+type SpanID [8]byte
+
+// String возвращает идентификатор в hex.
+func (s SpanID) String() string {
+	return hex.EncodeToString(s[:])
+}
+
+// IsValid сообщает, что идентификатор не нулевой.
+func (s SpanID) IsValid() bool {
+	return s != SpanID{}
+}
+
+// SpanContext - часть спана, передаваемая между сервисами.
+type SpanContext struct {
+	TraceID TraceID
+	SpanID  SpanID
+	Flags   byte
+}
+
+// Виды спанов
+const (
+	KindInternal = "internal"
+	KindServer   = "server"
+	KindClient   = "client"
+)
+
+// Статусы завершения спана
+const (
+	StatusOK    = "ok"
+	StatusError = "error"
+)
+
+// SpanData - завершенный спан в том виде, в котором он передается экспортеру.
+type SpanData struct {
+	TraceID      string                 `json:"trace_id"`
+	SpanID       string                 `json:"span_id"`
+	ParentSpanID string                 `json:"parent_span_id,omitempty"`
+	Name         string                 `json:"name"`
+	Kind         string                 `json:"kind"`
+	Start        time.Time              `json:"start"`
+	End          time.Time              `json:"end"`
+	DurationMs   float64                `json:"duration_ms"`
+	Status       string                 `json:"status"`
+	Error        string                 `json:"error,omitempty"`
+	Attributes   map[string]interface{} `json:"attributes,omitempty"`
+}
+
+// Tracer создает корневые спаны и передает завершенные спаны экспортеру.
+type Tracer struct {
+	exporter Exporter
+}
+
+// NewTracer создает трассировщик с указанным экспортером.
+func NewTracer(exporter Exporter) *Tracer {
+	return &Tracer{exporter: exporter}
+}
+
+// StartRemote начинает спан, продолжающий трассу из parent (например, из заголовка traceparent).
+// Если parent невалиден, начинается новая трасса.
+func (t *Tracer) StartRemote(ctx context.Context, parent SpanContext, name, kind string) (context.Context, *Span) {
+	span := &Span{
+		tracer: t,
+		kind:   kind,
+		name:   name,
+		start:  time.Now(),
+		sc:     SpanContext{TraceID: parent.TraceID, SpanID: newSpanID(), Flags: parent.Flags},
+	}
+	if parent.TraceID.IsValid() {
+		span.parent = parent.SpanID
+	} else {
+		span.sc.TraceID = newTraceID()
+		span.sc.Flags = flagSampled
+	}
+
+	return context.WithValue(ctx, spanKey{}, span), span
+}
+
+// Start начинает дочерний спан текущего спана из ctx.
+// Без активного спана возвращает nil: методы Span безопасны для nil, так что трассировка просто отключена.
+func Start(ctx context.Context, name string) (context.Context, *Span) {
+	return StartKind(ctx, name, KindInternal)
+}
+
+// StartKind - как Start, но с явным видом спана.
+func StartKind(ctx context.Context, name, kind string) (context.Context, *Span) {
+	parent := SpanFromContext(ctx)
+	if parent == nil {
+		return ctx, nil
+	}
+
+	return parent.tracer.StartRemote(ctx, parent.sc, name, kind)
+}
+
+type spanKey struct{}
+
+// SpanFromContext возвращает активный спан или nil.
+func SpanFromContext(ctx context.Context) *Span {
+	span, _ := ctx.Value(spanKey{}).(*Span)
+	return span
+}
+
+// TraceIDFromContext возвращает идентификатор трассы активного спана или пустую строку.
+func TraceIDFromContext(ctx context.Context) string {
+	span := SpanFromContext(ctx)
+	if span == nil {
+		return ""
+	}
+	return span.sc.TraceID.String()
+}
+
+// Span - выполняемая операция. Все методы безопасны для nil.
+type Span struct {
+	tracer *Tracer
+	sc     SpanContext
+	parent SpanID
+	name   string
+	kind   string
+	start  time.Time
+
+	mu         sync.Mutex
+	attributes map[string]interface{}
+	err        error
+	ended      bool
+}
+
+// Context возвращает SpanContext для передачи в другие сервисы.
+func (s *Span) Context() SpanContext {
+	if s == nil {
+		return SpanContext{}
+	}
+	return s.sc
+}
+
+// SetAttribute добавляет атрибут спана.
+func (s *Span) SetAttribute(key string, value interface{}) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.attributes == nil {
+		s.attributes = make(map[string]interface{})
+	}
+	s.attributes[key] = value
+}
+
+// RecordError помечает спан как завершившийся ошибкой.
+func (s *Span) RecordError(err error) {
+	if s == nil || err == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.err = err
+}
+
+// End завершает спан и передает его экспортеру; повторные вызовы игнорируются.
+func (s *Span) End() {
+	if s == nil {
+		return
+	}
+
+	s.mu.Lock()
+	if s.ended {
+		s.mu.Unlock()
+		return
+	}
+	s.ended = true
+
+	end := time.Now()
+	data := &SpanData{
+		TraceID:    s.sc.TraceID.String(),
+		SpanID:     s.sc.SpanID.String(),
+		Name:       s.name,
+		Kind:       s.kind,
+		Start:      s.start,
+		End:        end,
+		DurationMs: float64(end.Sub(s.start).Microseconds()) / 1000,
+		Status:     StatusOK,
+		Attributes: s.attributes,
+	}
+	if s.parent.IsValid() {
+		data.ParentSpanID = s.parent.String()
+	}
+	if s.err != nil {
+		data.Status = StatusError
+		data.Error = s.err.Error()
+	}
+	s.mu.Unlock()
+
+	s.tracer.exporter.Export(data)
+}
+
+func newTraceID() TraceID {
+	var id TraceID
+	_, _ = rand.Read(id[:])
+	return id
+}
+
+func newSpanID() SpanID {
+	var id SpanID
+	_, _ = rand.Read(id[:])
+	return id
+}
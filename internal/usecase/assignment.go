@@ -0,0 +1,159 @@
+package usecase
+
+import (
+	"strings"
+	"time"
+
+	"pr-reviewer-service/internal/domain"
+)
+
+// reviewerAvailability проверяет, может ли кандидат получить ревью в момент now:
+// он активен, не отсутствует и не превысил квоту открытых PR на ревью (maxOpenReviews 0 - без ограничения)
+type reviewerAvailability struct {
+	now            time.Time
+	maxOpenReviews int
+}
+
+// exclusion возвращает причину, по которой кандидат не может быть назначен ревьювером
+func (v reviewerAvailability) exclusion(candidate *domain.ReviewCandidate) (domain.ExclusionReason, bool) {
+	switch {
+	case !candidate.User.IsActive:
+		return domain.ExclusionInactive, true
+	case candidate.User.IsOutOfOffice(v.now):
+		return domain.ExclusionOutOfOffice, true
+	case v.maxOpenReviews > 0 && candidate.OpenReviews >= v.maxOpenReviews:
+		return domain.ExclusionOverQuota, true
+	default:
+		return "", false
+	}
+}
+
+// reviewerAssignment накапливает выбранных ревьюверов вместе с объяснением выбора:
+// причины выбора каждого, допустимых кандидатов и исключенных пользователей
+type reviewerAssignment struct {
+	availability reviewerAvailability
+	users        []*domain.User
+	selections   []*domain.ReviewerSelection
+	strategies   []string
+	pool         map[string]bool
+	excluded     []*domain.ExcludedCandidate
+}
+
+// newReviewerAssignment создает назначение; автор PR сразу попадает в исключенные
+func newReviewerAssignment(author *domain.User, availability reviewerAvailability) *reviewerAssignment {
+	a := &reviewerAssignment{availability: availability, pool: make(map[string]bool)}
+	a.exclude(author.ID, domain.ExclusionAuthor)
+	return a
+}
+
+// add добавляет ревьювера, если он еще не выбран
+func (a *reviewerAssignment) add(user *domain.User, selection domain.ReviewerSelection) bool {
+	if a.contains(user.ID) {
+		return false
+	}
+
+	selection.UserID = user.ID
+	a.consider(user.ID)
+	a.users = append(a.users, user)
+	a.selections = append(a.selections, &selection)
+
+	strategy := selectionStrategy(selection.Reason)
+	for _, used := range a.strategies {
+		if used == strategy {
+			return true
+		}
+	}
+	a.strategies = append(a.strategies, strategy)
+	return true
+}
+
+// addUsers добавляет пользователей с общей причиной выбора, пока ревьюверов меньше limit
+func (a *reviewerAssignment) addUsers(users []*domain.User, reason domain.SelectionReason, limit int) {
+	for _, user := range users {
+		a.consider(user.ID)
+	}
+	for _, user := range users {
+		if a.size() >= limit {
+			return
+		}
+		a.add(user, domain.ReviewerSelection{Reason: reason})
+	}
+}
+
+// consider учитывает пользователя в пуле допустимых кандидатов
+func (a *reviewerAssignment) consider(userID string) {
+	a.pool[userID] = true
+}
+
+// exclude фиксирует, что пользователь не рассматривался; сохраняется первая причина
+func (a *reviewerAssignment) exclude(userID string, reason domain.ExclusionReason) {
+	for _, excluded := range a.excluded {
+		if excluded.UserID == userID {
+			return
+		}
+	}
+	a.excluded = append(a.excluded, &domain.ExcludedCandidate{UserID: userID, Reason: reason})
+}
+
+// eligibleCandidates отбирает из состава команды допустимых кандидатов (доступных, кроме автора и уже выбранных),
+// фиксируя исключенных
+func (a *reviewerAssignment) eligibleCandidates(roster []*domain.ReviewCandidate, authorID string) []*domain.ReviewCandidate {
+	eligible := make([]*domain.ReviewCandidate, 0, len(roster))
+	for _, candidate := range roster {
+		reason, unavailable := a.availability.exclusion(candidate)
+		switch {
+		case candidate.User.ID == authorID:
+			a.exclude(candidate.User.ID, domain.ExclusionAuthor)
+		case unavailable:
+			a.exclude(candidate.User.ID, reason)
+		case a.contains(candidate.User.ID):
+		default:
+			a.consider(candidate.User.ID)
+			eligible = append(eligible, candidate)
+		}
+	}
+	return eligible
+}
+
+func (a *reviewerAssignment) contains(userID string) bool {
+	return containsUser(a.users, userID)
+}
+
+func (a *reviewerAssignment) size() int {
+	return len(a.users)
+}
+
+// ids возвращает ID ревьюверов в порядке выбора
+func (a *reviewerAssignment) ids() []string {
+	ids := make([]string, len(a.users))
+	for i, user := range a.users {
+		ids[i] = user.ID
+	}
+	return ids
+}
+
+// rationale формирует объяснение назначения
+func (a *reviewerAssignment) rationale() *domain.AssignmentRationale {
+	return &domain.AssignmentRationale{
+		Strategy:          strings.Join(a.strategies, "+"),
+		CandidatePoolSize: len(a.pool),
+		Reviewers:         a.selections,
+		Excluded:          a.excluded,
+	}
+}
+
+// selectionStrategy возвращает этап подбора, на котором выбирается ревьювер с данной причиной
+func selectionStrategy(reason domain.SelectionReason) string {
+	switch reason {
+	case domain.SelectionCodeOwner:
+		return domain.StrategyCodeOwners
+	case domain.SelectionSkillMatch, domain.SelectionLowWorkload:
+		return domain.StrategySkills
+	case domain.SelectionFairShare:
+		return domain.StrategyFairShare
+	case domain.SelectionEscalation:
+		return domain.StrategyEscalation
+	default:
+		return domain.StrategyTeamRandom
+	}
+}
@@ -0,0 +1,373 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"regexp"
+	"strings"
+
+	"pr-reviewer-service/internal/domain"
+)
+
+// compiledOwnershipRule - правило владения с предкомпилированным шаблоном пути
+type compiledOwnershipRule struct {
+	rule *domain.OwnershipRule
+	re   *regexp.Regexp
+}
+
+// ownershipMatcher сопоставляет пути файлов с правилами владения.
+// Как и в CODEOWNERS, при нескольких совпадениях побеждает последнее правило.
+type ownershipMatcher struct {
+	rules []compiledOwnershipRule
+}
+
+// newOwnershipMatcher компилирует шаблоны всех правил
+func newOwnershipMatcher(rules []*domain.OwnershipRule) (*ownershipMatcher, error) {
+	compiled := make([]compiledOwnershipRule, 0, len(rules))
+	for _, rule := range rules {
+		re, err := compileOwnershipPattern(rule.Pattern)
+		if err != nil {
+			return nil, err
+		}
+		compiled = append(compiled, compiledOwnershipRule{rule: rule, re: re})
+	}
+
+	return &ownershipMatcher{rules: compiled}, nil
+}
+
+// Match возвращает последнее правило, под которое попадает путь, или nil
+func (m *ownershipMatcher) Match(path string) *domain.OwnershipRule {
+	path = strings.TrimPrefix(strings.TrimPrefix(path, "./"), "/")
+	for i := len(m.rules) - 1; i >= 0; i-- {
+		if m.rules[i].re.MatchString(path) {
+			return m.rules[i].rule
+		}
+	}
+	return nil
+}
+
+// compileOwnershipPattern преобразует glob-шаблон CODEOWNERS в регулярное выражение.
+//
+// Поддерживается синтаксис gitignore:
+//   - "*" - любые символы, кроме "/"; "?" - один символ, кроме "/"
+//   - "**" - любое количество каталогов ("**/logs", "docs/**", "a/**/b")
+//   - "/" в начале или в середине шаблона привязывает его к корню репозитория,
+//     иначе шаблон совпадает на любой глубине
+//   - "/" в конце - только каталог (со всем содержимым)
+//
+// Шаблон без подстановок в последнем сегменте совпадает и со всем содержимым каталога
+// ("/docs" покрывает "docs/a/b.md"), а "docs/*" - только с файлами непосредственно в docs.
+func compileOwnershipPattern(pattern string) (*regexp.Regexp, error) {
+	p := strings.TrimSpace(pattern)
+	if p == "" || p == "/" {
+		return nil, domain.ErrInvalidOwnershipPattern
+	}
+
+	anchored := strings.HasPrefix(p, "/")
+	p = strings.TrimPrefix(p, "/")
+
+	dirOnly := strings.HasSuffix(p, "/")
+	p = strings.TrimSuffix(p, "/")
+
+	if strings.Contains(p, "/") {
+		anchored = true
+	}
+
+	segments := strings.Split(p, "/")
+
+	var b strings.Builder
+	if anchored {
+		b.WriteString("^")
+	} else {
+		b.WriteString("^(?:.*/)?")
+	}
+
+	for i, segment := range segments {
+		last := i == len(segments)-1
+		switch {
+		case segment == "":
+			return nil, domain.ErrInvalidOwnershipPattern
+		case segment == "**" && last:
+			b.WriteString(".*")
+		case segment == "**":
+			b.WriteString("(?:[^/]+/)*")
+		default:
+			b.WriteString(translateGlobSegment(segment))
+			if !last {
+				b.WriteString("/")
+			}
+		}
+	}
+
+	lastSegment := segments[len(segments)-1]
+	switch {
+	case dirOnly:
+		b.WriteString("/.*$")
+	case lastSegment != "**" && strings.ContainsAny(lastSegment, "*?"):
+		b.WriteString("$")
+	default:
+		b.WriteString("(?:/.*)?$")
+	}
+
+	re, err := regexp.Compile(b.String())
+	if err != nil {
+		return nil, domain.ErrInvalidOwnershipPattern
+	}
+
+	return re, nil
+}
+
+// translateGlobSegment экранирует сегмент пути, заменяя "*" и "?" на их аналоги в регулярном выражении
+func translateGlobSegment(segment string) string {
+	var b strings.Builder
+	for _, r := range segment {
+		switch r {
+		case '*':
+			b.WriteString("[^/]*")
+		case '?':
+			b.WriteString("[^/]")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	return b.String()
+}
+
+// addOwnerReviewers подбирает ревьюверов так, чтобы каждый затронутый путь, попавший под правило
+// владения, был покрыт хотя бы одним владельцем. Ничего не добавляет, если ни одно правило не сработало
+// или у сработавших правил нет доступных владельцев - тогда используется выбор по команде.
+func (uc *PRUseCase) addOwnerReviewers(ctx context.Context, assignment *reviewerAssignment, req *domain.CreatePRRequest, author *domain.User) error {
+	if uc.ownershipRepo == nil || req.Repository == "" || len(req.ChangedFiles) == 0 {
+		return nil
+	}
+
+	rules, err := uc.ownershipRepo.GetRules(ctx, req.Repository)
+	if err != nil {
+		return err
+	}
+	if len(rules) == 0 {
+		return nil
+	}
+
+	matcher, err := newOwnershipMatcher(rules)
+	if err != nil {
+		return err
+	}
+
+	// 1. Группируем затронутые пути по сработавшим правилам
+	var matched []*domain.OwnershipRule
+	seenRules := make(map[*domain.OwnershipRule]bool)
+	for _, path := range req.ChangedFiles {
+		rule := matcher.Match(path)
+		if rule == nil || seenRules[rule] {
+			continue
+		}
+		seenRules[rule] = true
+		matched = append(matched, rule)
+	}
+
+	// 2. Для каждого правила находим доступных владельцев (активных, не отсутствующих, в пределах квоты, кроме автора)
+	resolver := newOwnerResolver(uc.userRepo, uc.teamRepo, author.ID, assignment)
+	var groups [][]*domain.User
+	for _, rule := range matched {
+		owners, err := resolver.resolve(ctx, rule)
+		if err != nil {
+			return err
+		}
+		if len(owners) > 0 {
+			groups = append(groups, owners)
+		}
+	}
+
+	owners := coverOwnerGroups(groups)
+	assignment.addUsers(owners, domain.SelectionCodeOwner, len(owners))
+	return nil
+}
+
+// coverOwnerGroups жадно выбирает минимальный набор пользователей, покрывающий все группы владельцев:
+// на каждом шаге берется кандидат, владеющий наибольшим числом непокрытых групп (при равенстве - случайный).
+func coverOwnerGroups(groups [][]*domain.User) []*domain.User {
+	uncovered := make([]bool, len(groups))
+	remaining := len(groups)
+	for i := range uncovered {
+		uncovered[i] = true
+	}
+
+	var selected []*domain.User
+	for remaining > 0 {
+		counts := make(map[string]int)
+		users := make(map[string]*domain.User)
+		var order []string
+		for i, group := range groups {
+			if !uncovered[i] {
+				continue
+			}
+			for _, user := range group {
+				if _, ok := users[user.ID]; !ok {
+					users[user.ID] = user
+					order = append(order, user.ID)
+				}
+				counts[user.ID]++
+			}
+		}
+
+		// Перемешиваем, чтобы при равном покрытии нагрузка распределялась между владельцами
+		rand.Shuffle(len(order), func(i, j int) { order[i], order[j] = order[j], order[i] }) //nolint:gosec // не криптография
+
+		bestID := order[0]
+		for _, id := range order[1:] {
+			if counts[id] > counts[bestID] {
+				bestID = id
+			}
+		}
+
+		selected = append(selected, users[bestID])
+		for i, group := range groups {
+			if uncovered[i] && containsUser(group, bestID) {
+				uncovered[i] = false
+				remaining--
+			}
+		}
+	}
+
+	return selected
+}
+
+// ownerResolver раскрывает владельцев правила в активных пользователей с кэшированием запросов.
+// Допустимые и исключенные владельцы фиксируются в объяснении назначения.
+type ownerResolver struct {
+	userRepo   domain.UserRepository
+	teamRepo   domain.TeamRepository
+	authorID   string
+	assignment *reviewerAssignment
+	users      map[string]*domain.User
+	teams      map[string][]*domain.User
+	candidates map[string]*domain.ReviewCandidate
+}
+
+func newOwnerResolver(userRepo domain.UserRepository, teamRepo domain.TeamRepository, authorID string, assignment *reviewerAssignment) *ownerResolver {
+	return &ownerResolver{
+		userRepo:   userRepo,
+		teamRepo:   teamRepo,
+		authorID:   authorID,
+		assignment: assignment,
+		users:      make(map[string]*domain.User),
+		teams:      make(map[string][]*domain.User),
+		candidates: make(map[string]*domain.ReviewCandidate),
+	}
+}
+
+// resolve возвращает доступных владельцев правила (активных, не отсутствующих, в пределах квоты),
+// исключая автора PR
+func (r *ownerResolver) resolve(ctx context.Context, rule *domain.OwnershipRule) ([]*domain.User, error) {
+	var owners []*domain.User
+
+	for _, userID := range rule.UserIDs {
+		user, err := r.user(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case user == nil:
+		case user.ID == r.authorID:
+			r.assignment.exclude(user.ID, domain.ExclusionAuthor)
+		case !user.IsActive:
+			r.assignment.exclude(user.ID, domain.ExclusionInactive)
+		case !containsUser(owners, user.ID):
+			owners = append(owners, user)
+		}
+	}
+
+	for _, teamName := range rule.TeamNames {
+		members, err := r.team(ctx, teamName)
+		if err != nil {
+			return nil, err
+		}
+		for _, member := range members {
+			if member.ID != r.authorID && !containsUser(owners, member.ID) {
+				owners = append(owners, member)
+			}
+		}
+	}
+
+	return r.available(ctx, owners)
+}
+
+// available оставляет владельцев, которые могут получить ревью; отсутствующие и превысившие квоту
+// фиксируются как исключенные. Нагрузка и отсутствие запрашиваются одним запросом на еще не проверенных.
+func (r *ownerResolver) available(ctx context.Context, owners []*domain.User) ([]*domain.User, error) {
+	var unchecked []string
+	for _, owner := range owners {
+		if _, ok := r.candidates[owner.ID]; !ok {
+			unchecked = append(unchecked, owner.ID)
+		}
+	}
+	if len(unchecked) > 0 {
+		candidates, err := r.userRepo.GetReviewCandidates(ctx, unchecked)
+		if err != nil {
+			return nil, err
+		}
+		for _, candidate := range candidates {
+			r.candidates[candidate.User.ID] = candidate
+		}
+	}
+
+	available := make([]*domain.User, 0, len(owners))
+	for _, owner := range owners {
+		candidate, ok := r.candidates[owner.ID]
+		if !ok {
+			continue
+		}
+		if reason, unavailable := r.assignment.availability.exclusion(candidate); unavailable {
+			r.assignment.exclude(owner.ID, reason)
+			continue
+		}
+		r.assignment.consider(owner.ID)
+		available = append(available, owner)
+	}
+
+	return available, nil
+}
+
+func (r *ownerResolver) user(ctx context.Context, userID string) (*domain.User, error) {
+	if user, ok := r.users[userID]; ok {
+		return user, nil
+	}
+
+	user, err := r.userRepo.GetByID(ctx, userID)
+	if err != nil && !errors.Is(err, domain.ErrUserNotFound) {
+		return nil, err
+	}
+
+	// Удаленный пользователь просто не участвует в выборе
+	r.users[userID] = user
+	return user, nil
+}
+
+func (r *ownerResolver) team(ctx context.Context, teamName string) ([]*domain.User, error) {
+	if members, ok := r.teams[teamName]; ok {
+		return members, nil
+	}
+	if r.teamRepo == nil {
+		return nil, nil
+	}
+
+	members, err := r.teamRepo.GetActiveUsersFromTeam(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+
+	r.teams[teamName] = members
+	return members, nil
+}
+
+// containsUser проверяет наличие пользователя в списке
+func containsUser(users []*domain.User, userID string) bool {
+	for _, user := range users {
+		if user.ID == userID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,103 @@
+package usecase
+
+import (
+	"context"
+	"math/rand/v2"
+
+	"pr-reviewer-service/internal/domain"
+)
+
+// findEscalationCandidates ищет доступных ревьюверов в командах иерархии согласно политике эскалации.
+// Команды обходятся в порядке приоритета, пока не наберется uc.maxReviewers кандидатов;
+// внутри команды кандидаты выбираются случайно. Недоступные фиксируются в объяснении назначения.
+func (uc *PRUseCase) findEscalationCandidates(ctx context.Context, assignment *reviewerAssignment, author *domain.User) ([]*domain.User, error) {
+	if uc.teamRepo == nil || uc.escalationPolicy == domain.EscalationNone {
+		return nil, nil
+	}
+
+	teams, err := uc.escalationTeams(ctx, author.TeamName)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := make([]*domain.User, 0, uc.maxReviewers)
+	for _, teamName := range teams {
+		roster, err := uc.userRepo.GetTeamRoster(ctx, teamName)
+		if err != nil {
+			return nil, err
+		}
+
+		eligible := assignment.eligibleCandidates(roster, author.ID)
+		rand.Shuffle(len(eligible), func(i, j int) { eligible[i], eligible[j] = eligible[j], eligible[i] }) //nolint:gosec // не криптография
+		for _, candidate := range eligible {
+			candidates = append(candidates, candidate.User)
+			if len(candidates) == uc.maxReviewers {
+				return candidates, nil
+			}
+		}
+	}
+
+	return candidates, nil
+}
+
+// escalationTeams возвращает команды для расширенного поиска в порядке приоритета.
+func (uc *PRUseCase) escalationTeams(ctx context.Context, teamName string) ([]string, error) {
+	visited := map[string]bool{teamName: true}
+	var teams []string
+
+	add := func(names ...string) {
+		for _, name := range names {
+			if !visited[name] {
+				visited[name] = true
+				teams = append(teams, name)
+			}
+		}
+	}
+
+	current := teamName
+	for current != "" {
+		parent, err := uc.teamRepo.GetParent(ctx, current)
+		if err != nil {
+			return nil, err
+		}
+
+		if uc.escalationPolicy == domain.EscalationSiblings || uc.escalationPolicy == domain.EscalationSiblingsThenParent {
+			siblings, err := uc.siblingTeams(ctx, current, parent)
+			if err != nil {
+				return nil, err
+			}
+			add(siblings...)
+		}
+
+		// Для политики siblings подниматься по иерархии не нужно
+		if uc.escalationPolicy == domain.EscalationSiblings || parent == "" || visited[parent] {
+			break
+		}
+
+		add(parent)
+		current = parent
+	}
+
+	return teams, nil
+}
+
+// siblingTeams возвращает соседние команды (с тем же родителем), исключая саму команду
+func (uc *PRUseCase) siblingTeams(ctx context.Context, teamName, parentName string) ([]string, error) {
+	if parentName == "" {
+		return nil, nil
+	}
+
+	children, err := uc.teamRepo.GetChildren(ctx, parentName)
+	if err != nil {
+		return nil, err
+	}
+
+	siblings := make([]string, 0, len(children))
+	for _, child := range children {
+		if child != teamName {
+			siblings = append(siblings, child)
+		}
+	}
+
+	return siblings, nil
+}
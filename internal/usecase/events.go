@@ -0,0 +1,31 @@
+package usecase
+
+import (
+	"time"
+
+	"pr-reviewer-service/internal/domain"
+)
+
+// noopEvents - заглушка domain.AssignmentEventPublisher, если события никто не слушает
+type noopEvents struct{}
+
+func (noopEvents) PublishAssignment(domain.AssignmentEvent) {}
+
+// publishAssignments публикует события одного типа для каждого из ревьюверов PR
+func publishAssignments(events domain.AssignmentEventPublisher, eventType string, pr *domain.PullRequest, reviewerIDs []string) {
+	now := time.Now()
+	for _, reviewerID := range reviewerIDs {
+		events.PublishAssignment(newAssignmentEvent(eventType, pr, reviewerID, now))
+	}
+}
+
+func newAssignmentEvent(eventType string, pr *domain.PullRequest, reviewerID string, at time.Time) domain.AssignmentEvent {
+	return domain.AssignmentEvent{
+		Type:            eventType,
+		PullRequestID:   pr.ID,
+		PullRequestName: pr.Name,
+		AuthorID:        pr.AuthorID,
+		ReviewerID:      reviewerID,
+		OccurredAt:      at,
+	}
+}
@@ -0,0 +1,200 @@
+package usecase
+
+import (
+	"cmp"
+	"context"
+	"math"
+	"math/rand/v2"
+	"slices"
+	"time"
+
+	"pr-reviewer-service/internal/domain"
+)
+
+// defaultFairnessWindow - период отчета о справедливости, если граница не задана.
+const defaultFairnessWindow = 30 * 24 * time.Hour
+
+// fairnessOutlierSigmas - во сколько стандартных отклонений от ожидаемого числа назначений
+// участник считается выбросом. Назначения считаются пуассоновскими: отклонение равно sqrt(ожидаемого).
+const fairnessOutlierSigmas = 2
+
+// fairnessPeriod возвращает период отчета: без границ - последние 30 дней до now,
+// с одной границей - 30 дней от нее
+func fairnessPeriod(filter *domain.StatsFilter, now time.Time) (from, to time.Time) {
+	switch {
+	case filter.CreatedFrom != nil && filter.CreatedTo != nil:
+		return *filter.CreatedFrom, *filter.CreatedTo
+	case filter.CreatedFrom != nil:
+		return *filter.CreatedFrom, filter.CreatedFrom.Add(defaultFairnessWindow)
+	case filter.CreatedTo != nil:
+		return filter.CreatedTo.Add(-defaultFairnessWindow), *filter.CreatedTo
+	default:
+		return now.Add(-defaultFairnessWindow), now
+	}
+}
+
+// loadFairnessReport читает назначения и историю активности участников команды (пустое имя - всех команд)
+// и строит отчет за период [from, to). Дни после now не считаются днями активности.
+func loadFairnessReport(ctx context.Context, statsRepo domain.StatsRepository, teamName string,
+	from, to, now time.Time) (*domain.FairnessReport, error) {
+	members, err := statsRepo.GetMemberAssignments(ctx, teamName, from, to)
+	if err != nil {
+		return nil, err
+	}
+	history, err := statsRepo.GetUserStatusHistory(ctx, teamName, to)
+	if err != nil {
+		return nil, err
+	}
+	return buildFairnessReport(from, to, now, members, history), nil
+}
+
+// buildFairnessReport группирует участников по командам (members отсортированы по команде)
+// и оценивает распределение назначений в каждой
+func buildFairnessReport(from, to, now time.Time, members []*domain.MemberAssignments,
+	history []*domain.UserStatusChange) *domain.FairnessReport {
+	end := to
+	if now.Before(end) {
+		end = now
+	}
+
+	changes := make(map[string][]*domain.UserStatusChange)
+	for _, change := range history {
+		changes[change.UserID] = append(changes[change.UserID], change)
+	}
+
+	report := &domain.FairnessReport{From: from, To: to, Teams: []*domain.TeamFairness{}}
+	var team *domain.TeamFairness
+	for _, member := range members {
+		if team == nil || team.TeamName != member.TeamName {
+			team = &domain.TeamFairness{TeamName: member.TeamName}
+			report.Teams = append(report.Teams, team)
+		}
+		team.Assignments += member.Assignments
+		team.Members = append(team.Members, &domain.MemberFairness{
+			UserID:      member.UserID,
+			Username:    member.Username,
+			ActiveDays:  activeDays(changes[member.UserID], from, end),
+			Assignments: member.Assignments,
+		})
+	}
+
+	for _, team := range report.Teams {
+		scoreTeamFairness(team)
+	}
+	return report
+}
+
+// activeDays считает дни в [from, end), когда пользователь был активен. changes отсортированы по времени;
+// до первой записи пользователь считается неактивным (его еще не было)
+func activeDays(changes []*domain.UserStatusChange, from, end time.Time) float64 {
+	var active time.Duration
+	isActive, since := false, from
+	for _, change := range changes {
+		at := change.ChangedAt
+		if at.Before(from) {
+			at = from
+		}
+		if at.After(end) {
+			break
+		}
+		if isActive {
+			active += at.Sub(since)
+		}
+		isActive, since = change.IsActive, at
+	}
+	if isActive && end.After(since) {
+		active += end.Sub(since)
+	}
+	return active.Hours() / 24
+}
+
+// scoreTeamFairness распределяет назначения команды пропорционально дням активности,
+// отмечает выбросы и считает коэффициент Джини и разброс отклонений
+func scoreTeamFairness(team *domain.TeamFairness) {
+	var totalDays float64
+	for _, member := range team.Members {
+		totalDays += member.ActiveDays
+	}
+	if totalDays == 0 {
+		return
+	}
+
+	rates := make([]float64, 0, len(team.Members))
+	minDeviation, maxDeviation := math.Inf(1), math.Inf(-1)
+	for _, member := range team.Members {
+		member.ExpectedAssignments = float64(team.Assignments) * member.ActiveDays / totalDays
+		if member.ActiveDays == 0 {
+			continue
+		}
+
+		deviation := member.Deviation()
+		if math.Abs(deviation) > fairnessOutlierSigmas*math.Sqrt(member.ExpectedAssignments) {
+			member.Outlier = domain.FairnessUnderloaded
+			if deviation > 0 {
+				member.Outlier = domain.FairnessOverloaded
+			}
+		}
+		minDeviation, maxDeviation = min(minDeviation, deviation), max(maxDeviation, deviation)
+		rates = append(rates, float64(member.Assignments)/member.ActiveDays)
+	}
+
+	team.Gini = gini(rates)
+	team.MaxMinSpread = maxDeviation - minDeviation
+}
+
+// gini возвращает коэффициент Джини: 0 - все значения равны, ближе к 1 - все приходится на одного
+func gini(values []float64) float64 {
+	if len(values) < 2 {
+		return 0
+	}
+	sorted := slices.Clone(values)
+	slices.Sort(sorted)
+
+	// Для отсортированных значений sum|xi - xj| по всем парам = 2 * sum (2i - n + 1) * xi
+	var sum, weighted float64
+	n := float64(len(sorted))
+	for i, value := range sorted {
+		sum += value
+		weighted += (2*float64(i) - n + 1) * value
+	}
+	if sum == 0 {
+		return 0
+	}
+	return weighted / (n * sum)
+}
+
+// addFairShareReviewers дополняет назначение кандидатами, которые за окно WithFairShare больше других
+// недополучили назначений относительно справедливой доли; при равном отклонении порядок случайный.
+func (uc *PRUseCase) addFairShareReviewers(ctx context.Context, assignment *reviewerAssignment, author *domain.User,
+	candidates []*domain.ReviewCandidate) error {
+	now := time.Now()
+	report, err := loadFairnessReport(ctx, uc.statsRepo, author.TeamName, now.Add(-uc.fairShareWindow), now, now)
+	if err != nil {
+		return err
+	}
+
+	deviations := make(map[string]float64)
+	for _, team := range report.Teams {
+		for _, member := range team.Members {
+			deviations[member.UserID] = member.Deviation()
+		}
+	}
+
+	ordered := slices.Clone(candidates)
+	rand.Shuffle(len(ordered), func(i, j int) { ordered[i], ordered[j] = ordered[j], ordered[i] }) //nolint:gosec // не криптография
+	slices.SortStableFunc(ordered, func(a, b *domain.ReviewCandidate) int {
+		return cmp.Compare(deviations[a.User.ID], deviations[b.User.ID])
+	})
+
+	for _, candidate := range ordered {
+		if assignment.size() >= uc.maxReviewers {
+			break
+		}
+		assignment.add(candidate.User, domain.ReviewerSelection{
+			Reason:      domain.SelectionFairShare,
+			OpenReviews: candidate.OpenReviews,
+		})
+	}
+
+	return nil
+}
@@ -0,0 +1,10 @@
+package usecase
+
+// noopMetrics - заглушка domain.MetricsRecorder, если метрики не подключены
+type noopMetrics struct{}
+
+func (noopMetrics) PRCreated()                {}
+func (noopMetrics) PRMerged()                 {}
+func (noopMetrics) ReviewerReassigned()       {}
+func (noopMetrics) NoReviewerCandidate()      {}
+func (noopMetrics) DeactivationFailed(string) {}
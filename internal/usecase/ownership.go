@@ -0,0 +1,93 @@
+package usecase
+
+import (
+	"context"
+
+	"pr-reviewer-service/internal/domain"
+	"pr-reviewer-service/internal/tracing"
+)
+
+// OwnershipUseCase реализует бизнес-логику для управления правилами владения кодом.
+type OwnershipUseCase struct {
+	ownershipRepo domain.OwnershipRepository
+	userRepo      domain.UserRepository
+	teamRepo      domain.TeamRepository
+}
+
+// NewOwnershipUseCase создает новый экземпляр OwnershipUseCase.
+func NewOwnershipUseCase(ownershipRepo domain.OwnershipRepository, userRepo domain.UserRepository, teamRepo domain.TeamRepository) domain.OwnershipUseCase {
+	return &OwnershipUseCase{
+		ownershipRepo: ownershipRepo,
+		userRepo:      userRepo,
+		teamRepo:      teamRepo,
+	}
+}
+
+// SetRules заменяет правила владения репозитория. Правила применяются в порядке передачи,
+// при нескольких совпадениях побеждает последнее.
+func (uc *OwnershipUseCase) SetRules(ctx context.Context, repository string, rules []*domain.OwnershipRule) ([]*domain.OwnershipRule, error) {
+	ctx, span := tracing.Start(ctx, "OwnershipUseCase.SetRules")
+	defer span.End()
+
+	// Валидация
+	if repository == "" {
+		return nil, domain.ErrInvalidRepository
+	}
+
+	for _, rule := range rules {
+		if _, err := compileOwnershipPattern(rule.Pattern); err != nil {
+			return nil, err
+		}
+
+		// Повторы владельцев в одном правиле не имеют смысла
+		rule.UserIDs = uniqueStrings(rule.UserIDs)
+		rule.TeamNames = uniqueStrings(rule.TeamNames)
+
+		// Проверяем, что владельцы существуют
+		for _, userID := range rule.UserIDs {
+			if _, err := uc.userRepo.GetByID(ctx, userID); err != nil {
+				return nil, domain.ErrUserNotFound
+			}
+		}
+		for _, teamName := range rule.TeamNames {
+			exists, err := uc.teamRepo.ExistsTeam(ctx, teamName)
+			if err != nil {
+				return nil, err
+			}
+			if !exists {
+				return nil, domain.ErrTeamNotFound
+			}
+		}
+	}
+
+	if err := uc.ownershipRepo.ReplaceRules(ctx, repository, rules); err != nil {
+		return nil, err
+	}
+
+	return uc.ownershipRepo.GetRules(ctx, repository)
+}
+
+// GetRules возвращает правила владения репозитория.
+func (uc *OwnershipUseCase) GetRules(ctx context.Context, repository string) ([]*domain.OwnershipRule, error) {
+	ctx, span := tracing.Start(ctx, "OwnershipUseCase.GetRules")
+	defer span.End()
+
+	if repository == "" {
+		return nil, domain.ErrInvalidRepository
+	}
+
+	return uc.ownershipRepo.GetRules(ctx, repository)
+}
+
+// uniqueStrings удаляет повторы, сохраняя порядок
+func uniqueStrings(values []string) []string {
+	seen := make(map[string]bool, len(values))
+	result := make([]string, 0, len(values))
+	for _, value := range values {
+		if !seen[value] {
+			seen[value] = true
+			result = append(result, value)
+		}
+	}
+	return result
+}
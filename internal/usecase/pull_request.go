@@ -2,26 +2,133 @@ package usecase
 
 import (
 	"context"
+	"encoding/base64"
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"time"
 
 	"pr-reviewer-service/internal/domain"
+	"pr-reviewer-service/internal/tracing"
+)
+
+// defaultMaxReviewers - количество ревьюверов, назначаемых на PR, по умолчанию.
+const defaultMaxReviewers = 2
+
+// Размер страницы списка PR
+const (
+	defaultPRPageLimit = 50
+	maxPRPageLimit     = 100
 )
 
 // PRUseCase реализует бизнес-логику для работы с Pull Request'ами.
 type PRUseCase struct {
 	prRepo   domain.PRRepository
 	userRepo domain.UserRepository
+
+	teamRepo         domain.TeamRepository
+	escalationPolicy domain.EscalationPolicy
+	maxReviewers     int
+	maxOpenReviews   int
+	ownershipRepo    domain.OwnershipRepository
+	metrics          domain.MetricsRecorder
+	events           domain.AssignmentEventPublisher
+	statsRepo        domain.StatsRepository
+	fairShareWindow  time.Duration
+}
+
+// PRUseCaseOption настраивает необязательное поведение PRUseCase.
+type PRUseCaseOption func(*PRUseCase)
+
+// WithEscalation включает поиск ревьюверов в других командах иерархии,
+// если в команде автора нет кандидатов.
+func WithEscalation(teamRepo domain.TeamRepository, policy domain.EscalationPolicy) PRUseCaseOption {
+	return func(uc *PRUseCase) {
+		uc.teamRepo = teamRepo
+		uc.escalationPolicy = policy
+	}
+}
+
+// WithMaxReviewers задает количество ревьюверов, назначаемых на PR из команды автора и при эскалации.
+// Владельцев кода может быть больше: каждое сработавшее правило покрывается хотя бы одним владельцем.
+func WithMaxReviewers(n int) PRUseCaseOption {
+	return func(uc *PRUseCase) {
+		if n > 0 {
+			uc.maxReviewers = n
+		}
+	}
+}
+
+// WithReviewQuota ограничивает число открытых PR на ревью у одного пользователя: достигшие квоты
+// не назначаются ни при создании PR, ни при переназначении. n <= 0 - без ограничения.
+func WithReviewQuota(n int) PRUseCaseOption {
+	return func(uc *PRUseCase) {
+		if n > 0 {
+			uc.maxOpenReviews = n
+		}
+	}
+}
+
+// WithOwnershipRules включает подбор ревьюверов по правилам владения кодом (CODEOWNERS).
+// teamRepo нужен для раскрытия команд-владельцев в участников.
+func WithOwnershipRules(ownershipRepo domain.OwnershipRepository, teamRepo domain.TeamRepository) PRUseCaseOption {
+	return func(uc *PRUseCase) {
+		uc.ownershipRepo = ownershipRepo
+		uc.teamRepo = teamRepo
+	}
+}
+
+// WithMetrics включает запись доменных событий (создание, мерж, переназначение) в метрики.
+func WithMetrics(recorder domain.MetricsRecorder) PRUseCaseOption {
+	return func(uc *PRUseCase) {
+		uc.metrics = recorder
+	}
+}
+
+// WithEvents включает публикацию событий назначения ревьюверов (назначение, снятие, мерж).
+func WithEvents(publisher domain.AssignmentEventPublisher) PRUseCaseOption {
+	return func(uc *PRUseCase) {
+		uc.events = publisher
+	}
+}
+
+// WithFairShare включает выбор участников команды автора по отчету о справедливости вместо случайного:
+// назначаются те, кто за последние window больше других недополучил назначений относительно доли
+// по дням активности. Подбор владельцев кода и по навыкам не меняется. window <= 0 не включает выбор.
+func WithFairShare(statsRepo domain.StatsRepository, window time.Duration) PRUseCaseOption {
+	return func(uc *PRUseCase) {
+		if window > 0 {
+			uc.statsRepo = statsRepo
+			uc.fairShareWindow = window
+		}
+	}
 }
 
 // NewPRUseCase создает новый экземпляр PRUseCase.
-func NewPRUseCase(prRepo domain.PRRepository, userRepo domain.UserRepository) domain.PRUseCase {
-	return &PRUseCase{
-		prRepo:   prRepo,
-		userRepo: userRepo,
+func NewPRUseCase(prRepo domain.PRRepository, userRepo domain.UserRepository, opts ...PRUseCaseOption) domain.PRUseCase {
+	uc := &PRUseCase{
+		prRepo:           prRepo,
+		userRepo:         userRepo,
+		escalationPolicy: domain.EscalationNone,
+		maxReviewers:     defaultMaxReviewers,
+		metrics:          noopMetrics{},
+		events:           noopEvents{},
+	}
+
+	for _, opt := range opts {
+		opt(uc)
 	}
+
+	return uc
 }
 
 // CreatePR создает PR и автоматически назначает ревьюверов.
-func (uc *PRUseCase) CreatePR(ctx context.Context, prID, prName, authorID string) (*domain.PullRequest, error) {
+func (uc *PRUseCase) CreatePR(ctx context.Context, req *domain.CreatePRRequest) (*domain.PullRequest, error) {
+	ctx, span := tracing.Start(ctx, "PRUseCase.CreatePR")
+	defer span.End()
+
+	prID, prName, authorID := req.ID, req.Name, req.AuthorID
+
 	// Валидация входных данных
 	if prID == "" {
 		return nil, domain.ErrInvalidPRID
@@ -32,6 +139,10 @@ func (uc *PRUseCase) CreatePR(ctx context.Context, prID, prName, authorID string
 	if authorID == "" {
 		return nil, domain.ErrInvalidUserID
 	}
+	requiredTags, err := normalizeSkillTags(req.RequiredTags)
+	if err != nil {
+		return nil, err
+	}
 
 	// 1. Проверяем, что автор существует
 	author, err := uc.userRepo.GetByID(ctx, authorID)
@@ -48,42 +159,183 @@ func (uc *PRUseCase) CreatePR(ctx context.Context, prID, prName, authorID string
 		return nil, domain.ErrPRAlreadyExists
 	}
 
-	// 3. Находим активных пользователей в команде автора (исключая самого автора)
-	candidates, err := uc.userRepo.GetActiveUsersByTeam(ctx, author.TeamName, authorID)
-	if err != nil {
+	// 3. Подбираем владельцев затронутых путей (по одному на каждое сработавшее правило)
+	assignment := newReviewerAssignment(author, uc.availability())
+	if err := uc.addOwnerReviewers(ctx, assignment, req, author); err != nil {
 		return nil, err
 	}
 
-	// 4. Проверяем наличие кандидатов
-	if len(candidates) == 0 {
-		return nil, domain.ErrNoReviewerCandidate
+	// 4. Дополняем доступными пользователями из команды автора (исключая самого автора):
+	// при заданных навыках - по оценке покрытия и нагрузки, иначе - случайно или по справедливой доле
+	if assignment.size() < uc.maxReviewers {
+		if len(requiredTags) > 0 {
+			err = uc.addSkillReviewers(ctx, assignment, author, requiredTags)
+		} else {
+			err = uc.addTeamReviewers(ctx, assignment, author)
+		}
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	// 6. Создаем PR с ревьюверами
-	pr := &domain.PullRequest{
-		ID:       prID,
-		Name:     prName,
-		AuthorID: authorID,
-		Status:   "OPEN",
+	// 5. Если никого нет, расширяем поиск по иерархии команд
+	if assignment.size() == 0 {
+		escalated, err := uc.findEscalationCandidates(ctx, assignment, author)
+		if err != nil {
+			return nil, err
+		}
+		assignment.addUsers(escalated, domain.SelectionEscalation, uc.maxReviewers)
 	}
 
-	//Преобразуем в []string
-	reviewerIDs := make([]string, len(candidates))
-	for i, u := range candidates {
-		reviewerIDs[i] = u.ID
+	// 6. Проверяем наличие кандидатов
+	if assignment.size() == 0 {
+		uc.metrics.NoReviewerCandidate()
+		return nil, domain.ErrNoReviewerCandidate
 	}
 
+	// 7. Создаем PR с ревьюверами и объяснением выбора
+	pr := &domain.PullRequest{
+		ID:        prID,
+		Name:      prName,
+		AuthorID:  authorID,
+		Status:    domain.PRStatusOpen,
+		Rationale: assignment.rationale(),
+	}
+
+	reviewerIDs := assignment.ids()
 	err = uc.prRepo.CreateWithReviewers(ctx, pr, reviewerIDs)
 	if err != nil {
 		return nil, err
 	}
 
+	uc.metrics.PRCreated()
 	pr.AssignedReviewers = reviewerIDs
+	publishAssignments(uc.events, domain.AssignmentAssigned, pr, reviewerIDs)
+	return pr, nil
+}
+
+// availability возвращает проверку доступности кандидатов на текущий момент
+func (uc *PRUseCase) availability() reviewerAvailability {
+	return reviewerAvailability{now: time.Now(), maxOpenReviews: uc.maxOpenReviews}
+}
+
+// GetPR возвращает PR; при explain=true - вместе с объяснением выбора ревьюверов.
+func (uc *PRUseCase) GetPR(ctx context.Context, prID string, explain bool) (*domain.PullRequest, error) {
+	ctx, span := tracing.Start(ctx, "PRUseCase.GetPR")
+	defer span.End()
+
+	if prID == "" {
+		return nil, domain.ErrInvalidPRID
+	}
+
+	pr, err := uc.prRepo.GetByID(ctx, prID)
+	if err != nil {
+		return nil, err
+	}
+
+	if explain {
+		pr.Rationale, err = uc.prRepo.GetRationale(ctx, prID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return pr, nil
 }
 
-// MergePR помечает PR как MERGED.
-func (uc *PRUseCase) MergePR(ctx context.Context, prID string) (*domain.PullRequest, error) {
+// ListPRs возвращает страницу PR по фильтрам, от новых к старым.
+// Пагинация keyset: следующая страница начинается после последнего PR текущей,
+// поэтому новые PR не сдвигают уже просмотренные страницы.
+func (uc *PRUseCase) ListPRs(ctx context.Context, filter *domain.PRListFilter) (*domain.PRPage, error) {
+	ctx, span := tracing.Start(ctx, "PRUseCase.ListPRs")
+	defer span.End()
+
+	if filter.Status != "" && filter.Status != domain.PRStatusOpen && filter.Status != domain.PRStatusMerged {
+		return nil, domain.ErrInvalidPRStatus
+	}
+	if filter.CreatedFrom != nil && filter.CreatedTo != nil && !filter.CreatedFrom.Before(*filter.CreatedTo) {
+		return nil, domain.ErrInvalidTimeRange
+	}
+
+	limit, err := pageLimit(filter.Limit)
+	if err != nil {
+		return nil, err
+	}
+	after, err := pageCursor(filter.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	// Запрашиваем на один PR больше, чтобы узнать, есть ли следующая страница
+	prs, err := uc.prRepo.List(ctx, filter, after, limit+1)
+	if err != nil {
+		return nil, err
+	}
+
+	return newPRPage(prs, limit), nil
+}
+
+// pageLimit проверяет размер страницы PR; 0 - размер по умолчанию
+func pageLimit(limit int) (int, error) {
+	if limit == 0 {
+		return defaultPRPageLimit, nil
+	}
+	if limit < 0 || limit > maxPRPageLimit {
+		return 0, domain.ErrInvalidPageLimit
+	}
+	return limit, nil
+}
+
+// pageCursor разбирает курсор страницы PR; пустой курсор - первая страница
+func pageCursor(value string) (*domain.PRCursor, error) {
+	if value == "" {
+		return nil, nil
+	}
+	return decodePRCursor(value)
+}
+
+// newPRPage собирает страницу из выборки на limit+1 PR: лишний PR означает, что есть следующая страница
+func newPRPage(prs []*domain.PullRequest, limit int) *domain.PRPage {
+	page := &domain.PRPage{PullRequests: prs}
+	if len(prs) > limit {
+		page.PullRequests = prs[:limit]
+		last := page.PullRequests[limit-1]
+		page.NextCursor = encodePRCursor(&domain.PRCursor{CreatedAt: *last.CreatedAt, ID: last.ID})
+	}
+	return page
+}
+
+// encodePRCursor кодирует позицию в непрозрачную для клиента строку
+func encodePRCursor(cursor *domain.PRCursor) string {
+	raw := cursor.CreatedAt.UTC().Format(time.RFC3339Nano) + "|" + cursor.ID
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// decodePRCursor разбирает курсор, выданный encodePRCursor
+func decodePRCursor(value string) (*domain.PRCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, domain.ErrInvalidCursor
+	}
+
+	createdAt, id, ok := strings.Cut(string(raw), "|")
+	if !ok || id == "" {
+		return nil, domain.ErrInvalidCursor
+	}
+
+	t, err := time.Parse(time.RFC3339Nano, createdAt)
+	if err != nil {
+		return nil, domain.ErrInvalidCursor
+	}
+
+	return &domain.PRCursor{CreatedAt: t, ID: id}, nil
+}
+
+// MergePR помечает PR как MERGED. При ifMatch > 0 PR должен иметь эту версию, иначе ErrPRVersionConflict.
+func (uc *PRUseCase) MergePR(ctx context.Context, prID string, ifMatch int64) (*domain.PullRequest, error) {
+	ctx, span := tracing.Start(ctx, "PRUseCase.MergePR")
+	defer span.End()
+
 	// 1. Проверяем, что PR существует
 	exists, err := uc.prRepo.ExistsPr(ctx, prID)
 	if err != nil {
@@ -93,20 +345,39 @@ func (uc *PRUseCase) MergePR(ctx context.Context, prID string) (*domain.PullRequ
 		return nil, domain.ErrPRNotFound
 	}
 
-	// 2. Выполняем мердж (идемпотентная операция)
-	return uc.prRepo.Merge(ctx, prID)
+	// 2. Выполняем мердж (идемпотентная операция) с проверкой версии
+	pr, merged, err := uc.prRepo.Merge(ctx, prID, ifMatch)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. Повторный мердж уже смерженного PR не считается и не рассылает событий
+	if merged {
+		uc.metrics.PRMerged()
+		publishAssignments(uc.events, domain.AssignmentMerged, pr, pr.AssignedReviewers)
+	}
+	return pr, nil
 }
 
-// ReassignReviewer заменяет ревьювера на случайного из той же команды.
-func (uc *PRUseCase) ReassignReviewer(ctx context.Context, prID, oldReviewerID string) (*domain.PullRequest, string, error) {
+// ReassignReviewer заменяет ревьювера на случайного активного участника той же команды,
+// который еще не назначен на PR и не является его автором.
+// Замена выполняется, только если PR не изменился с момента чтения (и имеет версию ifMatch, если она задана):
+// конкурирующее переназначение получит ErrPRVersionConflict, а не снимет ревьювера повторно.
+func (uc *PRUseCase) ReassignReviewer(ctx context.Context, prID, oldReviewerID string, ifMatch int64) (*domain.PullRequest, string, error) {
+	ctx, span := tracing.Start(ctx, "PRUseCase.ReassignReviewer")
+	defer span.End()
+
 	// 1. Получаем PR и проверяем существование
 	pr, err := uc.prRepo.GetByID(ctx, prID)
 	if err != nil {
 		return nil, "", domain.ErrPRNotFound
 	}
+	if ifMatch != 0 && pr.Version != ifMatch {
+		return nil, "", domain.ErrPRVersionConflict
+	}
 
 	// 2. Нельзя менять ревьюверов у MERGED PR
-	if pr.Status == "MERGED" {
+	if pr.Status == domain.PRStatusMerged {
 		return nil, "", domain.ErrPRAlreadyMerged
 	}
 
@@ -125,25 +396,39 @@ func (uc *PRUseCase) ReassignReviewer(ctx context.Context, prID, oldReviewerID s
 		return nil, "", domain.ErrUserNotFound
 	}
 
-	// 5. Находим случайную замену из той же команды (исключая автора PR)
-	candidates, err := uc.userRepo.GetActiveUsersByTeam(ctx, oldReviewer.TeamName, pr.AuthorID)
+	// 5. Собираем кандидатов из той же команды: доступных (активных, не отсутствующих, в пределах квоты),
+	// кроме автора и текущих ревьюверов PR
+	roster, err := uc.userRepo.GetTeamRoster(ctx, oldReviewer.TeamName)
 	if err != nil {
 		return nil, "", err
 	}
+	availability := uc.availability()
+	candidates := make([]*domain.User, 0, len(roster))
+	for _, candidate := range roster {
+		user := candidate.User
+		if user.ID == pr.AuthorID || user.ID == oldReviewerID || slices.Contains(pr.AssignedReviewers, user.ID) {
+			continue
+		}
+		if _, unavailable := availability.exclusion(candidate); !unavailable {
+			candidates = append(candidates, user)
+		}
+	}
 
 	// 6. Проверяем наличие кандидатов для замены
 	if len(candidates) == 0 {
+		uc.metrics.NoReviewerCandidate()
 		return nil, "", domain.ErrNoReviewerCandidate
 	}
 
-	// 7. Выбираем первого случайного кандидата
-	newReviewer := candidates[0]
+	// 7. Выбираем случайного кандидата
+	newReviewer := candidates[rand.IntN(len(candidates))] //nolint:gosec // не криптография
 
-	// 8. Выполняем замену
-	err = uc.prRepo.ReassignReviewer(ctx, prID, oldReviewerID, newReviewer.ID)
+	// 8. Выполняем замену, если версия PR не изменилась после шага 1
+	err = uc.prRepo.ReassignReviewer(ctx, prID, oldReviewerID, newReviewer.ID, pr.Version)
 	if err != nil {
 		return nil, "", err
 	}
+	uc.metrics.ReviewerReassigned()
 
 	// 9. Получаем обновленный PR
 	updatedPR, err := uc.prRepo.GetByID(ctx, prID)
@@ -151,5 +436,7 @@ func (uc *PRUseCase) ReassignReviewer(ctx context.Context, prID, oldReviewerID s
 		return nil, "", err
 	}
 
+	publishAssignments(uc.events, domain.AssignmentUnassigned, updatedPR, []string{oldReviewerID})
+	publishAssignments(uc.events, domain.AssignmentAssigned, updatedPR, []string{newReviewer.ID})
 	return updatedPR, newReviewer.ID, nil
 }
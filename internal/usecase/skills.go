@@ -0,0 +1,197 @@
+package usecase
+
+import (
+	"context"
+	"math/rand/v2"
+	"regexp"
+	"sort"
+	"strings"
+
+	"pr-reviewer-service/internal/domain"
+)
+
+// Веса функции оценки кандидата при подборе по навыкам
+const (
+	skillCoverageWeight = 0.7
+	workloadWeight      = 0.3
+)
+
+// maxSkillTagLength - максимальная длина тега навыка (ограничение колонки user_skills.tag)
+const maxSkillTagLength = 50
+
+var skillTagPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9+#._-]*$`)
+
+// normalizeSkillTags приводит теги к нижнему регистру, удаляет повторы и проверяет формат
+func normalizeSkillTags(tags []string) ([]string, error) {
+	result := make([]string, 0, len(tags))
+	seen := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if len(tag) > maxSkillTagLength || !skillTagPattern.MatchString(tag) {
+			return nil, domain.ErrInvalidSkillTag
+		}
+		if !seen[tag] {
+			seen[tag] = true
+			result = append(result, tag)
+		}
+	}
+
+	sort.Strings(result)
+	return result, nil
+}
+
+// reviewerScore объединяет покрытие навыков (доля тегов, 0..1) и нагрузку:
+// кандидат без открытых ревью получает полный вес нагрузки, каждый следующий PR его уменьшает.
+// Возвращает оба слагаемых оценки.
+func reviewerScore(coverage float64, openReviews int) (skillScore, workloadScore float64) {
+	return skillCoverageWeight * coverage, workloadWeight / float64(1+openReviews)
+}
+
+// skillPick - кандидат, выбранный по навыкам, и причина выбора
+type skillPick struct {
+	user      *domain.User
+	selection domain.ReviewerSelection
+}
+
+// selectBySkills жадно выбирает до limit кандидатов. На каждом шаге оценивается покрытие еще не покрытых тегов
+// (если покрыты все - всех требуемых), при равной оценке предпочитается менее загруженный, затем по ID.
+// covered - теги, уже покрытые выбранными ранее ревьюверами; функция дополняет его.
+func selectBySkills(candidates []*domain.ReviewCandidate, requiredTags []string, covered map[string]bool, limit int) []skillPick {
+	remaining := make([]*domain.ReviewCandidate, len(candidates))
+	copy(remaining, candidates)
+
+	var picks []skillPick
+	for len(picks) < limit && len(remaining) > 0 {
+		target := uncoveredTags(requiredTags, covered)
+		if len(target) == 0 {
+			target = requiredTags
+		}
+
+		bestIdx := -1
+		var bestSkill, bestWorkload float64
+		var bestMatched []string
+		for i, candidate := range remaining {
+			matched := matchTags(candidate.User.Skills, target)
+			skillScore, workloadScore := reviewerScore(float64(len(matched))/float64(len(target)), candidate.OpenReviews)
+			score, bestScore := skillScore+workloadScore, bestSkill+bestWorkload
+			if bestIdx == -1 || score > bestScore ||
+				(score == bestScore && betterTieBreak(candidate, remaining[bestIdx])) {
+				bestIdx, bestSkill, bestWorkload, bestMatched = i, skillScore, workloadScore, matched
+			}
+		}
+
+		best := remaining[bestIdx]
+		remaining = append(remaining[:bestIdx], remaining[bestIdx+1:]...)
+
+		reason := domain.SelectionSkillMatch
+		if len(bestMatched) == 0 {
+			reason = domain.SelectionLowWorkload
+		}
+		for _, tag := range bestMatched {
+			covered[tag] = true
+		}
+
+		picks = append(picks, skillPick{
+			user: best.User,
+			selection: domain.ReviewerSelection{
+				UserID:        best.User.ID,
+				Reason:        reason,
+				MatchedTags:   matchTags(best.User.Skills, requiredTags),
+				OpenReviews:   best.OpenReviews,
+				SkillScore:    bestSkill,
+				WorkloadScore: bestWorkload,
+				Score:         bestSkill + bestWorkload,
+			},
+		})
+	}
+
+	return picks
+}
+
+// betterTieBreak сравнивает кандидатов с равной оценкой: сначала по нагрузке, затем по ID
+func betterTieBreak(a, b *domain.ReviewCandidate) bool {
+	if a.OpenReviews != b.OpenReviews {
+		return a.OpenReviews < b.OpenReviews
+	}
+	return a.User.ID < b.User.ID
+}
+
+// matchTags возвращает теги из target, которые есть среди навыков
+func matchTags(skills []string, target []string) []string {
+	matched := make([]string, 0, len(target))
+	for _, tag := range target {
+		for _, skill := range skills {
+			if skill == tag {
+				matched = append(matched, tag)
+				break
+			}
+		}
+	}
+	return matched
+}
+
+// uncoveredTags возвращает требуемые теги, которые еще никто не покрыл
+func uncoveredTags(requiredTags []string, covered map[string]bool) []string {
+	result := make([]string, 0, len(requiredTags))
+	for _, tag := range requiredTags {
+		if !covered[tag] {
+			result = append(result, tag)
+		}
+	}
+	return result
+}
+
+// addSkillReviewers дополняет назначение участниками команды автора с учетом требуемых навыков и нагрузки.
+func (uc *PRUseCase) addSkillReviewers(ctx context.Context, assignment *reviewerAssignment, author *domain.User, requiredTags []string) error {
+	roster, err := uc.userRepo.GetTeamRoster(ctx, author.TeamName)
+	if err != nil {
+		return err
+	}
+
+	// Навыки уже выбранных ревьюверов (владельцев из той же команды) считаются покрытыми
+	covered := make(map[string]bool)
+	for _, member := range roster {
+		if assignment.contains(member.User.ID) {
+			for _, tag := range matchTags(member.User.Skills, requiredTags) {
+				covered[tag] = true
+			}
+		}
+	}
+
+	candidates := assignment.eligibleCandidates(roster, author.ID)
+	for _, pick := range selectBySkills(candidates, requiredTags, covered, uc.maxReviewers-assignment.size()) {
+		assignment.add(pick.user, pick.selection)
+	}
+
+	return nil
+}
+
+// addTeamReviewers дополняет назначение случайными активными участниками команды автора
+// (с WithFairShare - участниками с наибольшим недобором назначений).
+func (uc *PRUseCase) addTeamReviewers(ctx context.Context, assignment *reviewerAssignment, author *domain.User) error {
+	roster, err := uc.userRepo.GetTeamRoster(ctx, author.TeamName)
+	if err != nil {
+		return err
+	}
+
+	candidates := assignment.eligibleCandidates(roster, author.ID)
+	if uc.fairShareWindow > 0 {
+		return uc.addFairShareReviewers(ctx, assignment, author, candidates)
+	}
+
+	// Случайное подмножество в порядке состава команды
+	picked := rand.Perm(len(candidates)) //nolint:gosec // не криптография
+	if slots := uc.maxReviewers - assignment.size(); len(picked) > slots {
+		picked = picked[:slots]
+	}
+	sort.Ints(picked)
+
+	for _, idx := range picked {
+		assignment.add(candidates[idx].User, domain.ReviewerSelection{
+			Reason:      domain.SelectionTeamMember,
+			OpenReviews: candidates[idx].OpenReviews,
+		})
+	}
+
+	return nil
+}
@@ -2,8 +2,10 @@ package usecase
 
 import (
 	"context"
+	"time"
 
 	"pr-reviewer-service/internal/domain"
+	"pr-reviewer-service/internal/tracing"
 )
 
 // StatsUseCase реализует бизнес-логику для работы со статистикой.
@@ -18,12 +20,64 @@ func NewStatsUseCase(statsRepo domain.StatsRepository) domain.StatsUseCase {
 	}
 }
 
-// GetReviewStats возвращает статистику по количеству ревью для каждого пользователя.
-func (uc *StatsUseCase) GetStatsReviews(ctx context.Context) ([]*domain.ReviewStat, error) {
-	return uc.statsRepo.GetStatsReviews(ctx)
+// GetStatsReviews возвращает число назначений на ревью для каждого пользователя с учетом фильтра.
+func (uc *StatsUseCase) GetStatsReviews(ctx context.Context, filter *domain.StatsFilter) ([]*domain.ReviewStat, error) {
+	ctx, span := tracing.Start(ctx, "StatsUseCase.GetStatsReviews")
+	defer span.End()
+
+	if err := validateStatsFilter(filter); err != nil {
+		return nil, err
+	}
+
+	return uc.statsRepo.GetStatsReviews(ctx, filter)
+}
+
+// GetStatsPrAssignments возвращает число назначенных ревьюверов на каждый PR с учетом фильтра.
+func (uc *StatsUseCase) GetStatsPrAssignments(ctx context.Context, filter *domain.StatsFilter) ([]*domain.PRAssignmentStat, error) {
+	ctx, span := tracing.Start(ctx, "StatsUseCase.GetStatsPrAssignments")
+	defer span.End()
+
+	if err := validateStatsFilter(filter); err != nil {
+		return nil, err
+	}
+
+	return uc.statsRepo.GetStatsPrAssignments(ctx, filter)
+}
+
+// GetStatsTeams возвращает показатели ревью по командам с учетом фильтра.
+func (uc *StatsUseCase) GetStatsTeams(ctx context.Context, filter *domain.StatsFilter) ([]*domain.TeamReviewStat, error) {
+	ctx, span := tracing.Start(ctx, "StatsUseCase.GetStatsTeams")
+	defer span.End()
+
+	if err := validateStatsFilter(filter); err != nil {
+		return nil, err
+	}
+
+	return uc.statsRepo.GetStatsTeams(ctx, filter)
+}
+
+// GetFairnessReport возвращает отчет о справедливости назначений по командам за период фильтра
+// (по умолчанию - последние 30 дней). Статус PR в фильтре не учитывается.
+func (uc *StatsUseCase) GetFairnessReport(ctx context.Context, filter *domain.StatsFilter) (*domain.FairnessReport, error) {
+	ctx, span := tracing.Start(ctx, "StatsUseCase.GetFairnessReport")
+	defer span.End()
+
+	now := time.Now()
+	from, to := fairnessPeriod(filter, now)
+	if !from.Before(to) {
+		return nil, domain.ErrInvalidTimeRange
+	}
+
+	return loadFairnessReport(ctx, uc.statsRepo, filter.TeamName, from, to, now)
 }
 
-// GetPRAssignmentStats возвращает статистику по количеству назначенных ревьюверов на каждый PR.
-func (uc *StatsUseCase) GetStatsPrAssignments(ctx context.Context) ([]*domain.PRAssignmentStat, error) {
-	return uc.statsRepo.GetStatsPrAssignments(ctx)
+// validateStatsFilter проверяет статус и интервал фильтра так же, как список PR.
+func validateStatsFilter(filter *domain.StatsFilter) error {
+	if filter.Status != "" && filter.Status != domain.PRStatusOpen && filter.Status != domain.PRStatusMerged {
+		return domain.ErrInvalidPRStatus
+	}
+	if filter.CreatedFrom != nil && filter.CreatedTo != nil && !filter.CreatedFrom.Before(*filter.CreatedTo) {
+		return domain.ErrInvalidTimeRange
+	}
+	return nil
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"pr-reviewer-service/internal/domain"
+	"pr-reviewer-service/internal/tracing"
 )
 
 // TeamUseCase реализует бизнес-логику для работы с командами.
@@ -11,19 +12,49 @@ type TeamUseCase struct {
 	teamRepo domain.TeamRepository
 	userRepo domain.UserRepository
 	prRepo   domain.PRRepository
+	metrics  domain.MetricsRecorder
+	events   domain.AssignmentEventPublisher
+}
+
+// TeamUseCaseOption настраивает необязательное поведение TeamUseCase.
+type TeamUseCaseOption func(*TeamUseCase)
+
+// WithTeamMetrics включает запись сбоев деактивации и переназначений в метрики.
+func WithTeamMetrics(recorder domain.MetricsRecorder) TeamUseCaseOption {
+	return func(uc *TeamUseCase) {
+		uc.metrics = recorder
+	}
+}
+
+// WithTeamEvents включает публикацию событий при переназначении ревьюверов во время деактивации команды.
+func WithTeamEvents(publisher domain.AssignmentEventPublisher) TeamUseCaseOption {
+	return func(uc *TeamUseCase) {
+		uc.events = publisher
+	}
 }
 
 // NewTeamUseCase создает новый экземпляр TeamUseCase.
-func NewTeamUseCase(teamRepo domain.TeamRepository, userRepo domain.UserRepository, prRepo domain.PRRepository) domain.TeamUseCase {
-	return &TeamUseCase{
+func NewTeamUseCase(teamRepo domain.TeamRepository, userRepo domain.UserRepository, prRepo domain.PRRepository, opts ...TeamUseCaseOption) domain.TeamUseCase {
+	uc := &TeamUseCase{
 		teamRepo: teamRepo,
 		userRepo: userRepo,
 		prRepo:   prRepo,
+		metrics:  noopMetrics{},
+		events:   noopEvents{},
+	}
+
+	for _, opt := range opts {
+		opt(uc)
 	}
+
+	return uc
 }
 
 // CreateTeam создает новую команду с участниками.
 func (uc *TeamUseCase) CreateTeam(ctx context.Context, team *domain.Team) error {
+	ctx, span := tracing.Start(ctx, "TeamUseCase.CreateTeam")
+	defer span.End()
+
 	// Валидация
 	if team.Name == "" {
 		return domain.ErrInvalidTeamName
@@ -42,12 +73,30 @@ func (uc *TeamUseCase) CreateTeam(ctx context.Context, team *domain.Team) error
 		return domain.ErrTeamAlreadyExists
 	}
 
+	// Проверяем родительскую команду
+	if team.ParentName != "" {
+		if team.ParentName == team.Name {
+			return domain.ErrTeamHierarchyCycle
+		}
+
+		parentExists, err := uc.teamRepo.ExistsTeam(ctx, team.ParentName)
+		if err != nil {
+			return err
+		}
+		if !parentExists {
+			return domain.ErrParentTeamNotFound
+		}
+	}
+
 	// Создаем команду
 	return uc.teamRepo.Create(ctx, team)
 }
 
 // GetTeam возвращает команду по названию.
 func (uc *TeamUseCase) GetTeam(ctx context.Context, teamName string) (*domain.Team, error) {
+	ctx, span := tracing.Start(ctx, "TeamUseCase.GetTeam")
+	defer span.End()
+
 	// Проверяем, что команда существует
 	exists, err := uc.teamRepo.ExistsTeam(ctx, teamName)
 	if err != nil {
@@ -60,8 +109,99 @@ func (uc *TeamUseCase) GetTeam(ctx context.Context, teamName string) (*domain.Te
 	return uc.teamRepo.GetByName(ctx, teamName)
 }
 
+// SetTeamParent перемещает команду под родительскую. Пустой parentName делает команду корневой.
+func (uc *TeamUseCase) SetTeamParent(ctx context.Context, teamName, parentName string) (*domain.Team, error) {
+	ctx, span := tracing.Start(ctx, "TeamUseCase.SetTeamParent")
+	defer span.End()
+
+	if teamName == "" {
+		return nil, domain.ErrInvalidTeamName
+	}
+
+	exists, err := uc.teamRepo.ExistsTeam(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, domain.ErrTeamNotFound
+	}
+
+	if parentName != "" {
+		parentExists, err := uc.teamRepo.ExistsTeam(ctx, parentName)
+		if err != nil {
+			return nil, err
+		}
+		if !parentExists {
+			return nil, domain.ErrParentTeamNotFound
+		}
+	}
+
+	// Репозиторий в той же транзакции проверяет, что новый родитель не лежит в поддереве команды
+	if err := uc.teamRepo.SetParent(ctx, teamName, parentName); err != nil {
+		return nil, err
+	}
+
+	return uc.teamRepo.GetByName(ctx, teamName)
+}
+
+// GetTeamTree возвращает иерархию команд со свёрткой статистики по поддеревьям.
+// Если rootTeamName задан, возвращается только поддерево этой команды.
+func (uc *TeamUseCase) GetTeamTree(ctx context.Context, rootTeamName string) ([]*domain.TeamTreeNode, error) {
+	ctx, span := tracing.Start(ctx, "TeamUseCase.GetTeamTree")
+	defer span.End()
+
+	nodes, err := uc.teamRepo.GetTeamTreeStats(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	byName := make(map[string]*domain.TeamTreeNode, len(nodes))
+	for _, node := range nodes {
+		node.Children = []*domain.TeamTreeNode{}
+		byName[node.Name] = node
+	}
+
+	// Связываем узлы; команды с неизвестным родителем считаем корневыми
+	var roots []*domain.TeamTreeNode
+	for _, node := range nodes {
+		parent, ok := byName[node.ParentName]
+		if node.ParentName == "" || !ok {
+			roots = append(roots, node)
+			continue
+		}
+		parent.Children = append(parent.Children, node)
+	}
+
+	for _, root := range roots {
+		rollUpTeamStats(root)
+	}
+
+	if rootTeamName == "" {
+		return roots, nil
+	}
+
+	root, ok := byName[rootTeamName]
+	if !ok {
+		return nil, domain.ErrTeamNotFound
+	}
+
+	return []*domain.TeamTreeNode{root}, nil
+}
+
+// rollUpTeamStats рекурсивно считает SubtreeStats для узла и его потомков
+func rollUpTeamStats(node *domain.TeamTreeNode) domain.TeamStats {
+	node.SubtreeStats = node.Stats
+	for _, child := range node.Children {
+		node.SubtreeStats.Add(rollUpTeamStats(child))
+	}
+	return node.SubtreeStats
+}
+
 // DeactivateTeamUsers массово деактивирует пользователей команды и безопасно переназначает открытые PR
 func (uc *TeamUseCase) DeactivateTeamUsers(ctx context.Context, teamName string) (*domain.TeamDeactivationResult, error) {
+	ctx, span := tracing.Start(ctx, "TeamUseCase.DeactivateTeamUsers")
+	defer span.End()
+
 	// Валидация
 	if teamName == "" {
 		return nil, domain.ErrInvalidTeamName
@@ -100,6 +240,7 @@ func (uc *TeamUseCase) DeactivateTeamUsers(ctx context.Context, teamName string)
 	for _, user := range activeUsers {
 		_, err := uc.userRepo.UpdateActiveStatus(ctx, user.ID, false)
 		if err != nil {
+			uc.metrics.DeactivationFailed(domain.DeactivationStageUsers)
 			return nil, domain.ErrTeamDeactivationFailed
 		}
 		result.DeactivatedUserIDs = append(result.DeactivatedUserIDs, user.ID)
@@ -112,6 +253,7 @@ func (uc *TeamUseCase) DeactivateTeamUsers(ctx context.Context, teamName string)
 		if success {
 			result.ReassignedPRs++
 		} else {
+			uc.metrics.DeactivationFailed(domain.DeactivationStageReassign)
 			result.FailedReassignments++
 		}
 	}
@@ -139,15 +281,33 @@ func (uc *TeamUseCase) safelyReassignPRReviewers(ctx context.Context, prID, team
 	}
 
 	// Заменяем каждого ревьювера из деактивируемой команды
+	var oldReviewerIDs, newReviewerIDs []string
 	for i, oldReviewerID := range teamReviewers {
 		if i < len(availableReviewers) {
 			newReviewerID := availableReviewers[i].ID
-			err := uc.prRepo.ReassignReviewer(ctx, prID, oldReviewerID, newReviewerID)
+			// Версию не сверяем: деактивация не опирается на состояние PR, прочитанное клиентом
+			err := uc.prRepo.ReassignReviewer(ctx, prID, oldReviewerID, newReviewerID, 0)
 			if err != nil {
 				return false
 			}
+			uc.metrics.ReviewerReassigned()
+			oldReviewerIDs = append(oldReviewerIDs, oldReviewerID)
+			newReviewerIDs = append(newReviewerIDs, newReviewerID)
 		}
 	}
+	if len(oldReviewerIDs) == 0 {
+		return true
+	}
+
+	// Получаем обновленный PR, чтобы события содержали его название и автора
+	pr, err := uc.prRepo.GetByID(ctx, prID)
+	if err != nil {
+		return false
+	}
+	for i, oldReviewerID := range oldReviewerIDs {
+		publishAssignments(uc.events, domain.AssignmentUnassigned, pr, []string{oldReviewerID})
+		publishAssignments(uc.events, domain.AssignmentAssigned, pr, []string{newReviewerIDs[i]})
+	}
 
 	return true
 }
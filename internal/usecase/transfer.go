@@ -0,0 +1,369 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"pr-reviewer-service/internal/domain"
+	"pr-reviewer-service/internal/tracing"
+)
+
+// errImportRolledBack откатывает транзакцию загрузки с ошибочными записями или в режиме dry-run
+var errImportRolledBack = errors.New("import rolled back")
+
+// importInvalidRecordCode - код ошибки записи, не соответствующей формату выгрузки
+const importInvalidRecordCode = "INVALID_RECORD"
+
+// Исход применения записи загрузки
+type importOutcome int
+
+const (
+	importCreated importOutcome = iota
+	importUpdated
+	importSkipped
+)
+
+// TransferUseCase реализует выгрузку и загрузку команд, пользователей и PR.
+type TransferUseCase struct {
+	transferRepo domain.TransferRepository
+}
+
+// NewTransferUseCase создает новый экземпляр TransferUseCase.
+func NewTransferUseCase(transferRepo domain.TransferRepository) domain.TransferUseCase {
+	return &TransferUseCase{
+		transferRepo: transferRepo,
+	}
+}
+
+// Export передает в emit все данные сервиса по одной записи. Команды упорядочены от корневых
+// к дочерним, чтобы при загрузке выгрузки родитель всегда создавался раньше потомков: для этого
+// команды (их немного) собираются перед отправкой, а пользователи и PR передаются по мере чтения.
+func (uc *TransferUseCase) Export(ctx context.Context, emit func(record *domain.ExportRecord) error) error {
+	ctx, span := tracing.Start(ctx, "TransferUseCase.Export")
+	defer span.End()
+
+	var teams []*domain.Team
+	teamsSent := false
+	sendTeams := func() error {
+		if teamsSent {
+			return nil
+		}
+		teamsSent = true
+		for _, team := range parentsFirst(teams) {
+			if err := emit(&domain.ExportRecord{Type: domain.RecordTypeTeam, Team: team}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	err := uc.transferRepo.Export(ctx, func(record *domain.ExportRecord) error {
+		if record.Team != nil {
+			teams = append(teams, record.Team)
+			return nil
+		}
+		if err := sendTeams(); err != nil {
+			return err
+		}
+		return emit(record)
+	})
+	if err != nil {
+		return err
+	}
+	return sendTeams()
+}
+
+// parentsFirst упорядочивает команды по глубине в иерархии, внутри уровня - в исходном порядке
+func parentsFirst(teams []*domain.Team) []*domain.Team {
+	parents := make(map[string]string, len(teams))
+	for _, team := range teams {
+		parents[team.Name] = team.ParentName
+	}
+
+	depth := make(map[string]int, len(teams))
+	for _, team := range teams {
+		// Цикл в иерархии не должен возникать, но если он есть, обход останавливается на повторе
+		visited := map[string]bool{team.Name: true}
+		for parent := team.ParentName; parent != "" && !visited[parent]; parent = parents[parent] {
+			visited[parent] = true
+			depth[team.Name]++
+		}
+	}
+
+	sorted := slices.Clone(teams)
+	slices.SortStableFunc(sorted, func(a, b *domain.Team) int {
+		return depth[a.Name] - depth[b.Name]
+	})
+	return sorted
+}
+
+// Import применяет записи по порядку в одной транзакции. Запись может ссылаться только на команды,
+// пользователей и PR, которые уже есть в базе или загружены записями выше. Ошибки записей
+// собираются в отчет; если есть хотя бы одна ошибка или задан dry-run, транзакция откатывается.
+// Ошибка возвращается, только если загрузку не удалось выполнить (ошибка базы).
+func (uc *TransferUseCase) Import(ctx context.Context, records []*domain.ImportRecord, opts domain.ImportOptions) (*domain.ImportReport, error) {
+	ctx, span := tracing.Start(ctx, "TransferUseCase.Import")
+	defer span.End()
+
+	var report *domain.ImportReport
+	err := uc.transferRepo.Import(ctx, func(store domain.ImportStore) error {
+		// Транзакция может повториться, поэтому отчет каждый раз собирается заново
+		report = &domain.ImportReport{
+			Mode:   opts.Mode,
+			DryRun: opts.DryRun,
+			Errors: []*domain.ImportRecordError{},
+		}
+
+		for _, record := range records {
+			outcome, err := importRecord(ctx, store, record, opts.Mode)
+			if err != nil {
+				recordErr, ok := toImportRecordError(record, err)
+				if !ok {
+					return err
+				}
+				report.Errors = append(report.Errors, recordErr)
+				continue
+			}
+
+			switch outcome {
+			case importCreated:
+				report.Created++
+			case importUpdated:
+				report.Updated++
+			case importSkipped:
+				report.Skipped++
+			}
+		}
+
+		if len(report.Errors) > 0 || opts.DryRun {
+			return errImportRolledBack
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, errImportRolledBack) {
+		return nil, err
+	}
+
+	report.Committed = err == nil
+	return report, nil
+}
+
+// importRecord проверяет и применяет одну запись
+func importRecord(ctx context.Context, store domain.ImportStore, record *domain.ImportRecord, mode domain.ImportMode) (importOutcome, error) {
+	switch {
+	case record.Invalid != nil:
+		return 0, record.Invalid
+	case record.Team != nil:
+		return importTeam(ctx, store, record.Team, mode)
+	case record.User != nil:
+		return importUser(ctx, store, record.User, mode)
+	case record.PullRequest != nil:
+		return importPR(ctx, store, record.PullRequest, mode)
+	default:
+		return 0, domain.ErrInvalidImportRecord
+	}
+}
+
+// resolveExisting решает, что делать с уже существующей сущностью в заданном режиме
+func resolveExisting(mode domain.ImportMode, existsErr error) (importOutcome, error) {
+	switch mode {
+	case domain.ImportModeUpsert:
+		return importUpdated, nil
+	case domain.ImportModeSkip:
+		return importSkipped, nil
+	default:
+		return 0, existsErr
+	}
+}
+
+func importTeam(ctx context.Context, store domain.ImportStore, team *domain.Team, mode domain.ImportMode) (importOutcome, error) {
+	if team.Name == "" {
+		return 0, domain.ErrInvalidTeamName
+	}
+	if team.ParentName == team.Name {
+		return 0, domain.ErrTeamHierarchyCycle
+	}
+
+	outcome := importCreated
+	exists, err := store.ExistsTeam(ctx, team.Name)
+	if err != nil {
+		return 0, err
+	}
+	if exists {
+		if outcome, err = resolveExisting(mode, domain.ErrTeamAlreadyExists); err != nil || outcome == importSkipped {
+			return outcome, err
+		}
+	}
+
+	if team.ParentName != "" {
+		// Среди предков родителя не должно быть самой команды (у новой команды потомков еще нет)
+		err = domain.CheckNoCycle(team.Name, team.ParentName, func(current string) (string, error) {
+			parent, err := store.GetParent(ctx, current)
+			if errors.Is(err, domain.ErrTeamNotFound) {
+				return "", domain.ErrParentTeamNotFound
+			}
+			return parent, err
+		})
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return outcome, store.SaveTeam(ctx, team)
+}
+
+func importUser(ctx context.Context, store domain.ImportStore, user *domain.User, mode domain.ImportMode) (importOutcome, error) {
+	if user.ID == "" || user.Username == "" {
+		return 0, domain.ErrInvalidUserID
+	}
+	if user.TeamName == "" {
+		return 0, domain.ErrInvalidTeamName
+	}
+	skills, err := normalizeSkillTags(user.Skills)
+	if err != nil {
+		return 0, err
+	}
+
+	teamExists, err := store.ExistsTeam(ctx, user.TeamName)
+	if err != nil {
+		return 0, err
+	}
+	if !teamExists {
+		return 0, domain.ErrTeamNotFound
+	}
+
+	outcome := importCreated
+	exists, err := store.ExistsUser(ctx, user.ID)
+	if err != nil {
+		return 0, err
+	}
+	if exists {
+		if outcome, err = resolveExisting(mode, domain.ErrUserAlreadyExists); err != nil || outcome == importSkipped {
+			return outcome, err
+		}
+	}
+
+	history, err := importStatusHistory(user)
+	if err != nil {
+		return 0, err
+	}
+
+	saved := *user
+	saved.Skills = skills
+	saved.StatusHistory = history
+	return outcome, store.SaveUser(ctx, &saved)
+}
+
+// importStatusHistory проверяет историю активности записи: время не убывает, последний статус
+// совпадает с is_active пользователя. Запись без истории получает одну смену на StatusHistoryEpoch.
+func importStatusHistory(user *domain.User) ([]*domain.UserStatusChange, error) {
+	if len(user.StatusHistory) == 0 {
+		return []*domain.UserStatusChange{{UserID: user.ID, IsActive: user.IsActive, ChangedAt: domain.StatusHistoryEpoch}}, nil
+	}
+
+	history := make([]*domain.UserStatusChange, len(user.StatusHistory))
+	for i, change := range user.StatusHistory {
+		if change.ChangedAt.IsZero() || (i > 0 && change.ChangedAt.Before(history[i-1].ChangedAt)) {
+			return nil, domain.ErrInvalidImportRecord
+		}
+		history[i] = &domain.UserStatusChange{UserID: user.ID, IsActive: change.IsActive, ChangedAt: change.ChangedAt}
+	}
+	if history[len(history)-1].IsActive != user.IsActive {
+		return nil, domain.ErrInvalidImportRecord
+	}
+	return history, nil
+}
+
+func importPR(ctx context.Context, store domain.ImportStore, pr *domain.PullRequest, mode domain.ImportMode) (importOutcome, error) {
+	if err := validateImportedPR(pr); err != nil {
+		return 0, err
+	}
+
+	authorExists, err := store.ExistsUser(ctx, pr.AuthorID)
+	if err != nil {
+		return 0, err
+	}
+	if !authorExists {
+		return 0, domain.ErrPRAuthorNotFound
+	}
+	for _, reviewerID := range pr.AssignedReviewers {
+		reviewerExists, err := store.ExistsUser(ctx, reviewerID)
+		if err != nil {
+			return 0, err
+		}
+		if !reviewerExists {
+			return 0, domain.ErrUserNotFound
+		}
+	}
+
+	outcome := importCreated
+	exists, err := store.ExistsPR(ctx, pr.ID)
+	if err != nil {
+		return 0, err
+	}
+	if exists {
+		if outcome, err = resolveExisting(mode, domain.ErrPRAlreadyExists); err != nil || outcome == importSkipped {
+			return outcome, err
+		}
+	}
+
+	return outcome, store.SavePR(ctx, pr)
+}
+
+// validateImportedPR проверяет поля PR: время мержа есть только у MERGED, ревьюверы не повторяются
+func validateImportedPR(pr *domain.PullRequest) error {
+	switch {
+	case pr.ID == "":
+		return domain.ErrInvalidPRID
+	case pr.Name == "":
+		return domain.ErrInvalidPRName
+	case pr.AuthorID == "":
+		return domain.ErrInvalidUserID
+	case pr.Status != domain.PRStatusOpen && pr.Status != domain.PRStatusMerged:
+		return domain.ErrInvalidPRStatus
+	case pr.CreatedAt == nil:
+		return domain.ErrInvalidImportRecord
+	case (pr.Status == domain.PRStatusMerged) != (pr.MergedAt != nil):
+		return domain.ErrInvalidImportRecord
+	}
+
+	reviewers := slices.Clone(pr.AssignedReviewers)
+	slices.Sort(reviewers)
+	if len(slices.Compact(reviewers)) != len(pr.AssignedReviewers) || slices.Contains(reviewers, pr.AuthorID) {
+		return domain.ErrInvalidImportRecord
+	}
+
+	return nil
+}
+
+// toImportRecordError описывает ошибку данных записи; ошибки базы (false) прерывают загрузку
+func toImportRecordError(record *domain.ImportRecord, err error) (*domain.ImportRecordError, bool) {
+	httpErr, ok := domain.ToHTTPError(err)
+	switch {
+	case errors.Is(err, domain.ErrInvalidTeamName), errors.Is(err, domain.ErrInvalidUserID),
+		errors.Is(err, domain.ErrInvalidPRID), errors.Is(err, domain.ErrInvalidPRName):
+		httpErr = domain.HTTPError{Code: importInvalidRecordCode, Message: err.Error()}
+	case !ok:
+		return nil, false
+	case httpErr.Code == "INVALID_REQUEST":
+		// Ошибки валидации относятся к записи, а не к запросу
+		httpErr.Code = importInvalidRecordCode
+	}
+
+	recordErr := &domain.ImportRecordError{
+		Line:    record.Line,
+		Type:    record.Type,
+		Code:    httpErr.Code,
+		Message: httpErr.Message,
+	}
+	switch {
+	case record.Team != nil:
+		recordErr.ID = record.Team.Name
+	case record.User != nil:
+		recordErr.ID = record.User.ID
+	case record.PullRequest != nil:
+		recordErr.ID = record.PullRequest.ID
+	}
+	return recordErr, true
+}
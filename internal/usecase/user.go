@@ -2,8 +2,10 @@ package usecase
 
 import (
 	"context"
+	"time"
 
 	"pr-reviewer-service/internal/domain"
+	"pr-reviewer-service/internal/tracing"
 )
 
 // UserUseCase реализует бизнес-логику для работы с пользователями.
@@ -22,6 +24,9 @@ func NewUserUseCase(userRepo domain.UserRepository, prRepo domain.PRRepository)
 
 // SetUserActive устанавливает флаг активности пользователя.
 func (uc *UserUseCase) SetUserActive(ctx context.Context, userID string, isActive bool) (*domain.User, error) {
+	ctx, span := tracing.Start(ctx, "UserUseCase.SetUserActive")
+	defer span.End()
+
 	// Проверяем, что пользователь существует
 	_, err := uc.userRepo.GetByID(ctx, userID)
 	if err != nil {
@@ -31,13 +36,74 @@ func (uc *UserUseCase) SetUserActive(ctx context.Context, userID string, isActiv
 	return uc.userRepo.UpdateActiveStatus(ctx, userID, isActive)
 }
 
-// GetUserReviewPRs возвращает PR, где пользователь назначен ревьювером.
-func (uc *UserUseCase) GetUserReviewPRs(ctx context.Context, userID string) ([]*domain.PullRequest, error) {
+// GetUserReviewPRs возвращает страницу PR, где пользователь назначен ревьювером, от новых к старым.
+// Пагинация та же, что у ListPRs: limit 0 - размер по умолчанию, cursor - NextCursor предыдущей страницы.
+func (uc *UserUseCase) GetUserReviewPRs(ctx context.Context, userID, cursor string, limit int) (*domain.PRPage, error) {
+	ctx, span := tracing.Start(ctx, "UserUseCase.GetUserReviewPRs")
+	defer span.End()
+
+	limit, err := pageLimit(limit)
+	if err != nil {
+		return nil, err
+	}
+	after, err := pageCursor(cursor)
+	if err != nil {
+		return nil, err
+	}
+
 	// Проверяем, что пользователь существует
-	_, err := uc.userRepo.GetByID(ctx, userID)
+	if _, err := uc.userRepo.GetByID(ctx, userID); err != nil {
+		return nil, domain.ErrUserNotFound
+	}
+
+	prs, err := uc.prRepo.GetUserAssignedPRs(ctx, userID, after, limit+1)
+	if err != nil {
+		return nil, err
+	}
+
+	return newPRPage(prs, limit), nil
+}
+
+// SetUserSkills заменяет теги навыков пользователя. Теги приводятся к нижнему регистру, повторы удаляются.
+func (uc *UserUseCase) SetUserSkills(ctx context.Context, userID string, skills []string) (*domain.User, error) {
+	ctx, span := tracing.Start(ctx, "UserUseCase.SetUserSkills")
+	defer span.End()
+
+	skills, err := normalizeSkillTags(skills)
+	if err != nil {
+		return nil, err
+	}
+
+	// Проверяем, что пользователь существует
+	user, err := uc.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, domain.ErrUserNotFound
+	}
+
+	if err := uc.userRepo.SetSkills(ctx, userID, skills); err != nil {
+		return nil, err
+	}
+
+	user.Skills = skills
+	return user, nil
+}
+
+// SetUserOutOfOffice отмечает пользователя отсутствующим до until: до этого момента он не назначается ревьювером.
+// nil снимает отметку.
+func (uc *UserUseCase) SetUserOutOfOffice(ctx context.Context, userID string, until *time.Time) (*domain.User, error) {
+	ctx, span := tracing.Start(ctx, "UserUseCase.SetUserOutOfOffice")
+	defer span.End()
+
+	// Проверяем, что пользователь существует
+	user, err := uc.userRepo.GetByID(ctx, userID)
 	if err != nil {
 		return nil, domain.ErrUserNotFound
 	}
 
-	return uc.prRepo.GetUserAssignedPRs(ctx, userID)
+	if err := uc.userRepo.SetOutOfOffice(ctx, userID, until); err != nil {
+		return nil, err
+	}
+
+	user.OutOfOfficeUntil = until
+	return user, nil
 }
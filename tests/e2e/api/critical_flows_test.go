@@ -207,6 +207,23 @@ func (suite *CriticalFlowsTestSuite) TestStatsEndpoints() {
 	assert.NoError(t, err)
 	defer resp.Body.Close()
 	assert.Equal(t, 200, resp.StatusCode)
+
+	resp, err = suite.httpClient.Get(suite.baseURL + "/stats/reviews?format=csv")
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, 200, resp.StatusCode)
+	assert.Contains(t, resp.Header.Get("Content-Type"), "text/csv")
+
+	resp, err = suite.httpClient.Get(suite.baseURL + "/stats/teams?status=MERGED&created_from=2020-01-01T00:00:00Z")
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, 200, resp.StatusCode)
+
+	resp, err = suite.httpClient.Get(suite.baseURL + "/stats/fairness?format=ndjson")
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, 200, resp.StatusCode)
+	assert.Contains(t, resp.Header.Get("Content-Type"), "application/x-ndjson")
 }
 
 func TestCriticalFlowsTestSuite(t *testing.T) {
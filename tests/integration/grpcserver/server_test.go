@@ -0,0 +1,275 @@
+package grpcserver_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"pr-reviewer-service/api/reviewerpb"
+	"pr-reviewer-service/internal/domain"
+	"pr-reviewer-service/internal/events"
+	"pr-reviewer-service/internal/grpcserver"
+	"pr-reviewer-service/tests/mocks"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type testUseCases struct {
+	team  *mocks.TeamUseCase
+	user  *mocks.UserUseCase
+	pr    *mocks.PRUseCase
+	stats *mocks.StatsUseCase
+}
+
+// newTestClient поднимает gRPC-сервер поверх моков usecase в памяти и возвращает клиента к нему
+func newTestClient(t *testing.T, broker *events.Broker, authTokens []string) (reviewerpb.ReviewerServiceClient, *testUseCases) {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+
+	uc := &testUseCases{
+		team:  mocks.NewTeamUseCase(t),
+		user:  mocks.NewUserUseCase(t),
+		pr:    mocks.NewPRUseCase(t),
+		stats: mocks.NewStatsUseCase(t),
+	}
+	srv := grpcserver.NewGRPCServer(grpcserver.NewServer(uc.team, uc.user, uc.pr, uc.stats, broker, logger), authTokens)
+
+	listener := bufconn.Listen(1 << 20)
+	go func() { _ = srv.Serve(listener) }()
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return reviewerpb.NewReviewerServiceClient(conn), uc
+}
+
+func TestGRPC_MapsDomainErrorsToStatusCodes(t *testing.T) {
+	client, uc := newTestClient(t, events.NewBroker(), nil)
+	ctx := context.Background()
+
+	uc.pr.On("MergePR", mock.Anything, "pr-404", int64(0)).Return(nil, domain.ErrPRNotFound)
+	uc.pr.On("ReassignReviewer", mock.Anything, "pr-1", "u2", int64(0)).Return(nil, "", domain.ErrNoReviewerCandidate)
+	uc.pr.On("ListPRs", mock.Anything, &domain.PRListFilter{Limit: 500}).Return(nil, domain.ErrInvalidPageLimit)
+	uc.team.On("CreateTeam", mock.Anything, mock.Anything).Return(domain.ErrTeamAlreadyExists)
+	uc.stats.On("GetStatsReviews", mock.Anything, &domain.StatsFilter{}).Return(nil, errors.New("connection refused"))
+	uc.stats.On("GetStatsPrAssignments", mock.Anything, &domain.StatsFilter{}).Return(nil, domain.ErrInvalidStatsFormat)
+
+	tests := []struct {
+		name    string
+		call    func() error
+		code    codes.Code
+		message string
+	}{
+		{
+			name: "not found",
+			call: func() error {
+				_, err := client.MergePullRequest(ctx, &reviewerpb.MergePullRequestRequest{PullRequestId: "pr-404"})
+				return err
+			},
+			code:    codes.NotFound,
+			message: "NOT_FOUND: pull request not found",
+		},
+		{
+			name: "conflict",
+			call: func() error {
+				_, err := client.ReassignReviewer(ctx, &reviewerpb.ReassignReviewerRequest{PullRequestId: "pr-1", OldUserId: "u2"})
+				return err
+			},
+			code:    codes.FailedPrecondition,
+			message: "NO_CANDIDATE: no active replacement candidate in team",
+		},
+		{
+			name: "duplicate",
+			call: func() error {
+				_, err := client.AddTeam(ctx, &reviewerpb.AddTeamRequest{Team: &reviewerpb.Team{TeamName: "backend"}})
+				return err
+			},
+			code:    codes.AlreadyExists,
+			message: "TEAM_EXISTS: team_name already exists",
+		},
+		{
+			name: "validation",
+			call: func() error {
+				_, err := client.ListPullRequests(ctx, &reviewerpb.ListPullRequestsRequest{Limit: 500})
+				return err
+			},
+			code:    codes.InvalidArgument,
+			message: "INVALID_REQUEST: limit must be between 1 and 100",
+		},
+		{
+			name: "validation shared with REST",
+			call: func() error {
+				_, err := client.GetPRAssignmentStats(ctx, &reviewerpb.GetPRAssignmentStatsRequest{})
+				return err
+			},
+			code:    codes.InvalidArgument,
+			message: "INVALID_REQUEST: format must be csv, json or ndjson",
+		},
+		{
+			name: "unexpected error",
+			call: func() error {
+				_, err := client.GetReviewStats(ctx, &reviewerpb.GetReviewStatsRequest{})
+				return err
+			},
+			code:    codes.Internal,
+			message: "INTERNAL_ERROR: connection refused",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st, ok := status.FromError(tt.call())
+			require.True(t, ok)
+			assert.Equal(t, tt.code, st.Code())
+			if tt.message != "" {
+				assert.Equal(t, tt.message, st.Message())
+			}
+		})
+	}
+}
+
+func TestGRPC_CreatePullRequest(t *testing.T) {
+	client, uc := newTestClient(t, events.NewBroker(), nil)
+
+	createdAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	uc.pr.On("CreatePR", mock.Anything, &domain.CreatePRRequest{
+		ID: "pr-1", Name: "Add search", AuthorID: "u1", ChangedFiles: []string{"search/index.go"},
+	}).Return(&domain.PullRequest{
+		ID: "pr-1", Name: "Add search", AuthorID: "u1", Status: domain.PRStatusOpen,
+		AssignedReviewers: []string{"u2"},
+		CreatedAt:         &createdAt,
+		Rationale: &domain.AssignmentRationale{
+			Reviewers: []*domain.ReviewerSelection{{UserID: "u2", Reason: domain.SelectionTeamMember, OpenReviews: 1}},
+		},
+	}, nil)
+
+	resp, err := client.CreatePullRequest(context.Background(), &reviewerpb.CreatePullRequestRequest{
+		PullRequestId: "pr-1", PullRequestName: "Add search", AuthorId: "u1", ChangedFiles: []string{"search/index.go"},
+	})
+	require.NoError(t, err)
+
+	pr := resp.GetPullRequest()
+	assert.Equal(t, reviewerpb.PullRequestStatus_PULL_REQUEST_STATUS_OPEN, pr.GetStatus())
+	assert.Equal(t, []string{"u2"}, pr.GetAssignedReviewers())
+	assert.Equal(t, createdAt, pr.GetCreatedAt().AsTime())
+	assert.Nil(t, pr.GetMergedAt())
+	require.Len(t, resp.GetReviewerSelection(), 1)
+	assert.Equal(t, "team_member", resp.GetReviewerSelection()[0].GetReason())
+}
+
+func TestGRPC_StatsPassFilters(t *testing.T) {
+	client, uc := newTestClient(t, events.NewBroker(), nil)
+	ctx := context.Background()
+
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+	uc.stats.On("GetStatsTeams", mock.Anything, &domain.StatsFilter{
+		CreatedFrom: &from, CreatedTo: &to, TeamName: "backend", Status: domain.PRStatusMerged,
+	}).Return([]*domain.TeamReviewStat{{
+		TeamName: "backend", PullRequests: 2, ReviewsGiven: 3, ReviewsReceived: 4, CrossTeamReviews: 1,
+	}}, nil)
+	uc.stats.On("GetFairnessReport", mock.Anything, &domain.StatsFilter{CreatedFrom: &from, CreatedTo: &to}).
+		Return(&domain.FairnessReport{From: from, To: to, Teams: []*domain.TeamFairness{{
+			TeamName: "backend", Assignments: 3, Gini: 0.25,
+			Members: []*domain.MemberFairness{{
+				UserID: "u2", ActiveDays: 31, Assignments: 3, ExpectedAssignments: 1.5, Outlier: domain.FairnessOverloaded,
+			}},
+		}}}, nil)
+
+	teams, err := client.GetTeamStats(ctx, &reviewerpb.GetTeamStatsRequest{
+		CreatedFrom: timestamppb.New(from), CreatedTo: timestamppb.New(to), TeamName: "backend",
+		Status: reviewerpb.PullRequestStatus_PULL_REQUEST_STATUS_MERGED,
+	})
+	require.NoError(t, err)
+	require.Len(t, teams.GetStats(), 1)
+	assert.Equal(t, "backend", teams.GetStats()[0].GetTeamName())
+	assert.InDelta(t, 2.0, teams.GetStats()[0].GetAvgReviewersPerPr(), 1e-9)
+	assert.InDelta(t, 0.25, teams.GetStats()[0].GetCrossTeamReviewsShare(), 1e-9)
+
+	report, err := client.GetFairnessReport(ctx, &reviewerpb.GetFairnessReportRequest{
+		CreatedFrom: timestamppb.New(from), CreatedTo: timestamppb.New(to),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, to, report.GetCreatedTo().AsTime())
+	require.Len(t, report.GetTeams(), 1)
+	member := report.GetTeams()[0].GetMembers()[0]
+	assert.Equal(t, "overloaded", member.GetOutlier())
+	assert.InDelta(t, 1.5, member.GetDeviation(), 1e-9)
+}
+
+func TestGRPC_RequiresBearerTokenWhenAuthEnabled(t *testing.T) {
+	client, uc := newTestClient(t, events.NewBroker(), []string{"secret"})
+	uc.team.On("GetTeam", mock.Anything, "backend").Return(&domain.Team{Name: "backend"}, nil).Once()
+
+	_, err := client.GetTeam(context.Background(), &reviewerpb.GetTeamRequest{TeamName: "backend"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer wrong")
+	_, err = client.GetTeam(ctx, &reviewerpb.GetTeamRequest{TeamName: "backend"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	ctx = metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer secret")
+	team, err := client.GetTeam(ctx, &reviewerpb.GetTeamRequest{TeamName: "backend"})
+	require.NoError(t, err)
+	assert.Equal(t, "backend", team.GetTeamName())
+
+	stream, err := client.WatchAssignments(context.Background(), &reviewerpb.WatchAssignmentsRequest{})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestGRPC_WatchAssignmentsStreamsReviewerEvents(t *testing.T) {
+	broker := events.NewBroker()
+	client, _ := newTestClient(t, broker, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream, err := client.WatchAssignments(ctx, &reviewerpb.WatchAssignmentsRequest{ReviewerId: "u2"})
+	require.NoError(t, err)
+	// Заголовки приходят после подписки - дальше события не потеряются
+	_, err = stream.Header()
+	require.NoError(t, err)
+
+	broker.PublishAssignment(domain.AssignmentEvent{Type: domain.AssignmentAssigned, PullRequestID: "pr-1", ReviewerID: "u3"})
+	broker.PublishAssignment(domain.AssignmentEvent{Type: domain.AssignmentAssigned, PullRequestID: "pr-1", ReviewerID: "u2"})
+	broker.PublishAssignment(domain.AssignmentEvent{Type: domain.AssignmentMerged, PullRequestID: "pr-1", ReviewerID: "u2"})
+
+	first, err := stream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), first.GetId())
+	assert.Equal(t, reviewerpb.AssignmentEventType_ASSIGNMENT_EVENT_TYPE_ASSIGNED, first.GetType())
+	assert.Equal(t, "u2", first.GetReviewerId())
+
+	second, err := stream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), second.GetId())
+	assert.Equal(t, reviewerpb.AssignmentEventType_ASSIGNMENT_EVENT_TYPE_MERGED, second.GetType())
+
+	// Остановка брокера завершает стрим
+	broker.Close()
+	_, err = stream.Recv()
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Equal(t, 0, broker.Subscribers())
+}
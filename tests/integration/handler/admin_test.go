@@ -0,0 +1,148 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"pr-reviewer-service/api"
+	"pr-reviewer-service/internal/domain"
+	"pr-reviewer-service/internal/events"
+	"pr-reviewer-service/internal/handler"
+	"pr-reviewer-service/tests/mocks"
+
+	"github.com/labstack/echo/v4"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newAdminServer(t *testing.T, transferUC *mocks.TransferUseCase) *echo.Echo {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+
+	e := echo.New()
+	api.RegisterHandlers(e, handler.NewAPIHandler(mocks.NewTeamUseCase(t), mocks.NewUserUseCase(t), mocks.NewPRUseCase(t),
+		mocks.NewStatsUseCase(t), mocks.NewOwnershipUseCase(t), transferUC, events.NewBroker(), time.Second, logger))
+	return e
+}
+
+func TestAdminHandler_Export(t *testing.T) {
+	transferUC := mocks.NewTransferUseCase(t)
+	createdAt := time.Date(2025, 10, 24, 12, 0, 0, 0, time.UTC)
+	oooUntil := time.Date(2025, 11, 1, 0, 0, 0, 0, time.UTC)
+	records := []*domain.ExportRecord{
+		{Type: domain.RecordTypeTeam, Team: &domain.Team{Name: "backend"}},
+		{Type: domain.RecordTypeUser, User: &domain.User{
+			ID: "u1", Username: "Alice", TeamName: "backend", IsActive: true, Skills: []string{"go"},
+			OutOfOfficeUntil: &oooUntil,
+			StatusHistory:    []*domain.UserStatusChange{{UserID: "u1", IsActive: true, ChangedAt: createdAt}},
+		}},
+		{Type: domain.RecordTypePullRequest, PullRequest: &domain.PullRequest{
+			ID: "pr-1", Name: "Add search", AuthorID: "u1", Status: domain.PRStatusOpen,
+			AssignedReviewers: []string{}, CreatedAt: &createdAt, Version: 3,
+		}},
+	}
+	transferUC.On("Export", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			emit := args.Get(1).(func(*domain.ExportRecord) error)
+			for _, record := range records {
+				require.NoError(t, emit(record))
+			}
+		}).
+		Return(nil)
+	e := newAdminServer(t, transferUC)
+
+	req := httptest.NewRequest(http.MethodGet, "/admin/export", nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, handler.NDJSONContentType, rec.Header().Get(echo.HeaderContentType))
+
+	lines := strings.Split(strings.TrimSuffix(rec.Body.String(), "\n"), "\n")
+	require.Len(t, lines, 3)
+	assert.JSONEq(t, `{"type":"team","team":{"team_name":"backend","parent_team_name":null}}`, lines[0])
+	assert.JSONEq(t, `{"type":"user","user":{"user_id":"u1","username":"Alice","team_name":"backend","is_active":true,"skills":["go"],
+		"out_of_office_until":"2025-11-01T00:00:00Z","status_history":[{"is_active":true,"changed_at":"2025-10-24T12:00:00Z"}]}}`, lines[1])
+	// Версия PR не выгружается: при загрузке она назначается заново
+	assert.JSONEq(t, `{"type":"pull_request","pull_request":{"pull_request_id":"pr-1","pull_request_name":"Add search",
+		"author_id":"u1","status":"OPEN","assigned_reviewers":[],"created_at":"2025-10-24T12:00:00Z","merged_at":null}}`, lines[2])
+}
+
+func TestAdminHandler_Export_FailsBeforeFirstRecord(t *testing.T) {
+	transferUC := mocks.NewTransferUseCase(t)
+	transferUC.On("Export", mock.Anything, mock.Anything).Return(errors.New("db down"))
+	e := newAdminServer(t, transferUC)
+
+	req := httptest.NewRequest(http.MethodGet, "/admin/export", nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	assert.Equal(t, "INTERNAL_ERROR", errorCode(t, rec))
+}
+
+func TestAdminHandler_Import(t *testing.T) {
+	transferUC := mocks.NewTransferUseCase(t)
+	createdAt := time.Date(2025, 10, 24, 12, 0, 0, 0, time.UTC)
+	expected := []*domain.ImportRecord{
+		{Line: 1, Type: "team", Team: &domain.Team{Name: "backend"}},
+		{Line: 3, Type: "user", User: &domain.User{ID: "u1", Username: "Alice", TeamName: "backend", IsActive: true, Skills: []string{"go"}}},
+		{Line: 4, Type: "pull_request", PullRequest: &domain.PullRequest{
+			ID: "pr-1", Name: "Add search", AuthorID: "u1", Status: "OPEN", AssignedReviewers: []string{}, CreatedAt: &createdAt,
+		}},
+		{Line: 5, Invalid: domain.ErrInvalidImportRecord},
+		{Line: 6, Type: "user", Invalid: domain.ErrInvalidImportRecord},
+	}
+	transferUC.On("Import", mock.Anything, expected, domain.ImportOptions{Mode: domain.ImportModeSkip, DryRun: true}).
+		Return(&domain.ImportReport{
+			Mode: domain.ImportModeSkip, DryRun: true, Created: 3,
+			Errors: []*domain.ImportRecordError{
+				{Line: 5, Code: "INVALID_RECORD", Message: "record does not match the export format"},
+				{Line: 6, Type: "user", Code: "INVALID_RECORD", Message: "record does not match the export format"},
+			},
+		}, nil)
+	e := newAdminServer(t, transferUC)
+
+	body := `{"type":"team","team":{"team_name":"backend","parent_team_name":null}}
+
+{"type":"user","user":{"user_id":"u1","username":"Alice","team_name":"backend","is_active":true,"skills":["go"]}}
+{"type":"pull_request","pull_request":{"pull_request_id":"pr-1","pull_request_name":"Add search","author_id":"u1","status":"OPEN","created_at":"2025-10-24T12:00:00Z"}}
+not json
+{"type":"user","team":{"team_name":"backend"}}
+`
+	req := httptest.NewRequest(http.MethodPost, "/admin/import?mode=skip&dry_run=true", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, handler.NDJSONContentType)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+	var report api.ImportReport
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &report))
+	assert.Equal(t, api.ImportReportModeSkip, report.Mode)
+	assert.True(t, report.DryRun)
+	assert.False(t, report.Committed)
+	assert.Equal(t, 3, report.Created)
+	require.Len(t, report.Errors, 2)
+	assert.Equal(t, 6, report.Errors[1].Line)
+	assert.Equal(t, "user", *report.Errors[1].Type)
+	assert.Nil(t, report.Errors[0].Type)
+}
+
+func TestAdminHandler_Import_InvalidMode(t *testing.T) {
+	e := newAdminServer(t, mocks.NewTransferUseCase(t))
+
+	req := httptest.NewRequest(http.MethodPost, "/admin/import?mode=replace", strings.NewReader(""))
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Equal(t, "INVALID_REQUEST", errorCode(t, rec))
+}
@@ -0,0 +1,49 @@
+package handler_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"pr-reviewer-service/internal/handler"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuthMiddleware(t *testing.T) {
+	e := echo.New()
+	e.Use(handler.AuthMiddleware([]string{"alpha", "beta"}, "/livez"))
+	ok := func(c echo.Context) error { return c.NoContent(http.StatusOK) }
+	e.GET("/team/get", ok)
+	e.GET("/livez", ok)
+
+	tests := []struct {
+		name          string
+		path          string
+		authorization string
+		expected      int
+	}{
+		{name: "valid token", path: "/team/get", authorization: "Bearer beta", expected: http.StatusOK},
+		{name: "missing token", path: "/team/get", expected: http.StatusUnauthorized},
+		{name: "wrong token", path: "/team/get", authorization: "Bearer gamma", expected: http.StatusUnauthorized},
+		{name: "wrong scheme", path: "/team/get", authorization: "Basic alpha", expected: http.StatusUnauthorized},
+		{name: "public path", path: "/livez", expected: http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			if tt.authorization != "" {
+				req.Header.Set(echo.HeaderAuthorization, tt.authorization)
+			}
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.expected, rec.Code)
+			if tt.expected == http.StatusUnauthorized {
+				assert.Contains(t, rec.Body.String(), "UNAUTHORIZED")
+			}
+		})
+	}
+}
@@ -0,0 +1,150 @@
+package handler_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"pr-reviewer-service/api"
+	"pr-reviewer-service/client"
+	"pr-reviewer-service/internal/domain"
+	"pr-reviewer-service/internal/events"
+	"pr-reviewer-service/internal/handler"
+	"pr-reviewer-service/tests/mocks"
+
+	"github.com/labstack/echo/v4"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// newClientTestServer поднимает настоящие обработчики API поверх моков usecase
+func newClientTestServer(t *testing.T, prUC *mocks.PRUseCase, teamUC *mocks.TeamUseCase) *httptest.Server {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+
+	e := echo.New()
+	e.Use(handler.AuthMiddleware([]string{"secret"}))
+	api.RegisterHandlers(e, handler.NewAPIHandler(teamUC, mocks.NewUserUseCase(t), prUC,
+		mocks.NewStatsUseCase(t), mocks.NewOwnershipUseCase(t), mocks.NewTransferUseCase(t), events.NewBroker(), time.Second, logger))
+
+	srv := httptest.NewServer(e)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+// flakyDoer отвечает 503 на первые failures запросов, затем передает запросы дальше
+type flakyDoer struct {
+	failures int32
+	calls    atomic.Int32
+}
+
+func (d *flakyDoer) Do(req *http.Request) (*http.Response, error) {
+	if d.calls.Add(1) <= d.failures {
+		return &http.Response{
+			StatusCode: http.StatusServiceUnavailable,
+			Header:     http.Header{"Content-Type": {"text/plain"}},
+			Body:       io.NopCloser(strings.NewReader("upstream unavailable")),
+			Request:    req,
+		}, nil
+	}
+	return http.DefaultClient.Do(req)
+}
+
+var fastRetry = client.RetryPolicy{Attempts: 3, Backoff: time.Millisecond, MaxBackoff: 5 * time.Millisecond}
+
+func TestClient_MapsErrorResponsesToDomainErrors(t *testing.T) {
+	prUC := mocks.NewPRUseCase(t)
+	prUC.On("MergePR", mock.Anything, "pr-404", int64(0)).Return(nil, domain.ErrPRNotFound)
+	prUC.On("ReassignReviewer", mock.Anything, "pr-1", "u2", int64(0)).Return(nil, "", domain.ErrNoReviewerCandidate)
+	srv := newClientTestServer(t, prUC, mocks.NewTeamUseCase(t))
+
+	c, err := client.New(srv.URL, client.WithToken("secret"))
+	require.NoError(t, err)
+
+	_, err = c.MergePullRequest(context.Background(), "pr-404")
+	require.Error(t, err)
+	assert.ErrorIs(t, err, domain.ErrPRNotFound)
+	assert.NotErrorIs(t, err, domain.ErrUserNotFound, "NOT_FOUND is disambiguated by message")
+
+	var apiErr *client.Error
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, http.StatusNotFound, apiErr.StatusCode)
+	assert.Equal(t, "NOT_FOUND", apiErr.Code)
+
+	_, _, err = c.ReassignReviewer(context.Background(), "pr-1", "u2")
+	assert.ErrorIs(t, err, domain.ErrNoReviewerCandidate)
+}
+
+func TestClient_Unauthorized(t *testing.T) {
+	srv := newClientTestServer(t, mocks.NewPRUseCase(t), mocks.NewTeamUseCase(t))
+
+	c, err := client.New(srv.URL, client.WithToken("wrong"))
+	require.NoError(t, err)
+
+	_, err = c.GetTeam(context.Background(), "backend")
+	var apiErr *client.Error
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, "UNAUTHORIZED", apiErr.Code)
+	assert.Nil(t, errors.Unwrap(err), "no domain error for UNAUTHORIZED")
+}
+
+func TestClient_RetriesOnlyIdempotentCalls(t *testing.T) {
+	teamUC := mocks.NewTeamUseCase(t)
+	teamUC.On("GetTeam", mock.Anything, "backend").Return(&domain.Team{
+		Name:    "backend",
+		Members: []*domain.User{{ID: "u1", Username: "Alice", TeamName: "backend", IsActive: true}},
+	}, nil).Once()
+	srv := newClientTestServer(t, mocks.NewPRUseCase(t), teamUC)
+
+	doer := &flakyDoer{failures: 2}
+	c, err := client.New(srv.URL, client.WithToken("secret"), client.WithDoer(doer), client.WithRetryPolicy(fastRetry))
+	require.NoError(t, err)
+
+	team, err := c.GetTeam(context.Background(), "backend")
+	require.NoError(t, err)
+	assert.Equal(t, "backend", team.TeamName)
+	assert.Equal(t, int32(3), doer.calls.Load())
+
+	doer = &flakyDoer{failures: 1}
+	c, err = client.New(srv.URL, client.WithToken("secret"), client.WithDoer(doer), client.WithRetryPolicy(fastRetry))
+	require.NoError(t, err)
+
+	_, err = c.CreatePullRequest(context.Background(), client.PostPullRequestCreateJSONRequestBody{
+		PullRequestId: "pr-1", PullRequestName: "Add search", AuthorId: "u1",
+	})
+	var apiErr *client.Error
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, http.StatusServiceUnavailable, apiErr.StatusCode)
+	assert.Equal(t, int32(1), doer.calls.Load(), "create is not retried")
+}
+
+func TestClient_Timeout(t *testing.T) {
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(2 * time.Second):
+		}
+	}))
+	t.Cleanup(slow.Close)
+
+	c, err := client.New(slow.URL, client.WithTimeout(50*time.Millisecond), client.WithRetryPolicy(fastRetry))
+	require.NoError(t, err)
+
+	start := time.Now()
+	_, err = c.GetTeam(context.Background(), "backend")
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, time.Since(start), time.Second)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err = c.GetUserReviews(ctx, client.GetUsersGetReviewParams{UserId: "u1"})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
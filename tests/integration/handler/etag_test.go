@@ -0,0 +1,90 @@
+package handler_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"pr-reviewer-service/api"
+	"pr-reviewer-service/internal/domain"
+	"pr-reviewer-service/internal/events"
+	"pr-reviewer-service/internal/handler"
+	"pr-reviewer-service/tests/mocks"
+
+	"github.com/labstack/echo/v4"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newETagServer(t *testing.T, prUC *mocks.PRUseCase) *echo.Echo {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+
+	e := echo.New()
+	api.RegisterHandlers(e, handler.NewAPIHandler(mocks.NewTeamUseCase(t), mocks.NewUserUseCase(t), prUC,
+		mocks.NewStatsUseCase(t), mocks.NewOwnershipUseCase(t), mocks.NewTransferUseCase(t), events.NewBroker(), time.Second, logger))
+	return e
+}
+
+func postWithIfMatch(e *echo.Echo, path, ifMatch, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	if ifMatch != "" {
+		req.Header.Set(handler.IfMatchHeader, ifMatch)
+	}
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestPRHandler_ReturnsVersionAsETag(t *testing.T) {
+	prUC := mocks.NewPRUseCase(t)
+	prUC.On("GetPR", mock.Anything, "pr-1", false).Return(&domain.PullRequest{
+		ID: "pr-1", Name: "Add search", AuthorID: "u1", Status: "OPEN", AssignedReviewers: []string{"u2"}, Version: 3,
+	}, nil)
+	prUC.On("MergePR", mock.Anything, "pr-1", int64(3)).Return(&domain.PullRequest{
+		ID: "pr-1", Name: "Add search", AuthorID: "u1", Status: "MERGED", Version: 4,
+	}, nil)
+	e := newETagServer(t, prUC)
+
+	req := httptest.NewRequest(http.MethodGet, "/pullRequest/get?pull_request_id=pr-1", nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+	etag := rec.Header().Get(handler.ETagHeader)
+	assert.Equal(t, `"3"`, etag)
+
+	rec = postWithIfMatch(e, "/pullRequest/merge", etag, `{"pull_request_id":"pr-1"}`)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, `"4"`, rec.Header().Get(handler.ETagHeader))
+}
+
+func TestPRHandler_IfMatch(t *testing.T) {
+	prUC := mocks.NewPRUseCase(t)
+	prUC.On("ReassignReviewer", mock.Anything, "pr-1", "u2", int64(0)).Return(&domain.PullRequest{
+		ID: "pr-1", Name: "Add search", AuthorID: "u1", Status: "OPEN", AssignedReviewers: []string{"u3"}, Version: 2,
+	}, "u3", nil).Once()
+	prUC.On("ReassignReviewer", mock.Anything, "pr-1", "u2", int64(1)).Return(nil, "", domain.ErrPRVersionConflict).Once()
+	e := newETagServer(t, prUC)
+	body := `{"pull_request_id":"pr-1","old_user_id":"u2"}`
+
+	// * - любая версия, как и отсутствие заголовка
+	rec := postWithIfMatch(e, "/pullRequest/reassign", "*", body)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, `"2"`, rec.Header().Get(handler.ETagHeader))
+
+	rec = postWithIfMatch(e, "/pullRequest/reassign", `"1"`, body)
+	assert.Equal(t, http.StatusConflict, rec.Code)
+	assert.Equal(t, "CONFLICT", errorCode(t, rec))
+
+	for _, value := range []string{`1`, `W/"1"`, `"1", "2"`, `"0"`, `"abc"`} {
+		rec = postWithIfMatch(e, "/pullRequest/reassign", value, body)
+		assert.Equal(t, http.StatusBadRequest, rec.Code, value)
+		assert.Equal(t, "INVALID_REQUEST", errorCode(t, rec))
+	}
+}
@@ -0,0 +1,249 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"pr-reviewer-service/internal/domain"
+	"pr-reviewer-service/internal/graphqlapi"
+	"pr-reviewer-service/internal/handler"
+	"pr-reviewer-service/tests/mocks"
+
+	"github.com/labstack/echo/v4"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type graphQLRepos struct {
+	teams *mocks.TeamRepository
+	users *mocks.UserRepository
+	prs   *mocks.PRRepository
+}
+
+type graphQLResponse struct {
+	Data   map[string]interface{} `json:"data"`
+	Errors []struct {
+		Message    string                 `json:"message"`
+		Extensions map[string]interface{} `json:"extensions"`
+	} `json:"errors"`
+}
+
+func newGraphQLServer(t *testing.T, limits graphqlapi.Limits) (*echo.Echo, *graphQLRepos) {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+
+	repos := &graphQLRepos{
+		teams: mocks.NewTeamRepository(t),
+		users: mocks.NewUserRepository(t),
+		prs:   mocks.NewPRRepository(t),
+	}
+	service, err := graphqlapi.NewService(repos.teams, repos.users, repos.prs, limits)
+	require.NoError(t, err)
+
+	e := echo.New()
+	h := handler.NewGraphQLHandler(service, logger)
+	e.GET("/graphql", h.Serve)
+	e.POST("/graphql", h.Serve)
+	return e, repos
+}
+
+func postGraphQL(t *testing.T, e *echo.Echo, query string, variables map[string]interface{}) (int, graphQLResponse) {
+	body, err := json.Marshal(map[string]interface{}{"query": query, "variables": variables})
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(string(body)))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	var resp graphQLResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp), rec.Body.String())
+	return rec.Code, resp
+}
+
+// sameKeys сопоставляет пакет ключей без учета порядка
+func sameKeys(want ...string) interface{} {
+	return mock.MatchedBy(func(keys []string) bool {
+		got := slices.Clone(keys)
+		slices.Sort(got)
+		return slices.Equal(got, want)
+	})
+}
+
+const dashboardQuery = `
+query Dashboard($team: String!) {
+  team(name: $team) {
+    name
+    parent { name }
+    members {
+      id
+      username
+      assignedPullRequests(status: OPEN) {
+        id
+        status
+        createdAt
+        author { username }
+        reviewers { id team { name } }
+      }
+    }
+  }
+}`
+
+func TestGraphQL_NestedQueryIsBatched(t *testing.T) {
+	e, repos := newGraphQLServer(t, graphqlapi.Limits{MaxDepth: 8, MaxComplexity: 5000})
+	createdAt := time.Date(2025, 10, 24, 12, 0, 0, 0, time.UTC)
+
+	// Каждый уровень вложенности - один вызов репозитория (строгие моки с Once)
+	repos.teams.On("GetByNames", mock.Anything, []string{"backend"}).
+		Return([]*domain.Team{{Name: "backend", ParentName: "engineering"}}, nil).Once()
+	repos.users.On("GetByTeams", mock.Anything, []string{"backend"}).Return([]*domain.User{
+		{ID: "u1", Username: "Alice", TeamName: "backend", IsActive: true},
+		{ID: "u2", Username: "Bob", TeamName: "backend", IsActive: true},
+		{ID: "u3", Username: "Carol", TeamName: "backend", IsActive: false},
+	}, nil).Once()
+	repos.teams.On("GetByNames", mock.Anything, []string{"engineering"}).
+		Return([]*domain.Team{{Name: "engineering"}}, nil).Once()
+	repos.prs.On("GetAssignedToUsers", mock.Anything, []string{"u1", "u2", "u3"}).Return(map[string][]*domain.PullRequest{
+		"u1": {
+			{ID: "pr-2", Name: "Search", AuthorID: "u2", Status: domain.PRStatusOpen, AssignedReviewers: []string{"u1", "u9"}, CreatedAt: &createdAt},
+			{ID: "pr-1", Name: "Old", AuthorID: "u2", Status: domain.PRStatusMerged, AssignedReviewers: []string{"u1"}, CreatedAt: &createdAt},
+		},
+		"u2": {
+			{ID: "pr-3", Name: "Cache", AuthorID: "u9", Status: domain.PRStatusOpen, AssignedReviewers: []string{"u2"}, CreatedAt: &createdAt},
+		},
+	}, nil).Once()
+	repos.users.On("GetByIDs", mock.Anything, sameKeys("u1", "u2", "u9")).Return([]*domain.User{
+		{ID: "u1", Username: "Alice", TeamName: "backend"},
+		{ID: "u2", Username: "Bob", TeamName: "backend"},
+		{ID: "u9", Username: "Zed", TeamName: "platform"},
+	}, nil).Once()
+	// backend уже загружена в рамках запроса - повторно не запрашивается
+	repos.teams.On("GetByNames", mock.Anything, []string{"platform"}).Return([]*domain.Team{{Name: "platform"}}, nil).Once()
+
+	code, resp := postGraphQL(t, e, dashboardQuery, map[string]interface{}{"team": "backend"})
+	require.Equal(t, http.StatusOK, code)
+	require.Empty(t, resp.Errors)
+
+	data, err := json.Marshal(resp.Data)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"team": {
+		"name": "backend",
+		"parent": {"name": "engineering"},
+		"members": [
+			{"id": "u1", "username": "Alice", "assignedPullRequests": [
+				{"id": "pr-2", "status": "OPEN", "createdAt": "2025-10-24T12:00:00Z", "author": {"username": "Bob"},
+				 "reviewers": [{"id": "u1", "team": {"name": "backend"}}, {"id": "u9", "team": {"name": "platform"}}]}
+			]},
+			{"id": "u2", "username": "Bob", "assignedPullRequests": [
+				{"id": "pr-3", "status": "OPEN", "createdAt": "2025-10-24T12:00:00Z", "author": {"username": "Zed"},
+				 "reviewers": [{"id": "u2", "team": {"name": "backend"}}]}
+			]},
+			{"id": "u3", "username": "Carol", "assignedPullRequests": []}
+		]
+	}}`, string(data))
+}
+
+func TestGraphQL_MissingObjectsAndFieldErrors(t *testing.T) {
+	e, repos := newGraphQLServer(t, graphqlapi.Limits{MaxDepth: 8, MaxComplexity: 5000})
+
+	repos.prs.On("GetByIDs", mock.Anything, []string{"pr-404"}).Return([]*domain.PullRequest{}, nil).Once()
+	repos.users.On("GetByIDs", mock.Anything, []string{"u1"}).Return(nil, errors.New("connection refused")).Once()
+
+	code, resp := postGraphQL(t, e, `{ pullRequest(id: "pr-404") { id } user(id: "u1") { id } }`, nil)
+	require.Equal(t, http.StatusOK, code)
+	assert.Equal(t, map[string]interface{}{"pullRequest": nil, "user": nil}, resp.Data)
+	require.Len(t, resp.Errors, 1)
+	assert.Equal(t, "connection refused", resp.Errors[0].Message)
+}
+
+func TestGraphQL_RejectsBeforeExecution(t *testing.T) {
+	// Моки без ожиданий: отклоненный запрос не должен обращаться к репозиториям
+	e, _ := newGraphQLServer(t, graphqlapi.Limits{MaxDepth: 4, MaxComplexity: 200})
+
+	tests := []struct {
+		name    string
+		query   string
+		code    string
+		message string
+	}{
+		{
+			name:    "too deep",
+			query:   `{ team(name: "a") { members { team { members { id } } } } }`,
+			code:    "QUERY_TOO_DEEP",
+			message: "query depth exceeds the limit of 4",
+		},
+		{
+			name:    "too deep through fragments",
+			query:   `{ team(name: "a") { ...M } } fragment M on Team { members { team { members { id } } } }`,
+			code:    "QUERY_TOO_DEEP",
+			message: "query depth exceeds the limit of 4",
+		},
+		{
+			// teams (1) + members (10) + PR (100) + reviewers (1000) > 200 - раньше, чем достигнута глубина
+			name:    "too complex",
+			query:   `{ teams { members { assignedPullRequests { reviewers { id username } } } } }`,
+			code:    "QUERY_TOO_COMPLEX",
+			message: "query complexity exceeds the limit of 200",
+		},
+		{
+			name:    "schema validation",
+			query:   `{ team(name: "a") { unknownField } }`,
+			message: `Cannot query field "unknownField" on type "Team".`,
+		},
+		{
+			name:    "read only",
+			query:   `mutation { team(name: "a") { name } }`,
+			message: "mutation operations are not supported, the API is read-only",
+		},
+		{
+			name:    "syntax",
+			query:   `{ team(name: "a") { name }`,
+			message: "Syntax Error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, resp := postGraphQL(t, e, tt.query, nil)
+			assert.Equal(t, http.StatusBadRequest, code)
+			assert.Nil(t, resp.Data)
+			require.NotEmpty(t, resp.Errors)
+			assert.Contains(t, resp.Errors[0].Message, tt.message)
+			if tt.code != "" {
+				assert.Equal(t, tt.code, resp.Errors[0].Extensions["code"])
+			}
+		})
+	}
+}
+
+func TestGraphQL_GetWithVariables(t *testing.T) {
+	e, repos := newGraphQLServer(t, graphqlapi.Limits{MaxDepth: 8, MaxComplexity: 5000})
+	repos.teams.On("GetAllTeams", mock.Anything).Return([]*domain.Team{{Name: "backend"}, {Name: "frontend"}}, nil).Once()
+	repos.teams.On("GetByNames", mock.Anything, []string{"backend", "frontend"}).
+		Return([]*domain.Team{{Name: "backend"}, {Name: "frontend", ParentName: "backend"}}, nil).Once()
+	repos.users.On("GetByIDs", mock.Anything, []string{"u1"}).
+		Return([]*domain.User{{ID: "u1", Username: "Alice", TeamName: "backend", IsActive: true}}, nil).Once()
+
+	params := url.Values{}
+	params.Set("query", `query($id: ID!) { user(id: $id) { username isActive } teams { name parent { name } } }`)
+	params.Set("variables", `{"id": "u1"}`)
+	req := httptest.NewRequest(http.MethodGet, "/graphql?"+params.Encode(), nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"data": {
+		"user": {"username": "Alice", "isActive": true},
+		"teams": [{"name": "backend", "parent": null}, {"name": "frontend", "parent": {"name": "backend"}}]
+	}}`, rec.Body.String())
+}
@@ -0,0 +1,92 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"pr-reviewer-service/internal/handler"
+
+	"github.com/labstack/echo/v4"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type readyzResponse struct {
+	Status string                         `json:"status"`
+	Checks map[string]handler.CheckResult `json:"checks"`
+}
+
+func callReadyz(t *testing.T, h *handler.HealthHandler) (int, readyzResponse) {
+	t.Helper()
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(httptest.NewRequest(http.MethodGet, "/readyz", nil), rec)
+
+	require.NoError(t, h.GetReadyz(c))
+
+	var body readyzResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	return rec.Code, body
+}
+
+func okCheck(name string) handler.ReadinessCheck {
+	return handler.ReadinessCheck{Name: name, Check: func(context.Context) error { return nil }}
+}
+
+func TestHealthHandler_Livez(t *testing.T) {
+	h := handler.NewHealthHandler(time.Second, logrus.New(), handler.ReadinessCheck{
+		Name:  "database",
+		Check: func(context.Context) error { return errors.New("connection refused") },
+	})
+
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(httptest.NewRequest(http.MethodGet, "/livez", nil), rec)
+
+	require.NoError(t, h.GetLivez(c))
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestHealthHandler_ReadyzAllChecksPass(t *testing.T) {
+	h := handler.NewHealthHandler(time.Second, logrus.New(), okCheck("database"), okCheck("migrations"))
+
+	code, body := callReadyz(t, h)
+
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "ok", body.Status)
+	assert.Equal(t, "ok", body.Checks["database"].Status)
+	assert.Equal(t, "ok", body.Checks["migrations"].Status)
+}
+
+func TestHealthHandler_ReadyzCheckFailsOnTimeout(t *testing.T) {
+	slow := handler.ReadinessCheck{Name: "database", Check: func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	}}
+	h := handler.NewHealthHandler(10*time.Millisecond, logrus.New(), slow, okCheck("migrations"))
+
+	code, body := callReadyz(t, h)
+
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	assert.Equal(t, "fail", body.Status)
+	assert.Equal(t, "fail", body.Checks["database"].Status)
+	assert.Equal(t, context.DeadlineExceeded.Error(), body.Checks["database"].Error)
+	assert.Equal(t, "ok", body.Checks["migrations"].Status)
+}
+
+func TestHealthHandler_ReadyzFailsWhileDraining(t *testing.T) {
+	h := handler.NewHealthHandler(time.Second, logrus.New(), okCheck("database"))
+	h.SetDraining()
+
+	code, body := callReadyz(t, h)
+
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	assert.Equal(t, "fail", body.Checks["shutdown"].Status)
+	assert.Equal(t, "ok", body.Checks["database"].Status)
+}
@@ -0,0 +1,289 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"pr-reviewer-service/api"
+	"pr-reviewer-service/client"
+	"pr-reviewer-service/internal/domain"
+	"pr-reviewer-service/internal/events"
+	"pr-reviewer-service/internal/handler"
+	"pr-reviewer-service/internal/repository/memory"
+	"pr-reviewer-service/tests/mocks"
+
+	"github.com/labstack/echo/v4"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// newIdempotencyServer защищает /pullRequest/create обработчиком, который считает вызовы
+// и отвечает status (по умолчанию 201) с номером вызова в ETag
+func newIdempotencyServer(calls *atomic.Int32, status *atomic.Int32) *echo.Echo {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+
+	e := echo.New()
+	e.Use(handler.IdempotencyMiddleware(memory.NewIdempotencyRepository(memory.NewStore()), time.Hour, time.Minute, logger, "/pullRequest/create"))
+	create := func(c echo.Context) error {
+		n := calls.Add(1)
+		code := int(status.Load())
+		if code == 0 {
+			code = http.StatusCreated
+		}
+		c.Response().Header().Set(handler.ETagHeader, fmt.Sprintf(`"%d"`, n))
+		return c.JSON(code, map[string]int32{"call": n})
+	}
+	e.POST("/pullRequest/create", create)
+	e.POST("/pullRequest/merge", create)
+	return e
+}
+
+func postWithKey(e *echo.Echo, path, key, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	if key != "" {
+		req.Header.Set(handler.IdempotencyKeyHeader, key)
+	}
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	return rec
+}
+
+func errorCode(t *testing.T, rec *httptest.ResponseRecorder) string {
+	var resp api.ErrorResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	return string(resp.Error.Code)
+}
+
+func TestIdempotencyMiddleware_ReplaysStoredResponse(t *testing.T) {
+	var calls, status atomic.Int32
+	e := newIdempotencyServer(&calls, &status)
+
+	first := postWithKey(e, "/pullRequest/create", "key-1", `{"pull_request_id":"pr-1"}`)
+	require.Equal(t, http.StatusCreated, first.Code)
+	assert.Empty(t, first.Header().Get(handler.IdempotentReplayedHeader))
+
+	retry := postWithKey(e, "/pullRequest/create", "key-1", `{"pull_request_id":"pr-1"}`)
+	assert.Equal(t, http.StatusCreated, retry.Code)
+	assert.Equal(t, "true", retry.Header().Get(handler.IdempotentReplayedHeader))
+	assert.Equal(t, first.Body.String(), retry.Body.String())
+	assert.Equal(t, first.Header().Get(echo.HeaderContentType), retry.Header().Get(echo.HeaderContentType))
+	// Версия PR повторяется вместе с ответом, чтобы клиент мог передать ее в следующий If-Match
+	assert.Equal(t, `"1"`, retry.Header().Get(handler.ETagHeader))
+	assert.Equal(t, int32(1), calls.Load())
+
+	// Другой ключ - новый запрос
+	other := postWithKey(e, "/pullRequest/create", "key-2", `{"pull_request_id":"pr-1"}`)
+	assert.Equal(t, http.StatusCreated, other.Code)
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func TestIdempotencyMiddleware_RejectsReusedKey(t *testing.T) {
+	var calls, status atomic.Int32
+	e := newIdempotencyServer(&calls, &status)
+
+	require.Equal(t, http.StatusCreated, postWithKey(e, "/pullRequest/create", "key-1", `{"pull_request_id":"pr-1"}`).Code)
+
+	rec := postWithKey(e, "/pullRequest/create", "key-1", `{"pull_request_id":"pr-2"}`)
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+	assert.Equal(t, "IDEMPOTENCY_KEY_REUSED", errorCode(t, rec))
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestIdempotencyMiddleware_IfMatchIsPartOfRequest(t *testing.T) {
+	var calls, status atomic.Int32
+	e := newIdempotencyServer(&calls, &status)
+
+	post := func(ifMatch string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/pullRequest/create", strings.NewReader(`{"pull_request_id":"pr-1"}`))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set(handler.IdempotencyKeyHeader, "key-1")
+		req.Header.Set(handler.IfMatchHeader, ifMatch)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec
+	}
+
+	require.Equal(t, http.StatusCreated, post(`"3"`).Code)
+	assert.Equal(t, "true", post(`"3"`).Header().Get(handler.IdempotentReplayedHeader))
+
+	rec := post(`"4"`)
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+	assert.Equal(t, "IDEMPOTENCY_KEY_REUSED", errorCode(t, rec))
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestIdempotencyMiddleware_RequestInProgress(t *testing.T) {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	repo := memory.NewIdempotencyRepository(memory.NewStore())
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	e := echo.New()
+	e.Use(handler.IdempotencyMiddleware(repo, time.Hour, time.Minute, logger, "/pullRequest/create"))
+	e.POST("/pullRequest/create", func(c echo.Context) error {
+		close(started)
+		<-release
+		return c.NoContent(http.StatusCreated)
+	})
+
+	done := make(chan *httptest.ResponseRecorder)
+	go func() { done <- postWithKey(e, "/pullRequest/create", "key-1", `{}`) }()
+	<-started
+
+	rec := postWithKey(e, "/pullRequest/create", "key-1", `{}`)
+	assert.Equal(t, http.StatusConflict, rec.Code)
+	assert.Equal(t, "IDEMPOTENCY_KEY_IN_PROGRESS", errorCode(t, rec))
+
+	close(release)
+	assert.Equal(t, http.StatusCreated, (<-done).Code)
+}
+
+func TestIdempotencyMiddleware_TakesOverExpiredLease(t *testing.T) {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	repo := memory.NewIdempotencyRepository(memory.NewStore())
+
+	var calls atomic.Int32
+	started := make(chan struct{})
+	release := make(chan struct{})
+	e := echo.New()
+	e.Use(handler.IdempotencyMiddleware(repo, time.Hour, 20*time.Millisecond, logger, "/pullRequest/create"))
+	e.POST("/pullRequest/create", func(c echo.Context) error {
+		n := calls.Add(1)
+		if n == 1 {
+			// Первый запрос зависает дольше аренды ключа
+			close(started)
+			<-release
+		}
+		return c.JSON(http.StatusCreated, map[string]int32{"call": n})
+	})
+
+	done := make(chan *httptest.ResponseRecorder)
+	go func() { done <- postWithKey(e, "/pullRequest/create", "key-1", `{}`) }()
+	<-started
+	time.Sleep(50 * time.Millisecond)
+
+	rec := postWithKey(e, "/pullRequest/create", "key-1", `{}`)
+	assert.Equal(t, http.StatusCreated, rec.Code)
+	assert.JSONEq(t, `{"call":2}`, rec.Body.String())
+
+	// Опоздавший ответ первого запроса не заменяет сохраненный
+	close(release)
+	assert.Equal(t, http.StatusCreated, (<-done).Code)
+	rec = postWithKey(e, "/pullRequest/create", "key-1", `{}`)
+	assert.Equal(t, "true", rec.Header().Get(handler.IdempotentReplayedHeader))
+	assert.JSONEq(t, `{"call":2}`, rec.Body.String())
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func TestIdempotencyMiddleware_ServerErrorsAreNotStored(t *testing.T) {
+	var calls, status atomic.Int32
+	e := newIdempotencyServer(&calls, &status)
+
+	status.Store(http.StatusServiceUnavailable)
+	assert.Equal(t, http.StatusServiceUnavailable, postWithKey(e, "/pullRequest/create", "key-1", `{}`).Code)
+
+	status.Store(0)
+	rec := postWithKey(e, "/pullRequest/create", "key-1", `{}`)
+	assert.Equal(t, http.StatusCreated, rec.Code)
+	assert.Empty(t, rec.Header().Get(handler.IdempotentReplayedHeader))
+	assert.Equal(t, int32(2), calls.Load())
+
+	// Ошибки клиента окончательны и повторяются из сохраненного ответа
+	status.Store(http.StatusConflict)
+	assert.Equal(t, http.StatusConflict, postWithKey(e, "/pullRequest/create", "key-2", `{}`).Code)
+	status.Store(0)
+	rec = postWithKey(e, "/pullRequest/create", "key-2", `{}`)
+	assert.Equal(t, http.StatusConflict, rec.Code)
+	assert.Equal(t, "true", rec.Header().Get(handler.IdempotentReplayedHeader))
+	assert.Equal(t, int32(3), calls.Load())
+}
+
+func TestIdempotencyMiddleware_InvalidKey(t *testing.T) {
+	var calls, status atomic.Int32
+	e := newIdempotencyServer(&calls, &status)
+
+	for _, key := range []string{"has space", strings.Repeat("k", 256)} {
+		rec := postWithKey(e, "/pullRequest/create", key, `{}`)
+		assert.Equal(t, http.StatusBadRequest, rec.Code, key)
+		assert.Equal(t, "INVALID_REQUEST", errorCode(t, rec))
+	}
+	assert.Zero(t, calls.Load())
+}
+
+func TestIdempotencyMiddleware_PassesThroughUnguardedRequests(t *testing.T) {
+	var calls, status atomic.Int32
+	e := newIdempotencyServer(&calls, &status)
+
+	// Без ключа и на незащищенном пути каждый запрос выполняется
+	postWithKey(e, "/pullRequest/create", "", `{}`)
+	postWithKey(e, "/pullRequest/create", "", `{}`)
+	postWithKey(e, "/pullRequest/merge", "key-1", `{}`)
+	rec := postWithKey(e, "/pullRequest/merge", "key-1", `{}`)
+
+	assert.Empty(t, rec.Header().Get(handler.IdempotentReplayedHeader))
+	assert.Equal(t, int32(4), calls.Load())
+}
+
+// lostResponseDoer передает первый запрос серверу, но теряет ответ (503), как при таймауте прокси
+type lostResponseDoer struct {
+	calls atomic.Int32
+}
+
+func (d *lostResponseDoer) Do(req *http.Request) (*http.Response, error) {
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil || d.calls.Add(1) > 1 {
+		return resp, err
+	}
+	resp.Body.Close()
+	return &http.Response{
+		StatusCode: http.StatusServiceUnavailable,
+		Header:     http.Header{"Content-Type": {"text/plain"}},
+		Body:       io.NopCloser(strings.NewReader("upstream timeout")),
+		Request:    req,
+	}, nil
+}
+
+func TestClient_RetriesCreateWithIdempotencyKey(t *testing.T) {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+
+	prUC := mocks.NewPRUseCase(t)
+	prUC.On("CreatePR", mock.Anything, mock.Anything).Return(&domain.PullRequest{
+		ID: "pr-1", Name: "Add search", AuthorID: "u1", Status: "OPEN", AssignedReviewers: []string{"u2"},
+		Rationale: &domain.AssignmentRationale{Strategy: "random", CandidatePoolSize: 1},
+	}, nil).Once()
+
+	e := echo.New()
+	e.Use(handler.IdempotencyMiddleware(memory.NewIdempotencyRepository(memory.NewStore()), time.Hour, time.Minute, logger,
+		"/pullRequest/create", "/pullRequest/reassign"))
+	api.RegisterHandlers(e, handler.NewAPIHandler(mocks.NewTeamUseCase(t), mocks.NewUserUseCase(t), prUC,
+		mocks.NewStatsUseCase(t), mocks.NewOwnershipUseCase(t), mocks.NewTransferUseCase(t), events.NewBroker(), time.Second, logger))
+	srv := httptest.NewServer(e)
+	t.Cleanup(srv.Close)
+
+	doer := &lostResponseDoer{}
+	c, err := client.New(srv.URL, client.WithDoer(doer), client.WithRetryPolicy(fastRetry), client.WithIdempotencyKeys())
+	require.NoError(t, err)
+
+	created, err := c.CreatePullRequest(context.Background(), client.PostPullRequestCreateJSONRequestBody{
+		PullRequestId: "pr-1", PullRequestName: "Add search", AuthorId: "u1",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "pr-1", created.PullRequest.PullRequestId)
+	assert.Equal(t, []string{"u2"}, created.PullRequest.AssignedReviewers)
+	assert.Equal(t, int32(2), doer.calls.Load())
+}
@@ -34,10 +34,10 @@ type PRHandlerTestSuite struct {
 }
 
 func (suite *PRHandlerTestSuite) SetupSuite() {
-	cfg, _ := config.LoadConfig()
+	cfg, _, _ := config.Load(nil)
 	dsn := fmt.Sprintf(
 		"postgres://%s:%s@%s:%s/%s?sslmode=disable",
-		cfg.DBUser, cfg.DBPassword, "localhost", "5433", "pr_reviewer_test",
+		cfg.Database.User, cfg.Database.Password, "localhost", "5433", "pr_reviewer_test",
 	)
 
 	var err error
@@ -110,7 +110,7 @@ func (suite *PRHandlerTestSuite) TestPostPullRequestCreate_Success() {
 
 	c := suite.echo.NewContext(req, rec)
 
-	err := suite.handler.PostPullRequestCreate(c)
+	err := suite.handler.PostPullRequestCreate(c, api.PostPullRequestCreateParams{})
 
 	assert.NoError(suite.T(), err)
 	assert.Equal(suite.T(), http.StatusCreated, rec.Code)
@@ -133,7 +133,7 @@ func (suite *PRHandlerTestSuite) TestPostPullRequestMerge_Success() {
 
 	c := suite.echo.NewContext(req, rec)
 
-	err := suite.handler.PostPullRequestMerge(c)
+	err := suite.handler.PostPullRequestMerge(c, api.PostPullRequestMergeParams{})
 
 	assert.NoError(suite.T(), err)
 	assert.Equal(suite.T(), http.StatusOK, rec.Code)
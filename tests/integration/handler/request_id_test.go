@@ -0,0 +1,79 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"pr-reviewer-service/internal/handler"
+	"pr-reviewer-service/internal/logging"
+
+	"github.com/labstack/echo/v4"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newRequestIDServer(buf *bytes.Buffer) *echo.Echo {
+	logger := logrus.New()
+	logger.SetOutput(buf)
+	logger.SetFormatter(&logrus.JSONFormatter{})
+
+	e := echo.New()
+	e.Use(handler.RequestIDMiddleware())
+	e.Use(handler.LoggingMiddleware(logger))
+	e.GET("/livez", func(c echo.Context) error {
+		logging.FromContext(c.Request().Context(), logger).Error("repository failed")
+		return c.NoContent(http.StatusOK)
+	})
+	return e
+}
+
+// logEntries разбирает JSON-записи логгера
+func logEntries(t *testing.T, buf *bytes.Buffer) []map[string]interface{} {
+	var entries []map[string]interface{}
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		var entry map[string]interface{}
+		require.NoError(t, json.Unmarshal([]byte(line), &entry))
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+func TestRequestIDMiddleware_AcceptsIncomingID(t *testing.T) {
+	var buf bytes.Buffer
+	e := newRequestIDServer(&buf)
+
+	req := httptest.NewRequest(http.MethodGet, "/livez", nil)
+	req.Header.Set("X-Request-ID", "req-123")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, "req-123", rec.Header().Get("X-Request-ID"))
+
+	entries := logEntries(t, &buf)
+	require.Len(t, entries, 2)
+	for _, entry := range entries {
+		assert.Equal(t, "req-123", entry["request_id"])
+	}
+}
+
+func TestRequestIDMiddleware_GeneratesID(t *testing.T) {
+	var buf bytes.Buffer
+	e := newRequestIDServer(&buf)
+
+	req := httptest.NewRequest(http.MethodGet, "/livez", nil)
+	req.Header.Set("X-Request-ID", "bad\nid")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	requestID := rec.Header().Get("X-Request-ID")
+	assert.Len(t, requestID, 32)
+
+	for _, entry := range logEntries(t, &buf) {
+		assert.Equal(t, requestID, entry["request_id"])
+	}
+}
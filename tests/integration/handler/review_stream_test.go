@@ -0,0 +1,192 @@
+package handler_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"pr-reviewer-service/api"
+	"pr-reviewer-service/internal/domain"
+	"pr-reviewer-service/internal/events"
+	"pr-reviewer-service/internal/handler"
+	"pr-reviewer-service/tests/mocks"
+
+	"github.com/labstack/echo/v4"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// sseEvent - разобранное событие потока; комментарии (heartbeat) попадают в comment
+type sseEvent struct {
+	id      string
+	event   string
+	data    string
+	comment string
+}
+
+// sseReader читает события из тела ответа text/event-stream
+type sseReader struct {
+	scanner *bufio.Scanner
+}
+
+func (r *sseReader) next(t *testing.T) sseEvent {
+	t.Helper()
+	var ev sseEvent
+	for r.scanner.Scan() {
+		line := r.scanner.Text()
+		switch {
+		case line == "":
+			return ev
+		case strings.HasPrefix(line, ":"):
+			ev.comment = strings.TrimSpace(line[1:])
+		default:
+			field, value, _ := strings.Cut(line, ": ")
+			switch field {
+			case "id":
+				ev.id = value
+			case "event":
+				ev.event = value
+			case "data":
+				ev.data = value
+			}
+		}
+	}
+	require.NoError(t, r.scanner.Err())
+	t.Fatal("stream ended")
+	return ev
+}
+
+func newReviewStreamServer(t *testing.T, userUC *mocks.UserUseCase, broker *events.Broker, heartbeat time.Duration) *httptest.Server {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+
+	e := echo.New()
+	api.RegisterHandlers(e, handler.NewAPIHandler(mocks.NewTeamUseCase(t), userUC, mocks.NewPRUseCase(t),
+		mocks.NewStatsUseCase(t), mocks.NewOwnershipUseCase(t), mocks.NewTransferUseCase(t), broker, heartbeat, logger))
+
+	srv := httptest.NewServer(e)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func openReviewStream(t *testing.T, ctx context.Context, url, lastEventID string) (*http.Response, *sseReader) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url+"/users/reviewStream?user_id=u2", nil)
+	require.NoError(t, err)
+	if lastEventID != "" {
+		req.Header.Set("Last-Event-ID", lastEventID)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = resp.Body.Close() })
+	return resp, &sseReader{scanner: bufio.NewScanner(resp.Body)}
+}
+
+func TestReviewStream_SnapshotThenEvents(t *testing.T) {
+	broker := events.NewBroker()
+	broker.PublishAssignment(domain.AssignmentEvent{Type: domain.AssignmentAssigned, PullRequestID: "pr-0", ReviewerID: "u2"})
+
+	userUC := mocks.NewUserUseCase(t)
+	userUC.On("GetUserReviewPRs", mock.Anything, "u2", "", 0).Return(&domain.PRPage{
+		PullRequests: []*domain.PullRequest{{ID: "pr-0", Name: "Old", AuthorID: "u1", Status: domain.PRStatusOpen}},
+		NextCursor:   "next",
+	}, nil).Once()
+	srv := newReviewStreamServer(t, userUC, broker, 50*time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	resp, stream := openReviewStream(t, ctx, srv.URL, "")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	snapshot := stream.next(t)
+	assert.Equal(t, "snapshot", snapshot.event)
+	assert.Equal(t, "1", snapshot.id, "snapshot carries the last event id before subscription")
+	assert.JSONEq(t, `{"user_id":"u2","pull_requests":[{"pull_request_id":"pr-0","pull_request_name":"Old","author_id":"u1","status":"OPEN"}],"next_cursor":"next"}`, snapshot.data)
+
+	occurredAt := time.Date(2025, 10, 24, 12, 0, 0, 0, time.UTC)
+	broker.PublishAssignment(domain.AssignmentEvent{Type: domain.AssignmentAssigned, PullRequestID: "pr-1", ReviewerID: "u3"})
+	broker.PublishAssignment(domain.AssignmentEvent{
+		Type: domain.AssignmentAssigned, PullRequestID: "pr-1", PullRequestName: "Add search",
+		AuthorID: "u1", ReviewerID: "u2", OccurredAt: occurredAt,
+	})
+
+	assigned := stream.next(t)
+	assert.Equal(t, "3", assigned.id)
+	assert.Equal(t, "assigned", assigned.event)
+	var payload map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(assigned.data), &payload))
+	assert.Equal(t, map[string]interface{}{
+		"type": "assigned", "pull_request_id": "pr-1", "pull_request_name": "Add search",
+		"author_id": "u1", "occurred_at": "2025-10-24T12:00:00Z",
+	}, payload)
+
+	assert.Equal(t, "heartbeat", stream.next(t).comment)
+
+	// Отключение клиента снимает подписку
+	cancel()
+	assert.Eventually(t, func() bool { return broker.Subscribers() == 0 }, time.Second, 10*time.Millisecond)
+}
+
+func TestReviewStream_ResumesFromLastEventID(t *testing.T) {
+	broker := events.NewBroker()
+	for _, reviewer := range []string{"u2", "u3", "u2"} {
+		broker.PublishAssignment(domain.AssignmentEvent{Type: domain.AssignmentAssigned, PullRequestID: "pr-1", ReviewerID: reviewer})
+	}
+
+	// Снимок при переподключении не нужен: мок без ожиданий упадет, если его запросить
+	srv := newReviewStreamServer(t, mocks.NewUserUseCase(t), broker, time.Minute)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	_, stream := openReviewStream(t, ctx, srv.URL, "1")
+
+	missed := stream.next(t)
+	assert.Equal(t, "3", missed.id)
+	assert.Equal(t, "assigned", missed.event)
+
+	broker.PublishAssignment(domain.AssignmentEvent{Type: domain.AssignmentMerged, PullRequestID: "pr-1", ReviewerID: "u2"})
+	live := stream.next(t)
+	assert.Equal(t, "4", live.id)
+	assert.Equal(t, "merged", live.event)
+}
+
+func TestReviewStream_UnknownLastEventIDStartsWithSnapshot(t *testing.T) {
+	broker := events.NewBroker()
+	userUC := mocks.NewUserUseCase(t)
+	userUC.On("GetUserReviewPRs", mock.Anything, "u2", "", 0).Return(&domain.PRPage{PullRequests: []*domain.PullRequest{}}, nil).Once()
+	srv := newReviewStreamServer(t, userUC, broker, time.Minute)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	// id из прошлого запуска сервиса
+	_, stream := openReviewStream(t, ctx, srv.URL, "500")
+
+	snapshot := stream.next(t)
+	assert.Equal(t, "snapshot", snapshot.event)
+	assert.Equal(t, "0", snapshot.id)
+	assert.JSONEq(t, `{"user_id":"u2","pull_requests":[],"next_cursor":null}`, snapshot.data)
+}
+
+func TestReviewStream_UserNotFound(t *testing.T) {
+	broker := events.NewBroker()
+	userUC := mocks.NewUserUseCase(t)
+	userUC.On("GetUserReviewPRs", mock.Anything, "u2", "", 0).Return(nil, domain.ErrUserNotFound).Once()
+	srv := newReviewStreamServer(t, userUC, broker, time.Minute)
+
+	resp, _ := openReviewStream(t, context.Background(), srv.URL, "")
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	var body api.ErrorResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Equal(t, api.ErrorResponseErrorCode("NOT_FOUND"), body.Error.Code)
+	assert.Equal(t, 0, broker.Subscribers())
+}
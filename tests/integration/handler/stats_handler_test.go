@@ -0,0 +1,217 @@
+package handler_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"pr-reviewer-service/api"
+	"pr-reviewer-service/internal/domain"
+	"pr-reviewer-service/internal/events"
+	"pr-reviewer-service/internal/handler"
+	"pr-reviewer-service/tests/mocks"
+
+	"github.com/labstack/echo/v4"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newStatsServer(t *testing.T, statsUC *mocks.StatsUseCase) *echo.Echo {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+
+	e := echo.New()
+	api.RegisterHandlers(e, handler.NewAPIHandler(mocks.NewTeamUseCase(t), mocks.NewUserUseCase(t), mocks.NewPRUseCase(t),
+		statsUC, mocks.NewOwnershipUseCase(t), mocks.NewTransferUseCase(t), events.NewBroker(), time.Second, logger))
+	return e
+}
+
+func getStats(e *echo.Echo, path, accept string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	if accept != "" {
+		req.Header.Set(echo.HeaderAccept, accept)
+	}
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestStatsHandler_Reviews_Formats(t *testing.T) {
+	statsUC := mocks.NewStatsUseCase(t)
+	statsUC.On("GetStatsReviews", mock.Anything, &domain.StatsFilter{}).Return([]*domain.ReviewStat{
+		{UserID: "u2", Username: "Bob, Jr.", ReviewCount: 3},
+		{UserID: "u1", Username: "Alice", ReviewCount: 1},
+	}, nil)
+	e := newStatsServer(t, statsUC)
+
+	rec := getStats(e, "/stats/reviews", "")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, echo.MIMEApplicationJSON, rec.Header().Get(echo.HeaderContentType))
+	assert.JSONEq(t, `{"stats":[
+		{"user_id":"u2","username":"Bob, Jr.","review_count":3},
+		{"user_id":"u1","username":"Alice","review_count":1}]}`, rec.Body.String())
+
+	csvBody := "user_id,username,review_count\nu2,\"Bob, Jr.\",3\nu1,Alice,1\n"
+	rec = getStats(e, "/stats/reviews", "text/csv")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, handler.CSVContentType, rec.Header().Get(echo.HeaderContentType))
+	assert.Equal(t, `attachment; filename="review-stats.csv"`, rec.Header().Get(echo.HeaderContentDisposition))
+	assert.Equal(t, csvBody, rec.Body.String())
+
+	// Параметр format важнее Accept
+	rec = getStats(e, "/stats/reviews?format=csv", echo.MIMEApplicationJSON)
+	assert.Equal(t, csvBody, rec.Body.String())
+
+	rec = getStats(e, "/stats/reviews?format=ndjson", "")
+	assert.Equal(t, handler.NDJSONContentType, rec.Header().Get(echo.HeaderContentType))
+	assert.Equal(t, `{"review_count":3,"user_id":"u2","username":"Bob, Jr."}
+{"review_count":1,"user_id":"u1","username":"Alice"}
+`, rec.Body.String())
+
+	// Первый поддерживаемый тип из Accept
+	rec = getStats(e, "/stats/reviews", "text/html, application/x-ndjson;q=0.9, text/csv")
+	assert.Equal(t, handler.NDJSONContentType, rec.Header().Get(echo.HeaderContentType))
+}
+
+func TestStatsHandler_PRAssignments_CSV(t *testing.T) {
+	statsUC := mocks.NewStatsUseCase(t)
+	statsUC.On("GetStatsPrAssignments", mock.Anything, &domain.StatsFilter{}).Return([]*domain.PRAssignmentStat{
+		{PRID: "pr-1", PRName: "Add search", ReviewersCount: 2},
+	}, nil)
+	e := newStatsServer(t, statsUC)
+
+	rec := getStats(e, "/stats/pr-assignments?format=csv", "")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "pull_request_id,pull_request_name,reviewers_count\npr-1,Add search,2\n", rec.Body.String())
+
+	rec = getStats(e, "/stats/pr-assignments?format=json", "text/csv")
+	assert.JSONEq(t, `{"stats":[{"pull_request_id":"pr-1","pull_request_name":"Add search","reviewers_count":2}]}`,
+		rec.Body.String())
+}
+
+func TestStatsHandler_CSV_EscapesFormulas(t *testing.T) {
+	statsUC := mocks.NewStatsUseCase(t)
+	statsUC.On("GetStatsReviews", mock.Anything, &domain.StatsFilter{}).Return([]*domain.ReviewStat{
+		{UserID: "u1", Username: `=HYPERLINK("http://evil.example","click")`, ReviewCount: 2},
+		{UserID: "@u2", Username: "+Bob", ReviewCount: 1},
+		{UserID: "u3", Username: "-Carol", ReviewCount: 0},
+	}, nil)
+	statsUC.On("GetStatsPrAssignments", mock.Anything, &domain.StatsFilter{}).Return([]*domain.PRAssignmentStat{
+		{PRID: "pr-1", PRName: "\tcmd|' /C calc'!A0", ReviewersCount: 1},
+	}, nil)
+	e := newStatsServer(t, statsUC)
+
+	rec := getStats(e, "/stats/reviews?format=csv", "")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "user_id,username,review_count\n"+
+		"u1,\"'=HYPERLINK(\"\"http://evil.example\"\",\"\"click\"\")\",2\n"+
+		"'@u2,'+Bob,1\n"+
+		"u3,'-Carol,0\n", rec.Body.String())
+
+	rec = getStats(e, "/stats/pr-assignments?format=csv", "")
+	assert.Equal(t, "pull_request_id,pull_request_name,reviewers_count\npr-1,'\tcmd|' /C calc'!A0,1\n", rec.Body.String())
+
+	// JSON и NDJSON отдают значения как есть
+	rec = getStats(e, "/stats/reviews?format=ndjson", "")
+	assert.Contains(t, rec.Body.String(), `"username":"=HYPERLINK(\"http://evil.example\",\"click\")"`)
+}
+
+func TestStatsHandler_InvalidFormat(t *testing.T) {
+	e := newStatsServer(t, mocks.NewStatsUseCase(t))
+
+	for _, path := range []string{"/stats/reviews?format=xlsx", "/stats/pr-assignments?format=CSV"} {
+		rec := getStats(e, path, "")
+		assert.Equal(t, http.StatusBadRequest, rec.Code, path)
+		assert.Equal(t, "INVALID_REQUEST", errorCode(t, rec))
+	}
+}
+
+func TestStatsHandler_Teams_Filter(t *testing.T) {
+	statsUC := mocks.NewStatsUseCase(t)
+	from := time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 11, 1, 0, 0, 0, 0, time.UTC)
+	statsUC.On("GetStatsTeams", mock.Anything, &domain.StatsFilter{
+		CreatedFrom: &from, CreatedTo: &to, TeamName: "backend", Status: domain.PRStatusMerged,
+	}).Return([]*domain.TeamReviewStat{
+		{TeamName: "backend", PullRequests: 4, OpenPullRequests: 1, ReviewsGiven: 5, ReviewsReceived: 6, CrossTeamReviews: 2},
+	}, nil)
+	e := newStatsServer(t, statsUC)
+
+	query := "/stats/teams?created_from=2025-10-01T00:00:00Z&created_to=2025-11-01T00:00:00Z&team_name=backend&status=MERGED"
+	rec := getStats(e, query, "")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"stats":[{"team_name":"backend","pull_requests":4,"open_pull_requests":1,"avg_reviewers_per_pr":1.5,
+		"reviews_given":5,"reviews_received":6,"cross_team_reviews_share":0.3333333333333333}]}`, rec.Body.String())
+
+	rec = getStats(e, query+"&format=csv", "")
+	assert.Equal(t, `attachment; filename="team-stats.csv"`, rec.Header().Get(echo.HeaderContentDisposition))
+	assert.Equal(t, "team_name,pull_requests,open_pull_requests,avg_reviewers_per_pr,reviews_given,reviews_received,cross_team_reviews_share\n"+
+		"backend,4,1,1.5,5,6,0.3333333333333333\n", rec.Body.String())
+}
+
+func TestStatsHandler_Fairness_Formats(t *testing.T) {
+	statsUC := mocks.NewStatsUseCase(t)
+	from := time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 10, 31, 0, 0, 0, 0, time.UTC)
+	statsUC.On("GetFairnessReport", mock.Anything, &domain.StatsFilter{CreatedFrom: &from, TeamName: "backend"}).
+		Return(&domain.FairnessReport{From: from, To: to, Teams: []*domain.TeamFairness{{
+			TeamName: "backend", Assignments: 20, Gini: 0.4, MaxMinSpread: 16,
+			Members: []*domain.MemberFairness{
+				{UserID: "u1", Username: "Alice", ActiveDays: 30, Assignments: 18, ExpectedAssignments: 10, Outlier: domain.FairnessOverloaded},
+				{UserID: "u2", Username: "Bob", ActiveDays: 30, Assignments: 2, ExpectedAssignments: 10, Outlier: domain.FairnessUnderloaded},
+				{UserID: "u3", Username: "Charlie", ActiveDays: 0},
+			},
+		}}}, nil)
+	e := newStatsServer(t, statsUC)
+
+	query := "/stats/fairness?created_from=2025-10-01T00:00:00Z&team_name=backend"
+	rec := getStats(e, query, "")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"created_from":"2025-10-01T00:00:00Z","created_to":"2025-10-31T00:00:00Z","teams":[
+		{"team_name":"backend","assignments":20,"gini":0.4,"max_min_spread":16,"members":[
+			{"user_id":"u1","username":"Alice","active_days":30,"assignments":18,"expected_assignments":10,"deviation":8,"outlier":"overloaded"},
+			{"user_id":"u2","username":"Bob","active_days":30,"assignments":2,"expected_assignments":10,"deviation":-8,"outlier":"underloaded"},
+			{"user_id":"u3","username":"Charlie","active_days":0,"assignments":0,"expected_assignments":0,"deviation":0}]}]}`,
+		rec.Body.String())
+
+	rec = getStats(e, query, "text/csv")
+	assert.Equal(t, `attachment; filename="fairness-report.csv"`, rec.Header().Get(echo.HeaderContentDisposition))
+	assert.Equal(t, "team_name,team_gini,team_max_min_spread,user_id,username,active_days,assignments,expected_assignments,deviation,outlier\n"+
+		"backend,0.4,16,u1,Alice,30,18,10,8,overloaded\n"+
+		"backend,0.4,16,u2,Bob,30,2,10,-8,underloaded\n"+
+		"backend,0.4,16,u3,Charlie,0,0,0,0,\n", rec.Body.String())
+
+	rec = getStats(e, query+"&format=ndjson", "")
+	assert.Equal(t, handler.NDJSONContentType, rec.Header().Get(echo.HeaderContentType))
+	lines := strings.Split(strings.TrimSuffix(rec.Body.String(), "\n"), "\n")
+	assert.Len(t, lines, 3)
+	assert.JSONEq(t, `{"team_name":"backend","team_gini":0.4,"team_max_min_spread":16,"user_id":"u3","username":"Charlie",
+		"active_days":0,"assignments":0,"expected_assignments":0,"deviation":0}`, lines[2])
+}
+
+func TestStatsHandler_InvalidFilter(t *testing.T) {
+	statsUC := mocks.NewStatsUseCase(t)
+	from := time.Date(2025, 11, 1, 0, 0, 0, 0, time.UTC)
+	statsUC.On("GetStatsReviews", mock.Anything, &domain.StatsFilter{CreatedFrom: &from, CreatedTo: &from}).
+		Return(nil, domain.ErrInvalidTimeRange)
+	statsUC.On("GetStatsTeams", mock.Anything, &domain.StatsFilter{Status: "CLOSED"}).Return(nil, domain.ErrInvalidPRStatus)
+	statsUC.On("GetFairnessReport", mock.Anything, &domain.StatsFilter{CreatedFrom: &from, CreatedTo: &from}).
+		Return(nil, domain.ErrInvalidTimeRange)
+	e := newStatsServer(t, statsUC)
+
+	rec := getStats(e, "/stats/reviews?created_from=2025-11-01T00:00:00Z&created_to=2025-11-01T00:00:00Z", "")
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Equal(t, "INVALID_REQUEST", errorCode(t, rec))
+
+	rec = getStats(e, "/stats/teams?status=CLOSED", "")
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Equal(t, "INVALID_REQUEST", errorCode(t, rec))
+
+	rec = getStats(e, "/stats/fairness?created_from=2025-11-01T00:00:00Z&created_to=2025-11-01T00:00:00Z", "")
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Equal(t, "INVALID_REQUEST", errorCode(t, rec))
+}
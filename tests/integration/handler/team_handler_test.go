@@ -35,10 +35,10 @@ type TeamHandlerTestSuite struct {
 
 func (suite *TeamHandlerTestSuite) SetupSuite() {
 	// Настройка БД
-	cfg, _ := config.LoadConfig()
+	cfg, _, _ := config.Load(nil)
 	dsn := fmt.Sprintf(
 		"postgres://%s:%s@%s:%s/%s?sslmode=disable",
-		cfg.DBUser, cfg.DBPassword, "localhost", "5433", "pr_reviewer_test",
+		cfg.Database.User, cfg.Database.Password, "localhost", "5433", "pr_reviewer_test",
 	)
 
 	var err error
@@ -0,0 +1,57 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"pr-reviewer-service/internal/handler"
+	"pr-reviewer-service/internal/tracing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTracingMiddleware_ContinuesIncomingTrace(t *testing.T) {
+	var buf bytes.Buffer
+	e := echo.New()
+	e.Use(handler.TracingMiddleware(tracing.NewTracer(tracing.NewJSONExporter(&buf))))
+
+	var traceID string
+	e.GET("/pullRequest/get", func(c echo.Context) error {
+		traceID = tracing.TraceIDFromContext(c.Request().Context())
+		return echo.NewHTTPError(http.StatusBadRequest, "missing pull_request_id")
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/pullRequest/get?pull_request_id=pr-1", nil)
+	req.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	e.ServeHTTP(httptest.NewRecorder(), req)
+
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", traceID)
+
+	var span tracing.SpanData
+	require.NoError(t, json.Unmarshal([]byte(strings.TrimSpace(buf.String())), &span))
+	assert.Equal(t, "GET /pullRequest/get", span.Name)
+	assert.Equal(t, "00f067aa0ba902b7", span.ParentSpanID)
+	assert.Equal(t, float64(http.StatusBadRequest), span.Attributes["http.status_code"])
+	assert.Equal(t, tracing.StatusError, span.Status)
+}
+
+func TestTracingMiddleware_StartsNewTraceWithoutHeader(t *testing.T) {
+	var buf bytes.Buffer
+	e := echo.New()
+	e.Use(handler.TracingMiddleware(tracing.NewTracer(tracing.NewJSONExporter(&buf))))
+	e.GET("/livez", func(c echo.Context) error { return c.NoContent(http.StatusOK) })
+
+	e.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/livez", nil))
+
+	var span tracing.SpanData
+	require.NoError(t, json.Unmarshal([]byte(strings.TrimSpace(buf.String())), &span))
+	assert.Len(t, span.TraceID, 32)
+	assert.Empty(t, span.ParentSpanID)
+	assert.Equal(t, tracing.StatusOK, span.Status)
+}
@@ -6,22 +6,28 @@ import (
 	"database/sql"
 	"encoding/json"
 	"fmt"
+	"io"
 	"log"
 	"net/http"
 	"net/http/httptest"
 	"os"
 	"testing"
+	"time"
 
 	"pr-reviewer-service/api"
 	"pr-reviewer-service/internal/config"
 	"pr-reviewer-service/internal/database"
+	"pr-reviewer-service/internal/domain"
 	"pr-reviewer-service/internal/handler"
 	"pr-reviewer-service/internal/repository"
 	"pr-reviewer-service/internal/usecase"
+	"pr-reviewer-service/tests/mocks"
 
 	"github.com/labstack/echo/v4"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -34,10 +40,10 @@ type UserHandlerTestSuite struct {
 }
 
 func (suite *UserHandlerTestSuite) SetupSuite() {
-	cfg, _ := config.LoadConfig()
+	cfg, _, _ := config.Load(nil)
 	dsn := fmt.Sprintf(
 		"postgres://%s:%s@%s:%s/%s?sslmode=disable",
-		cfg.DBUser, cfg.DBPassword, "localhost", "5433", "pr_reviewer_test",
+		cfg.Database.User, cfg.Database.Password, "localhost", "5433", "pr_reviewer_test",
 	)
 
 	var err error
@@ -124,6 +130,45 @@ func (suite *UserHandlerTestSuite) TestGetUsersGetReview_Success() {
 	assert.Equal(suite.T(), http.StatusOK, rec.Code)
 }
 
+func TestPostUsersSetOutOfOffice(t *testing.T) {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	userUC := mocks.NewUserUseCase(t)
+	h := handler.NewUserHandler(userUC, logger)
+
+	until := time.Date(2026, time.November, 2, 9, 0, 0, 0, time.UTC)
+	userUC.On("SetUserOutOfOffice", mock.Anything, "u2", mock.MatchedBy(func(value *time.Time) bool {
+		return value != nil && value.Equal(until)
+	})).Return(&domain.User{ID: "u2", Username: "Bob", TeamName: "backend", IsActive: true, OutOfOfficeUntil: &until}, nil)
+	userUC.On("SetUserOutOfOffice", mock.Anything, "u3", (*time.Time)(nil)).Return(&domain.User{ID: "u3", Username: "Carol", TeamName: "backend", IsActive: true}, nil)
+	userUC.On("SetUserOutOfOffice", mock.Anything, "ghost", (*time.Time)(nil)).Return(nil, domain.ErrUserNotFound)
+
+	post := func(body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/users/setOutOfOffice", bytes.NewReader([]byte(body)))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		require.NoError(t, h.PostUsersSetOutOfOffice(echo.New().NewContext(req, rec)))
+		return rec
+	}
+
+	rec := post(`{"user_id": "u2", "until": "2026-11-02T09:00:00Z"}`)
+	require.Equal(t, http.StatusOK, rec.Code)
+	var resp struct {
+		User api.User `json:"user"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	require.NotNil(t, resp.User.OutOfOfficeUntil)
+	assert.True(t, until.Equal(*resp.User.OutOfOfficeUntil))
+
+	// null снимает отметку, поле out_of_office_until в ответе пропадает
+	rec = post(`{"user_id": "u3", "until": null}`)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.NotContains(t, rec.Body.String(), "out_of_office_until")
+
+	assert.Equal(t, http.StatusNotFound, post(`{"user_id": "ghost"}`).Code)
+	assert.Equal(t, http.StatusBadRequest, post(`{"user_id": "u2", "until": "next monday"}`).Code)
+}
+
 func TestUserHandlerTestSuite(t *testing.T) {
 	if os.Getenv("RUN_INTEGRATION_TESTS") != "1" {
 		t.Skip("Skipping integration test. Set RUN_INTEGRATION_TESTS=1 to run.")
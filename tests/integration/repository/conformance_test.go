@@ -0,0 +1,1182 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"pr-reviewer-service/internal/database"
+	"pr-reviewer-service/internal/database/sqlitedb"
+	"pr-reviewer-service/internal/domain"
+	"pr-reviewer-service/internal/repository"
+	"pr-reviewer-service/internal/repository/memory"
+	"pr-reviewer-service/internal/repository/sqlite"
+
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+// conformanceRepos - репозитории одной реализации над общим хранилищем
+type conformanceRepos struct {
+	teams       domain.TeamRepository
+	users       domain.UserRepository
+	prs         domain.PRRepository
+	stats       domain.StatsRepository
+	ownership   domain.OwnershipRepository
+	transfer    domain.TransferRepository
+	idempotency domain.IdempotencyRepository
+}
+
+// ConformanceTestSuite проверяет поведение, общее для всех реализаций репозиториев.
+// Порядок, который реализация не гарантирует (участники команды, ревьюверы PR), не проверяется.
+type ConformanceTestSuite struct {
+	suite.Suite
+	ctx   context.Context
+	repos conformanceRepos
+
+	// open возвращает репозитории над пустым хранилищем
+	open func(t *testing.T) conformanceRepos
+}
+
+func (suite *ConformanceTestSuite) SetupTest() {
+	suite.ctx = context.Background()
+	suite.repos = suite.open(suite.T())
+}
+
+func TestConformance_Memory(t *testing.T) {
+	suite.Run(t, &ConformanceTestSuite{open: func(*testing.T) conformanceRepos {
+		store := memory.NewStore()
+		return conformanceRepos{
+			teams:       memory.NewTeamRepository(store),
+			users:       memory.NewUserRepository(store),
+			prs:         memory.NewPRRepository(store),
+			stats:       memory.NewStatsRepository(store),
+			ownership:   memory.NewOwnershipRepository(store),
+			transfer:    memory.NewTransferRepository(store),
+			idempotency: memory.NewIdempotencyRepository(store),
+		}
+	}})
+}
+
+func TestConformance_SQLite(t *testing.T) {
+	suite.Run(t, &ConformanceTestSuite{open: func(t *testing.T) conformanceRepos {
+		ctx := context.Background()
+		db, err := sqlitedb.Open(ctx, filepath.Join(t.TempDir(), "pr_reviewer.db"))
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = db.Close() })
+
+		migrator, err := sqlitedb.NewMigrator(db)
+		require.NoError(t, err)
+		_, err = migrator.Up(ctx)
+		require.NoError(t, err)
+
+		queries := sqlitedb.New(db)
+		return conformanceRepos{
+			teams:       sqlite.NewTeamRepository(db, queries),
+			users:       sqlite.NewUserRepository(db, queries),
+			prs:         sqlite.NewPRRepository(db, queries),
+			stats:       sqlite.NewStatsRepository(queries),
+			ownership:   sqlite.NewOwnershipRepository(db, queries),
+			transfer:    sqlite.NewTransferRepository(db, queries),
+			idempotency: sqlite.NewIdempotencyRepository(db, queries),
+		}
+	}})
+}
+
+func TestConformance_Postgres(t *testing.T) {
+	if os.Getenv("RUN_INTEGRATION_TESTS") != "1" {
+		t.Skip("Skipping integration test. Set RUN_INTEGRATION_TESTS=1 to run.")
+	}
+
+	dsn := fmt.Sprintf(
+		"postgres://%s:%s@%s:%s/%s?sslmode=disable",
+		"postgres", "password", "localhost", "5433", "pr_reviewer_test",
+	)
+	db, err := sql.Open("pgx", dsn)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+	require.NoError(t, db.Ping())
+	queries := database.New(db)
+
+	suite.Run(t, &ConformanceTestSuite{open: func(t *testing.T) conformanceRepos {
+		// Остальные таблицы очищаются каскадно
+		_, err := db.Exec("TRUNCATE teams, users, pull_requests, ownership_rules, idempotency_keys CASCADE")
+		require.NoError(t, err)
+		return conformanceRepos{
+			teams:       repository.NewTeamRepository(db, queries),
+			users:       repository.NewUserRepository(db, queries),
+			prs:         repository.NewPRRepository(db, queries),
+			stats:       repository.NewStatsRepository(queries),
+			ownership:   repository.NewOwnershipRepository(db, queries),
+			transfer:    repository.NewTransferRepository(db, queries),
+			idempotency: repository.NewIdempotencyRepository(queries),
+		}
+	}})
+}
+
+// createTeam создает команду; участники с префиксом "-" неактивны
+func (suite *ConformanceTestSuite) createTeam(name, parent string, members ...string) {
+	team := &domain.Team{Name: name, ParentName: parent}
+	for _, id := range members {
+		active := id[0] != '-'
+		if !active {
+			id = id[1:]
+		}
+		team.Members = append(team.Members, &domain.User{ID: id, Username: "name-" + id, TeamName: name, IsActive: active})
+	}
+	require.NoError(suite.T(), suite.repos.teams.Create(suite.ctx, team))
+}
+
+// createPR создает PR; пауза гарантирует разное время создания для проверки порядка
+func (suite *ConformanceTestSuite) createPR(id, author string, reviewers ...string) *domain.PullRequest {
+	pr := &domain.PullRequest{ID: id, Name: "name-" + id, AuthorID: author, Status: domain.PRStatusOpen}
+	require.NoError(suite.T(), suite.repos.prs.CreateWithReviewers(suite.ctx, pr, reviewers))
+	time.Sleep(2 * time.Millisecond)
+	return pr
+}
+
+func ids(prs []*domain.PullRequest) []string {
+	result := make([]string, 0, len(prs))
+	for _, pr := range prs {
+		result = append(result, pr.ID)
+	}
+	return result
+}
+
+func userIDs(users []*domain.User) []string {
+	result := make([]string, 0, len(users))
+	for _, u := range users {
+		result = append(result, u.ID)
+	}
+	return result
+}
+
+func (suite *ConformanceTestSuite) TestTeam_CreateAndGet() {
+	suite.createTeam("backend", "", "u1", "-u2")
+
+	team, err := suite.repos.teams.GetByName(suite.ctx, "backend")
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "backend", team.Name)
+	assert.ElementsMatch(suite.T(), []string{"u1", "u2"}, userIDs(team.Members))
+
+	exists, err := suite.repos.teams.ExistsTeam(suite.ctx, "backend")
+	require.NoError(suite.T(), err)
+	assert.True(suite.T(), exists)
+
+	// Несуществующая команда - пустая команда без ошибки
+	team, err = suite.repos.teams.GetByName(suite.ctx, "ghost")
+	require.NoError(suite.T(), err)
+	assert.Empty(suite.T(), team.Members)
+	exists, err = suite.repos.teams.ExistsTeam(suite.ctx, "ghost")
+	require.NoError(suite.T(), err)
+	assert.False(suite.T(), exists)
+
+	active, err := suite.repos.teams.GetActiveUsersFromTeam(suite.ctx, "backend")
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []string{"u1"}, userIDs(active))
+}
+
+func (suite *ConformanceTestSuite) TestTeam_DuplicateNameIsRejected() {
+	suite.createTeam("backend", "", "u1")
+
+	err := suite.repos.teams.Create(suite.ctx, &domain.Team{
+		Name:    "backend",
+		Members: []*domain.User{{ID: "u2", Username: "Bob", TeamName: "backend", IsActive: true}},
+	})
+	assert.ErrorIs(suite.T(), err, domain.ErrTeamAlreadyExists)
+
+	// Неудачное создание ничего не меняет
+	_, err = suite.repos.users.GetByID(suite.ctx, "u2")
+	assert.ErrorIs(suite.T(), err, domain.ErrUserNotFound)
+}
+
+func (suite *ConformanceTestSuite) TestTeam_ExistingMemberOnlyChangesTeam() {
+	suite.createTeam("backend", "", "u1")
+	_, err := suite.repos.users.UpdateActiveStatus(suite.ctx, "u1", false)
+	require.NoError(suite.T(), err)
+
+	require.NoError(suite.T(), suite.repos.teams.Create(suite.ctx, &domain.Team{
+		Name:    "frontend",
+		Members: []*domain.User{{ID: "u1", Username: "Renamed", TeamName: "frontend", IsActive: true}},
+	}))
+
+	user, err := suite.repos.users.GetByID(suite.ctx, "u1")
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), &domain.User{ID: "u1", Username: "name-u1", TeamName: "frontend", IsActive: false}, user)
+}
+
+func (suite *ConformanceTestSuite) TestTeam_Hierarchy() {
+	suite.createTeam("engineering", "")
+	suite.createTeam("frontend", "engineering", "f1")
+	suite.createTeam("backend", "engineering", "b1")
+
+	parent, err := suite.repos.teams.GetParent(suite.ctx, "backend")
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "engineering", parent)
+
+	_, err = suite.repos.teams.GetParent(suite.ctx, "ghost")
+	assert.ErrorIs(suite.T(), err, domain.ErrTeamNotFound)
+
+	children, err := suite.repos.teams.GetChildren(suite.ctx, "engineering")
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []string{"backend", "frontend"}, children)
+
+	require.NoError(suite.T(), suite.repos.teams.SetParent(suite.ctx, "frontend", ""))
+	children, err = suite.repos.teams.GetChildren(suite.ctx, "engineering")
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []string{"backend"}, children)
+
+	// Несуществующий родитель, команда в роли своего родителя и перенос в свое поддерево отклоняются
+	assert.ErrorIs(suite.T(), suite.repos.teams.SetParent(suite.ctx, "backend", "ghost"), domain.ErrParentTeamNotFound)
+	assert.ErrorIs(suite.T(), suite.repos.teams.SetParent(suite.ctx, "backend", "backend"), domain.ErrTeamHierarchyCycle)
+	assert.ErrorIs(suite.T(), suite.repos.teams.SetParent(suite.ctx, "engineering", "backend"), domain.ErrTeamHierarchyCycle)
+
+	teams, err := suite.repos.teams.GetByNames(suite.ctx, []string{"backend", "ghost", "engineering"})
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []*domain.Team{
+		{Name: "backend", ParentName: "engineering"},
+		{Name: "engineering"},
+	}, teams)
+}
+
+func (suite *ConformanceTestSuite) TestTeam_ConcurrentOppositeMovesKeepTree() {
+	for i := range 10 {
+		a, b := fmt.Sprintf("a%d", i), fmt.Sprintf("b%d", i)
+		suite.createTeam(a, "")
+		suite.createTeam(b, "")
+
+		// Встречные переносы a -> b и b -> a: успеть может только один
+		errs := make(chan error, 2)
+		var wg sync.WaitGroup
+		for _, move := range [][2]string{{a, b}, {b, a}} {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				errs <- suite.repos.teams.SetParent(suite.ctx, move[0], move[1])
+			}()
+		}
+		wg.Wait()
+		close(errs)
+
+		var succeeded int
+		for err := range errs {
+			if err == nil {
+				succeeded++
+			} else {
+				assert.ErrorIs(suite.T(), err, domain.ErrTeamHierarchyCycle)
+			}
+		}
+		assert.Equal(suite.T(), 1, succeeded)
+
+		parentA, err := suite.repos.teams.GetParent(suite.ctx, a)
+		require.NoError(suite.T(), err)
+		parentB, err := suite.repos.teams.GetParent(suite.ctx, b)
+		require.NoError(suite.T(), err)
+		assert.False(suite.T(), parentA == b && parentB == a, "cycle %s <-> %s", a, b)
+	}
+}
+
+func (suite *ConformanceTestSuite) TestUser_RandomActiveCandidates() {
+	suite.createTeam("backend", "", "author", "r1", "r2", "r3", "r4", "-inactive")
+	suite.createTeam("frontend", "", "f1")
+
+	seen := make(map[string]bool)
+	for i := 0; i < 50; i++ {
+		candidates, err := suite.repos.users.GetActiveUsersByTeam(suite.ctx, "backend", "author")
+		require.NoError(suite.T(), err)
+		require.Len(suite.T(), candidates, 2)
+		require.NotEqual(suite.T(), candidates[0].ID, candidates[1].ID)
+		for _, c := range candidates {
+			require.Subset(suite.T(), []string{"r1", "r2", "r3", "r4"}, []string{c.ID})
+			seen[c.ID] = true
+		}
+	}
+	// Выбор случайный: за 50 выборок каждый из четырех кандидатов почти наверняка встретится
+	assert.Len(suite.T(), seen, 4)
+
+	candidates, err := suite.repos.users.GetActiveUsersByTeam(suite.ctx, "frontend", "f1")
+	require.NoError(suite.T(), err)
+	assert.Empty(suite.T(), candidates)
+}
+
+func (suite *ConformanceTestSuite) TestUser_StatusTeamAndSkills() {
+	suite.createTeam("backend", "", "u1")
+
+	_, err := suite.repos.users.GetByID(suite.ctx, "ghost")
+	assert.ErrorIs(suite.T(), err, domain.ErrUserNotFound)
+	_, err = suite.repos.users.GetUserTeam(suite.ctx, "ghost")
+	assert.ErrorIs(suite.T(), err, domain.ErrUserNotFound)
+	_, err = suite.repos.users.UpdateActiveStatus(suite.ctx, "ghost", false)
+	assert.ErrorIs(suite.T(), err, domain.ErrUserNotFound)
+
+	user, err := suite.repos.users.UpdateActiveStatus(suite.ctx, "u1", false)
+	require.NoError(suite.T(), err)
+	assert.False(suite.T(), user.IsActive)
+
+	teamName, err := suite.repos.users.GetUserTeam(suite.ctx, "u1")
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "backend", teamName)
+
+	require.NoError(suite.T(), suite.repos.users.SetSkills(suite.ctx, "u1", []string{"sql", "go"}))
+	skills, err := suite.repos.users.GetSkills(suite.ctx, "u1")
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []string{"go", "sql"}, skills)
+
+	require.NoError(suite.T(), suite.repos.users.SetSkills(suite.ctx, "u1", nil))
+	skills, err = suite.repos.users.GetSkills(suite.ctx, "u1")
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []string{}, skills)
+
+	assert.Error(suite.T(), suite.repos.users.SetSkills(suite.ctx, "ghost", []string{"go"}))
+}
+
+func (suite *ConformanceTestSuite) TestUser_OutOfOffice() {
+	suite.createTeam("backend", "", "u1", "u2", "-u3")
+	suite.createPR("pr-1", "u1", "u2")
+
+	until := time.Date(2030, time.March, 1, 9, 30, 0, 0, time.UTC)
+	require.NoError(suite.T(), suite.repos.users.SetOutOfOffice(suite.ctx, "u2", &until))
+
+	roster, err := suite.repos.users.GetTeamRoster(suite.ctx, "backend")
+	require.NoError(suite.T(), err)
+	require.Len(suite.T(), roster, 3)
+	assert.Nil(suite.T(), roster[0].User.OutOfOfficeUntil)
+	require.NotNil(suite.T(), roster[1].User.OutOfOfficeUntil)
+	assert.True(suite.T(), until.Equal(*roster[1].User.OutOfOfficeUntil))
+
+	candidates, err := suite.repos.users.GetReviewCandidates(suite.ctx, []string{"u3", "u2", "ghost"})
+	require.NoError(suite.T(), err)
+	require.Len(suite.T(), candidates, 2)
+	assert.Equal(suite.T(), "u2", candidates[0].User.ID)
+	assert.Equal(suite.T(), 1, candidates[0].OpenReviews)
+	require.NotNil(suite.T(), candidates[0].User.OutOfOfficeUntil)
+	assert.True(suite.T(), until.Equal(*candidates[0].User.OutOfOfficeUntil))
+	assert.Equal(suite.T(), "u3", candidates[1].User.ID)
+	assert.False(suite.T(), candidates[1].User.IsActive)
+
+	// Повторная отметка заменяет дату, nil снимает отметку
+	later := until.Add(48 * time.Hour)
+	require.NoError(suite.T(), suite.repos.users.SetOutOfOffice(suite.ctx, "u2", &later))
+	candidates, err = suite.repos.users.GetReviewCandidates(suite.ctx, []string{"u2"})
+	require.NoError(suite.T(), err)
+	require.Len(suite.T(), candidates, 1)
+	assert.True(suite.T(), later.Equal(*candidates[0].User.OutOfOfficeUntil))
+
+	require.NoError(suite.T(), suite.repos.users.SetOutOfOffice(suite.ctx, "u2", nil))
+	candidates, err = suite.repos.users.GetReviewCandidates(suite.ctx, []string{"u2"})
+	require.NoError(suite.T(), err)
+	require.Len(suite.T(), candidates, 1)
+	assert.Nil(suite.T(), candidates[0].User.OutOfOfficeUntil)
+
+	assert.Error(suite.T(), suite.repos.users.SetOutOfOffice(suite.ctx, "ghost", &until))
+}
+
+func (suite *ConformanceTestSuite) TestPR_DuplicateIDIsRejected() {
+	suite.createTeam("backend", "", "author", "r1", "r2")
+	created := suite.createPR("pr-1", "author", "r1")
+	require.NotNil(suite.T(), created.CreatedAt)
+
+	err := suite.repos.prs.CreateWithReviewers(suite.ctx,
+		&domain.PullRequest{ID: "pr-1", Name: "Other", AuthorID: "author"}, []string{"r2"})
+	assert.ErrorIs(suite.T(), err, domain.ErrPRAlreadyExists)
+
+	pr, err := suite.repos.prs.GetByID(suite.ctx, "pr-1")
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "name-pr-1", pr.Name)
+	assert.Equal(suite.T(), []string{"r1"}, pr.AssignedReviewers)
+}
+
+func (suite *ConformanceTestSuite) TestPR_UnknownAuthorOrReviewerCreatesNothing() {
+	suite.createTeam("backend", "", "author", "r1")
+
+	err := suite.repos.prs.CreateWithReviewers(suite.ctx,
+		&domain.PullRequest{ID: "pr-1", Name: "PR", AuthorID: "ghost"}, nil)
+	assert.Error(suite.T(), err)
+
+	err = suite.repos.prs.CreateWithReviewers(suite.ctx,
+		&domain.PullRequest{ID: "pr-2", Name: "PR", AuthorID: "author"}, []string{"r1", "ghost"})
+	assert.Error(suite.T(), err)
+
+	for _, id := range []string{"pr-1", "pr-2"} {
+		exists, err := suite.repos.prs.ExistsPr(suite.ctx, id)
+		require.NoError(suite.T(), err)
+		assert.False(suite.T(), exists, id)
+	}
+}
+
+func (suite *ConformanceTestSuite) TestPR_MergeIsIdempotent() {
+	suite.createTeam("backend", "", "author", "r1")
+	suite.createPR("pr-1", "author", "r1")
+
+	_, err := suite.repos.prs.GetByID(suite.ctx, "ghost")
+	assert.ErrorIs(suite.T(), err, domain.ErrPRNotFound)
+	_, _, err = suite.repos.prs.Merge(suite.ctx, "ghost", 0)
+	assert.ErrorIs(suite.T(), err, domain.ErrPRNotFound)
+
+	merged, changed, err := suite.repos.prs.Merge(suite.ctx, "pr-1", 0)
+	require.NoError(suite.T(), err)
+	assert.True(suite.T(), changed)
+	assert.Equal(suite.T(), domain.PRStatusMerged, merged.Status)
+	require.NotNil(suite.T(), merged.MergedAt)
+	assert.Equal(suite.T(), []string{"r1"}, merged.AssignedReviewers)
+
+	time.Sleep(2 * time.Millisecond)
+	again, changed, err := suite.repos.prs.Merge(suite.ctx, "pr-1", 0)
+	require.NoError(suite.T(), err)
+	assert.False(suite.T(), changed, "repeated merge must not report a status change")
+	assert.Equal(suite.T(), domain.PRStatusMerged, again.Status)
+	assert.True(suite.T(), merged.MergedAt.Equal(*again.MergedAt), "merged_at must not change")
+}
+
+func (suite *ConformanceTestSuite) TestPR_VersionChecks() {
+	suite.createTeam("backend", "", "author", "r1", "r2", "r3")
+	created := suite.createPR("pr-1", "author", "r1")
+	assert.Equal(suite.T(), int64(1), created.Version)
+
+	// Несовпадение версии ничего не меняет
+	err := suite.repos.prs.ReassignReviewer(suite.ctx, "pr-1", "r1", "r2", 2)
+	assert.ErrorIs(suite.T(), err, domain.ErrPRVersionConflict)
+	require.NoError(suite.T(), suite.repos.prs.ReassignReviewer(suite.ctx, "pr-1", "r1", "r2", 1))
+
+	pr, err := suite.repos.prs.GetByID(suite.ctx, "pr-1")
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(2), pr.Version)
+	assert.Equal(suite.T(), []string{"r2"}, pr.AssignedReviewers)
+
+	// Снятый ревьювер не снимается повторно
+	err = suite.repos.prs.ReassignReviewer(suite.ctx, "pr-1", "r1", "r3", 0)
+	assert.ErrorIs(suite.T(), err, domain.ErrReviewerNotAssigned)
+
+	_, _, err = suite.repos.prs.Merge(suite.ctx, "pr-1", 1)
+	assert.ErrorIs(suite.T(), err, domain.ErrPRVersionConflict)
+	merged, _, err := suite.repos.prs.Merge(suite.ctx, "pr-1", 2)
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(3), merged.Version)
+
+	// Повторный merge версию не меняет, переназначение после мержа запрещено
+	again, _, err := suite.repos.prs.Merge(suite.ctx, "pr-1", 0)
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(3), again.Version)
+	err = suite.repos.prs.ReassignReviewer(suite.ctx, "pr-1", "r2", "r3", 0)
+	assert.ErrorIs(suite.T(), err, domain.ErrPRAlreadyMerged)
+
+	err = suite.repos.prs.ReassignReviewer(suite.ctx, "ghost", "r2", "r3", 0)
+	assert.ErrorIs(suite.T(), err, domain.ErrPRNotFound)
+}
+
+func (suite *ConformanceTestSuite) TestPR_ConcurrentReassignsWithSameVersion() {
+	suite.createTeam("backend", "", "author", "r1", "r2", "r3", "r4", "r5")
+	suite.createPR("pr-1", "author", "r1")
+
+	// Все переназначения прочитали версию 1: применяется ровно одно
+	var wg sync.WaitGroup
+	errs := make([]error, 4)
+	for i := range errs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = suite.repos.prs.ReassignReviewer(suite.ctx, "pr-1", "r1", fmt.Sprintf("r%d", i+2), 1)
+		}()
+	}
+	wg.Wait()
+
+	succeeded := 0
+	for _, err := range errs {
+		if err == nil {
+			succeeded++
+			continue
+		}
+		assert.ErrorIs(suite.T(), err, domain.ErrPRVersionConflict)
+	}
+	assert.Equal(suite.T(), 1, succeeded)
+
+	pr, err := suite.repos.prs.GetByID(suite.ctx, "pr-1")
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(2), pr.Version)
+	assert.Len(suite.T(), pr.AssignedReviewers, 1)
+	assert.NotEqual(suite.T(), "r1", pr.AssignedReviewers[0])
+}
+
+func (suite *ConformanceTestSuite) TestPR_ReassignUpdatesReviewersAndRationale() {
+	suite.createTeam("backend", "", "author", "r1", "r2", "r3", "-idle")
+	pr := &domain.PullRequest{
+		ID: "pr-1", Name: "PR", AuthorID: "author",
+		Rationale: &domain.AssignmentRationale{
+			Strategy:          domain.StrategySkills,
+			CandidatePoolSize: 3,
+			Reviewers: []*domain.ReviewerSelection{
+				{UserID: "r2", Reason: domain.SelectionSkillMatch, MatchedTags: []string{"go"}, OpenReviews: 1, SkillScore: 0.5, WorkloadScore: 0.25},
+				{UserID: "r1", Reason: domain.SelectionLowWorkload, WorkloadScore: 1},
+			},
+			Excluded: []*domain.ExcludedCandidate{
+				{UserID: "idle", Reason: domain.ExclusionInactive},
+				{UserID: "author", Reason: domain.ExclusionAuthor},
+			},
+		},
+	}
+	require.NoError(suite.T(), suite.repos.prs.CreateWithReviewers(suite.ctx, pr, []string{"r2", "r1"}))
+
+	rationale, err := suite.repos.prs.GetRationale(suite.ctx, "pr-1")
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), &domain.AssignmentRationale{
+		Strategy:          domain.StrategySkills,
+		CandidatePoolSize: 3,
+		Reviewers: []*domain.ReviewerSelection{
+			{UserID: "r2", Reason: domain.SelectionSkillMatch, MatchedTags: []string{"go"}, OpenReviews: 1, SkillScore: 0.5, WorkloadScore: 0.25, Score: 0.75},
+			{UserID: "r1", Reason: domain.SelectionLowWorkload, MatchedTags: []string{}, WorkloadScore: 1, Score: 1},
+		},
+		Excluded: []*domain.ExcludedCandidate{
+			{UserID: "author", Reason: domain.ExclusionAuthor},
+			{UserID: "idle", Reason: domain.ExclusionInactive},
+		},
+	}, rationale)
+
+	require.NoError(suite.T(), suite.repos.prs.ReassignReviewer(suite.ctx, "pr-1", "r2", "r3", 0))
+	// Назначенного ревьювера нельзя добавить второй раз
+	assert.Error(suite.T(), suite.repos.prs.ReassignReviewer(suite.ctx, "pr-1", "r3", "r1", 0))
+
+	reviewers, err := suite.repos.prs.GetReviewers(suite.ctx, "pr-1")
+	require.NoError(suite.T(), err)
+	assert.ElementsMatch(suite.T(), []string{"r1", "r3"}, reviewers)
+
+	isReviewer, err := suite.repos.prs.IsUserReviewer(suite.ctx, "pr-1", "r2")
+	require.NoError(suite.T(), err)
+	assert.False(suite.T(), isReviewer)
+
+	rationale, err = suite.repos.prs.GetRationale(suite.ctx, "pr-1")
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), &domain.ReviewerSelection{
+		UserID: "r3", Reason: domain.SelectionReassignment, MatchedTags: []string{},
+	}, rationale.Reviewers[0])
+
+	suite.createPR("pr-2", "author", "r1")
+	rationale, err = suite.repos.prs.GetRationale(suite.ctx, "pr-2")
+	require.NoError(suite.T(), err)
+	assert.Nil(suite.T(), rationale)
+}
+
+func (suite *ConformanceTestSuite) TestPR_ListAndBatchLoads() {
+	suite.createTeam("backend", "", "b1", "b2", "b3")
+	suite.createTeam("frontend", "", "f1")
+	first := suite.createPR("pr-1", "b1", "b3", "b2")
+	suite.createPR("pr-2", "f1", "b2")
+	suite.createPR("pr-3", "b2", "b1")
+	_, _, err := suite.repos.prs.Merge(suite.ctx, "pr-3", 0)
+	require.NoError(suite.T(), err)
+
+	page, err := suite.repos.prs.List(suite.ctx, &domain.PRListFilter{}, nil, 2)
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []string{"pr-3", "pr-2"}, ids(page))
+	// Мерж увеличил версию pr-3
+	assert.Equal(suite.T(), []int64{2, 1}, []int64{page[0].Version, page[1].Version})
+	last := page[len(page)-1]
+	page, err = suite.repos.prs.List(suite.ctx, &domain.PRListFilter{}, &domain.PRCursor{CreatedAt: *last.CreatedAt, ID: last.ID}, 2)
+	require.NoError(suite.T(), err)
+	require.Equal(suite.T(), []string{"pr-1"}, ids(page))
+	assert.Equal(suite.T(), []string{"b2", "b3"}, page[0].AssignedReviewers)
+
+	filtered, err := suite.repos.prs.List(suite.ctx, &domain.PRListFilter{Status: domain.PRStatusOpen, TeamName: "backend"}, nil, 10)
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []string{"pr-1"}, ids(filtered))
+
+	filtered, err = suite.repos.prs.List(suite.ctx, &domain.PRListFilter{ReviewerID: "b2"}, nil, 10)
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []string{"pr-2", "pr-1"}, ids(filtered))
+
+	// CreatedFrom включительно, CreatedTo - нет
+	filtered, err = suite.repos.prs.List(suite.ctx, &domain.PRListFilter{CreatedFrom: first.CreatedAt, CreatedTo: first.CreatedAt}, nil, 10)
+	require.NoError(suite.T(), err)
+	assert.Empty(suite.T(), filtered)
+	to := first.CreatedAt.Add(time.Microsecond)
+	filtered, err = suite.repos.prs.List(suite.ctx, &domain.PRListFilter{CreatedFrom: first.CreatedAt, CreatedTo: &to}, nil, 10)
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []string{"pr-1"}, ids(filtered))
+
+	byIDs, err := suite.repos.prs.GetByIDs(suite.ctx, []string{"pr-3", "ghost", "pr-1"})
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []string{"pr-1", "pr-3"}, ids(byIDs))
+
+	assigned, err := suite.repos.prs.GetAssignedToUsers(suite.ctx, []string{"b1", "b2", "f1"})
+	require.NoError(suite.T(), err)
+	assert.Len(suite.T(), assigned, 2)
+	assert.Equal(suite.T(), []string{"pr-3"}, ids(assigned["b1"]))
+	assert.Equal(suite.T(), []string{"pr-2", "pr-1"}, ids(assigned["b2"]))
+
+	userPRs, err := suite.repos.prs.GetUserAssignedPRs(suite.ctx, "b2", nil, 1)
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []string{"pr-2"}, ids(userPRs))
+	userPRs, err = suite.repos.prs.GetUserAssignedPRs(suite.ctx, "b2", &domain.PRCursor{CreatedAt: *userPRs[0].CreatedAt, ID: "pr-2"}, 10)
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []string{"pr-1"}, ids(userPRs))
+}
+
+func (suite *ConformanceTestSuite) TestStats() {
+	suite.createTeam("engineering", "")
+	suite.createTeam("backend", "engineering", "b1", "b2", "-b3")
+	suite.createTeam("frontend", "engineering", "f1")
+	suite.createPR("pr-1", "b1", "b2", "f1")
+	suite.createPR("pr-2", "f1", "b2")
+	suite.createPR("pr-3", "b2", "b1")
+	_, _, err := suite.repos.prs.Merge(suite.ctx, "pr-3", 0)
+	require.NoError(suite.T(), err)
+
+	reviewStats, err := suite.repos.stats.GetStatsReviews(suite.ctx, &domain.StatsFilter{})
+	require.NoError(suite.T(), err)
+	counts := make(map[string]int64)
+	for i, stat := range reviewStats {
+		counts[stat.UserID] = stat.ReviewCount
+		if i > 0 {
+			assert.LessOrEqual(suite.T(), stat.ReviewCount, reviewStats[i-1].ReviewCount)
+		}
+	}
+	assert.Equal(suite.T(), map[string]int64{"b1": 1, "b2": 2, "b3": 0, "f1": 1}, counts)
+
+	prStats, err := suite.repos.stats.GetStatsPrAssignments(suite.ctx, &domain.StatsFilter{})
+	require.NoError(suite.T(), err)
+	require.Len(suite.T(), prStats, 3)
+	assert.Equal(suite.T(), &domain.PRAssignmentStat{PRID: "pr-1", PRName: "name-pr-1", ReviewersCount: 2}, prStats[0])
+
+	open, err := suite.repos.stats.CountOpenPRs(suite.ctx)
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(2), open)
+
+	load, err := suite.repos.stats.GetTeamReviewLoad(suite.ctx)
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []*domain.TeamReviewLoad{
+		{TeamName: "backend", OpenReviews: 2},
+		{TeamName: "engineering", OpenReviews: 0},
+		{TeamName: "frontend", OpenReviews: 1},
+	}, load)
+
+	tree, err := suite.repos.teams.GetTeamTreeStats(suite.ctx)
+	require.NoError(suite.T(), err)
+	require.Len(suite.T(), tree, 3)
+	assert.Equal(suite.T(), "backend", tree[0].Name)
+	assert.Equal(suite.T(), "engineering", tree[0].ParentName)
+	assert.Equal(suite.T(), domain.TeamStats{MembersCount: 3, ActiveMembersCount: 2, OpenPRsCount: 1, OpenReviewsCount: 2}, tree[0].Stats)
+
+	roster, err := suite.repos.users.GetTeamRoster(suite.ctx, "backend")
+	require.NoError(suite.T(), err)
+	require.Len(suite.T(), roster, 3)
+	assert.Equal(suite.T(), "b2", roster[1].User.ID)
+	assert.Equal(suite.T(), 2, roster[1].OpenReviews)
+
+	withTeamReviewers, err := suite.repos.teams.GetOpenPRsWithTeamReviewers(suite.ctx, "backend")
+	require.NoError(suite.T(), err)
+	assert.ElementsMatch(suite.T(), []string{"pr-1", "pr-2"}, withTeamReviewers)
+
+	fromTeam, err := suite.repos.teams.GetPRReviewersFromTeam(suite.ctx, "pr-1", "backend")
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []string{"b2"}, fromTeam)
+}
+
+func (suite *ConformanceTestSuite) TestStats_Filters() {
+	suite.createTeam("engineering", "")
+	suite.createTeam("backend", "engineering", "b1", "b2", "-b3")
+	suite.createTeam("frontend", "engineering", "f1")
+	suite.createPR("pr-1", "b1", "b2", "f1")
+	second := suite.createPR("pr-2", "f1", "b2")
+	suite.createPR("pr-3", "b2", "b1")
+	_, _, err := suite.repos.prs.Merge(suite.ctx, "pr-3", 0)
+	require.NoError(suite.T(), err)
+
+	reviewCounts := func(filter *domain.StatsFilter) map[string]int64 {
+		stats, err := suite.repos.stats.GetStatsReviews(suite.ctx, filter)
+		require.NoError(suite.T(), err)
+		counts := make(map[string]int64)
+		for _, stat := range stats {
+			counts[stat.UserID] = stat.ReviewCount
+		}
+		return counts
+	}
+	assert.Equal(suite.T(), map[string]int64{"b1": 1, "b2": 0, "b3": 0, "f1": 0},
+		reviewCounts(&domain.StatsFilter{Status: domain.PRStatusMerged}))
+	assert.Equal(suite.T(), map[string]int64{"f1": 1}, reviewCounts(&domain.StatsFilter{TeamName: "frontend"}))
+	// CreatedFrom включительно: pr-1 не попадает в интервал
+	assert.Equal(suite.T(), map[string]int64{"b1": 1, "b2": 1, "b3": 0, "f1": 0},
+		reviewCounts(&domain.StatsFilter{CreatedFrom: second.CreatedAt}))
+
+	prStats, err := suite.repos.stats.GetStatsPrAssignments(suite.ctx, &domain.StatsFilter{TeamName: "backend", Status: domain.PRStatusOpen})
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []*domain.PRAssignmentStat{{PRID: "pr-1", PRName: "name-pr-1", ReviewersCount: 2}}, prStats)
+	prStats, err = suite.repos.stats.GetStatsPrAssignments(suite.ctx, &domain.StatsFilter{CreatedTo: second.CreatedAt})
+	require.NoError(suite.T(), err)
+	require.Len(suite.T(), prStats, 1)
+	assert.Equal(suite.T(), "pr-1", prStats[0].PRID)
+
+	teamStats, err := suite.repos.stats.GetStatsTeams(suite.ctx, &domain.StatsFilter{})
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []*domain.TeamReviewStat{
+		{TeamName: "backend", PullRequests: 2, OpenPullRequests: 1, ReviewsGiven: 3, ReviewsReceived: 3, CrossTeamReviews: 1},
+		{TeamName: "engineering"},
+		{TeamName: "frontend", PullRequests: 1, OpenPullRequests: 1, ReviewsGiven: 1, ReviewsReceived: 1, CrossTeamReviews: 1},
+	}, teamStats)
+
+	teamStats, err = suite.repos.stats.GetStatsTeams(suite.ctx, &domain.StatsFilter{TeamName: "backend", Status: domain.PRStatusMerged})
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []*domain.TeamReviewStat{
+		{TeamName: "backend", PullRequests: 1, ReviewsGiven: 1, ReviewsReceived: 1},
+	}, teamStats)
+}
+
+func (suite *ConformanceTestSuite) TestStats_Fairness() {
+	start := time.Now().Add(-time.Minute)
+	suite.createTeam("backend", "", "b1", "b2", "-b3")
+	suite.createTeam("frontend", "", "f1")
+	suite.createPR("pr-1", "b1", "b2", "f1")
+	second := suite.createPR("pr-2", "f1", "b2")
+	suite.createPR("pr-3", "b2", "b1")
+	_, err := suite.repos.users.UpdateActiveStatus(suite.ctx, "b2", false)
+	require.NoError(suite.T(), err)
+	// Повторная установка того же статуса не попадает в историю
+	_, err = suite.repos.users.UpdateActiveStatus(suite.ctx, "b2", false)
+	require.NoError(suite.T(), err)
+	end := time.Now().Add(time.Minute)
+
+	counts := func(teamName string, from time.Time) map[string]int64 {
+		members, err := suite.repos.stats.GetMemberAssignments(suite.ctx, teamName, from, end)
+		require.NoError(suite.T(), err)
+		result := make(map[string]int64)
+		for i, member := range members {
+			result[member.TeamName+"/"+member.UserID] = member.Assignments
+			if i > 0 {
+				assert.LessOrEqual(suite.T(), members[i-1].TeamName, member.TeamName)
+			}
+		}
+		return result
+	}
+	assert.Equal(suite.T(), map[string]int64{"backend/b1": 1, "backend/b2": 2, "backend/b3": 0, "frontend/f1": 1}, counts("", start))
+	assert.Equal(suite.T(), map[string]int64{"backend/b1": 1, "backend/b2": 1, "backend/b3": 0}, counts("backend", *second.CreatedAt))
+
+	history, err := suite.repos.stats.GetUserStatusHistory(suite.ctx, "backend", end)
+	require.NoError(suite.T(), err)
+	statuses := make(map[string][]bool)
+	for _, change := range history {
+		statuses[change.UserID] = append(statuses[change.UserID], change.IsActive)
+		assert.WithinRange(suite.T(), change.ChangedAt, start, end)
+	}
+	assert.Equal(suite.T(), map[string][]bool{"b1": {true}, "b2": {true, false}, "b3": {false}}, statuses)
+
+	history, err = suite.repos.stats.GetUserStatusHistory(suite.ctx, "frontend", start)
+	require.NoError(suite.T(), err)
+	assert.Empty(suite.T(), history)
+}
+
+func (suite *ConformanceTestSuite) TestConcurrentCreateHasSingleWinner() {
+	suite.createTeam("backend", "", "author", "r1")
+
+	const workers = 8
+	errs := make([]error, workers)
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			pr := &domain.PullRequest{ID: "pr-1", Name: fmt.Sprintf("attempt-%d", i), AuthorID: "author"}
+			errs[i] = suite.repos.prs.CreateWithReviewers(suite.ctx, pr, []string{"r1"})
+		}(i)
+	}
+	wg.Wait()
+
+	created := 0
+	for _, err := range errs {
+		if err == nil {
+			created++
+			continue
+		}
+		assert.ErrorIs(suite.T(), err, domain.ErrPRAlreadyExists)
+	}
+	assert.Equal(suite.T(), 1, created)
+
+	reviewers, err := suite.repos.prs.GetReviewers(suite.ctx, "pr-1")
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []string{"r1"}, reviewers)
+}
+
+func (suite *ConformanceTestSuite) TestOwnership_ReplaceAndGet() {
+	rules, err := suite.repos.ownership.GetRules(suite.ctx, "search")
+	require.NoError(suite.T(), err)
+	assert.Empty(suite.T(), rules)
+
+	require.NoError(suite.T(), suite.repos.ownership.ReplaceRules(suite.ctx, "search", []*domain.OwnershipRule{
+		{Pattern: "*", UserIDs: []string{"u2", "u1"}},
+		{Pattern: "*.sql", TeamNames: []string{"platform"}},
+	}))
+	require.NoError(suite.T(), suite.repos.ownership.ReplaceRules(suite.ctx, "billing", []*domain.OwnershipRule{
+		{Pattern: "/docs/", UserIDs: []string{"u3"}},
+	}))
+
+	rules, err = suite.repos.ownership.GetRules(suite.ctx, "search")
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []*domain.OwnershipRule{
+		{Pattern: "*", UserIDs: []string{"u1", "u2"}, TeamNames: []string{}},
+		{Pattern: "*.sql", UserIDs: []string{}, TeamNames: []string{"platform"}},
+	}, rules)
+
+	// Замена удаляет прежние правила только своего репозитория
+	require.NoError(suite.T(), suite.repos.ownership.ReplaceRules(suite.ctx, "search", nil))
+	rules, err = suite.repos.ownership.GetRules(suite.ctx, "search")
+	require.NoError(suite.T(), err)
+	assert.Empty(suite.T(), rules)
+	rules, err = suite.repos.ownership.GetRules(suite.ctx, "billing")
+	require.NoError(suite.T(), err)
+	assert.Len(suite.T(), rules, 1)
+}
+
+func (suite *ConformanceTestSuite) TestIdempotency_ReserveCompleteAndRelease() {
+	repo := suite.repos.idempotency
+	leaseExpiresAt := time.Now().Add(time.Minute)
+	expiresAt := time.Now().Add(time.Hour)
+
+	record, reserved, err := repo.Reserve(suite.ctx, "key-1", "fp-1", leaseExpiresAt, expiresAt)
+	suite.Require().NoError(err)
+	suite.True(reserved)
+	suite.Nil(record)
+
+	// Повтор до ответа видит незавершенную запись
+	record, reserved, err = repo.Reserve(suite.ctx, "key-1", "fp-2", leaseExpiresAt, expiresAt)
+	suite.Require().NoError(err)
+	suite.False(reserved)
+	suite.Equal("fp-1", record.Fingerprint)
+	suite.Zero(record.StatusCode)
+	suite.WithinDuration(leaseExpiresAt, record.LeaseExpiresAt, time.Millisecond)
+
+	// Ответ сохраняет только запрос, занявший ключ
+	suite.ErrorIs(repo.Complete(suite.ctx, "key-1", "fp-2", 201, "application/json", "", nil), domain.ErrIdempotencyLeaseLost)
+	suite.Require().NoError(repo.Complete(suite.ctx, "key-1", "fp-1", 201, "application/json", `"2"`, []byte(`{"ok":true}`)))
+	record, reserved, err = repo.Reserve(suite.ctx, "key-1", "fp-1", leaseExpiresAt, expiresAt)
+	suite.Require().NoError(err)
+	suite.False(reserved)
+	suite.Equal("key-1", record.Key)
+	suite.Equal(201, record.StatusCode)
+	suite.Equal("application/json", record.ContentType)
+	suite.Equal(`"2"`, record.ETag)
+	suite.Equal(`{"ok":true}`, string(record.Body))
+	suite.WithinDuration(expiresAt, record.ExpiresAt, time.Millisecond)
+
+	// Повторный Complete не перезаписывает сохраненный ответ
+	suite.ErrorIs(repo.Complete(suite.ctx, "key-1", "fp-1", 500, "text/plain", "", nil), domain.ErrIdempotencyLeaseLost)
+
+	// Ключ с сохраненным ответом не освобождается, без ответа - освобождается только занявшим его запросом
+	suite.Require().NoError(repo.Release(suite.ctx, "key-1", "fp-1"))
+	record, reserved, err = repo.Reserve(suite.ctx, "key-1", "fp-1", leaseExpiresAt, expiresAt)
+	suite.Require().NoError(err)
+	suite.False(reserved)
+	suite.Equal(201, record.StatusCode)
+
+	_, reserved, err = repo.Reserve(suite.ctx, "key-2", "fp-1", leaseExpiresAt, expiresAt)
+	suite.Require().NoError(err)
+	suite.Require().True(reserved)
+	suite.Require().NoError(repo.Release(suite.ctx, "key-2", "fp-3"))
+	_, reserved, err = repo.Reserve(suite.ctx, "key-2", "fp-3", leaseExpiresAt, expiresAt)
+	suite.Require().NoError(err)
+	suite.Require().False(reserved)
+	suite.Require().NoError(repo.Release(suite.ctx, "key-2", "fp-1"))
+	_, reserved, err = repo.Reserve(suite.ctx, "key-2", "fp-3", leaseExpiresAt, expiresAt)
+	suite.Require().NoError(err)
+	suite.True(reserved)
+}
+
+func (suite *ConformanceTestSuite) TestIdempotency_LeaseTakeover() {
+	repo := suite.repos.idempotency
+	expiresAt := time.Now().Add(time.Hour)
+
+	// Запрос занял ключ, но не ответил до конца аренды
+	_, reserved, err := repo.Reserve(suite.ctx, "key-1", "fp-1", time.Now().Add(-time.Second), expiresAt)
+	suite.Require().NoError(err)
+	suite.Require().True(reserved)
+
+	// Повтор перезанимает брошенный ключ
+	_, reserved, err = repo.Reserve(suite.ctx, "key-1", "fp-2", time.Now().Add(time.Minute), expiresAt)
+	suite.Require().NoError(err)
+	suite.Require().True(reserved)
+
+	// Опоздавший первый запрос не сохраняет ответ и не освобождает чужой ключ
+	suite.ErrorIs(repo.Complete(suite.ctx, "key-1", "fp-1", 201, "application/json", "", []byte(`{}`)), domain.ErrIdempotencyLeaseLost)
+	suite.Require().NoError(repo.Release(suite.ctx, "key-1", "fp-1"))
+	record, reserved, err := repo.Reserve(suite.ctx, "key-1", "fp-1", time.Now().Add(time.Minute), expiresAt)
+	suite.Require().NoError(err)
+	suite.False(reserved)
+	suite.Equal("fp-2", record.Fingerprint)
+
+	// Ключ с сохраненным ответом не перезанимается и после конца аренды
+	_, reserved, err = repo.Reserve(suite.ctx, "key-2", "fp-1", time.Now().Add(-time.Second), expiresAt)
+	suite.Require().NoError(err)
+	suite.Require().True(reserved)
+	suite.Require().NoError(repo.Complete(suite.ctx, "key-2", "fp-1", 201, "application/json", "", []byte(`{}`)))
+	record, reserved, err = repo.Reserve(suite.ctx, "key-2", "fp-1", time.Now().Add(time.Minute), expiresAt)
+	suite.Require().NoError(err)
+	suite.False(reserved)
+	suite.Equal(201, record.StatusCode)
+}
+
+func (suite *ConformanceTestSuite) TestIdempotency_ExpiredKeys() {
+	repo := suite.repos.idempotency
+	leaseExpiresAt := time.Now().Add(time.Minute)
+
+	_, reserved, err := repo.Reserve(suite.ctx, "old", "fp-1", leaseExpiresAt, time.Now().Add(-time.Second))
+	suite.Require().NoError(err)
+	suite.Require().True(reserved)
+	suite.Require().NoError(repo.Complete(suite.ctx, "old", "fp-1", 200, "application/json", "", []byte(`{}`)))
+	_, reserved, err = repo.Reserve(suite.ctx, "fresh", "fp-1", leaseExpiresAt, time.Now().Add(time.Hour))
+	suite.Require().NoError(err)
+	suite.Require().True(reserved)
+
+	// Истекший ключ занимается заново другим запросом
+	_, reserved, err = repo.Reserve(suite.ctx, "old", "fp-2", leaseExpiresAt, time.Now().Add(-time.Second))
+	suite.Require().NoError(err)
+	suite.True(reserved)
+
+	deleted, err := repo.DeleteExpired(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Equal(int64(1), deleted)
+
+	record, reserved, err := repo.Reserve(suite.ctx, "fresh", "fp-1", leaseExpiresAt, time.Now().Add(time.Hour))
+	suite.Require().NoError(err)
+	suite.False(reserved)
+	suite.Equal("fp-1", record.Fingerprint)
+}
+
+// exported - выгрузка хранилища, собранная по типам записей
+type exported struct {
+	Teams        []*domain.Team
+	Users        []*domain.User
+	PullRequests []*domain.PullRequest
+}
+
+// exportAll собирает потоковую выгрузку и проверяет, что записи идут по типам в порядке ключей
+func exportAll(t *testing.T, ctx context.Context, repo domain.TransferRepository) *exported {
+	var result exported
+	lastType := ""
+	require.NoError(t, repo.Export(ctx, func(record *domain.ExportRecord) error {
+		switch record.Type {
+		case domain.RecordTypeTeam:
+			assert.Empty(t, lastType, "teams go first")
+			result.Teams = append(result.Teams, record.Team)
+		case domain.RecordTypeUser:
+			assert.NotEqual(t, domain.RecordTypePullRequest, lastType, "users go before pull requests")
+			result.Users = append(result.Users, record.User)
+		default:
+			result.PullRequests = append(result.PullRequests, record.PullRequest)
+		}
+		if record.Type != domain.RecordTypeTeam {
+			lastType = record.Type
+		}
+		return nil
+	}))
+	assert.IsNonDecreasing(t, userIDs(result.Users))
+	assert.IsNonDecreasing(t, ids(result.PullRequests))
+	return &result
+}
+
+// importSnapshot загружает выгрузку в хранилище в порядке записей
+func importSnapshot(ctx context.Context, repo domain.TransferRepository, snapshot *exported) error {
+	return repo.Import(ctx, func(store domain.ImportStore) error {
+		for _, team := range snapshot.Teams {
+			if err := store.SaveTeam(ctx, team); err != nil {
+				return err
+			}
+		}
+		for _, user := range snapshot.Users {
+			if err := store.SaveUser(ctx, user); err != nil {
+				return err
+			}
+		}
+		for _, pr := range snapshot.PullRequests {
+			if err := store.SavePR(ctx, pr); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// normalizeSnapshot убирает из выгрузки то, что не переносится или не гарантируется:
+// версии PR, порядок ревьюверов и часовой пояс времени
+func normalizeSnapshot(snapshot *exported) {
+	for _, user := range snapshot.Users {
+		if user.OutOfOfficeUntil != nil {
+			until := user.OutOfOfficeUntil.UTC()
+			user.OutOfOfficeUntil = &until
+		}
+		for _, change := range user.StatusHistory {
+			change.ChangedAt = change.ChangedAt.UTC()
+		}
+	}
+	for _, pr := range snapshot.PullRequests {
+		pr.Version = 0
+		slices.Sort(pr.AssignedReviewers)
+		createdAt := pr.CreatedAt.UTC()
+		pr.CreatedAt = &createdAt
+		if pr.MergedAt != nil {
+			mergedAt := pr.MergedAt.UTC()
+			pr.MergedAt = &mergedAt
+		}
+	}
+}
+
+func (suite *ConformanceTestSuite) TestTransfer_ExportImportRoundTrip() {
+	suite.createTeam("eng", "")
+	suite.createTeam("backend", "eng", "author", "r1", "-r2")
+	require.NoError(suite.T(), suite.repos.users.SetSkills(suite.ctx, "r1", []string{"go", "sql"}))
+	require.NoError(suite.T(), suite.repos.prs.CreateWithReviewers(suite.ctx, &domain.PullRequest{
+		ID: "pr-1", Name: "PR", AuthorID: "author",
+		Rationale: &domain.AssignmentRationale{
+			Strategy:          domain.StrategySkills,
+			CandidatePoolSize: 2,
+			Reviewers: []*domain.ReviewerSelection{
+				{UserID: "r1", Reason: domain.SelectionSkillMatch, MatchedTags: []string{"go"}, SkillScore: 0.7, WorkloadScore: 0.3},
+			},
+			Excluded: []*domain.ExcludedCandidate{{UserID: "r2", Reason: domain.ExclusionInactive}},
+		},
+	}, []string{"r1"}))
+	time.Sleep(2 * time.Millisecond)
+	suite.createPR("pr-2", "r1", "author")
+	_, _, err := suite.repos.prs.Merge(suite.ctx, "pr-2", 0)
+	require.NoError(suite.T(), err)
+	until := time.Date(2030, time.March, 1, 9, 30, 0, 0, time.UTC)
+	require.NoError(suite.T(), suite.repos.users.SetOutOfOffice(suite.ctx, "r1", &until))
+	_, err = suite.repos.users.UpdateActiveStatus(suite.ctx, "r2", true)
+	require.NoError(suite.T(), err)
+
+	snapshot := exportAll(suite.T(), suite.ctx, suite.repos.transfer)
+	assert.Len(suite.T(), snapshot.Teams, 2)
+	assert.Equal(suite.T(), []string{"author", "r1", "r2"}, userIDs(snapshot.Users))
+	assert.Equal(suite.T(), []string{"go", "sql"}, snapshot.Users[1].Skills)
+	require.NotNil(suite.T(), snapshot.Users[1].OutOfOfficeUntil)
+	assert.True(suite.T(), until.Equal(*snapshot.Users[1].OutOfOfficeUntil))
+	assert.Nil(suite.T(), snapshot.Users[0].OutOfOfficeUntil)
+	require.Len(suite.T(), snapshot.Users[2].StatusHistory, 2)
+	assert.False(suite.T(), snapshot.Users[2].StatusHistory[0].IsActive)
+	assert.True(suite.T(), snapshot.Users[2].StatusHistory[1].IsActive)
+	assert.Equal(suite.T(), []string{"pr-1", "pr-2"}, ids(snapshot.PullRequests))
+	assert.Equal(suite.T(), int64(2), snapshot.PullRequests[1].Version)
+	require.NotNil(suite.T(), snapshot.PullRequests[0].Rationale)
+	assert.Nil(suite.T(), snapshot.PullRequests[1].Rationale)
+
+	// Выгрузка, загруженная в пустое хранилище, выгружается обратно без изменений
+	target := suite.open(suite.T())
+	// Родитель должен быть загружен раньше потомка
+	slices.SortFunc(snapshot.Teams, func(a, b *domain.Team) int { return len(a.ParentName) - len(b.ParentName) })
+	require.NoError(suite.T(), importSnapshot(suite.ctx, target.transfer, snapshot))
+
+	imported := exportAll(suite.T(), suite.ctx, target.transfer)
+	for _, pr := range imported.PullRequests {
+		assert.Equal(suite.T(), int64(1), pr.Version, pr.ID)
+	}
+	slices.SortFunc(imported.Teams, func(a, b *domain.Team) int { return len(a.ParentName) - len(b.ParentName) })
+	normalizeSnapshot(snapshot)
+	normalizeSnapshot(imported)
+	assert.Equal(suite.T(), snapshot, imported)
+
+	isReviewer, err := target.prs.IsUserReviewer(suite.ctx, "pr-2", "author")
+	require.NoError(suite.T(), err)
+	assert.True(suite.T(), isReviewer)
+}
+
+func (suite *ConformanceTestSuite) TestTransfer_ImportOverwrites() {
+	suite.createTeam("backend", "", "author", "r1", "r2")
+	suite.createTeam("frontend", "")
+	require.NoError(suite.T(), suite.repos.users.SetSkills(suite.ctx, "r1", []string{"go"}))
+	pr := suite.createPR("pr-1", "author", "r1")
+
+	createdAt := time.Date(2025, 10, 24, 12, 0, 0, 0, time.UTC)
+	mergedAt := createdAt.Add(time.Hour)
+	history := []*domain.UserStatusChange{
+		{UserID: "r1", IsActive: true, ChangedAt: domain.StatusHistoryEpoch},
+		{UserID: "r1", IsActive: false, ChangedAt: createdAt},
+	}
+	err := suite.repos.transfer.Import(suite.ctx, func(store domain.ImportStore) error {
+		exists, err := store.ExistsPR(suite.ctx, "pr-1")
+		require.NoError(suite.T(), err)
+		assert.True(suite.T(), exists)
+
+		parent, err := store.GetParent(suite.ctx, "frontend")
+		require.NoError(suite.T(), err)
+		assert.Empty(suite.T(), parent)
+		_, err = store.GetParent(suite.ctx, "ghost")
+		assert.ErrorIs(suite.T(), err, domain.ErrTeamNotFound)
+
+		if err := store.SaveTeam(suite.ctx, &domain.Team{Name: "frontend", ParentName: "backend"}); err != nil {
+			return err
+		}
+		if err := store.SaveUser(suite.ctx, &domain.User{
+			ID: "r1", Username: "Renamed", TeamName: "frontend", IsActive: false, Skills: []string{"css"},
+			OutOfOfficeUntil: &mergedAt, StatusHistory: history,
+		}); err != nil {
+			return err
+		}
+		return store.SavePR(suite.ctx, &domain.PullRequest{
+			ID: "pr-1", Name: "Imported", AuthorID: "author", Status: domain.PRStatusMerged,
+			AssignedReviewers: []string{"r2"}, CreatedAt: &createdAt, MergedAt: &mergedAt,
+		})
+	})
+	require.NoError(suite.T(), err)
+
+	parent, err := suite.repos.teams.GetParent(suite.ctx, "frontend")
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "backend", parent)
+
+	user, err := suite.repos.users.GetByID(suite.ctx, "r1")
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), &domain.User{ID: "r1", Username: "Renamed", TeamName: "frontend", IsActive: false}, user)
+	skills, err := suite.repos.users.GetSkills(suite.ctx, "r1")
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []string{"css"}, skills)
+
+	// История заменяется загруженной целиком: триггер не добавляет смену статуса на момент загрузки
+	snapshot := exportAll(suite.T(), suite.ctx, suite.repos.transfer)
+	normalizeSnapshot(snapshot)
+	require.Equal(suite.T(), "r1", snapshot.Users[1].ID)
+	assert.Equal(suite.T(), history, snapshot.Users[1].StatusHistory)
+	require.NotNil(suite.T(), snapshot.Users[1].OutOfOfficeUntil)
+	assert.Equal(suite.T(), mergedAt, *snapshot.Users[1].OutOfOfficeUntil)
+
+	imported, err := suite.repos.prs.GetByID(suite.ctx, "pr-1")
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "Imported", imported.Name)
+	assert.Equal(suite.T(), domain.PRStatusMerged, imported.Status)
+	assert.Equal(suite.T(), []string{"r2"}, imported.AssignedReviewers)
+	assert.True(suite.T(), createdAt.Equal(*imported.CreatedAt))
+	require.NotNil(suite.T(), imported.MergedAt)
+	assert.True(suite.T(), mergedAt.Equal(*imported.MergedAt))
+	// Перезапись меняет версию, чтобы If-Match со старой версией не прошел
+	assert.Equal(suite.T(), pr.Version+1, imported.Version)
+}
+
+func (suite *ConformanceTestSuite) TestTransfer_ImportRollsBackOnError() {
+	suite.createTeam("backend", "", "author", "r1")
+	suite.createPR("pr-1", "author", "r1")
+	before := exportAll(suite.T(), suite.ctx, suite.repos.transfer)
+
+	createdAt := time.Now()
+	rollback := errors.New("rollback")
+	err := suite.repos.transfer.Import(suite.ctx, func(store domain.ImportStore) error {
+		require.NoError(suite.T(), store.SaveTeam(suite.ctx, &domain.Team{Name: "frontend"}))
+		require.NoError(suite.T(), store.SaveUser(suite.ctx, &domain.User{ID: "u9", Username: "New", TeamName: "frontend", Skills: []string{}}))
+		require.NoError(suite.T(), store.SaveUser(suite.ctx, &domain.User{ID: "r1", Username: "Renamed", TeamName: "frontend", Skills: []string{"go"}}))
+		require.NoError(suite.T(), store.SavePR(suite.ctx, &domain.PullRequest{
+			ID: "pr-1", Name: "Imported", AuthorID: "author", Status: domain.PRStatusOpen,
+			AssignedReviewers: []string{"u9"}, CreatedAt: &createdAt,
+		}))
+
+		// Внутри транзакции изменения уже видны
+		exists, err := store.ExistsUser(suite.ctx, "u9")
+		require.NoError(suite.T(), err)
+		assert.True(suite.T(), exists)
+		return rollback
+	})
+	assert.ErrorIs(suite.T(), err, rollback)
+
+	after := exportAll(suite.T(), suite.ctx, suite.repos.transfer)
+	assert.Equal(suite.T(), before, after)
+}
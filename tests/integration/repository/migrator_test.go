@@ -0,0 +1,141 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"pr-reviewer-service/internal/database"
+	"pr-reviewer-service/internal/database/sqlitedb"
+
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/pressly/goose/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type MigratorTestSuite struct {
+	suite.Suite
+	db       *sql.DB
+	migrator *database.Migrator
+	ctx      context.Context
+}
+
+func (suite *MigratorTestSuite) SetupSuite() {
+	suite.ctx = context.Background()
+
+	dsn := fmt.Sprintf(
+		"postgres://%s:%s@%s:%s/%s?sslmode=disable",
+		"postgres", "password", "localhost", "5433", "pr_reviewer_test",
+	)
+
+	var err error
+	suite.db, err = sql.Open("pgx", dsn)
+	if err != nil {
+		log.Fatalf("Failed to connect to test database: %v", err)
+	}
+
+	err = suite.db.Ping()
+	if err != nil {
+		log.Fatalf("Failed to ping test database: %v", err)
+	}
+
+	suite.migrator, err = database.NewMigrator(suite.db)
+	if err != nil {
+		log.Fatalf("Failed to create migrator: %v", err)
+	}
+
+	_, err = suite.migrator.Up(suite.ctx)
+	if err != nil {
+		log.Fatalf("Failed to apply migrations: %v", err)
+	}
+}
+
+func (suite *MigratorTestSuite) TearDownSuite() {
+	if suite.db != nil {
+		suite.db.Close()
+	}
+}
+
+func (suite *MigratorTestSuite) TestUpToDateSchema() {
+	expected, err := database.ExpectedMigrationVersion()
+	suite.Require().NoError(err)
+
+	current, target, err := suite.migrator.Versions(suite.ctx)
+	suite.Require().NoError(err)
+	assert.Equal(suite.T(), expected, current)
+	assert.Equal(suite.T(), expected, target)
+	assert.NoError(suite.T(), suite.migrator.Check(suite.ctx))
+
+	statuses, err := suite.migrator.Status(suite.ctx)
+	suite.Require().NoError(err)
+	for _, status := range statuses {
+		assert.Equal(suite.T(), goose.StateApplied, status.State, status.Source.Path)
+	}
+}
+
+func (suite *MigratorTestSuite) TestConcurrentUpIsSerialized() {
+	// Реплики стартуют одновременно: advisory-блокировка не дает им применять миграции параллельно
+	var wg sync.WaitGroup
+	errs := make([]error, 3)
+	for i := range errs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			migrator, err := database.NewMigrator(suite.db)
+			if err == nil {
+				_, err = migrator.Up(suite.ctx)
+			}
+			errs[i] = err
+		}(i)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		assert.NoError(suite.T(), err)
+	}
+	assert.NoError(suite.T(), suite.migrator.Check(suite.ctx))
+}
+
+func (suite *MigratorTestSuite) TestRedoRestoresLatestVersion() {
+	results, err := suite.migrator.Redo(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().Len(results, 2)
+	assert.Equal(suite.T(), "down", results[0].Direction)
+	assert.Equal(suite.T(), "up", results[1].Direction)
+	assert.NoError(suite.T(), suite.migrator.Check(suite.ctx))
+}
+
+func TestMigratorTestSuite(t *testing.T) {
+	if os.Getenv("RUN_INTEGRATION_TESTS") != "1" {
+		t.Skip("Skipping integration test. Set RUN_INTEGRATION_TESTS=1 to run.")
+	}
+	suite.Run(t, new(MigratorTestSuite))
+}
+
+func TestSQLiteMigrator(t *testing.T) {
+	ctx := context.Background()
+	db, err := sqlitedb.Open(ctx, filepath.Join(t.TempDir(), "pr_reviewer.db"))
+	require.NoError(t, err)
+	defer db.Close()
+
+	migrator, err := sqlitedb.NewMigrator(db)
+	require.NoError(t, err)
+	assert.ErrorIs(t, migrator.Check(ctx), database.ErrSchemaBehind)
+
+	results, err := migrator.Up(ctx)
+	require.NoError(t, err)
+	assert.NotEmpty(t, results)
+	assert.NoError(t, migrator.Check(ctx))
+
+	results, err = migrator.Redo(ctx)
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	assert.NoError(t, migrator.Check(ctx))
+}
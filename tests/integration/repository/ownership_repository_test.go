@@ -0,0 +1,130 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"os"
+	"testing"
+
+	"pr-reviewer-service/internal/database"
+	"pr-reviewer-service/internal/domain"
+	"pr-reviewer-service/internal/repository"
+
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type OwnershipRepositoryTestSuite struct {
+	suite.Suite
+	db      *sql.DB
+	queries *database.Queries
+	repo    domain.OwnershipRepository
+	ctx     context.Context
+}
+
+func (suite *OwnershipRepositoryTestSuite) SetupSuite() {
+	suite.ctx = context.Background()
+
+	dsn := fmt.Sprintf(
+		"postgres://%s:%s@%s:%s/%s?sslmode=disable",
+		"postgres", "password", "localhost", "5433", "pr_reviewer_test",
+	)
+
+	var err error
+	suite.db, err = sql.Open("pgx", dsn)
+	if err != nil {
+		log.Fatalf("Failed to connect to test database: %v", err)
+	}
+
+	err = suite.db.Ping()
+	if err != nil {
+		log.Fatalf("Failed to ping test database: %v", err)
+	}
+
+	suite.queries = database.New(suite.db)
+	suite.repo = repository.NewOwnershipRepository(suite.db, suite.queries)
+
+	suite.cleanDatabase()
+}
+
+func (suite *OwnershipRepositoryTestSuite) TearDownTest() {
+	suite.cleanDatabase()
+}
+
+func (suite *OwnershipRepositoryTestSuite) TearDownSuite() {
+	if suite.db != nil {
+		suite.db.Close()
+	}
+}
+
+func (suite *OwnershipRepositoryTestSuite) cleanDatabase() {
+	tables := []string{"ownership_rule_owners", "ownership_rules"}
+	for _, table := range tables {
+		_, err := suite.db.ExecContext(suite.ctx, fmt.Sprintf("DELETE FROM %s", table))
+		if err != nil {
+			log.Printf("Failed to clean table %s: %v", table, err)
+		}
+	}
+}
+
+func (suite *OwnershipRepositoryTestSuite) TestReplaceAndGetRules() {
+	rules := []*domain.OwnershipRule{
+		{Pattern: "*", UserIDs: []string{}, TeamNames: []string{"backend"}},
+		{Pattern: "/docs/", UserIDs: []string{"u5", "u6"}, TeamNames: []string{}},
+		{Pattern: "*.sql", UserIDs: []string{"u7"}, TeamNames: []string{"platform"}},
+	}
+
+	err := suite.repo.ReplaceRules(suite.ctx, "search", rules)
+	assert.NoError(suite.T(), err)
+
+	result, err := suite.repo.GetRules(suite.ctx, "search")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 3, len(result))
+	assert.Equal(suite.T(), "*", result[0].Pattern)
+	assert.Equal(suite.T(), []string{"backend"}, result[0].TeamNames)
+	assert.Equal(suite.T(), "/docs/", result[1].Pattern)
+	assert.ElementsMatch(suite.T(), []string{"u5", "u6"}, result[1].UserIDs)
+	assert.Equal(suite.T(), "*.sql", result[2].Pattern)
+	assert.Equal(suite.T(), []string{"u7"}, result[2].UserIDs)
+	assert.Equal(suite.T(), []string{"platform"}, result[2].TeamNames)
+}
+
+func (suite *OwnershipRepositoryTestSuite) TestReplaceRules_OverwritesPrevious() {
+	err := suite.repo.ReplaceRules(suite.ctx, "search", []*domain.OwnershipRule{
+		{Pattern: "*", UserIDs: []string{"u1"}},
+		{Pattern: "/docs/", UserIDs: []string{"u5"}},
+	})
+	assert.NoError(suite.T(), err)
+
+	err = suite.repo.ReplaceRules(suite.ctx, "search", []*domain.OwnershipRule{
+		{Pattern: "*.go", TeamNames: []string{"backend"}},
+	})
+	assert.NoError(suite.T(), err)
+
+	result, err := suite.repo.GetRules(suite.ctx, "search")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 1, len(result))
+	assert.Equal(suite.T(), "*.go", result[0].Pattern)
+	assert.Empty(suite.T(), result[0].UserIDs)
+}
+
+func (suite *OwnershipRepositoryTestSuite) TestGetRules_IsolatedByRepository() {
+	err := suite.repo.ReplaceRules(suite.ctx, "search", []*domain.OwnershipRule{
+		{Pattern: "*", UserIDs: []string{"u1"}},
+	})
+	assert.NoError(suite.T(), err)
+
+	result, err := suite.repo.GetRules(suite.ctx, "billing")
+	assert.NoError(suite.T(), err)
+	assert.Empty(suite.T(), result)
+}
+
+func TestOwnershipRepositoryTestSuite(t *testing.T) {
+	if os.Getenv("RUN_INTEGRATION_TESTS") != "1" {
+		t.Skip("Skipping integration test. Set RUN_INTEGRATION_TESTS=1 to run.")
+	}
+	suite.Run(t, new(OwnershipRepositoryTestSuite))
+}
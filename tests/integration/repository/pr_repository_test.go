@@ -198,7 +198,7 @@ func (suite *PRRepositoryTestSuite) TestMerge_Success() {
 	assert.NoError(suite.T(), err)
 
 	// Мержим PR
-	mergedPR, err := suite.repo.Merge(suite.ctx, "pr-004")
+	mergedPR, _, err := suite.repo.Merge(suite.ctx, "pr-004", 0)
 	assert.NoError(suite.T(), err)
 	assert.Equal(suite.T(), "pr-004", mergedPR.ID)
 	assert.Equal(suite.T(), "MERGED", mergedPR.Status)
@@ -223,13 +223,13 @@ func (suite *PRRepositoryTestSuite) TestMerge_AlreadyMerged() {
 	assert.NoError(suite.T(), err)
 
 	// Первый мерж
-	mergedPR1, err := suite.repo.Merge(suite.ctx, "pr-005")
+	mergedPR1, _, err := suite.repo.Merge(suite.ctx, "pr-005", 0)
 	assert.NoError(suite.T(), err)
 	firstMergeTime := mergedPR1.MergedAt
 
 	// Второй мерж (идемпотентный)
 	time.Sleep(100 * time.Millisecond) // Чтобы время было разное
-	mergedPR2, err := suite.repo.Merge(suite.ctx, "pr-005")
+	mergedPR2, _, err := suite.repo.Merge(suite.ctx, "pr-005", 0)
 	assert.NoError(suite.T(), err)
 
 	// Проверяем что merged_at не изменился при повторном мерже
@@ -248,7 +248,7 @@ func (suite *PRRepositoryTestSuite) TestReassignReviewer_Success() {
 	assert.NoError(suite.T(), err)
 
 	// Переназначаем ревьювера
-	err = suite.repo.ReassignReviewer(suite.ctx, "pr-006", "backend_reviewer1", "backend_reviewer2")
+	err = suite.repo.ReassignReviewer(suite.ctx, "pr-006", "backend_reviewer1", "backend_reviewer2", 0)
 	assert.NoError(suite.T(), err)
 
 	// Проверяем что ревьювер изменился
@@ -259,6 +259,60 @@ func (suite *PRRepositoryTestSuite) TestReassignReviewer_Success() {
 	assert.NotContains(suite.T(), reviewers, "backend_reviewer1")
 }
 
+func (suite *PRRepositoryTestSuite) TestRationale_SavedAndUpdatedOnReassign() {
+	pr := &domain.PullRequest{
+		ID:       "pr-001",
+		Name:     "Test PR",
+		AuthorID: "backend_author",
+		Status:   "OPEN",
+		Rationale: &domain.AssignmentRationale{
+			Strategy:          "skills",
+			CandidatePoolSize: 2,
+			Reviewers: []*domain.ReviewerSelection{
+				{UserID: "backend_reviewer1", Reason: domain.SelectionSkillMatch, MatchedTags: []string{"go", "sql"}, OpenReviews: 1, SkillScore: 0.7, WorkloadScore: 0.15},
+			},
+			Excluded: []*domain.ExcludedCandidate{
+				{UserID: "backend_author", Reason: domain.ExclusionAuthor},
+				{UserID: "backend_inactive", Reason: domain.ExclusionInactive},
+			},
+		},
+	}
+
+	err := suite.repo.CreateWithReviewers(suite.ctx, pr, []string{"backend_reviewer1"})
+	assert.NoError(suite.T(), err)
+
+	rationale, err := suite.repo.GetRationale(suite.ctx, "pr-001")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "skills", rationale.Strategy)
+	assert.Equal(suite.T(), 2, rationale.CandidatePoolSize)
+	assert.Equal(suite.T(), []string{"go", "sql"}, rationale.Reviewers[0].MatchedTags)
+	assert.InDelta(suite.T(), 0.85, rationale.Reviewers[0].Score, 1e-9)
+	assert.Equal(suite.T(), 2, len(rationale.Excluded))
+
+	err = suite.repo.ReassignReviewer(suite.ctx, "pr-001", "backend_reviewer1", "backend_reviewer2", 0)
+	assert.NoError(suite.T(), err)
+
+	rationale, err = suite.repo.GetRationale(suite.ctx, "pr-001")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "backend_reviewer2", rationale.Reviewers[0].UserID)
+	assert.Equal(suite.T(), domain.SelectionReassignment, rationale.Reviewers[0].Reason)
+	assert.Empty(suite.T(), rationale.Reviewers[0].MatchedTags)
+}
+
+func (suite *PRRepositoryTestSuite) TestGetRationale_Missing() {
+	err := suite.repo.CreateWithReviewers(suite.ctx, &domain.PullRequest{
+		ID:       "pr-legacy",
+		Name:     "Legacy PR",
+		AuthorID: "backend_author",
+		Status:   "OPEN",
+	}, []string{"backend_reviewer1"})
+	assert.NoError(suite.T(), err)
+
+	rationale, err := suite.repo.GetRationale(suite.ctx, "pr-legacy")
+	assert.NoError(suite.T(), err)
+	assert.Nil(suite.T(), rationale)
+}
+
 func (suite *PRRepositoryTestSuite) TestGetUserAssignedPRs() {
 	// Создаем PR где пользователь ревьювер
 	pr1 := &domain.PullRequest{
@@ -281,7 +335,7 @@ func (suite *PRRepositoryTestSuite) TestGetUserAssignedPRs() {
 	assert.NoError(suite.T(), err)
 
 	// Получаем PR назначенные пользователю
-	userPRs, err := suite.repo.GetUserAssignedPRs(suite.ctx, "backend_reviewer1")
+	userPRs, err := suite.repo.GetUserAssignedPRs(suite.ctx, "backend_reviewer1", nil, 10)
 	assert.NoError(suite.T(), err)
 	assert.Equal(suite.T(), 2, len(userPRs))
 
@@ -295,7 +349,7 @@ func (suite *PRRepositoryTestSuite) TestGetUserAssignedPRs() {
 }
 
 func (suite *PRRepositoryTestSuite) TestGetUserAssignedPRs_Empty() {
-	userPRs, err := suite.repo.GetUserAssignedPRs(suite.ctx, "user_without_prs")
+	userPRs, err := suite.repo.GetUserAssignedPRs(suite.ctx, "user_without_prs", nil, 10)
 	assert.NoError(suite.T(), err)
 	assert.Empty(suite.T(), userPRs)
 }
@@ -342,6 +396,84 @@ func (suite *PRRepositoryTestSuite) TestGetReviewers() {
 	assert.Contains(suite.T(), reviewers, "backend_reviewer2")
 }
 
+func (suite *PRRepositoryTestSuite) TestList_FiltersAndKeysetPagination() {
+	prs := []struct {
+		id        string
+		author    string
+		reviewers []string
+	}{
+		{id: "pr-list-1", author: "backend_author", reviewers: []string{"backend_reviewer1"}},
+		{id: "pr-list-2", author: "frontend_author", reviewers: []string{"frontend_reviewer1"}},
+		{id: "pr-list-3", author: "backend_author", reviewers: []string{"backend_reviewer2", "backend_reviewer1"}},
+	}
+	for _, p := range prs {
+		err := suite.repo.CreateWithReviewers(suite.ctx, &domain.PullRequest{ID: p.id, Name: p.id, AuthorID: p.author}, p.reviewers)
+		suite.Require().NoError(err)
+	}
+	_, _, err := suite.repo.Merge(suite.ctx, "pr-list-1", 0)
+	suite.Require().NoError(err)
+
+	// Без фильтров: от новых к старым, ревьюверы в алфавитном порядке
+	all, err := suite.repo.List(suite.ctx, &domain.PRListFilter{}, nil, 10)
+	suite.Require().NoError(err)
+	suite.Require().Len(all, 3)
+	assert.Equal(suite.T(), "pr-list-3", all[0].ID)
+	assert.Equal(suite.T(), []string{"backend_reviewer1", "backend_reviewer2"}, all[0].AssignedReviewers)
+	assert.NotNil(suite.T(), all[0].CreatedAt)
+
+	// Фильтры
+	byTeam, err := suite.repo.List(suite.ctx, &domain.PRListFilter{TeamName: "backend", Status: "OPEN"}, nil, 10)
+	suite.Require().NoError(err)
+	suite.Require().Len(byTeam, 1)
+	assert.Equal(suite.T(), "pr-list-3", byTeam[0].ID)
+
+	byReviewer, err := suite.repo.List(suite.ctx, &domain.PRListFilter{ReviewerID: "backend_reviewer1"}, nil, 10)
+	suite.Require().NoError(err)
+	assert.Len(suite.T(), byReviewer, 2)
+
+	// Страница после курсора продолжает выдачу без повторов
+	first, err := suite.repo.List(suite.ctx, &domain.PRListFilter{}, nil, 2)
+	suite.Require().NoError(err)
+	suite.Require().Len(first, 2)
+	last := first[1]
+	rest, err := suite.repo.List(suite.ctx, &domain.PRListFilter{}, &domain.PRCursor{CreatedAt: *last.CreatedAt, ID: last.ID}, 2)
+	suite.Require().NoError(err)
+	suite.Require().Len(rest, 1)
+	assert.Equal(suite.T(), all[2].ID, rest[0].ID)
+}
+
+func (suite *PRRepositoryTestSuite) TestBatchLoads() {
+	prs := []struct {
+		id        string
+		reviewers []string
+	}{
+		{id: "pr-batch-1", reviewers: []string{"backend_reviewer1"}},
+		{id: "pr-batch-2", reviewers: []string{"backend_reviewer2", "backend_reviewer1"}},
+	}
+	for _, p := range prs {
+		err := suite.repo.CreateWithReviewers(suite.ctx, &domain.PullRequest{ID: p.id, Name: p.id, AuthorID: "backend_author"}, p.reviewers)
+		suite.Require().NoError(err)
+	}
+
+	found, err := suite.repo.GetByIDs(suite.ctx, []string{"pr-batch-2", "pr-missing", "pr-batch-1"})
+	suite.Require().NoError(err)
+	suite.Require().Len(found, 2)
+	assert.Equal(suite.T(), "pr-batch-1", found[0].ID)
+	assert.Equal(suite.T(), []string{"backend_reviewer1", "backend_reviewer2"}, found[1].AssignedReviewers)
+
+	// Пользователи без назначений в результат не попадают, PR идут от новых к старым
+	assigned, err := suite.repo.GetAssignedToUsers(suite.ctx, []string{"backend_reviewer1", "backend_reviewer2", "backend_author"})
+	suite.Require().NoError(err)
+	suite.Require().Len(assigned, 2)
+	suite.Require().Len(assigned["backend_reviewer1"], 2)
+	assert.Equal(suite.T(), "pr-batch-2", assigned["backend_reviewer1"][0].ID)
+	assert.Equal(suite.T(), "pr-batch-2", assigned["backend_reviewer2"][0].ID)
+
+	empty, err := suite.repo.GetByIDs(suite.ctx, []string{})
+	suite.Require().NoError(err)
+	assert.Empty(suite.T(), empty)
+}
+
 func TestPRRepositoryTestSuite(t *testing.T) {
 	if os.Getenv("RUN_INTEGRATION_TESTS") != "1" {
 		t.Skip("Skipping integration test. Set RUN_INTEGRATION_TESTS=1 to run.")
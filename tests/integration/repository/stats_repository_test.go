@@ -166,7 +166,7 @@ func (suite *StatsRepositoryTestSuite) setupTestData() {
 }
 
 func (suite *StatsRepositoryTestSuite) TestGetReviewStats() {
-	stats, err := suite.repo.GetStatsReviews(suite.ctx)
+	stats, err := suite.repo.GetStatsReviews(suite.ctx, &domain.StatsFilter{})
 	assert.NoError(suite.T(), err)
 	assert.NotNil(suite.T(), stats)
 
@@ -197,13 +197,13 @@ func (suite *StatsRepositoryTestSuite) TestGetReviewStats_Empty() {
 	// Очищаем БД и проверяем пустую статистику
 	suite.cleanDatabase()
 
-	stats, err := suite.repo.GetStatsReviews(suite.ctx)
+	stats, err := suite.repo.GetStatsReviews(suite.ctx, &domain.StatsFilter{})
 	assert.NoError(suite.T(), err)
 	assert.Empty(suite.T(), stats)
 }
 
 func (suite *StatsRepositoryTestSuite) TestGetPRAssignmentStats() {
-	stats, err := suite.repo.GetStatsPrAssignments(suite.ctx)
+	stats, err := suite.repo.GetStatsPrAssignments(suite.ctx, &domain.StatsFilter{})
 	assert.NoError(suite.T(), err)
 	assert.NotNil(suite.T(), stats)
 
@@ -229,13 +229,13 @@ func (suite *StatsRepositoryTestSuite) TestGetPRAssignmentStats_Empty() {
 	// Очищаем БД и проверяем пустую статистику
 	suite.cleanDatabase()
 
-	stats, err := suite.repo.GetStatsPrAssignments(suite.ctx)
+	stats, err := suite.repo.GetStatsPrAssignments(suite.ctx, &domain.StatsFilter{})
 	assert.NoError(suite.T(), err)
 	assert.Empty(suite.T(), stats)
 }
 
 func (suite *StatsRepositoryTestSuite) TestGetReviewStats_IncludesAllUsers() {
-	stats, err := suite.repo.GetStatsReviews(suite.ctx)
+	stats, err := suite.repo.GetStatsReviews(suite.ctx, &domain.StatsFilter{})
 	assert.NoError(suite.T(), err)
 
 	// Проверяем что в статистике есть все пользователи (даже с 0 назначений)
@@ -255,6 +255,25 @@ func (suite *StatsRepositoryTestSuite) TestGetReviewStats_IncludesAllUsers() {
 	}
 }
 
+func (suite *StatsRepositoryTestSuite) TestOpenWorkload() {
+	_, err := suite.queries.MergePullRequest(suite.ctx, "pr-one-review")
+	suite.Require().NoError(err)
+
+	openPRs, err := suite.repo.CountOpenPRs(suite.ctx)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(3), openPRs)
+
+	loads, err := suite.repo.GetTeamReviewLoad(suite.ctx)
+	assert.NoError(suite.T(), err)
+
+	teamLoad := make(map[string]int64)
+	for _, load := range loads {
+		teamLoad[load.TeamName] = load.OpenReviews
+	}
+	// Ревью на смерженном PR не учитываются
+	assert.Equal(suite.T(), map[string]int64{"backend": 3, "frontend": 2}, teamLoad)
+}
+
 func TestStatsRepositoryTestSuite(t *testing.T) {
 	if os.Getenv("RUN_INTEGRATION_TESTS") != "1" {
 		t.Skip("Skipping integration test. Set RUN_INTEGRATION_TESTS=1 to run.")
@@ -270,6 +270,86 @@ func (suite *TeamRepositoryTestSuite) TestCreateTeam_EmptyMembers() {
 	assert.Empty(suite.T(), users)
 }
 
+func (suite *TeamRepositoryTestSuite) TestTeamHierarchy() {
+	department := &domain.Team{
+		Name:    "fintech",
+		Members: []*domain.User{{ID: "head", Username: "Head", TeamName: "fintech", IsActive: true}},
+	}
+	err := suite.repo.Create(suite.ctx, department)
+	assert.NoError(suite.T(), err)
+
+	for _, name := range []string{"payments", "billing"} {
+		err = suite.repo.Create(suite.ctx, &domain.Team{
+			Name:       name,
+			ParentName: "fintech",
+			Members:    []*domain.User{{ID: name + "_dev", Username: name, TeamName: name, IsActive: true}},
+		})
+		assert.NoError(suite.T(), err)
+	}
+
+	parent, err := suite.repo.GetParent(suite.ctx, "payments")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "fintech", parent)
+
+	children, err := suite.repo.GetChildren(suite.ctx, "fintech")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []string{"billing", "payments"}, children)
+
+	team, err := suite.repo.GetByName(suite.ctx, "billing")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "fintech", team.ParentName)
+
+	// Делаем billing корневой командой
+	err = suite.repo.SetParent(suite.ctx, "billing", "")
+	assert.NoError(suite.T(), err)
+
+	parent, err = suite.repo.GetParent(suite.ctx, "billing")
+	assert.NoError(suite.T(), err)
+	assert.Empty(suite.T(), parent)
+
+	_, err = suite.repo.GetParent(suite.ctx, "nonexistent")
+	assert.ErrorIs(suite.T(), err, domain.ErrTeamNotFound)
+}
+
+func (suite *TeamRepositoryTestSuite) TestGetTeamTreeStats() {
+	err := suite.repo.Create(suite.ctx, &domain.Team{
+		Name: "fintech",
+		Members: []*domain.User{
+			{ID: "head", Username: "Head", TeamName: "fintech", IsActive: true},
+			{ID: "former", Username: "Former", TeamName: "fintech", IsActive: false},
+		},
+	})
+	assert.NoError(suite.T(), err)
+
+	err = suite.repo.Create(suite.ctx, &domain.Team{
+		Name:       "payments",
+		ParentName: "fintech",
+		Members:    []*domain.User{{ID: "dev", Username: "Dev", TeamName: "payments", IsActive: true}},
+	})
+	assert.NoError(suite.T(), err)
+
+	_, err = suite.queries.CreatePullRequest(suite.ctx, database.CreatePullRequestParams{
+		PullRequestID: "pr-tree", PullRequestName: "Tree PR", AuthorID: "dev",
+	})
+	assert.NoError(suite.T(), err)
+	err = suite.queries.AssignReviewer(suite.ctx, database.AssignReviewerParams{
+		PullRequestID: "pr-tree", UserID: "head",
+	})
+	assert.NoError(suite.T(), err)
+
+	nodes, err := suite.repo.GetTeamTreeStats(suite.ctx)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 2, len(nodes))
+
+	assert.Equal(suite.T(), "fintech", nodes[0].Name)
+	assert.Empty(suite.T(), nodes[0].ParentName)
+	assert.Equal(suite.T(), domain.TeamStats{MembersCount: 2, ActiveMembersCount: 1, OpenReviewsCount: 1}, nodes[0].Stats)
+
+	assert.Equal(suite.T(), "payments", nodes[1].Name)
+	assert.Equal(suite.T(), "fintech", nodes[1].ParentName)
+	assert.Equal(suite.T(), domain.TeamStats{MembersCount: 1, ActiveMembersCount: 1, OpenPRsCount: 1}, nodes[1].Stats)
+}
+
 func TestTeamRepositoryTestSuite(t *testing.T) {
 	if os.Getenv("RUN_INTEGRATION_TESTS") != "1" {
 		t.Skip("Skipping integration test. Set RUN_INTEGRATION_TESTS=1 to run.")
@@ -242,6 +242,42 @@ func (suite *UserRepositoryTestSuite) TestGetUserTeam_UserNotFound() {
 	assert.Equal(suite.T(), domain.ErrUserNotFound, err)
 }
 
+func (suite *UserRepositoryTestSuite) TestSetSkills_ReplacesPrevious() {
+	err := suite.repo.SetSkills(suite.ctx, "backend_user1", []string{"go", "postgres"})
+	assert.NoError(suite.T(), err)
+
+	err = suite.repo.SetSkills(suite.ctx, "backend_user1", []string{"kafka", "go"})
+	assert.NoError(suite.T(), err)
+
+	skills, err := suite.repo.GetSkills(suite.ctx, "backend_user1")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []string{"go", "kafka"}, skills)
+}
+
+func (suite *UserRepositoryTestSuite) TestGetTeamRoster_SkillsAndWorkload() {
+	err := suite.repo.SetSkills(suite.ctx, "backend_user2", []string{"postgres"})
+	assert.NoError(suite.T(), err)
+
+	prRepo := repository.NewPRRepository(suite.db, suite.queries)
+	err = prRepo.CreateWithReviewers(suite.ctx, &domain.PullRequest{
+		ID:       "pr-1",
+		Name:     "Open PR",
+		AuthorID: "frontend_user1",
+		Status:   "OPEN",
+	}, []string{"backend_user2"})
+	assert.NoError(suite.T(), err)
+
+	roster, err := suite.repo.GetTeamRoster(suite.ctx, "backend")
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 3, len(roster))
+	assert.Equal(suite.T(), "backend_user2", roster[1].User.ID)
+	assert.Equal(suite.T(), []string{"postgres"}, roster[1].User.Skills)
+	assert.Equal(suite.T(), 1, roster[1].OpenReviews)
+	assert.Equal(suite.T(), 0, roster[0].OpenReviews)
+	assert.False(suite.T(), roster[2].User.IsActive)
+}
+
 func TestUserRepositoryTestSuite(t *testing.T) {
 	if os.Getenv("RUN_INTEGRATION_TESTS") != "1" {
 		t.Skip("Skipping integration test. Set RUN_INTEGRATION_TESTS=1 to run.")
@@ -1,14 +1,17 @@
 package main
 
 import (
-	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"math/rand"
 	"net/http"
 	"time"
 
+	"pr-reviewer-service/client"
+
 	vegeta "github.com/tsenart/vegeta/v12/lib"
 )
 
@@ -18,80 +21,46 @@ const (
 	duration   = 3 * time.Minute // ← теперь 3 минуты
 )
 
-type TeamMember struct {
-	UserID   string `json:"user_id"`
-	Username string `json:"username"`
-	IsActive bool   `json:"is_active"`
-}
-
-type Team struct {
-	TeamName string       `json:"team_name"`
-	Members  []TeamMember `json:"members"`
-}
-
-type PRCreateRequest struct {
-	PullRequestID   string `json:"pull_request_id"`
-	PullRequestName string `json:"pull_request_name"`
-	AuthorID        string `json:"author_id"`
-}
-
 var (
 	teams []string
 	users []string
 	prs   []string
-	httpc = &http.Client{Timeout: 10 * time.Second}
 )
 
-func postJSON(url string, body any) (int, error) {
-	b, _ := json.Marshal(body)
-	req, _ := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(b))
-	req.Header.Set("Content-Type", "application/json")
-	resp, err := httpc.Do(req)
-	if err != nil {
-		return 0, err
-	}
-	defer resp.Body.Close()
-	return resp.StatusCode, nil
-}
-
-func getURL(url string) (int, error) {
-	req, _ := http.NewRequest(http.MethodGet, url, nil)
-	resp, err := httpc.Do(req)
+// Seed
+func seedData() error {
+	api, err := client.New(targetHost, client.WithTimeout(10*time.Second))
 	if err != nil {
-		return 0, err
+		return err
 	}
-	defer resp.Body.Close()
-	return resp.StatusCode, nil
-}
+	ctx := context.Background()
 
-// Seed
-func seedData() error {
 	log.Println("Seeding: creating teams and users...")
 
 	for t := 1; t <= 20; t++ {
 		teamName := fmt.Sprintf("team-%02d", t)
-		var members []TeamMember
+		var members []client.TeamMember
 		for u := 1; u <= 10; u++ {
 			uid := fmt.Sprintf("u-%d-%d", t, u)
-			members = append(members, TeamMember{
-				UserID:   uid,
+			members = append(members, client.TeamMember{
+				UserId:   uid,
 				Username: fmt.Sprintf("User_%d_%d", t, u),
 				IsActive: true,
 			})
 			users = append(users, uid)
 		}
 
-		team := Team{
+		team := client.Team{
 			TeamName: teamName,
 			Members:  members,
 		}
 
-		status, err := postJSON(targetHost+"/team/add", team)
-		if err != nil {
-			return err
-		}
-		if status >= 400 {
-			log.Printf("WARN team/add returned %d\n", status)
+		if _, err := api.CreateTeam(ctx, team); err != nil {
+			var apiErr *client.Error
+			if !errors.As(err, &apiErr) {
+				return err
+			}
+			log.Printf("WARN team/add returned %s\n", apiErr)
 		}
 
 		teams = append(teams, teamName)
@@ -103,18 +72,18 @@ func seedData() error {
 	prCounter := 1
 	for _, uid := range users {
 		prID := fmt.Sprintf("pr-%04d", prCounter)
-		req := PRCreateRequest{
-			PullRequestID:   prID,
+		req := client.PostPullRequestCreateJSONRequestBody{
+			PullRequestId:   prID,
 			PullRequestName: fmt.Sprintf("PR %d by %s", prCounter, uid),
-			AuthorID:        uid,
+			AuthorId:        uid,
 		}
 
-		status, err := postJSON(targetHost+"/pullRequest/create", req)
-		if err != nil {
-			return err
-		}
-		if status >= 400 {
-			log.Printf("WARN pullRequest/create returned %d\n", status)
+		if _, err := api.CreatePullRequest(ctx, req); err != nil {
+			var apiErr *client.Error
+			if !errors.As(err, &apiErr) {
+				return err
+			}
+			log.Printf("WARN pullRequest/create returned %s\n", apiErr)
 		}
 
 		prs = append(prs, prID)
@@ -154,9 +123,9 @@ func makeTargeter() vegeta.Targeter {
 		// 3% POST team/add
 		if r < 0.98 {
 			teamName := fmt.Sprintf("loadteam-%d", time.Now().UnixNano())
-			body, _ := json.Marshal(Team{
+			body, _ := json.Marshal(client.Team{
 				TeamName: teamName,
-				Members:  []TeamMember{},
+				Members:  []client.TeamMember{},
 			})
 			t.Method = http.MethodPost
 			t.URL = targetHost + "/team/add"
@@ -169,10 +138,10 @@ func makeTargeter() vegeta.Targeter {
 		if r < 0.995 {
 			uid := users[rand.Intn(len(users))]
 			prID := fmt.Sprintf("loadpr-%d", time.Now().UnixNano())
-			body, _ := json.Marshal(PRCreateRequest{
-				PullRequestID:   prID,
+			body, _ := json.Marshal(client.PostPullRequestCreateJSONRequestBody{
+				PullRequestId:   prID,
 				PullRequestName: "Load PR",
-				AuthorID:        uid,
+				AuthorId:        uid,
 			})
 			t.Method = http.MethodPost
 			t.URL = targetHost + "/pullRequest/create"
@@ -183,7 +152,7 @@ func makeTargeter() vegeta.Targeter {
 
 		// 0.5% merge
 		pr := prs[rand.Intn(len(prs))]
-		body, _ := json.Marshal(map[string]string{"pull_request_id": pr})
+		body, _ := json.Marshal(client.PostPullRequestMergeJSONRequestBody{PullRequestId: pr})
 		t.Method = http.MethodPost
 		t.URL = targetHost + "/pullRequest/merge"
 		t.Body = body
@@ -0,0 +1,49 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import mock "github.com/stretchr/testify/mock"
+
+// MetricsRecorder is an autogenerated mock type for the MetricsRecorder type
+type MetricsRecorder struct {
+	mock.Mock
+}
+
+// DeactivationFailed provides a mock function with given fields: stage
+func (_m *MetricsRecorder) DeactivationFailed(stage string) {
+	_m.Called(stage)
+}
+
+// NoReviewerCandidate provides a mock function with no fields
+func (_m *MetricsRecorder) NoReviewerCandidate() {
+	_m.Called()
+}
+
+// PRCreated provides a mock function with no fields
+func (_m *MetricsRecorder) PRCreated() {
+	_m.Called()
+}
+
+// PRMerged provides a mock function with no fields
+func (_m *MetricsRecorder) PRMerged() {
+	_m.Called()
+}
+
+// ReviewerReassigned provides a mock function with no fields
+func (_m *MetricsRecorder) ReviewerReassigned() {
+	_m.Called()
+}
+
+// NewMetricsRecorder creates a new instance of MetricsRecorder. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMetricsRecorder(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MetricsRecorder {
+	mock := &MetricsRecorder{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,89 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "pr-reviewer-service/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// OwnershipUseCase is an autogenerated mock type for the OwnershipUseCase type
+type OwnershipUseCase struct {
+	mock.Mock
+}
+
+// GetRules provides a mock function with given fields: ctx, repository
+func (_m *OwnershipUseCase) GetRules(ctx context.Context, repository string) ([]*domain.OwnershipRule, error) {
+	ret := _m.Called(ctx, repository)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRules")
+	}
+
+	var r0 []*domain.OwnershipRule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*domain.OwnershipRule, error)); ok {
+		return rf(ctx, repository)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*domain.OwnershipRule); ok {
+		r0 = rf(ctx, repository)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.OwnershipRule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, repository)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetRules provides a mock function with given fields: ctx, repository, rules
+func (_m *OwnershipUseCase) SetRules(ctx context.Context, repository string, rules []*domain.OwnershipRule) ([]*domain.OwnershipRule, error) {
+	ret := _m.Called(ctx, repository, rules)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetRules")
+	}
+
+	var r0 []*domain.OwnershipRule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []*domain.OwnershipRule) ([]*domain.OwnershipRule, error)); ok {
+		return rf(ctx, repository, rules)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []*domain.OwnershipRule) []*domain.OwnershipRule); ok {
+		r0 = rf(ctx, repository, rules)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.OwnershipRule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []*domain.OwnershipRule) error); ok {
+		r1 = rf(ctx, repository, rules)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewOwnershipUseCase creates a new instance of OwnershipUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewOwnershipUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *OwnershipUseCase {
+	mock := &OwnershipUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
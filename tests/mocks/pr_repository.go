@@ -60,6 +60,36 @@ func (_m *PRRepository) ExistsPr(ctx context.Context, prID string) (bool, error)
 	return r0, r1
 }
 
+// GetAssignedToUsers provides a mock function with given fields: ctx, userIDs
+func (_m *PRRepository) GetAssignedToUsers(ctx context.Context, userIDs []string) (map[string][]*domain.PullRequest, error) {
+	ret := _m.Called(ctx, userIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAssignedToUsers")
+	}
+
+	var r0 map[string][]*domain.PullRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) (map[string][]*domain.PullRequest, error)); ok {
+		return rf(ctx, userIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) map[string][]*domain.PullRequest); ok {
+		r0 = rf(ctx, userIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string][]*domain.PullRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, userIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetByID provides a mock function with given fields: ctx, prID
 func (_m *PRRepository) GetByID(ctx context.Context, prID string) (*domain.PullRequest, error) {
 	ret := _m.Called(ctx, prID)
@@ -90,6 +120,66 @@ func (_m *PRRepository) GetByID(ctx context.Context, prID string) (*domain.PullR
 	return r0, r1
 }
 
+// GetByIDs provides a mock function with given fields: ctx, prIDs
+func (_m *PRRepository) GetByIDs(ctx context.Context, prIDs []string) ([]*domain.PullRequest, error) {
+	ret := _m.Called(ctx, prIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByIDs")
+	}
+
+	var r0 []*domain.PullRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) ([]*domain.PullRequest, error)); ok {
+		return rf(ctx, prIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []*domain.PullRequest); ok {
+		r0 = rf(ctx, prIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.PullRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, prIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRationale provides a mock function with given fields: ctx, prID
+func (_m *PRRepository) GetRationale(ctx context.Context, prID string) (*domain.AssignmentRationale, error) {
+	ret := _m.Called(ctx, prID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRationale")
+	}
+
+	var r0 *domain.AssignmentRationale
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*domain.AssignmentRationale, error)); ok {
+		return rf(ctx, prID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domain.AssignmentRationale); ok {
+		r0 = rf(ctx, prID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.AssignmentRationale)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, prID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetReviewers provides a mock function with given fields: ctx, prID
 func (_m *PRRepository) GetReviewers(ctx context.Context, prID string) ([]string, error) {
 	ret := _m.Called(ctx, prID)
@@ -120,9 +210,9 @@ func (_m *PRRepository) GetReviewers(ctx context.Context, prID string) ([]string
 	return r0, r1
 }
 
-// GetUserAssignedPRs provides a mock function with given fields: ctx, userID
-func (_m *PRRepository) GetUserAssignedPRs(ctx context.Context, userID string) ([]*domain.PullRequest, error) {
-	ret := _m.Called(ctx, userID)
+// GetUserAssignedPRs provides a mock function with given fields: ctx, userID, after, limit
+func (_m *PRRepository) GetUserAssignedPRs(ctx context.Context, userID string, after *domain.PRCursor, limit int) ([]*domain.PullRequest, error) {
+	ret := _m.Called(ctx, userID, after, limit)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetUserAssignedPRs")
@@ -130,19 +220,19 @@ func (_m *PRRepository) GetUserAssignedPRs(ctx context.Context, userID string) (
 
 	var r0 []*domain.PullRequest
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*domain.PullRequest, error)); ok {
-		return rf(ctx, userID)
+	if rf, ok := ret.Get(0).(func(context.Context, string, *domain.PRCursor, int) ([]*domain.PullRequest, error)); ok {
+		return rf(ctx, userID, after, limit)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) []*domain.PullRequest); ok {
-		r0 = rf(ctx, userID)
+	if rf, ok := ret.Get(0).(func(context.Context, string, *domain.PRCursor, int) []*domain.PullRequest); ok {
+		r0 = rf(ctx, userID, after, limit)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*domain.PullRequest)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, userID)
+	if rf, ok := ret.Get(1).(func(context.Context, string, *domain.PRCursor, int) error); ok {
+		r1 = rf(ctx, userID, after, limit)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -178,29 +268,29 @@ func (_m *PRRepository) IsUserReviewer(ctx context.Context, prID string, userID
 	return r0, r1
 }
 
-// Merge provides a mock function with given fields: ctx, prID
-func (_m *PRRepository) Merge(ctx context.Context, prID string) (*domain.PullRequest, error) {
-	ret := _m.Called(ctx, prID)
+// List provides a mock function with given fields: ctx, filter, after, limit
+func (_m *PRRepository) List(ctx context.Context, filter *domain.PRListFilter, after *domain.PRCursor, limit int) ([]*domain.PullRequest, error) {
+	ret := _m.Called(ctx, filter, after, limit)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Merge")
+		panic("no return value specified for List")
 	}
 
-	var r0 *domain.PullRequest
+	var r0 []*domain.PullRequest
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (*domain.PullRequest, error)); ok {
-		return rf(ctx, prID)
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.PRListFilter, *domain.PRCursor, int) ([]*domain.PullRequest, error)); ok {
+		return rf(ctx, filter, after, limit)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) *domain.PullRequest); ok {
-		r0 = rf(ctx, prID)
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.PRListFilter, *domain.PRCursor, int) []*domain.PullRequest); ok {
+		r0 = rf(ctx, filter, after, limit)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.PullRequest)
+			r0 = ret.Get(0).([]*domain.PullRequest)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, prID)
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.PRListFilter, *domain.PRCursor, int) error); ok {
+		r1 = rf(ctx, filter, after, limit)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -208,17 +298,54 @@ func (_m *PRRepository) Merge(ctx context.Context, prID string) (*domain.PullReq
 	return r0, r1
 }
 
-// ReassignReviewer provides a mock function with given fields: ctx, prID, oldReviewerID, newReviewerID
-func (_m *PRRepository) ReassignReviewer(ctx context.Context, prID string, oldReviewerID string, newReviewerID string) error {
-	ret := _m.Called(ctx, prID, oldReviewerID, newReviewerID)
+// Merge provides a mock function with given fields: ctx, prID, expectedVersion
+func (_m *PRRepository) Merge(ctx context.Context, prID string, expectedVersion int64) (*domain.PullRequest, bool, error) {
+	ret := _m.Called(ctx, prID, expectedVersion)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Merge")
+	}
+
+	var r0 *domain.PullRequest
+	var r1 bool
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) (*domain.PullRequest, bool, error)); ok {
+		return rf(ctx, prID, expectedVersion)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) *domain.PullRequest); ok {
+		r0 = rf(ctx, prID, expectedVersion)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.PullRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64) bool); ok {
+		r1 = rf(ctx, prID, expectedVersion)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, int64) error); ok {
+		r2 = rf(ctx, prID, expectedVersion)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ReassignReviewer provides a mock function with given fields: ctx, prID, oldReviewerID, newReviewerID, expectedVersion
+func (_m *PRRepository) ReassignReviewer(ctx context.Context, prID string, oldReviewerID string, newReviewerID string, expectedVersion int64) error {
+	ret := _m.Called(ctx, prID, oldReviewerID, newReviewerID, expectedVersion)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ReassignReviewer")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
-		r0 = rf(ctx, prID, oldReviewerID, newReviewerID)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, int64) error); ok {
+		r0 = rf(ctx, prID, oldReviewerID, newReviewerID, expectedVersion)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -14,9 +14,9 @@ type PRUseCase struct {
 	mock.Mock
 }
 
-// CreatePR provides a mock function with given fields: ctx, prID, prName, authorID
-func (_m *PRUseCase) CreatePR(ctx context.Context, prID string, prName string, authorID string) (*domain.PullRequest, error) {
-	ret := _m.Called(ctx, prID, prName, authorID)
+// CreatePR provides a mock function with given fields: ctx, req
+func (_m *PRUseCase) CreatePR(ctx context.Context, req *domain.CreatePRRequest) (*domain.PullRequest, error) {
+	ret := _m.Called(ctx, req)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CreatePR")
@@ -24,19 +24,19 @@ func (_m *PRUseCase) CreatePR(ctx context.Context, prID string, prName string, a
 
 	var r0 *domain.PullRequest
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (*domain.PullRequest, error)); ok {
-		return rf(ctx, prID, prName, authorID)
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.CreatePRRequest) (*domain.PullRequest, error)); ok {
+		return rf(ctx, req)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) *domain.PullRequest); ok {
-		r0 = rf(ctx, prID, prName, authorID)
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.CreatePRRequest) *domain.PullRequest); ok {
+		r0 = rf(ctx, req)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*domain.PullRequest)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
-		r1 = rf(ctx, prID, prName, authorID)
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.CreatePRRequest) error); ok {
+		r1 = rf(ctx, req)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -44,9 +44,69 @@ func (_m *PRUseCase) CreatePR(ctx context.Context, prID string, prName string, a
 	return r0, r1
 }
 
-// MergePR provides a mock function with given fields: ctx, prID
-func (_m *PRUseCase) MergePR(ctx context.Context, prID string) (*domain.PullRequest, error) {
-	ret := _m.Called(ctx, prID)
+// GetPR provides a mock function with given fields: ctx, prID, explain
+func (_m *PRUseCase) GetPR(ctx context.Context, prID string, explain bool) (*domain.PullRequest, error) {
+	ret := _m.Called(ctx, prID, explain)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPR")
+	}
+
+	var r0 *domain.PullRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool) (*domain.PullRequest, error)); ok {
+		return rf(ctx, prID, explain)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool) *domain.PullRequest); ok {
+		r0 = rf(ctx, prID, explain)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.PullRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, bool) error); ok {
+		r1 = rf(ctx, prID, explain)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListPRs provides a mock function with given fields: ctx, filter
+func (_m *PRUseCase) ListPRs(ctx context.Context, filter *domain.PRListFilter) (*domain.PRPage, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPRs")
+	}
+
+	var r0 *domain.PRPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.PRListFilter) (*domain.PRPage, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.PRListFilter) *domain.PRPage); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.PRPage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.PRListFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MergePR provides a mock function with given fields: ctx, prID, ifMatch
+func (_m *PRUseCase) MergePR(ctx context.Context, prID string, ifMatch int64) (*domain.PullRequest, error) {
+	ret := _m.Called(ctx, prID, ifMatch)
 
 	if len(ret) == 0 {
 		panic("no return value specified for MergePR")
@@ -54,19 +114,19 @@ func (_m *PRUseCase) MergePR(ctx context.Context, prID string) (*domain.PullRequ
 
 	var r0 *domain.PullRequest
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (*domain.PullRequest, error)); ok {
-		return rf(ctx, prID)
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) (*domain.PullRequest, error)); ok {
+		return rf(ctx, prID, ifMatch)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) *domain.PullRequest); ok {
-		r0 = rf(ctx, prID)
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) *domain.PullRequest); ok {
+		r0 = rf(ctx, prID, ifMatch)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*domain.PullRequest)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, prID)
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64) error); ok {
+		r1 = rf(ctx, prID, ifMatch)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -74,9 +134,9 @@ func (_m *PRUseCase) MergePR(ctx context.Context, prID string) (*domain.PullRequ
 	return r0, r1
 }
 
-// ReassignReviewer provides a mock function with given fields: ctx, prID, oldReviewerID
-func (_m *PRUseCase) ReassignReviewer(ctx context.Context, prID string, oldReviewerID string) (*domain.PullRequest, string, error) {
-	ret := _m.Called(ctx, prID, oldReviewerID)
+// ReassignReviewer provides a mock function with given fields: ctx, prID, oldReviewerID, ifMatch
+func (_m *PRUseCase) ReassignReviewer(ctx context.Context, prID string, oldReviewerID string, ifMatch int64) (*domain.PullRequest, string, error) {
+	ret := _m.Called(ctx, prID, oldReviewerID, ifMatch)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ReassignReviewer")
@@ -85,25 +145,25 @@ func (_m *PRUseCase) ReassignReviewer(ctx context.Context, prID string, oldRevie
 	var r0 *domain.PullRequest
 	var r1 string
 	var r2 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*domain.PullRequest, string, error)); ok {
-		return rf(ctx, prID, oldReviewerID)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int64) (*domain.PullRequest, string, error)); ok {
+		return rf(ctx, prID, oldReviewerID, ifMatch)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) *domain.PullRequest); ok {
-		r0 = rf(ctx, prID, oldReviewerID)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int64) *domain.PullRequest); ok {
+		r0 = rf(ctx, prID, oldReviewerID, ifMatch)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*domain.PullRequest)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, string) string); ok {
-		r1 = rf(ctx, prID, oldReviewerID)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int64) string); ok {
+		r1 = rf(ctx, prID, oldReviewerID, ifMatch)
 	} else {
 		r1 = ret.Get(1).(string)
 	}
 
-	if rf, ok := ret.Get(2).(func(context.Context, string, string) error); ok {
-		r2 = rf(ctx, prID, oldReviewerID)
+	if rf, ok := ret.Get(2).(func(context.Context, string, string, int64) error); ok {
+		r2 = rf(ctx, prID, oldReviewerID, ifMatch)
 	} else {
 		r2 = ret.Error(2)
 	}
@@ -7,6 +7,8 @@ import (
 	domain "pr-reviewer-service/internal/domain"
 
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // StatsRepository is an autogenerated mock type for the StatsRepository type
@@ -14,29 +16,87 @@ type StatsRepository struct {
 	mock.Mock
 }
 
-// GetStatsPrAssignments provides a mock function with given fields: ctx
-func (_m *StatsRepository) GetStatsPrAssignments(ctx context.Context) ([]*domain.PRAssignmentStat, error) {
+// CountOpenPRs provides a mock function with given fields: ctx
+func (_m *StatsRepository) CountOpenPRs(ctx context.Context) (int64, error) {
 	ret := _m.Called(ctx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetStatsPrAssignments")
+		panic("no return value specified for CountOpenPRs")
 	}
 
-	var r0 []*domain.PRAssignmentStat
+	var r0 int64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) ([]*domain.PRAssignmentStat, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context) (int64, error)); ok {
 		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) []*domain.PRAssignmentStat); ok {
+	if rf, ok := ret.Get(0).(func(context.Context) int64); ok {
 		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetMemberAssignments provides a mock function with given fields: ctx, teamName, from, to
+func (_m *StatsRepository) GetMemberAssignments(ctx context.Context, teamName string, from time.Time, to time.Time) ([]*domain.MemberAssignments, error) {
+	ret := _m.Called(ctx, teamName, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMemberAssignments")
+	}
+
+	var r0 []*domain.MemberAssignments
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time, time.Time) ([]*domain.MemberAssignments, error)); ok {
+		return rf(ctx, teamName, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time, time.Time) []*domain.MemberAssignments); ok {
+		r0 = rf(ctx, teamName, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.MemberAssignments)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, teamName, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStatsPrAssignments provides a mock function with given fields: ctx, filter
+func (_m *StatsRepository) GetStatsPrAssignments(ctx context.Context, filter *domain.StatsFilter) ([]*domain.PRAssignmentStat, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStatsPrAssignments")
+	}
+
+	var r0 []*domain.PRAssignmentStat
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.StatsFilter) ([]*domain.PRAssignmentStat, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.StatsFilter) []*domain.PRAssignmentStat); ok {
+		r0 = rf(ctx, filter)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*domain.PRAssignmentStat)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.StatsFilter) error); ok {
+		r1 = rf(ctx, filter)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -44,9 +104,9 @@ func (_m *StatsRepository) GetStatsPrAssignments(ctx context.Context) ([]*domain
 	return r0, r1
 }
 
-// GetStatsReviews provides a mock function with given fields: ctx
-func (_m *StatsRepository) GetStatsReviews(ctx context.Context) ([]*domain.ReviewStat, error) {
-	ret := _m.Called(ctx)
+// GetStatsReviews provides a mock function with given fields: ctx, filter
+func (_m *StatsRepository) GetStatsReviews(ctx context.Context, filter *domain.StatsFilter) ([]*domain.ReviewStat, error) {
+	ret := _m.Called(ctx, filter)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetStatsReviews")
@@ -54,14 +114,74 @@ func (_m *StatsRepository) GetStatsReviews(ctx context.Context) ([]*domain.Revie
 
 	var r0 []*domain.ReviewStat
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) ([]*domain.ReviewStat, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.StatsFilter) ([]*domain.ReviewStat, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.StatsFilter) []*domain.ReviewStat); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.ReviewStat)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.StatsFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStatsTeams provides a mock function with given fields: ctx, filter
+func (_m *StatsRepository) GetStatsTeams(ctx context.Context, filter *domain.StatsFilter) ([]*domain.TeamReviewStat, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStatsTeams")
+	}
+
+	var r0 []*domain.TeamReviewStat
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.StatsFilter) ([]*domain.TeamReviewStat, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.StatsFilter) []*domain.TeamReviewStat); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.TeamReviewStat)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.StatsFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTeamReviewLoad provides a mock function with given fields: ctx
+func (_m *StatsRepository) GetTeamReviewLoad(ctx context.Context) ([]*domain.TeamReviewLoad, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTeamReviewLoad")
+	}
+
+	var r0 []*domain.TeamReviewLoad
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*domain.TeamReviewLoad, error)); ok {
 		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) []*domain.ReviewStat); ok {
+	if rf, ok := ret.Get(0).(func(context.Context) []*domain.TeamReviewLoad); ok {
 		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*domain.ReviewStat)
+			r0 = ret.Get(0).([]*domain.TeamReviewLoad)
 		}
 	}
 
@@ -74,6 +194,36 @@ func (_m *StatsRepository) GetStatsReviews(ctx context.Context) ([]*domain.Revie
 	return r0, r1
 }
 
+// GetUserStatusHistory provides a mock function with given fields: ctx, teamName, before
+func (_m *StatsRepository) GetUserStatusHistory(ctx context.Context, teamName string, before time.Time) ([]*domain.UserStatusChange, error) {
+	ret := _m.Called(ctx, teamName, before)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserStatusHistory")
+	}
+
+	var r0 []*domain.UserStatusChange
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) ([]*domain.UserStatusChange, error)); ok {
+		return rf(ctx, teamName, before)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) []*domain.UserStatusChange); ok {
+		r0 = rf(ctx, teamName, before)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.UserStatusChange)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Time) error); ok {
+		r1 = rf(ctx, teamName, before)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewStatsRepository creates a new instance of StatsRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewStatsRepository(t interface {
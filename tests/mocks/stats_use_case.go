@@ -14,9 +14,39 @@ type StatsUseCase struct {
 	mock.Mock
 }
 
-// GetStatsPrAssignments provides a mock function with given fields: ctx
-func (_m *StatsUseCase) GetStatsPrAssignments(ctx context.Context) ([]*domain.PRAssignmentStat, error) {
-	ret := _m.Called(ctx)
+// GetFairnessReport provides a mock function with given fields: ctx, filter
+func (_m *StatsUseCase) GetFairnessReport(ctx context.Context, filter *domain.StatsFilter) (*domain.FairnessReport, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFairnessReport")
+	}
+
+	var r0 *domain.FairnessReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.StatsFilter) (*domain.FairnessReport, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.StatsFilter) *domain.FairnessReport); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.FairnessReport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.StatsFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStatsPrAssignments provides a mock function with given fields: ctx, filter
+func (_m *StatsUseCase) GetStatsPrAssignments(ctx context.Context, filter *domain.StatsFilter) ([]*domain.PRAssignmentStat, error) {
+	ret := _m.Called(ctx, filter)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetStatsPrAssignments")
@@ -24,19 +54,19 @@ func (_m *StatsUseCase) GetStatsPrAssignments(ctx context.Context) ([]*domain.PR
 
 	var r0 []*domain.PRAssignmentStat
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) ([]*domain.PRAssignmentStat, error)); ok {
-		return rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.StatsFilter) ([]*domain.PRAssignmentStat, error)); ok {
+		return rf(ctx, filter)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) []*domain.PRAssignmentStat); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.StatsFilter) []*domain.PRAssignmentStat); ok {
+		r0 = rf(ctx, filter)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*domain.PRAssignmentStat)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.StatsFilter) error); ok {
+		r1 = rf(ctx, filter)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -44,9 +74,9 @@ func (_m *StatsUseCase) GetStatsPrAssignments(ctx context.Context) ([]*domain.PR
 	return r0, r1
 }
 
-// GetStatsReviews provides a mock function with given fields: ctx
-func (_m *StatsUseCase) GetStatsReviews(ctx context.Context) ([]*domain.ReviewStat, error) {
-	ret := _m.Called(ctx)
+// GetStatsReviews provides a mock function with given fields: ctx, filter
+func (_m *StatsUseCase) GetStatsReviews(ctx context.Context, filter *domain.StatsFilter) ([]*domain.ReviewStat, error) {
+	ret := _m.Called(ctx, filter)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetStatsReviews")
@@ -54,19 +84,49 @@ func (_m *StatsUseCase) GetStatsReviews(ctx context.Context) ([]*domain.ReviewSt
 
 	var r0 []*domain.ReviewStat
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) ([]*domain.ReviewStat, error)); ok {
-		return rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.StatsFilter) ([]*domain.ReviewStat, error)); ok {
+		return rf(ctx, filter)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) []*domain.ReviewStat); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.StatsFilter) []*domain.ReviewStat); ok {
+		r0 = rf(ctx, filter)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*domain.ReviewStat)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.StatsFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStatsTeams provides a mock function with given fields: ctx, filter
+func (_m *StatsUseCase) GetStatsTeams(ctx context.Context, filter *domain.StatsFilter) ([]*domain.TeamReviewStat, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStatsTeams")
+	}
+
+	var r0 []*domain.TeamReviewStat
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.StatsFilter) ([]*domain.TeamReviewStat, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.StatsFilter) []*domain.TeamReviewStat); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.TeamReviewStat)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.StatsFilter) error); ok {
+		r1 = rf(ctx, filter)
 	} else {
 		r1 = ret.Error(1)
 	}
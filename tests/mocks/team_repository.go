@@ -180,6 +180,66 @@ func (_m *TeamRepository) GetByName(ctx context.Context, teamName string) (*doma
 	return r0, r1
 }
 
+// GetByNames provides a mock function with given fields: ctx, teamNames
+func (_m *TeamRepository) GetByNames(ctx context.Context, teamNames []string) ([]*domain.Team, error) {
+	ret := _m.Called(ctx, teamNames)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByNames")
+	}
+
+	var r0 []*domain.Team
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) ([]*domain.Team, error)); ok {
+		return rf(ctx, teamNames)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []*domain.Team); ok {
+		r0 = rf(ctx, teamNames)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Team)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, teamNames)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetChildren provides a mock function with given fields: ctx, teamName
+func (_m *TeamRepository) GetChildren(ctx context.Context, teamName string) ([]string, error) {
+	ret := _m.Called(ctx, teamName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetChildren")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]string, error)); ok {
+		return rf(ctx, teamName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []string); ok {
+		r0 = rf(ctx, teamName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, teamName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetOpenPRsWithTeamReviewers provides a mock function with given fields: ctx, teamName
 func (_m *TeamRepository) GetOpenPRsWithTeamReviewers(ctx context.Context, teamName string) ([]string, error) {
 	ret := _m.Called(ctx, teamName)
@@ -240,6 +300,82 @@ func (_m *TeamRepository) GetPRReviewersFromTeam(ctx context.Context, prID strin
 	return r0, r1
 }
 
+// GetParent provides a mock function with given fields: ctx, teamName
+func (_m *TeamRepository) GetParent(ctx context.Context, teamName string) (string, error) {
+	ret := _m.Called(ctx, teamName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetParent")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (string, error)); ok {
+		return rf(ctx, teamName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = rf(ctx, teamName)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, teamName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTeamTreeStats provides a mock function with given fields: ctx
+func (_m *TeamRepository) GetTeamTreeStats(ctx context.Context) ([]*domain.TeamTreeNode, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTeamTreeStats")
+	}
+
+	var r0 []*domain.TeamTreeNode
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*domain.TeamTreeNode, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*domain.TeamTreeNode); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.TeamTreeNode)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetParent provides a mock function with given fields: ctx, teamName, parentName
+func (_m *TeamRepository) SetParent(ctx context.Context, teamName string, parentName string) error {
+	ret := _m.Called(ctx, teamName, parentName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetParent")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, teamName, parentName)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewTeamRepository creates a new instance of TeamRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewTeamRepository(t interface {
@@ -92,6 +92,66 @@ func (_m *TeamUseCase) GetTeam(ctx context.Context, teamName string) (*domain.Te
 	return r0, r1
 }
 
+// GetTeamTree provides a mock function with given fields: ctx, rootTeamName
+func (_m *TeamUseCase) GetTeamTree(ctx context.Context, rootTeamName string) ([]*domain.TeamTreeNode, error) {
+	ret := _m.Called(ctx, rootTeamName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTeamTree")
+	}
+
+	var r0 []*domain.TeamTreeNode
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*domain.TeamTreeNode, error)); ok {
+		return rf(ctx, rootTeamName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*domain.TeamTreeNode); ok {
+		r0 = rf(ctx, rootTeamName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.TeamTreeNode)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, rootTeamName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetTeamParent provides a mock function with given fields: ctx, teamName, parentName
+func (_m *TeamUseCase) SetTeamParent(ctx context.Context, teamName string, parentName string) (*domain.Team, error) {
+	ret := _m.Called(ctx, teamName, parentName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetTeamParent")
+	}
+
+	var r0 *domain.Team
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*domain.Team, error)); ok {
+		return rf(ctx, teamName, parentName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *domain.Team); ok {
+		r0 = rf(ctx, teamName, parentName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Team)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, teamName, parentName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewTeamUseCase creates a new instance of TeamUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewTeamUseCase(t interface {
@@ -0,0 +1,65 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "pr-reviewer-service/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TransferRepository is an autogenerated mock type for the TransferRepository type
+type TransferRepository struct {
+	mock.Mock
+}
+
+// Export provides a mock function with given fields: ctx, emit
+func (_m *TransferRepository) Export(ctx context.Context, emit func(*domain.ExportRecord) error) error {
+	ret := _m.Called(ctx, emit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Export")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, func(*domain.ExportRecord) error) error); ok {
+		r0 = rf(ctx, emit)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Import provides a mock function with given fields: ctx, fn
+func (_m *TransferRepository) Import(ctx context.Context, fn func(domain.ImportStore) error) error {
+	ret := _m.Called(ctx, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Import")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, func(domain.ImportStore) error) error); ok {
+		r0 = rf(ctx, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewTransferRepository creates a new instance of TransferRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTransferRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TransferRepository {
+	mock := &TransferRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
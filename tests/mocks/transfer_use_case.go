@@ -0,0 +1,77 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "pr-reviewer-service/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TransferUseCase is an autogenerated mock type for the TransferUseCase type
+type TransferUseCase struct {
+	mock.Mock
+}
+
+// Export provides a mock function with given fields: ctx, emit
+func (_m *TransferUseCase) Export(ctx context.Context, emit func(*domain.ExportRecord) error) error {
+	ret := _m.Called(ctx, emit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Export")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, func(*domain.ExportRecord) error) error); ok {
+		r0 = rf(ctx, emit)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Import provides a mock function with given fields: ctx, records, opts
+func (_m *TransferUseCase) Import(ctx context.Context, records []*domain.ImportRecord, opts domain.ImportOptions) (*domain.ImportReport, error) {
+	ret := _m.Called(ctx, records, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Import")
+	}
+
+	var r0 *domain.ImportReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []*domain.ImportRecord, domain.ImportOptions) (*domain.ImportReport, error)); ok {
+		return rf(ctx, records, opts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []*domain.ImportRecord, domain.ImportOptions) *domain.ImportReport); ok {
+		r0 = rf(ctx, records, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.ImportReport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []*domain.ImportRecord, domain.ImportOptions) error); ok {
+		r1 = rf(ctx, records, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewTransferUseCase creates a new instance of TransferUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTransferUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TransferUseCase {
+	mock := &TransferUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -7,6 +7,8 @@ import (
 	domain "pr-reviewer-service/internal/domain"
 
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // UserRepository is an autogenerated mock type for the UserRepository type
@@ -74,6 +76,156 @@ func (_m *UserRepository) GetByID(ctx context.Context, userID string) (*domain.U
 	return r0, r1
 }
 
+// GetByIDs provides a mock function with given fields: ctx, userIDs
+func (_m *UserRepository) GetByIDs(ctx context.Context, userIDs []string) ([]*domain.User, error) {
+	ret := _m.Called(ctx, userIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByIDs")
+	}
+
+	var r0 []*domain.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) ([]*domain.User, error)); ok {
+		return rf(ctx, userIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []*domain.User); ok {
+		r0 = rf(ctx, userIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, userIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByTeams provides a mock function with given fields: ctx, teamNames
+func (_m *UserRepository) GetByTeams(ctx context.Context, teamNames []string) ([]*domain.User, error) {
+	ret := _m.Called(ctx, teamNames)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByTeams")
+	}
+
+	var r0 []*domain.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) ([]*domain.User, error)); ok {
+		return rf(ctx, teamNames)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []*domain.User); ok {
+		r0 = rf(ctx, teamNames)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, teamNames)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetReviewCandidates provides a mock function with given fields: ctx, userIDs
+func (_m *UserRepository) GetReviewCandidates(ctx context.Context, userIDs []string) ([]*domain.ReviewCandidate, error) {
+	ret := _m.Called(ctx, userIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReviewCandidates")
+	}
+
+	var r0 []*domain.ReviewCandidate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) ([]*domain.ReviewCandidate, error)); ok {
+		return rf(ctx, userIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []*domain.ReviewCandidate); ok {
+		r0 = rf(ctx, userIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.ReviewCandidate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, userIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSkills provides a mock function with given fields: ctx, userID
+func (_m *UserRepository) GetSkills(ctx context.Context, userID string) ([]string, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSkills")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]string, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []string); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTeamRoster provides a mock function with given fields: ctx, teamName
+func (_m *UserRepository) GetTeamRoster(ctx context.Context, teamName string) ([]*domain.ReviewCandidate, error) {
+	ret := _m.Called(ctx, teamName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTeamRoster")
+	}
+
+	var r0 []*domain.ReviewCandidate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*domain.ReviewCandidate, error)); ok {
+		return rf(ctx, teamName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*domain.ReviewCandidate); ok {
+		r0 = rf(ctx, teamName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.ReviewCandidate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, teamName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetUserTeam provides a mock function with given fields: ctx, userID
 func (_m *UserRepository) GetUserTeam(ctx context.Context, userID string) (string, error) {
 	ret := _m.Called(ctx, userID)
@@ -102,6 +254,42 @@ func (_m *UserRepository) GetUserTeam(ctx context.Context, userID string) (strin
 	return r0, r1
 }
 
+// SetOutOfOffice provides a mock function with given fields: ctx, userID, until
+func (_m *UserRepository) SetOutOfOffice(ctx context.Context, userID string, until *time.Time) error {
+	ret := _m.Called(ctx, userID, until)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetOutOfOffice")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *time.Time) error); ok {
+		r0 = rf(ctx, userID, until)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetSkills provides a mock function with given fields: ctx, userID, skills
+func (_m *UserRepository) SetSkills(ctx context.Context, userID string, skills []string) error {
+	ret := _m.Called(ctx, userID, skills)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetSkills")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) error); ok {
+		r0 = rf(ctx, userID, skills)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateActiveStatus provides a mock function with given fields: ctx, userID, isActive
 func (_m *UserRepository) UpdateActiveStatus(ctx context.Context, userID string, isActive bool) (*domain.User, error) {
 	ret := _m.Called(ctx, userID, isActive)
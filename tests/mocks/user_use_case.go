@@ -7,6 +7,8 @@ import (
 	domain "pr-reviewer-service/internal/domain"
 
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // UserUseCase is an autogenerated mock type for the UserUseCase type
@@ -14,29 +16,29 @@ type UserUseCase struct {
 	mock.Mock
 }
 
-// GetUserReviewPRs provides a mock function with given fields: ctx, userID
-func (_m *UserUseCase) GetUserReviewPRs(ctx context.Context, userID string) ([]*domain.PullRequest, error) {
-	ret := _m.Called(ctx, userID)
+// GetUserReviewPRs provides a mock function with given fields: ctx, userID, cursor, limit
+func (_m *UserUseCase) GetUserReviewPRs(ctx context.Context, userID string, cursor string, limit int) (*domain.PRPage, error) {
+	ret := _m.Called(ctx, userID, cursor, limit)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetUserReviewPRs")
 	}
 
-	var r0 []*domain.PullRequest
+	var r0 *domain.PRPage
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*domain.PullRequest, error)); ok {
-		return rf(ctx, userID)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int) (*domain.PRPage, error)); ok {
+		return rf(ctx, userID, cursor, limit)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) []*domain.PullRequest); ok {
-		r0 = rf(ctx, userID)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int) *domain.PRPage); ok {
+		r0 = rf(ctx, userID, cursor, limit)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*domain.PullRequest)
+			r0 = ret.Get(0).(*domain.PRPage)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, userID)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int) error); ok {
+		r1 = rf(ctx, userID, cursor, limit)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -74,6 +76,66 @@ func (_m *UserUseCase) SetUserActive(ctx context.Context, userID string, isActiv
 	return r0, r1
 }
 
+// SetUserOutOfOffice provides a mock function with given fields: ctx, userID, until
+func (_m *UserUseCase) SetUserOutOfOffice(ctx context.Context, userID string, until *time.Time) (*domain.User, error) {
+	ret := _m.Called(ctx, userID, until)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetUserOutOfOffice")
+	}
+
+	var r0 *domain.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *time.Time) (*domain.User, error)); ok {
+		return rf(ctx, userID, until)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *time.Time) *domain.User); ok {
+		r0 = rf(ctx, userID, until)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *time.Time) error); ok {
+		r1 = rf(ctx, userID, until)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetUserSkills provides a mock function with given fields: ctx, userID, skills
+func (_m *UserUseCase) SetUserSkills(ctx context.Context, userID string, skills []string) (*domain.User, error) {
+	ret := _m.Called(ctx, userID, skills)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetUserSkills")
+	}
+
+	var r0 *domain.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) (*domain.User, error)); ok {
+		return rf(ctx, userID, skills)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) *domain.User); ok {
+		r0 = rf(ctx, userID, skills)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []string) error); ok {
+		r1 = rf(ctx, userID, skills)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewUserUseCase creates a new instance of UserUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewUserUseCase(t interface {
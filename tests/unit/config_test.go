@@ -0,0 +1,126 @@
+package usecase_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"pr-reviewer-service/internal/config"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeConfigFile(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestConfigLoad_Precedence(t *testing.T) {
+	path := writeConfigFile(t, `
+server:
+  port: 9000
+  write_timeout: 45s
+database:
+  host: db.internal
+  max_open_conns: 40
+assignment:
+  max_reviewers: 3
+cors:
+  allowed_origins: ["https://ui.example.com"]
+`)
+	t.Setenv("CONFIG_FILE", path)
+	t.Setenv("SERVER_PORT", "9100")
+	t.Setenv("DB_HOST", "")
+	t.Setenv("AUTH_TOKENS", "alpha, beta")
+
+	cfg, opts, err := config.Load([]string{"--server.port=9200", "--auth.enabled"})
+	require.NoError(t, err)
+
+	assert.Equal(t, path, opts.File)
+	assert.False(t, opts.PrintConfig)
+	assert.Equal(t, 9200, cfg.Server.Port, "флаг важнее окружения")
+	assert.Equal(t, 45*time.Second, cfg.Server.WriteTimeout, "значение из файла")
+	assert.Equal(t, 5*time.Second, cfg.Server.ReadHeaderTimeout, "значение по умолчанию")
+	assert.Equal(t, "db.internal", cfg.Database.Host, "пустая переменная не переопределяет файл")
+	assert.Equal(t, 40, cfg.Database.MaxOpenConns)
+	assert.Equal(t, 3, cfg.Assignment.MaxReviewers)
+	assert.True(t, cfg.Auth.Enabled)
+	assert.Equal(t, []string{"alpha", "beta"}, cfg.Auth.Tokens)
+	assert.Equal(t, []string{"https://ui.example.com"}, cfg.CORS.AllowedOrigins)
+}
+
+func TestConfigLoad_RejectsUnknownKeys(t *testing.T) {
+	path := writeConfigFile(t, "server:\n  prot: 9000\n")
+
+	_, _, err := config.Load([]string{"--config", path})
+	assert.ErrorContains(t, err, "prot")
+}
+
+func TestConfigLoad_InvalidValue(t *testing.T) {
+	_, _, err := config.Load([]string{"--server.read_timeout=soon"})
+	assert.ErrorContains(t, err, "--server.read_timeout")
+}
+
+func TestConfigValidate_ReportsAllErrors(t *testing.T) {
+	cfg := config.Default()
+	cfg.Storage = "mongo"
+	cfg.Server.Port = 0
+	cfg.Server.GRPCPort = 70000
+	cfg.Database.MaxOpenConns = 2
+	cfg.Database.MaxIdleConns = 5
+	cfg.Assignment.EscalationPolicy = "everyone"
+	cfg.Auth.Enabled = true
+	cfg.CORS.AllowedOrigins = []string{"ui.example.com"}
+	cfg.Log.Format = "xml"
+
+	err := cfg.Validate()
+	require.Error(t, err)
+	for _, key := range []string{
+		"storage", "server.port", "server.grpc_port", "database.max_idle_conns", "assignment.escalation_policy",
+		"auth.tokens", "cors.allowed_origins", "log.format",
+	} {
+		assert.ErrorContains(t, err, key)
+	}
+
+	assert.NoError(t, config.Default().Validate())
+}
+
+func TestConfigLoad_SQLiteStorage(t *testing.T) {
+	t.Setenv("SQLITE_PATH", "/var/lib/pr-reviewer/data.db")
+
+	cfg, _, err := config.Load([]string{"--storage=sqlite"})
+	require.NoError(t, err)
+	assert.Equal(t, config.StorageSQLite, cfg.Storage)
+	assert.Equal(t, "/var/lib/pr-reviewer/data.db", cfg.SQLite.Path)
+
+	cfg.SQLite.Path = ""
+	assert.ErrorContains(t, cfg.Validate(), "sqlite.path")
+}
+
+func TestConfigWriteYAML_RedactsSecrets(t *testing.T) {
+	cfg := config.Default()
+	cfg.Storage = config.StorageMemory
+	cfg.Database.Password = "s3cret"
+	cfg.Auth.Tokens = []string{"token-1"}
+
+	var buf bytes.Buffer
+	require.NoError(t, cfg.WriteYAML(&buf))
+
+	out := buf.String()
+	assert.NotContains(t, out, "s3cret")
+	assert.NotContains(t, out, "token-1")
+	assert.Contains(t, out, "password: <redacted>")
+	assert.Contains(t, out, "read_timeout: 15s")
+	assert.Contains(t, out, "storage: memory\n")
+
+	// Вывод снова читается как файл конфигурации
+	path := writeConfigFile(t, out)
+	loaded, _, err := config.Load([]string{"--config", path})
+	require.NoError(t, err)
+	assert.Equal(t, cfg.Server, loaded.Server)
+	assert.Equal(t, config.StorageMemory, loaded.Storage)
+}
@@ -0,0 +1,104 @@
+package usecase_test
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"net/url"
+	"testing"
+	"time"
+
+	"pr-reviewer-service/internal/config"
+	"pr-reviewer-service/internal/database"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsTransient(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{name: "serialization failure", err: &pgconn.PgError{Code: "40001"}, expected: true},
+		{name: "deadlock", err: fmt.Errorf("failed to assign reviewer: %w", &pgconn.PgError{Code: "40P01"}), expected: true},
+		{name: "connection failure", err: &pgconn.PgError{Code: "08006"}, expected: true},
+		{name: "server restarting", err: &pgconn.PgError{Code: "57P03"}, expected: true},
+		{name: "bad connection", err: driver.ErrBadConn, expected: true},
+		{name: "unique violation", err: &pgconn.PgError{Code: "23505"}, expected: false},
+		{name: "statement timeout", err: &pgconn.PgError{Code: "57014"}, expected: false},
+		{name: "context canceled", err: context.Canceled, expected: false},
+		{name: "plain error", err: errors.New("boom"), expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, database.IsTransient(tt.err))
+		})
+	}
+}
+
+func TestRetry_StopsOnSuccess(t *testing.T) {
+	policy := database.RetryPolicy{Attempts: 5, Backoff: time.Millisecond}
+	calls := 0
+
+	err := database.Retry(context.Background(), policy, func() error {
+		calls++
+		if calls < 3 {
+			return &pgconn.PgError{Code: "40001"}
+		}
+		return nil
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 3, calls)
+}
+
+func TestRetry_BoundedAttempts(t *testing.T) {
+	policy := database.RetryPolicy{Attempts: 3, Backoff: time.Millisecond}
+	calls := 0
+
+	err := database.Retry(context.Background(), policy, func() error {
+		calls++
+		return &pgconn.PgError{Code: "40P01"}
+	})
+
+	var pgErr *pgconn.PgError
+	require.ErrorAs(t, err, &pgErr)
+	assert.Equal(t, "40P01", pgErr.Code)
+	assert.Equal(t, 3, calls)
+}
+
+func TestRetry_PermanentErrorNotRetried(t *testing.T) {
+	calls := 0
+
+	err := database.Retry(context.Background(), database.DefaultRetryPolicy, func() error {
+		calls++
+		return &pgconn.PgError{Code: "23505"}
+	})
+
+	assert.Error(t, err)
+	assert.Equal(t, 1, calls)
+}
+
+func TestDSN(t *testing.T) {
+	cfg := config.Default().Database
+	cfg.Password = "p@ss:word"
+	cfg.SSLMode = "verify-full"
+	cfg.SSLRootCert = "/certs/ca.pem"
+	cfg.StatementTimeout = 1500 * time.Millisecond
+
+	dsn, err := url.Parse(database.DSN(cfg))
+	require.NoError(t, err)
+
+	password, _ := dsn.User.Password()
+	assert.Equal(t, "p@ss:word", password)
+	assert.Equal(t, "localhost:5432", dsn.Host)
+	assert.Equal(t, "/pr_reviewer", dsn.Path)
+	assert.Equal(t, "verify-full", dsn.Query().Get("sslmode"))
+	assert.Equal(t, "/certs/ca.pem", dsn.Query().Get("sslrootcert"))
+	assert.Equal(t, "1500", dsn.Query().Get("statement_timeout"))
+}
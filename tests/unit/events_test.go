@@ -0,0 +1,102 @@
+package usecase_test
+
+import (
+	"fmt"
+	"testing"
+
+	"pr-reviewer-service/internal/domain"
+	"pr-reviewer-service/internal/events"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBroker_FiltersAndNumbersEvents(t *testing.T) {
+	broker := events.NewBroker()
+	all := broker.Subscribe(nil)
+	defer all.Close()
+	mine := broker.Subscribe(events.ForReviewer("u2"))
+	defer mine.Close()
+
+	broker.PublishAssignment(domain.AssignmentEvent{Type: domain.AssignmentAssigned, ReviewerID: "u1"})
+	broker.PublishAssignment(domain.AssignmentEvent{Type: domain.AssignmentAssigned, ReviewerID: "u2"})
+
+	assert.Equal(t, uint64(1), (<-all.C).ID)
+	assert.Equal(t, uint64(2), (<-all.C).ID)
+
+	event := <-mine.C
+	assert.Equal(t, uint64(2), event.ID)
+	assert.Equal(t, "u2", event.ReviewerID)
+	assert.Empty(t, mine.C)
+}
+
+func TestBroker_DropsSlowSubscriber(t *testing.T) {
+	broker := events.NewBroker()
+	slow := broker.Subscribe(nil)
+	fast := broker.Subscribe(events.ForReviewer("u2"))
+	defer fast.Close()
+
+	// Публикация не блокируется, даже если подписчик не читает
+	for i := 0; i < 1000; i++ {
+		broker.PublishAssignment(domain.AssignmentEvent{ReviewerID: fmt.Sprintf("u%d", i%2)})
+	}
+
+	assert.True(t, slow.Dropped())
+	assert.False(t, fast.Dropped())
+	assert.Equal(t, 1, broker.Subscribers())
+
+	received := 0
+	for range slow.C {
+		received++
+	}
+	assert.Less(t, received, 1000, "channel is closed after overflow")
+
+	slow.Close() // повторное закрытие безопасно
+}
+
+func TestBroker_CloseEndsSubscriptions(t *testing.T) {
+	broker := events.NewBroker()
+	sub := broker.Subscribe(nil)
+
+	broker.Close()
+	_, ok := <-sub.C
+	assert.False(t, ok)
+	assert.False(t, sub.Dropped())
+
+	late := broker.Subscribe(nil)
+	_, ok = <-late.C
+	require.False(t, ok, "subscriptions after Close are already closed")
+	assert.Equal(t, 0, broker.Subscribers())
+}
+
+func TestBroker_SubscribeFromReplaysHistory(t *testing.T) {
+	broker := events.NewBroker()
+	for i := 0; i < 1100; i++ {
+		broker.PublishAssignment(domain.AssignmentEvent{ReviewerID: fmt.Sprintf("u%d", i%2)})
+	}
+
+	sub, missed, complete := broker.SubscribeFrom(1095, events.ForReviewer("u1"))
+	defer sub.Close()
+	require.True(t, complete)
+	require.Len(t, missed, 3)
+	assert.Equal(t, []uint64{1096, 1098, 1100}, []uint64{missed[0].ID, missed[1].ID, missed[2].ID})
+	assert.Equal(t, uint64(1100), sub.StartID())
+
+	// Последние 1024 события еще в истории, более ранние вытеснены
+	oldest, missed, complete := broker.SubscribeFrom(1100-1024, nil)
+	defer oldest.Close()
+	assert.True(t, complete)
+	assert.Len(t, missed, 1024)
+
+	evicted, missed, complete := broker.SubscribeFrom(10, nil)
+	defer evicted.Close()
+	assert.False(t, complete)
+	assert.Empty(t, missed)
+
+	future, _, complete := broker.SubscribeFrom(5000, nil)
+	defer future.Close()
+	assert.False(t, complete, "id from before a restart")
+
+	broker.PublishAssignment(domain.AssignmentEvent{ReviewerID: "u1"})
+	assert.Equal(t, uint64(1101), (<-sub.C).ID)
+}
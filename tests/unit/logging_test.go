@@ -0,0 +1,27 @@
+package usecase_test
+
+import (
+	"testing"
+
+	"pr-reviewer-service/internal/logging"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoggingConfigure(t *testing.T) {
+	logger := logrus.New()
+
+	require.NoError(t, logging.Configure(logger, "debug", "text"))
+	assert.Equal(t, logrus.DebugLevel, logger.GetLevel())
+	assert.IsType(t, &logrus.TextFormatter{}, logger.Formatter)
+
+	require.NoError(t, logging.Configure(logger, "warn", "JSON"))
+	assert.Equal(t, logrus.WarnLevel, logger.GetLevel())
+	assert.IsType(t, &logrus.JSONFormatter{}, logger.Formatter)
+
+	assert.Error(t, logging.Configure(logger, "verbose", "json"))
+	assert.Error(t, logging.Configure(logger, "info", "xml"))
+	assert.Equal(t, logrus.WarnLevel, logger.GetLevel(), "невалидная конфигурация не применяется")
+}
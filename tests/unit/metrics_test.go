@@ -0,0 +1,181 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"pr-reviewer-service/internal/domain"
+	"pr-reviewer-service/internal/events"
+	"pr-reviewer-service/internal/metrics"
+	"pr-reviewer-service/internal/usecase"
+	"pr-reviewer-service/tests/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// scrapeMetrics возвращает текущий вывод /metrics
+func scrapeMetrics(t *testing.T, m *metrics.Metrics) string {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	m.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "text/plain; version=0.0.4; charset=utf-8", rec.Header().Get("Content-Type"))
+	return rec.Body.String()
+}
+
+func TestMetrics_PRUseCaseRecordsEvents(t *testing.T) {
+	ctx := context.Background()
+	prRepo := &mocks.PRRepository{}
+	userRepo := &mocks.UserRepository{}
+	serviceMetrics := metrics.New()
+	uc := usecase.NewPRUseCase(prRepo, userRepo, usecase.WithMetrics(serviceMetrics))
+
+	author := &domain.User{ID: "u1", TeamName: "backend", IsActive: true}
+	userRepo.On("GetByID", ctx, "u1").Return(author, nil)
+	prRepo.On("ExistsPr", ctx, "pr-1").Return(false, nil)
+	prRepo.On("ExistsPr", ctx, "pr-2").Return(false, nil)
+	userRepo.On("GetTeamRoster", ctx, "backend").Return(toRoster([]*domain.User{
+		{ID: "u2", TeamName: "backend", IsActive: true},
+	}), nil).Once()
+	userRepo.On("GetTeamRoster", ctx, "backend").Return(toRoster(nil), nil).Once()
+	prRepo.On("CreateWithReviewers", ctx, mock.AnythingOfType("*domain.PullRequest"), []string{"u2"}).Return(nil)
+
+	_, err := uc.CreatePR(ctx, &domain.CreatePRRequest{ID: "pr-1", Name: "PR", AuthorID: "u1"})
+	require.NoError(t, err)
+	_, err = uc.CreatePR(ctx, &domain.CreatePRRequest{ID: "pr-2", Name: "PR", AuthorID: "u1"})
+	require.ErrorIs(t, err, domain.ErrNoReviewerCandidate)
+
+	output := scrapeMetrics(t, serviceMetrics)
+	assert.Contains(t, output, "# TYPE pr_reviewer_prs_created_total counter\npr_reviewer_prs_created_total 1\n")
+	assert.Contains(t, output, "pr_reviewer_no_reviewer_candidate_total 1\n")
+	assert.Contains(t, output, "pr_reviewer_prs_merged_total 0\n")
+}
+
+func TestMetrics_RepeatedMergeCountedOnce(t *testing.T) {
+	ctx := context.Background()
+	prRepo := &mocks.PRRepository{}
+	userRepo := &mocks.UserRepository{}
+	serviceMetrics := metrics.New()
+	broker := events.NewBroker()
+	sub := broker.Subscribe(nil)
+	defer sub.Close()
+	uc := usecase.NewPRUseCase(prRepo, userRepo, usecase.WithMetrics(serviceMetrics), usecase.WithEvents(broker))
+
+	mergedPR := &domain.PullRequest{ID: "pr-1", Name: "PR", AuthorID: "u1", Status: domain.PRStatusMerged, AssignedReviewers: []string{"u2"}}
+	prRepo.On("ExistsPr", ctx, "pr-1").Return(true, nil)
+	prRepo.On("Merge", ctx, "pr-1", int64(0)).Return(mergedPR, true, nil).Once()
+	prRepo.On("Merge", ctx, "pr-1", int64(0)).Return(mergedPR, false, nil).Once()
+
+	_, err := uc.MergePR(ctx, "pr-1", 0)
+	require.NoError(t, err)
+	_, err = uc.MergePR(ctx, "pr-1", 0)
+	require.NoError(t, err)
+
+	output := scrapeMetrics(t, serviceMetrics)
+	assert.Contains(t, output, "pr_reviewer_prs_merged_total 1\n")
+
+	merged := <-sub.C
+	assert.Equal(t, domain.AssignmentMerged, merged.Type)
+	assert.Equal(t, "u2", merged.ReviewerID)
+	assert.Empty(t, sub.C, "repeated merge must not publish events")
+	prRepo.AssertExpectations(t)
+}
+
+func TestMetrics_TeamDeactivationFailure(t *testing.T) {
+	ctx := context.Background()
+	teamRepo := &mocks.TeamRepository{}
+	userRepo := &mocks.UserRepository{}
+	prRepo := &mocks.PRRepository{}
+	serviceMetrics := metrics.New()
+	uc := usecase.NewTeamUseCase(teamRepo, userRepo, prRepo, usecase.WithTeamMetrics(serviceMetrics))
+
+	teamRepo.On("ExistsTeam", ctx, "backend").Return(true, nil)
+	teamRepo.On("GetActiveUsersFromTeam", ctx, "backend").Return([]*domain.User{{ID: "u1"}}, nil)
+	teamRepo.On("GetOpenPRsWithTeamReviewers", ctx, "backend").Return([]string{}, nil)
+	userRepo.On("UpdateActiveStatus", ctx, "u1", false).Return(nil, errors.New("db down"))
+
+	_, err := uc.DeactivateTeamUsers(ctx, "backend")
+	require.ErrorIs(t, err, domain.ErrTeamDeactivationFailed)
+
+	output := scrapeMetrics(t, serviceMetrics)
+	assert.Contains(t, output, `pr_reviewer_deactivation_failures_total{stage="deactivate_users"} 1`)
+}
+
+func TestMetrics_RefreshWorkload(t *testing.T) {
+	ctx := context.Background()
+	statsRepo := &mocks.StatsRepository{}
+	serviceMetrics := metrics.New()
+
+	statsRepo.On("CountOpenPRs", ctx).Return(int64(3), nil)
+	statsRepo.On("GetTeamReviewLoad", ctx).Return([]*domain.TeamReviewLoad{
+		{TeamName: "backend", OpenReviews: 5},
+		{TeamName: `team "q"`, OpenReviews: 0},
+	}, nil)
+
+	require.NoError(t, serviceMetrics.RefreshWorkload(ctx, statsRepo))
+
+	output := scrapeMetrics(t, serviceMetrics)
+	assert.Contains(t, output, "# TYPE pr_reviewer_open_prs gauge\npr_reviewer_open_prs 3\n")
+	assert.Contains(t, output, `pr_reviewer_team_open_reviews{team="backend"} 5`)
+	assert.Contains(t, output, `pr_reviewer_team_open_reviews{team="team \"q\""} 0`)
+}
+
+func TestMetrics_RefreshWorkload_DropsOnlyStaleTeams(t *testing.T) {
+	ctx := context.Background()
+	statsRepo := &mocks.StatsRepository{}
+	serviceMetrics := metrics.New()
+
+	statsRepo.On("CountOpenPRs", ctx).Return(int64(2), nil)
+	statsRepo.On("GetTeamReviewLoad", ctx).Return([]*domain.TeamReviewLoad{
+		{TeamName: "backend", OpenReviews: 4},
+		{TeamName: "frontend", OpenReviews: 1},
+	}, nil).Once()
+	statsRepo.On("GetTeamReviewLoad", ctx).Return([]*domain.TeamReviewLoad{
+		{TeamName: "backend", OpenReviews: 2},
+		{TeamName: "mobile", OpenReviews: 3},
+	}, nil).Once()
+
+	require.NoError(t, serviceMetrics.RefreshWorkload(ctx, statsRepo))
+	require.NoError(t, serviceMetrics.RefreshWorkload(ctx, statsRepo))
+
+	output := scrapeMetrics(t, serviceMetrics)
+	assert.Contains(t, output, `pr_reviewer_team_open_reviews{team="backend"} 2`)
+	assert.Contains(t, output, `pr_reviewer_team_open_reviews{team="mobile"} 3`)
+	assert.NotContains(t, output, `team="frontend"`)
+}
+
+func TestGaugeVec_DeleteLabelValues(t *testing.T) {
+	registry := metrics.NewRegistry()
+	gauge := registry.NewGaugeVec("test_gauge", "Test gauge.", "team")
+	gauge.Set(1, "backend")
+	gauge.Set(2, "frontend")
+
+	assert.True(t, gauge.DeleteLabelValues("frontend"))
+	assert.False(t, gauge.DeleteLabelValues("frontend"))
+
+	var buf strings.Builder
+	require.NoError(t, registry.Write(&buf))
+	assert.Contains(t, buf.String(), `test_gauge{team="backend"} 1`)
+	assert.NotContains(t, buf.String(), "frontend")
+}
+
+func TestMetrics_RequestLatencyHistogram(t *testing.T) {
+	serviceMetrics := metrics.New()
+
+	serviceMetrics.ObserveRequest(http.MethodPost, "/pullRequest/create", http.StatusCreated, 30*time.Millisecond)
+	serviceMetrics.ObserveRequest(http.MethodPost, "/pullRequest/create", http.StatusCreated, 2*time.Second)
+
+	output := scrapeMetrics(t, serviceMetrics)
+	labels := `method="POST",operation="/pullRequest/create",status="201"`
+	assert.Contains(t, output, "pr_reviewer_http_request_duration_seconds_bucket{"+labels+`,le="0.025"} 0`)
+	assert.Contains(t, output, "pr_reviewer_http_request_duration_seconds_bucket{"+labels+`,le="0.05"} 1`)
+	assert.Contains(t, output, "pr_reviewer_http_request_duration_seconds_bucket{"+labels+`,le="+Inf"} 2`)
+	assert.Contains(t, output, "pr_reviewer_http_request_duration_seconds_count{"+labels+"} 2")
+}
@@ -0,0 +1,174 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"pr-reviewer-service/internal/domain"
+	"pr-reviewer-service/internal/usecase"
+	"pr-reviewer-service/tests/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestOwnershipUseCase_SetRules_Success(t *testing.T) {
+	ctx := context.Background()
+	ownershipRepo := &mocks.OwnershipRepository{}
+	userRepo := &mocks.UserRepository{}
+	teamRepo := &mocks.TeamRepository{}
+	uc := usecase.NewOwnershipUseCase(ownershipRepo, userRepo, teamRepo)
+
+	rules := []*domain.OwnershipRule{
+		{Pattern: "*", TeamNames: []string{"backend", "backend"}},
+		{Pattern: "/docs/", UserIDs: []string{"u5"}},
+	}
+	expected := []*domain.OwnershipRule{
+		{Pattern: "*", UserIDs: []string{}, TeamNames: []string{"backend"}},
+		{Pattern: "/docs/", UserIDs: []string{"u5"}, TeamNames: []string{}},
+	}
+
+	teamRepo.On("ExistsTeam", ctx, "backend").Return(true, nil)
+	userRepo.On("GetByID", ctx, "u5").Return(&domain.User{ID: "u5"}, nil)
+	ownershipRepo.On("ReplaceRules", ctx, "search", expected).Return(nil)
+	ownershipRepo.On("GetRules", ctx, "search").Return(expected, nil)
+
+	result, err := uc.SetRules(ctx, "search", rules)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expected, result)
+	ownershipRepo.AssertExpectations(t)
+}
+
+func TestOwnershipUseCase_SetRules_ValidationErrors(t *testing.T) {
+	ctx := context.Background()
+
+	testCases := []struct {
+		name       string
+		repository string
+		rules      []*domain.OwnershipRule
+		expected   error
+	}{
+		{
+			name:       "Empty repository",
+			repository: "",
+			expected:   domain.ErrInvalidRepository,
+		},
+		{
+			name:       "Empty pattern",
+			repository: "search",
+			rules:      []*domain.OwnershipRule{{Pattern: " "}},
+			expected:   domain.ErrInvalidOwnershipPattern,
+		},
+		{
+			name:       "Root-only pattern",
+			repository: "search",
+			rules:      []*domain.OwnershipRule{{Pattern: "/"}},
+			expected:   domain.ErrInvalidOwnershipPattern,
+		},
+		{
+			name:       "Empty path segment",
+			repository: "search",
+			rules:      []*domain.OwnershipRule{{Pattern: "docs//api"}},
+			expected:   domain.ErrInvalidOwnershipPattern,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ownershipRepo := &mocks.OwnershipRepository{}
+			uc := usecase.NewOwnershipUseCase(ownershipRepo, &mocks.UserRepository{}, &mocks.TeamRepository{})
+
+			result, err := uc.SetRules(ctx, tc.repository, tc.rules)
+
+			assert.ErrorIs(t, err, tc.expected)
+			assert.Nil(t, result)
+			ownershipRepo.AssertNotCalled(t, "ReplaceRules", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestOwnershipUseCase_SetRules_UnknownOwners(t *testing.T) {
+	ctx := context.Background()
+	ownershipRepo := &mocks.OwnershipRepository{}
+	userRepo := &mocks.UserRepository{}
+	teamRepo := &mocks.TeamRepository{}
+	uc := usecase.NewOwnershipUseCase(ownershipRepo, userRepo, teamRepo)
+
+	userRepo.On("GetByID", ctx, "ghost").Return(nil, domain.ErrUserNotFound)
+	teamRepo.On("ExistsTeam", ctx, "nobody").Return(false, nil)
+
+	_, err := uc.SetRules(ctx, "search", []*domain.OwnershipRule{{Pattern: "*.go", UserIDs: []string{"ghost"}}})
+	assert.ErrorIs(t, err, domain.ErrUserNotFound)
+
+	_, err = uc.SetRules(ctx, "search", []*domain.OwnershipRule{{Pattern: "*.go", TeamNames: []string{"nobody"}}})
+	assert.ErrorIs(t, err, domain.ErrTeamNotFound)
+
+	ownershipRepo.AssertNotCalled(t, "ReplaceRules", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestOwnershipUseCase_PatternMatching(t *testing.T) {
+	ctx := context.Background()
+
+	testCases := []struct {
+		name    string
+		pattern string
+		path    string
+		matches bool
+	}{
+		{name: "Extension at any depth", pattern: "*.sql", path: "db/schema/users.sql", matches: true},
+		{name: "Extension does not cross directories", pattern: "*.sql", path: "db/schema.sql.bak", matches: false},
+		{name: "Anchored directory", pattern: "/docs/", path: "docs/api/index.md", matches: true},
+		{name: "Anchored directory only at root", pattern: "/docs/", path: "web/docs/index.md", matches: false},
+		{name: "Unanchored directory at any depth", pattern: "docs/", path: "web/docs/index.md", matches: true},
+		{name: "Directory without slash covers contents", pattern: "/internal/search", path: "internal/search/index.go", matches: true},
+		{name: "Single star is one level", pattern: "docs/*", path: "docs/api/index.md", matches: false},
+		{name: "Double star crosses levels", pattern: "docs/**", path: "docs/api/v1/index.md", matches: true},
+		{name: "Double star in the middle", pattern: "api/**/openapi.yaml", path: "api/v1/public/openapi.yaml", matches: true},
+		{name: "Leading double star", pattern: "**/testdata", path: "internal/usecase/testdata/x.json", matches: true},
+		{name: "Question mark", pattern: "/cmd/app?/main.go", path: "cmd/app2/main.go", matches: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			prRepo := &mocks.PRRepository{}
+			userRepo := &mocks.UserRepository{}
+			ownershipRepo := &mocks.OwnershipRepository{}
+			uc := usecase.NewPRUseCase(prRepo, userRepo, usecase.WithOwnershipRules(ownershipRepo, &mocks.TeamRepository{}))
+
+			author := &domain.User{ID: "u1", TeamName: "backend", IsActive: true}
+			owner := &domain.User{ID: "owner", TeamName: "platform", IsActive: true}
+			teammate := &domain.User{ID: "u2", TeamName: "backend", IsActive: true}
+
+			userRepo.On("GetByID", ctx, "u1").Return(author, nil)
+			userRepo.On("GetByID", ctx, "owner").Return(owner, nil)
+			userRepo.On("GetReviewCandidates", ctx, []string{"owner"}).Return(toRoster([]*domain.User{owner}), nil)
+			userRepo.On("GetTeamRoster", ctx, "backend").Return(toRoster([]*domain.User{teammate}), nil)
+			prRepo.On("ExistsPr", ctx, "pr-1").Return(false, nil)
+			prRepo.On("CreateWithReviewers", ctx, mock.Anything, mock.Anything).Return(nil)
+			ownershipRepo.On("GetRules", ctx, "repo").Return([]*domain.OwnershipRule{
+				{Pattern: tc.pattern, UserIDs: []string{"owner"}},
+			}, nil)
+
+			pr, err := uc.CreatePR(ctx, &domain.CreatePRRequest{
+				ID:           "pr-1",
+				Name:         "Change",
+				AuthorID:     "u1",
+				Repository:   "repo",
+				ChangedFiles: []string{tc.path},
+			})
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.matches, containsID(pr.AssignedReviewers, "owner"))
+		})
+	}
+}
+
+func containsID(ids []string, id string) bool {
+	for _, value := range ids {
+		if value == id {
+			return true
+		}
+	}
+	return false
+}
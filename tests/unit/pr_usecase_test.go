@@ -4,8 +4,10 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"pr-reviewer-service/internal/domain"
+	"pr-reviewer-service/internal/events"
 	"pr-reviewer-service/internal/usecase"
 	"pr-reviewer-service/tests/mocks"
 
@@ -30,11 +32,11 @@ func TestPRUseCase_CreatePR_Success(t *testing.T) {
 	// Mock expectations
 	userRepo.On("GetByID", ctx, "u1").Return(author, nil)
 	prRepo.On("ExistsPr", ctx, "pr-1001").Return(false, nil)
-	userRepo.On("GetActiveUsersByTeam", ctx, "backend", "u1").Return(candidates, nil)
+	userRepo.On("GetTeamRoster", ctx, "backend").Return(toRoster(candidates), nil)
 	prRepo.On("CreateWithReviewers", ctx, mock.AnythingOfType("*domain.PullRequest"), []string{"u2", "u3"}).Return(nil)
 
 	// Execute
-	pr, err := uc.CreatePR(ctx, "pr-1001", "Add feature", "u1")
+	pr, err := uc.CreatePR(ctx, &domain.CreatePRRequest{ID: "pr-1001", Name: "Add feature", AuthorID: "u1"})
 
 	// Assert
 	assert.NoError(t, err)
@@ -50,6 +52,35 @@ func TestPRUseCase_CreatePR_Success(t *testing.T) {
 	prRepo.AssertExpectations(t)
 }
 
+func TestPRUseCase_CreatePR_MaxReviewers(t *testing.T) {
+	ctx := context.Background()
+	prRepo := &mocks.PRRepository{}
+	userRepo := &mocks.UserRepository{}
+	uc := usecase.NewPRUseCase(prRepo, userRepo, usecase.WithMaxReviewers(3))
+
+	author := &domain.User{ID: "u1", Username: "Alice", TeamName: "backend", IsActive: true}
+	candidates := []*domain.User{
+		{ID: "u2", Username: "Bob", TeamName: "backend", IsActive: true},
+		{ID: "u3", Username: "Charlie", TeamName: "backend", IsActive: true},
+		{ID: "u4", Username: "Dave", TeamName: "backend", IsActive: true},
+		{ID: "u5", Username: "Eve", TeamName: "backend", IsActive: true},
+	}
+
+	userRepo.On("GetByID", ctx, "u1").Return(author, nil)
+	prRepo.On("ExistsPr", ctx, "pr-1001").Return(false, nil)
+	userRepo.On("GetTeamRoster", ctx, "backend").Return(toRoster(candidates), nil)
+	prRepo.On("CreateWithReviewers", ctx, mock.AnythingOfType("*domain.PullRequest"), mock.AnythingOfType("[]string")).Return(nil)
+
+	pr, err := uc.CreatePR(ctx, &domain.CreatePRRequest{ID: "pr-1001", Name: "Add feature", AuthorID: "u1"})
+
+	assert.NoError(t, err)
+	assert.Len(t, pr.AssignedReviewers, 3)
+	assert.NotContains(t, pr.AssignedReviewers, "u1")
+
+	userRepo.AssertExpectations(t)
+	prRepo.AssertExpectations(t)
+}
+
 func TestPRUseCase_CreatePR_ValidationErrors(t *testing.T) {
 	ctx := context.Background()
 	prRepo := &mocks.PRRepository{}
@@ -70,7 +101,7 @@ func TestPRUseCase_CreatePR_ValidationErrors(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			pr, err := uc.CreatePR(ctx, tc.prID, tc.prName, tc.authorID)
+			pr, err := uc.CreatePR(ctx, &domain.CreatePRRequest{ID: tc.prID, Name: tc.prName, AuthorID: tc.authorID})
 			assert.ErrorIs(t, err, tc.expected)
 			assert.Nil(t, pr)
 		})
@@ -85,7 +116,7 @@ func TestPRUseCase_CreatePR_AuthorNotFound(t *testing.T) {
 
 	userRepo.On("GetByID", ctx, "u1").Return(nil, errors.New("not found"))
 
-	pr, err := uc.CreatePR(ctx, "pr-1001", "Add feature", "u1")
+	pr, err := uc.CreatePR(ctx, &domain.CreatePRRequest{ID: "pr-1001", Name: "Add feature", AuthorID: "u1"})
 
 	assert.ErrorIs(t, err, domain.ErrPRAuthorNotFound)
 	assert.Nil(t, pr)
@@ -101,7 +132,7 @@ func TestPRUseCase_CreatePR_AlreadyExists(t *testing.T) {
 	userRepo.On("GetByID", ctx, "u1").Return(author, nil)
 	prRepo.On("ExistsPr", ctx, "pr-1001").Return(true, nil)
 
-	pr, err := uc.CreatePR(ctx, "pr-1001", "Add feature", "u1")
+	pr, err := uc.CreatePR(ctx, &domain.CreatePRRequest{ID: "pr-1001", Name: "Add feature", AuthorID: "u1"})
 
 	assert.ErrorIs(t, err, domain.ErrPRAlreadyExists)
 	assert.Nil(t, pr)
@@ -116,9 +147,9 @@ func TestPRUseCase_CreatePR_NoReviewerCandidates(t *testing.T) {
 	author := &domain.User{ID: "u1", Username: "Alice", TeamName: "backend", IsActive: true}
 	userRepo.On("GetByID", ctx, "u1").Return(author, nil)
 	prRepo.On("ExistsPr", ctx, "pr-1001").Return(false, nil)
-	userRepo.On("GetActiveUsersByTeam", ctx, "backend", "u1").Return([]*domain.User{}, nil)
+	userRepo.On("GetTeamRoster", ctx, "backend").Return(toRoster([]*domain.User{}), nil)
 
-	pr, err := uc.CreatePR(ctx, "pr-1001", "Add feature", "u1")
+	pr, err := uc.CreatePR(ctx, &domain.CreatePRRequest{ID: "pr-1001", Name: "Add feature", AuthorID: "u1"})
 
 	assert.ErrorIs(t, err, domain.ErrNoReviewerCandidate)
 	assert.Nil(t, pr)
@@ -138,9 +169,9 @@ func TestPRUseCase_MergePR_Success(t *testing.T) {
 	}
 
 	prRepo.On("ExistsPr", ctx, "pr-1001").Return(true, nil)
-	prRepo.On("Merge", ctx, "pr-1001").Return(mergedPR, nil)
+	prRepo.On("Merge", ctx, "pr-1001", int64(0)).Return(mergedPR, true, nil)
 
-	result, err := uc.MergePR(ctx, "pr-1001")
+	result, err := uc.MergePR(ctx, "pr-1001", 0)
 
 	assert.NoError(t, err)
 	assert.Equal(t, mergedPR, result)
@@ -154,7 +185,7 @@ func TestPRUseCase_MergePR_NotFound(t *testing.T) {
 
 	prRepo.On("ExistsPr", ctx, "pr-1001").Return(false, nil)
 
-	result, err := uc.MergePR(ctx, "pr-1001")
+	result, err := uc.MergePR(ctx, "pr-1001", 0)
 
 	assert.ErrorIs(t, err, domain.ErrPRNotFound)
 	assert.Nil(t, result)
@@ -171,6 +202,7 @@ func TestPRUseCase_ReassignReviewer_Success(t *testing.T) {
 		Name:     "Add feature",
 		AuthorID: "u1",
 		Status:   "OPEN",
+		Version:  3,
 	}
 	oldReviewer := &domain.User{ID: "u2", Username: "Bob", TeamName: "backend", IsActive: true}
 	candidates := []*domain.User{
@@ -182,16 +214,18 @@ func TestPRUseCase_ReassignReviewer_Success(t *testing.T) {
 		AuthorID:          "u1",
 		Status:            "OPEN",
 		AssignedReviewers: []string{"u3"},
+		Version:           4,
 	}
 
 	prRepo.On("GetByID", ctx, "pr-1001").Return(pr, nil).Once()
 	prRepo.On("IsUserReviewer", ctx, "pr-1001", "u2").Return(true, nil)
 	userRepo.On("GetByID", ctx, "u2").Return(oldReviewer, nil)
-	userRepo.On("GetActiveUsersByTeam", ctx, "backend", "u1").Return(candidates, nil)
-	prRepo.On("ReassignReviewer", ctx, "pr-1001", "u2", "u3").Return(nil)
+	userRepo.On("GetTeamRoster", ctx, "backend").Return(toRoster(append([]*domain.User{oldReviewer}, candidates...)), nil)
+	// Замена выполняется только при версии, прочитанной в начале
+	prRepo.On("ReassignReviewer", ctx, "pr-1001", "u2", "u3", int64(3)).Return(nil)
 	prRepo.On("GetByID", ctx, "pr-1001").Return(updatedPR, nil).Once()
 
-	resultPR, newReviewerID, err := uc.ReassignReviewer(ctx, "pr-1001", "u2")
+	resultPR, newReviewerID, err := uc.ReassignReviewer(ctx, "pr-1001", "u2", 0)
 
 	assert.NoError(t, err)
 	assert.Equal(t, updatedPR, resultPR)
@@ -202,6 +236,84 @@ func TestPRUseCase_ReassignReviewer_Success(t *testing.T) {
 	userRepo.AssertExpectations(t)
 }
 
+func TestPRUseCase_ReassignReviewer_SkipsCurrentReviewers(t *testing.T) {
+	ctx := context.Background()
+	prRepo := &mocks.PRRepository{}
+	userRepo := &mocks.UserRepository{}
+	uc := usecase.NewPRUseCase(prRepo, userRepo)
+
+	// Автор из другой команды (например, назначение владельцев кода), в команде ревьюверов трое
+	pr := &domain.PullRequest{ID: "pr-1001", AuthorID: "u1", Status: "OPEN", AssignedReviewers: []string{"u2", "u3"}}
+	prRepo.On("GetByID", ctx, "pr-1001").Return(pr, nil)
+	prRepo.On("IsUserReviewer", ctx, "pr-1001", "u2").Return(true, nil)
+	userRepo.On("GetByID", ctx, "u2").Return(&domain.User{ID: "u2", TeamName: "platform", IsActive: true}, nil)
+	userRepo.On("GetTeamRoster", ctx, "platform").Return(toRoster([]*domain.User{
+		{ID: "u2", TeamName: "platform", IsActive: true},
+		{ID: "u3", TeamName: "platform", IsActive: true},
+		{ID: "u4", TeamName: "platform", IsActive: true},
+	}), nil)
+	prRepo.On("ReassignReviewer", ctx, "pr-1001", "u2", mock.Anything, int64(0)).Return(nil)
+
+	for range 20 {
+		_, newReviewerID, err := uc.ReassignReviewer(ctx, "pr-1001", "u2", 0)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "u4", newReviewerID)
+	}
+	prRepo.AssertNotCalled(t, "ReassignReviewer", ctx, "pr-1001", "u2", "u2", int64(0))
+	prRepo.AssertNotCalled(t, "ReassignReviewer", ctx, "pr-1001", "u2", "u3", int64(0))
+}
+
+func TestPRUseCase_ReassignReviewer_SkipsUnavailable(t *testing.T) {
+	ctx := context.Background()
+	prRepo := &mocks.PRRepository{}
+	userRepo := &mocks.UserRepository{}
+	uc := usecase.NewPRUseCase(prRepo, userRepo, usecase.WithReviewQuota(3))
+
+	vacationEnd := time.Now().Add(72 * time.Hour)
+	pr := &domain.PullRequest{ID: "pr-1001", AuthorID: "u1", Status: "OPEN", AssignedReviewers: []string{"u2"}}
+	prRepo.On("GetByID", ctx, "pr-1001").Return(pr, nil)
+	prRepo.On("IsUserReviewer", ctx, "pr-1001", "u2").Return(true, nil)
+	userRepo.On("GetByID", ctx, "u2").Return(&domain.User{ID: "u2", TeamName: "backend", IsActive: true}, nil)
+	userRepo.On("GetTeamRoster", ctx, "backend").Return([]*domain.ReviewCandidate{
+		{User: &domain.User{ID: "u2", TeamName: "backend", IsActive: true}},
+		{User: &domain.User{ID: "u3", TeamName: "backend", IsActive: true, OutOfOfficeUntil: &vacationEnd}},
+		{User: &domain.User{ID: "u4", TeamName: "backend", IsActive: true}, OpenReviews: 3},
+		{User: &domain.User{ID: "u5", TeamName: "backend", IsActive: true}, OpenReviews: 2},
+	}, nil)
+	prRepo.On("ReassignReviewer", ctx, "pr-1001", "u2", "u5", int64(0)).Return(nil)
+
+	for range 20 {
+		_, newReviewerID, err := uc.ReassignReviewer(ctx, "pr-1001", "u2", 0)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "u5", newReviewerID)
+	}
+}
+
+func TestPRUseCase_ReassignReviewer_NoCandidateBesidesReviewers(t *testing.T) {
+	ctx := context.Background()
+	prRepo := &mocks.PRRepository{}
+	userRepo := &mocks.UserRepository{}
+	uc := usecase.NewPRUseCase(prRepo, userRepo)
+
+	// Команда из автора и двух уже назначенных ревьюверов: заменить некем
+	pr := &domain.PullRequest{ID: "pr-1001", AuthorID: "u1", Status: "OPEN", AssignedReviewers: []string{"u2", "u3"}}
+	prRepo.On("GetByID", ctx, "pr-1001").Return(pr, nil)
+	prRepo.On("IsUserReviewer", ctx, "pr-1001", "u2").Return(true, nil)
+	userRepo.On("GetByID", ctx, "u2").Return(&domain.User{ID: "u2", TeamName: "backend", IsActive: true}, nil)
+	userRepo.On("GetTeamRoster", ctx, "backend").Return(toRoster([]*domain.User{
+		{ID: "u1", TeamName: "backend", IsActive: true},
+		{ID: "u2", TeamName: "backend", IsActive: true},
+		{ID: "u3", TeamName: "backend", IsActive: true},
+	}), nil)
+
+	_, _, err := uc.ReassignReviewer(ctx, "pr-1001", "u2", 0)
+
+	assert.ErrorIs(t, err, domain.ErrNoReviewerCandidate)
+	prRepo.AssertNotCalled(t, "ReassignReviewer", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
 func TestPRUseCase_ReassignReviewer_PRNotFound(t *testing.T) {
 	ctx := context.Background()
 	prRepo := &mocks.PRRepository{}
@@ -210,7 +322,7 @@ func TestPRUseCase_ReassignReviewer_PRNotFound(t *testing.T) {
 
 	prRepo.On("GetByID", ctx, "pr-1001").Return(nil, errors.New("not found"))
 
-	resultPR, newReviewerID, err := uc.ReassignReviewer(ctx, "pr-1001", "u2")
+	resultPR, newReviewerID, err := uc.ReassignReviewer(ctx, "pr-1001", "u2", 0)
 
 	assert.ErrorIs(t, err, domain.ErrPRNotFound)
 	assert.Nil(t, resultPR)
@@ -232,7 +344,7 @@ func TestPRUseCase_ReassignReviewer_PRAlreadyMerged(t *testing.T) {
 
 	prRepo.On("GetByID", ctx, "pr-1001").Return(pr, nil)
 
-	resultPR, newReviewerID, err := uc.ReassignReviewer(ctx, "pr-1001", "u2")
+	resultPR, newReviewerID, err := uc.ReassignReviewer(ctx, "pr-1001", "u2", 0)
 
 	assert.ErrorIs(t, err, domain.ErrPRAlreadyMerged)
 	assert.Nil(t, resultPR)
@@ -255,13 +367,54 @@ func TestPRUseCase_ReassignReviewer_ReviewerNotAssigned(t *testing.T) {
 	prRepo.On("GetByID", ctx, "pr-1001").Return(pr, nil)
 	prRepo.On("IsUserReviewer", ctx, "pr-1001", "u2").Return(false, nil)
 
-	resultPR, newReviewerID, err := uc.ReassignReviewer(ctx, "pr-1001", "u2")
+	resultPR, newReviewerID, err := uc.ReassignReviewer(ctx, "pr-1001", "u2", 0)
 
 	assert.ErrorIs(t, err, domain.ErrReviewerNotAssigned)
 	assert.Nil(t, resultPR)
 	assert.Equal(t, "", newReviewerID)
 }
 
+func TestPRUseCase_ReassignReviewer_VersionMismatch(t *testing.T) {
+	ctx := context.Background()
+	prRepo := &mocks.PRRepository{}
+	userRepo := &mocks.UserRepository{}
+	uc := usecase.NewPRUseCase(prRepo, userRepo)
+
+	pr := &domain.PullRequest{
+		ID:                "pr-1001",
+		Name:              "Add feature",
+		AuthorID:          "u1",
+		Status:            "OPEN",
+		AssignedReviewers: []string{"u2"},
+		Version:           4,
+	}
+
+	prRepo.On("GetByID", ctx, "pr-1001").Return(pr, nil)
+
+	resultPR, newReviewerID, err := uc.ReassignReviewer(ctx, "pr-1001", "u2", 3)
+
+	assert.ErrorIs(t, err, domain.ErrPRVersionConflict)
+	assert.Nil(t, resultPR)
+	assert.Equal(t, "", newReviewerID)
+	prRepo.AssertNotCalled(t, "ReassignReviewer", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestPRUseCase_MergePR_PassesIfMatch(t *testing.T) {
+	ctx := context.Background()
+	prRepo := &mocks.PRRepository{}
+	userRepo := &mocks.UserRepository{}
+	uc := usecase.NewPRUseCase(prRepo, userRepo)
+
+	prRepo.On("ExistsPr", ctx, "pr-1001").Return(true, nil)
+	prRepo.On("Merge", ctx, "pr-1001", int64(2)).Return(nil, false, domain.ErrPRVersionConflict)
+
+	result, err := uc.MergePR(ctx, "pr-1001", 2)
+
+	assert.ErrorIs(t, err, domain.ErrPRVersionConflict)
+	assert.Nil(t, result)
+	prRepo.AssertExpectations(t)
+}
+
 func TestPRUseCase_MergePR_Idempotent(t *testing.T) {
 	ctx := context.Background()
 	prRepo := &mocks.PRRepository{}
@@ -277,22 +430,654 @@ func TestPRUseCase_MergePR_Idempotent(t *testing.T) {
 
 	// Первый вызов - мердж
 	prRepo.On("ExistsPr", ctx, "pr-1001").Return(true, nil).Once()
-	prRepo.On("Merge", ctx, "pr-1001").Return(mergedPR, nil).Once()
+	prRepo.On("Merge", ctx, "pr-1001", int64(0)).Return(mergedPR, true, nil).Once()
 
 	// Второй вызов - идемпотентный, возвращает тот же результат
 	prRepo.On("ExistsPr", ctx, "pr-1001").Return(true, nil).Once()
-	prRepo.On("Merge", ctx, "pr-1001").Return(mergedPR, nil).Once()
+	prRepo.On("Merge", ctx, "pr-1001", int64(0)).Return(mergedPR, false, nil).Once()
 
 	// Первый мердж
-	result1, err1 := uc.MergePR(ctx, "pr-1001")
+	result1, err1 := uc.MergePR(ctx, "pr-1001", 0)
 	assert.NoError(t, err1)
 	assert.Equal(t, "MERGED", result1.Status)
 
 	// Второй мердж (идемпотентный)
-	result2, err2 := uc.MergePR(ctx, "pr-1001")
+	result2, err2 := uc.MergePR(ctx, "pr-1001", 0)
 	assert.NoError(t, err2)
 	assert.Equal(t, "MERGED", result2.Status)
 	assert.Equal(t, result1, result2)
 
 	prRepo.AssertExpectations(t)
 }
+
+func TestPRUseCase_CreatePR_EscalatesToSiblingTeam(t *testing.T) {
+	ctx := context.Background()
+	prRepo := &mocks.PRRepository{}
+	userRepo := &mocks.UserRepository{}
+	teamRepo := &mocks.TeamRepository{}
+	uc := usecase.NewPRUseCase(prRepo, userRepo, usecase.WithEscalation(teamRepo, domain.EscalationSiblings))
+
+	author := &domain.User{ID: "u1", Username: "Alice", TeamName: "payments", IsActive: true}
+	siblingCandidates := []*domain.User{
+		{ID: "u5", Username: "Eve", TeamName: "billing", IsActive: true},
+	}
+
+	userRepo.On("GetByID", ctx, "u1").Return(author, nil)
+	prRepo.On("ExistsPr", ctx, "pr-1001").Return(false, nil)
+	userRepo.On("GetTeamRoster", ctx, "payments").Return(toRoster([]*domain.User{}), nil)
+	teamRepo.On("GetParent", ctx, "payments").Return("fintech", nil)
+	teamRepo.On("GetChildren", ctx, "fintech").Return([]string{"billing", "payments"}, nil)
+	userRepo.On("GetTeamRoster", ctx, "billing").Return(toRoster(siblingCandidates), nil)
+	prRepo.On("CreateWithReviewers", ctx, mock.AnythingOfType("*domain.PullRequest"), []string{"u5"}).Return(nil)
+
+	pr, err := uc.CreatePR(ctx, &domain.CreatePRRequest{ID: "pr-1001", Name: "Add feature", AuthorID: "u1"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"u5"}, pr.AssignedReviewers)
+	teamRepo.AssertExpectations(t)
+	userRepo.AssertExpectations(t)
+}
+
+func TestPRUseCase_CreatePR_EscalatesUpParentChain(t *testing.T) {
+	ctx := context.Background()
+	prRepo := &mocks.PRRepository{}
+	userRepo := &mocks.UserRepository{}
+	teamRepo := &mocks.TeamRepository{}
+	uc := usecase.NewPRUseCase(prRepo, userRepo, usecase.WithEscalation(teamRepo, domain.EscalationParent))
+
+	author := &domain.User{ID: "u1", Username: "Alice", TeamName: "payments", IsActive: true}
+
+	userRepo.On("GetByID", ctx, "u1").Return(author, nil)
+	prRepo.On("ExistsPr", ctx, "pr-1001").Return(false, nil)
+	userRepo.On("GetTeamRoster", ctx, "payments").Return(toRoster([]*domain.User{}), nil)
+	teamRepo.On("GetParent", ctx, "payments").Return("fintech", nil)
+	teamRepo.On("GetParent", ctx, "fintech").Return("company", nil)
+	teamRepo.On("GetParent", ctx, "company").Return("", nil)
+	userRepo.On("GetTeamRoster", ctx, "fintech").Return(toRoster([]*domain.User{
+		{ID: "u7", Username: "Head", TeamName: "fintech", IsActive: true},
+		{ID: "u6", Username: "Gone", TeamName: "fintech", IsActive: false},
+	}), nil)
+	userRepo.On("GetTeamRoster", ctx, "company").Return(toRoster([]*domain.User{
+		{ID: "u8", Username: "CTO", TeamName: "company", IsActive: true},
+	}), nil)
+	prRepo.On("CreateWithReviewers", ctx, mock.AnythingOfType("*domain.PullRequest"), []string{"u7", "u8"}).Return(nil)
+
+	pr, err := uc.CreatePR(ctx, &domain.CreatePRRequest{ID: "pr-1001", Name: "Add feature", AuthorID: "u1"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"u7", "u8"}, pr.AssignedReviewers)
+	teamRepo.AssertNotCalled(t, "GetChildren", mock.Anything, mock.Anything)
+}
+
+func TestPRUseCase_CreatePR_EscalationFindsNobody(t *testing.T) {
+	ctx := context.Background()
+	prRepo := &mocks.PRRepository{}
+	userRepo := &mocks.UserRepository{}
+	teamRepo := &mocks.TeamRepository{}
+	uc := usecase.NewPRUseCase(prRepo, userRepo, usecase.WithEscalation(teamRepo, domain.EscalationSiblingsThenParent))
+
+	author := &domain.User{ID: "u1", Username: "Alice", TeamName: "payments", IsActive: true}
+
+	userRepo.On("GetByID", ctx, "u1").Return(author, nil)
+	prRepo.On("ExistsPr", ctx, "pr-1001").Return(false, nil)
+	userRepo.On("GetTeamRoster", ctx, "payments").Return(toRoster([]*domain.User{}), nil)
+	teamRepo.On("GetParent", ctx, "payments").Return("", nil)
+
+	pr, err := uc.CreatePR(ctx, &domain.CreatePRRequest{ID: "pr-1001", Name: "Add feature", AuthorID: "u1"})
+
+	assert.ErrorIs(t, err, domain.ErrNoReviewerCandidate)
+	assert.Nil(t, pr)
+}
+
+func TestPRUseCase_CreatePR_CoversEveryOwnershipRule(t *testing.T) {
+	ctx := context.Background()
+	prRepo := &mocks.PRRepository{}
+	userRepo := &mocks.UserRepository{}
+	teamRepo := &mocks.TeamRepository{}
+	ownershipRepo := &mocks.OwnershipRepository{}
+	uc := usecase.NewPRUseCase(prRepo, userRepo, usecase.WithOwnershipRules(ownershipRepo, teamRepo))
+
+	author := &domain.User{ID: "u1", Username: "Alice", TeamName: "backend", IsActive: true}
+	rules := []*domain.OwnershipRule{
+		{Pattern: "*", TeamNames: []string{"backend"}},
+		{Pattern: "/migrations/", UserIDs: []string{"u7"}},
+		{Pattern: "docs/**", UserIDs: []string{"u8", "u1"}},
+	}
+
+	userRepo.On("GetByID", ctx, "u1").Return(author, nil)
+	userRepo.On("GetByID", ctx, "u7").Return(&domain.User{ID: "u7", Username: "Dba", TeamName: "platform", IsActive: true}, nil)
+	userRepo.On("GetByID", ctx, "u8").Return(&domain.User{ID: "u8", Username: "Writer", TeamName: "docs", IsActive: true}, nil)
+	userRepo.On("GetReviewCandidates", ctx, []string{"u2"}).Return(toRoster([]*domain.User{{ID: "u2", TeamName: "backend", IsActive: true}}), nil)
+	userRepo.On("GetReviewCandidates", ctx, []string{"u7"}).Return(toRoster([]*domain.User{{ID: "u7", TeamName: "platform", IsActive: true}}), nil)
+	userRepo.On("GetReviewCandidates", ctx, []string{"u8"}).Return(toRoster([]*domain.User{{ID: "u8", TeamName: "docs", IsActive: true}}), nil)
+	prRepo.On("ExistsPr", ctx, "pr-1001").Return(false, nil)
+	ownershipRepo.On("GetRules", ctx, "search").Return(rules, nil)
+	teamRepo.On("GetActiveUsersFromTeam", ctx, "backend").Return([]*domain.User{
+		{ID: "u1", Username: "Alice", TeamName: "backend", IsActive: true},
+		{ID: "u2", Username: "Bob", TeamName: "backend", IsActive: true},
+	}, nil)
+	prRepo.On("CreateWithReviewers", ctx, mock.AnythingOfType("*domain.PullRequest"), mock.Anything).Return(nil)
+
+	pr, err := uc.CreatePR(ctx, &domain.CreatePRRequest{
+		ID:           "pr-1001",
+		Name:         "Add feature",
+		AuthorID:     "u1",
+		Repository:   "search",
+		ChangedFiles: []string{"internal/search.go", "migrations/001_init.sql", "docs/search/index.md"},
+	})
+
+	// Каждое сработавшее правило покрыто своим владельцем, поэтому ревьюверов трое
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{"u2", "u7", "u8"}, pr.AssignedReviewers)
+	userRepo.AssertNotCalled(t, "GetActiveUsersByTeam", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestPRUseCase_CreatePR_OwnerTopUpFromAuthorTeam(t *testing.T) {
+	ctx := context.Background()
+	prRepo := &mocks.PRRepository{}
+	userRepo := &mocks.UserRepository{}
+	teamRepo := &mocks.TeamRepository{}
+	ownershipRepo := &mocks.OwnershipRepository{}
+	uc := usecase.NewPRUseCase(prRepo, userRepo, usecase.WithOwnershipRules(ownershipRepo, teamRepo))
+
+	author := &domain.User{ID: "u1", Username: "Alice", TeamName: "backend", IsActive: true}
+	owner := &domain.User{ID: "u7", Username: "Dba", TeamName: "platform", IsActive: true}
+
+	userRepo.On("GetByID", ctx, "u1").Return(author, nil)
+	userRepo.On("GetByID", ctx, "u7").Return(owner, nil)
+	userRepo.On("GetReviewCandidates", ctx, []string{"u7"}).Return(toRoster([]*domain.User{owner}), nil)
+	prRepo.On("ExistsPr", ctx, "pr-1001").Return(false, nil)
+	ownershipRepo.On("GetRules", ctx, "search").Return([]*domain.OwnershipRule{
+		{Pattern: "*.sql", UserIDs: []string{"u7"}},
+	}, nil)
+	userRepo.On("GetTeamRoster", ctx, "backend").Return(toRoster([]*domain.User{
+		{ID: "u1", Username: "Alice", TeamName: "backend", IsActive: true},
+		{ID: "u2", Username: "Bob", TeamName: "backend", IsActive: true},
+		{ID: "u7", Username: "Dba", TeamName: "platform", IsActive: true},
+	}), nil)
+	prRepo.On("CreateWithReviewers", ctx, mock.AnythingOfType("*domain.PullRequest"), []string{"u7", "u2"}).Return(nil)
+
+	pr, err := uc.CreatePR(ctx, &domain.CreatePRRequest{
+		ID:           "pr-1001",
+		Name:         "Add feature",
+		AuthorID:     "u1",
+		Repository:   "search",
+		ChangedFiles: []string{"db/schema/users.sql", "README.md"},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"u7", "u2"}, pr.AssignedReviewers)
+	prRepo.AssertExpectations(t)
+}
+
+func TestPRUseCase_CreatePR_InactiveOwnersFallBackToTeam(t *testing.T) {
+	ctx := context.Background()
+	prRepo := &mocks.PRRepository{}
+	userRepo := &mocks.UserRepository{}
+	teamRepo := &mocks.TeamRepository{}
+	ownershipRepo := &mocks.OwnershipRepository{}
+	uc := usecase.NewPRUseCase(prRepo, userRepo, usecase.WithOwnershipRules(ownershipRepo, teamRepo))
+
+	author := &domain.User{ID: "u1", Username: "Alice", TeamName: "backend", IsActive: true}
+
+	userRepo.On("GetByID", ctx, "u1").Return(author, nil)
+	userRepo.On("GetByID", ctx, "u7").Return(&domain.User{ID: "u7", Username: "Dba", TeamName: "platform", IsActive: false}, nil)
+	prRepo.On("ExistsPr", ctx, "pr-1001").Return(false, nil)
+	ownershipRepo.On("GetRules", ctx, "search").Return([]*domain.OwnershipRule{
+		{Pattern: "/migrations", UserIDs: []string{"u7"}},
+	}, nil)
+	userRepo.On("GetTeamRoster", ctx, "backend").Return(toRoster([]*domain.User{
+		{ID: "u2", Username: "Bob", TeamName: "backend", IsActive: true},
+	}), nil)
+	prRepo.On("CreateWithReviewers", ctx, mock.AnythingOfType("*domain.PullRequest"), []string{"u2"}).Return(nil)
+
+	pr, err := uc.CreatePR(ctx, &domain.CreatePRRequest{
+		ID:           "pr-1001",
+		Name:         "Add feature",
+		AuthorID:     "u1",
+		Repository:   "search",
+		ChangedFiles: []string{"migrations/002_add_index.sql"},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"u2"}, pr.AssignedReviewers)
+}
+
+func TestPRUseCase_CreatePR_PrefersSkillCoverage(t *testing.T) {
+	ctx := context.Background()
+	prRepo := &mocks.PRRepository{}
+	userRepo := &mocks.UserRepository{}
+	uc := usecase.NewPRUseCase(prRepo, userRepo)
+
+	author := &domain.User{ID: "u1", Username: "Alice", TeamName: "backend", IsActive: true}
+	pool := []*domain.ReviewCandidate{
+		{User: &domain.User{ID: "u2", Username: "Bob", TeamName: "backend", IsActive: true, Skills: []string{"go"}}, OpenReviews: 0},
+		{User: &domain.User{ID: "u3", Username: "Charlie", TeamName: "backend", IsActive: true, Skills: []string{"go", "postgres"}}, OpenReviews: 3},
+		{User: &domain.User{ID: "u4", Username: "Dave", TeamName: "backend", IsActive: true}, OpenReviews: 0},
+		{User: &domain.User{ID: "u5", Username: "Eve", TeamName: "backend", IsActive: true, Skills: []string{"postgres"}}, OpenReviews: 5},
+	}
+
+	userRepo.On("GetByID", ctx, "u1").Return(author, nil)
+	prRepo.On("ExistsPr", ctx, "pr-1001").Return(false, nil)
+	userRepo.On("GetTeamRoster", ctx, "backend").Return(pool, nil)
+	prRepo.On("CreateWithReviewers", ctx, mock.AnythingOfType("*domain.PullRequest"), []string{"u3", "u2"}).Return(nil)
+
+	pr, err := uc.CreatePR(ctx, &domain.CreatePRRequest{
+		ID:           "pr-1001",
+		Name:         "Add feature",
+		AuthorID:     "u1",
+		RequiredTags: []string{"Postgres", "go"},
+	})
+
+	// u3 покрывает оба тега, несмотря на нагрузку; после этого все теги покрыты,
+	// и второй ревьювер выбирается по полному покрытию и нагрузке (u2 с go и без ревью)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"u3", "u2"}, pr.AssignedReviewers)
+	assert.Equal(t, domain.SelectionSkillMatch, pr.Rationale.Reviewers[0].Reason)
+	assert.Equal(t, []string{"go", "postgres"}, pr.Rationale.Reviewers[0].MatchedTags)
+	assert.Equal(t, 3, pr.Rationale.Reviewers[0].OpenReviews)
+	assert.InDelta(t, 0.7+0.3/4, pr.Rationale.Reviewers[0].Score, 1e-9)
+	assert.Equal(t, []string{"go"}, pr.Rationale.Reviewers[1].MatchedTags)
+	userRepo.AssertNotCalled(t, "GetActiveUsersByTeam", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestPRUseCase_CreatePR_SkillsFallBackToWorkload(t *testing.T) {
+	ctx := context.Background()
+	prRepo := &mocks.PRRepository{}
+	userRepo := &mocks.UserRepository{}
+	uc := usecase.NewPRUseCase(prRepo, userRepo)
+
+	author := &domain.User{ID: "u1", Username: "Alice", TeamName: "backend", IsActive: true}
+	pool := []*domain.ReviewCandidate{
+		{User: &domain.User{ID: "u2", Username: "Bob", TeamName: "backend", IsActive: true}, OpenReviews: 4},
+		{User: &domain.User{ID: "u3", Username: "Charlie", TeamName: "backend", IsActive: true}, OpenReviews: 1},
+	}
+
+	userRepo.On("GetByID", ctx, "u1").Return(author, nil)
+	prRepo.On("ExistsPr", ctx, "pr-1001").Return(false, nil)
+	userRepo.On("GetTeamRoster", ctx, "backend").Return(pool, nil)
+	prRepo.On("CreateWithReviewers", ctx, mock.AnythingOfType("*domain.PullRequest"), []string{"u3", "u2"}).Return(nil)
+
+	pr, err := uc.CreatePR(ctx, &domain.CreatePRRequest{
+		ID:           "pr-1001",
+		Name:         "Add feature",
+		AuthorID:     "u1",
+		RequiredTags: []string{"rust"},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"u3", "u2"}, pr.AssignedReviewers)
+	assert.Equal(t, domain.SelectionLowWorkload, pr.Rationale.Reviewers[0].Reason)
+	assert.Equal(t, domain.SelectionLowWorkload, pr.Rationale.Reviewers[1].Reason)
+}
+
+func TestPRUseCase_CreatePR_InvalidRequiredTag(t *testing.T) {
+	ctx := context.Background()
+	prRepo := &mocks.PRRepository{}
+	userRepo := &mocks.UserRepository{}
+	uc := usecase.NewPRUseCase(prRepo, userRepo)
+
+	pr, err := uc.CreatePR(ctx, &domain.CreatePRRequest{
+		ID:           "pr-1001",
+		Name:         "Add feature",
+		AuthorID:     "u1",
+		RequiredTags: []string{"go lang"},
+	})
+
+	assert.ErrorIs(t, err, domain.ErrInvalidSkillTag)
+	assert.Nil(t, pr)
+	userRepo.AssertNotCalled(t, "GetByID", mock.Anything, mock.Anything)
+}
+
+// toRoster оборачивает пользователей в состав команды без нагрузки
+func toRoster(users []*domain.User) []*domain.ReviewCandidate {
+	roster := make([]*domain.ReviewCandidate, len(users))
+	for i, user := range users {
+		roster[i] = &domain.ReviewCandidate{User: user}
+	}
+	return roster
+}
+
+func TestPRUseCase_CreatePR_RecordsRationale(t *testing.T) {
+	ctx := context.Background()
+	prRepo := &mocks.PRRepository{}
+	userRepo := &mocks.UserRepository{}
+	uc := usecase.NewPRUseCase(prRepo, userRepo)
+
+	author := &domain.User{ID: "u1", Username: "Alice", TeamName: "backend", IsActive: true}
+	roster := []*domain.ReviewCandidate{
+		{User: author, OpenReviews: 0},
+		{User: &domain.User{ID: "u2", Username: "Bob", TeamName: "backend", IsActive: true}, OpenReviews: 2},
+		{User: &domain.User{ID: "u3", Username: "Charlie", TeamName: "backend", IsActive: false}, OpenReviews: 0},
+	}
+
+	userRepo.On("GetByID", ctx, "u1").Return(author, nil)
+	prRepo.On("ExistsPr", ctx, "pr-1001").Return(false, nil)
+	userRepo.On("GetTeamRoster", ctx, "backend").Return(roster, nil)
+	prRepo.On("CreateWithReviewers", ctx, mock.MatchedBy(func(pr *domain.PullRequest) bool {
+		return pr.Rationale != nil && pr.Rationale.Strategy == domain.StrategyTeamRandom
+	}), []string{"u2"}).Return(nil)
+
+	pr, err := uc.CreatePR(ctx, &domain.CreatePRRequest{ID: "pr-1001", Name: "Add feature", AuthorID: "u1"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, pr.Rationale.CandidatePoolSize)
+	assert.Equal(t, []*domain.ReviewerSelection{
+		{UserID: "u2", Reason: domain.SelectionTeamMember, OpenReviews: 2},
+	}, pr.Rationale.Reviewers)
+	assert.Equal(t, []*domain.ExcludedCandidate{
+		{UserID: "u1", Reason: domain.ExclusionAuthor},
+		{UserID: "u3", Reason: domain.ExclusionInactive},
+	}, pr.Rationale.Excluded)
+	prRepo.AssertExpectations(t)
+}
+
+func TestPRUseCase_CreatePR_ExcludesOutOfOfficeAndOverQuota(t *testing.T) {
+	ctx := context.Background()
+	prRepo := &mocks.PRRepository{}
+	userRepo := &mocks.UserRepository{}
+	uc := usecase.NewPRUseCase(prRepo, userRepo, usecase.WithReviewQuota(3))
+
+	author := &domain.User{ID: "u1", Username: "Alice", TeamName: "backend", IsActive: true}
+	vacationEnd := time.Now().Add(72 * time.Hour)
+	returned := time.Now().Add(-time.Hour)
+	roster := []*domain.ReviewCandidate{
+		{User: author},
+		{User: &domain.User{ID: "u2", Username: "Bob", TeamName: "backend", IsActive: true, OutOfOfficeUntil: &vacationEnd}},
+		{User: &domain.User{ID: "u3", Username: "Charlie", TeamName: "backend", IsActive: true}, OpenReviews: 3},
+		{User: &domain.User{ID: "u4", Username: "Dave", TeamName: "backend", IsActive: true, OutOfOfficeUntil: &returned}, OpenReviews: 1},
+		{User: &domain.User{ID: "u5", Username: "Eve", TeamName: "backend", IsActive: true}, OpenReviews: 2},
+	}
+
+	userRepo.On("GetByID", ctx, "u1").Return(author, nil)
+	prRepo.On("ExistsPr", ctx, "pr-1001").Return(false, nil)
+	userRepo.On("GetTeamRoster", ctx, "backend").Return(roster, nil)
+	prRepo.On("CreateWithReviewers", ctx, mock.AnythingOfType("*domain.PullRequest"), []string{"u4", "u5"}).Return(nil)
+
+	pr, err := uc.CreatePR(ctx, &domain.CreatePRRequest{ID: "pr-1001", Name: "Add feature", AuthorID: "u1"})
+
+	// Отсутствие u4 уже закончилось, у u5 нагрузка ниже квоты
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"u4", "u5"}, pr.AssignedReviewers)
+	assert.Equal(t, 2, pr.Rationale.CandidatePoolSize)
+	assert.Equal(t, []*domain.ExcludedCandidate{
+		{UserID: "u1", Reason: domain.ExclusionAuthor},
+		{UserID: "u2", Reason: domain.ExclusionOutOfOffice},
+		{UserID: "u3", Reason: domain.ExclusionOverQuota},
+	}, pr.Rationale.Excluded)
+}
+
+func TestPRUseCase_CreatePR_UnavailableOwnerFallsBackToTeam(t *testing.T) {
+	ctx := context.Background()
+	prRepo := &mocks.PRRepository{}
+	userRepo := &mocks.UserRepository{}
+	teamRepo := &mocks.TeamRepository{}
+	ownershipRepo := &mocks.OwnershipRepository{}
+	uc := usecase.NewPRUseCase(prRepo, userRepo, usecase.WithOwnershipRules(ownershipRepo, teamRepo), usecase.WithReviewQuota(5))
+
+	author := &domain.User{ID: "u1", Username: "Alice", TeamName: "backend", IsActive: true}
+	vacationEnd := time.Now().Add(72 * time.Hour)
+
+	userRepo.On("GetByID", ctx, "u1").Return(author, nil)
+	userRepo.On("GetByID", ctx, "u7").Return(&domain.User{ID: "u7", TeamName: "platform", IsActive: true}, nil)
+	prRepo.On("ExistsPr", ctx, "pr-1001").Return(false, nil)
+	ownershipRepo.On("GetRules", ctx, "search").Return([]*domain.OwnershipRule{
+		{Pattern: "*.sql", UserIDs: []string{"u7"}, TeamNames: []string{"dba"}},
+	}, nil)
+	teamRepo.On("GetActiveUsersFromTeam", ctx, "dba").Return([]*domain.User{
+		{ID: "u8", TeamName: "dba", IsActive: true},
+	}, nil)
+	userRepo.On("GetReviewCandidates", ctx, []string{"u7", "u8"}).Return([]*domain.ReviewCandidate{
+		{User: &domain.User{ID: "u7", TeamName: "platform", IsActive: true, OutOfOfficeUntil: &vacationEnd}},
+		{User: &domain.User{ID: "u8", TeamName: "dba", IsActive: true}, OpenReviews: 5},
+	}, nil)
+	userRepo.On("GetTeamRoster", ctx, "backend").Return(toRoster([]*domain.User{
+		{ID: "u2", Username: "Bob", TeamName: "backend", IsActive: true},
+	}), nil)
+	prRepo.On("CreateWithReviewers", ctx, mock.AnythingOfType("*domain.PullRequest"), []string{"u2"}).Return(nil)
+
+	pr, err := uc.CreatePR(ctx, &domain.CreatePRRequest{
+		ID:           "pr-1001",
+		Name:         "Add feature",
+		AuthorID:     "u1",
+		Repository:   "search",
+		ChangedFiles: []string{"schema.sql"},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"u2"}, pr.AssignedReviewers)
+	assert.Equal(t, domain.StrategyTeamRandom, pr.Rationale.Strategy)
+	assert.Contains(t, pr.Rationale.Excluded, &domain.ExcludedCandidate{UserID: "u7", Reason: domain.ExclusionOutOfOffice})
+	assert.Contains(t, pr.Rationale.Excluded, &domain.ExcludedCandidate{UserID: "u8", Reason: domain.ExclusionOverQuota})
+}
+
+func TestPRUseCase_CreatePR_RationaleCombinesStrategies(t *testing.T) {
+	ctx := context.Background()
+	prRepo := &mocks.PRRepository{}
+	userRepo := &mocks.UserRepository{}
+	teamRepo := &mocks.TeamRepository{}
+	ownershipRepo := &mocks.OwnershipRepository{}
+	uc := usecase.NewPRUseCase(prRepo, userRepo, usecase.WithOwnershipRules(ownershipRepo, teamRepo))
+
+	author := &domain.User{ID: "u1", Username: "Alice", TeamName: "backend", IsActive: true}
+
+	userRepo.On("GetByID", ctx, "u1").Return(author, nil)
+	userRepo.On("GetByID", ctx, "u7").Return(&domain.User{ID: "u7", TeamName: "platform", IsActive: true}, nil)
+	userRepo.On("GetByID", ctx, "u8").Return(&domain.User{ID: "u8", TeamName: "platform", IsActive: false}, nil)
+	userRepo.On("GetReviewCandidates", ctx, []string{"u7"}).Return(toRoster([]*domain.User{{ID: "u7", TeamName: "platform", IsActive: true}}), nil)
+	prRepo.On("ExistsPr", ctx, "pr-1001").Return(false, nil)
+	ownershipRepo.On("GetRules", ctx, "search").Return([]*domain.OwnershipRule{
+		{Pattern: "*.sql", UserIDs: []string{"u7", "u8"}},
+	}, nil)
+	userRepo.On("GetTeamRoster", ctx, "backend").Return([]*domain.ReviewCandidate{
+		{User: &domain.User{ID: "u2", TeamName: "backend", IsActive: true, Skills: []string{"go"}}, OpenReviews: 1},
+	}, nil)
+	prRepo.On("CreateWithReviewers", ctx, mock.AnythingOfType("*domain.PullRequest"), []string{"u7", "u2"}).Return(nil)
+
+	pr, err := uc.CreatePR(ctx, &domain.CreatePRRequest{
+		ID:           "pr-1001",
+		Name:         "Add feature",
+		AuthorID:     "u1",
+		Repository:   "search",
+		ChangedFiles: []string{"schema.sql"},
+		RequiredTags: []string{"go"},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "code_owners+skills", pr.Rationale.Strategy)
+	assert.Equal(t, 2, pr.Rationale.CandidatePoolSize)
+	assert.Equal(t, domain.SelectionCodeOwner, pr.Rationale.Reviewers[0].Reason)
+	assert.Equal(t, domain.SelectionSkillMatch, pr.Rationale.Reviewers[1].Reason)
+	assert.InDelta(t, 0.7, pr.Rationale.Reviewers[1].SkillScore, 1e-9)
+	assert.InDelta(t, 0.15, pr.Rationale.Reviewers[1].WorkloadScore, 1e-9)
+	assert.Contains(t, pr.Rationale.Excluded, &domain.ExcludedCandidate{UserID: "u8", Reason: domain.ExclusionInactive})
+}
+
+func TestPRUseCase_GetPR_Explain(t *testing.T) {
+	ctx := context.Background()
+	prRepo := &mocks.PRRepository{}
+	userRepo := &mocks.UserRepository{}
+	uc := usecase.NewPRUseCase(prRepo, userRepo)
+
+	rationale := &domain.AssignmentRationale{Strategy: domain.StrategyTeamRandom, CandidatePoolSize: 3}
+	prRepo.On("GetByID", ctx, "pr-1001").Return(&domain.PullRequest{ID: "pr-1001", Status: "OPEN"}, nil)
+	prRepo.On("GetRationale", ctx, "pr-1001").Return(rationale, nil)
+
+	pr, err := uc.GetPR(ctx, "pr-1001", false)
+	assert.NoError(t, err)
+	assert.Nil(t, pr.Rationale)
+	prRepo.AssertNotCalled(t, "GetRationale", ctx, "pr-1001")
+
+	pr, err = uc.GetPR(ctx, "pr-1001", true)
+	assert.NoError(t, err)
+	assert.Equal(t, rationale, pr.Rationale)
+}
+
+func TestPRUseCase_GetPR_NotFound(t *testing.T) {
+	ctx := context.Background()
+	prRepo := &mocks.PRRepository{}
+	userRepo := &mocks.UserRepository{}
+	uc := usecase.NewPRUseCase(prRepo, userRepo)
+
+	prRepo.On("GetByID", ctx, "missing").Return(nil, domain.ErrPRNotFound)
+
+	pr, err := uc.GetPR(ctx, "missing", true)
+
+	assert.ErrorIs(t, err, domain.ErrPRNotFound)
+	assert.Nil(t, pr)
+}
+
+func TestPRUseCase_ListPRs_Pagination(t *testing.T) {
+	ctx := context.Background()
+	prRepo := &mocks.PRRepository{}
+	userRepo := &mocks.UserRepository{}
+	uc := usecase.NewPRUseCase(prRepo, userRepo)
+
+	newer := time.Date(2025, 10, 24, 12, 0, 0, 0, time.UTC)
+	older := newer.Add(-time.Hour)
+	oldest := older.Add(-time.Hour)
+	filter := &domain.PRListFilter{Status: domain.PRStatusOpen, Limit: 2}
+
+	// Репозиторий просят на один PR больше, чтобы узнать о следующей странице
+	prRepo.On("List", ctx, filter, (*domain.PRCursor)(nil), 3).Return([]*domain.PullRequest{
+		{ID: "pr-3", CreatedAt: &newer},
+		{ID: "pr-2", CreatedAt: &older},
+		{ID: "pr-1", CreatedAt: &oldest},
+	}, nil).Once()
+
+	page, err := uc.ListPRs(ctx, filter)
+	assert.NoError(t, err)
+	assert.Len(t, page.PullRequests, 2)
+	assert.NotEmpty(t, page.NextCursor)
+
+	// Курсор указывает на последний PR страницы
+	next := &domain.PRListFilter{Status: domain.PRStatusOpen, Limit: 2, Cursor: page.NextCursor}
+	prRepo.On("List", ctx, next, &domain.PRCursor{CreatedAt: older, ID: "pr-2"}, 3).Return([]*domain.PullRequest{
+		{ID: "pr-1", CreatedAt: &oldest},
+	}, nil).Once()
+
+	page, err = uc.ListPRs(ctx, next)
+	assert.NoError(t, err)
+	assert.Len(t, page.PullRequests, 1)
+	assert.Empty(t, page.NextCursor)
+	prRepo.AssertExpectations(t)
+}
+
+func TestPRUseCase_ListPRs_DefaultLimit(t *testing.T) {
+	ctx := context.Background()
+	prRepo := &mocks.PRRepository{}
+	userRepo := &mocks.UserRepository{}
+	uc := usecase.NewPRUseCase(prRepo, userRepo)
+
+	filter := &domain.PRListFilter{}
+	prRepo.On("List", ctx, filter, (*domain.PRCursor)(nil), 51).Return([]*domain.PullRequest{}, nil)
+
+	page, err := uc.ListPRs(ctx, filter)
+	assert.NoError(t, err)
+	assert.Empty(t, page.PullRequests)
+	assert.Empty(t, page.NextCursor)
+}
+
+func TestPRUseCase_ListPRs_InvalidFilter(t *testing.T) {
+	ctx := context.Background()
+	from := time.Date(2025, 10, 24, 0, 0, 0, 0, time.UTC)
+	to := from.Add(-time.Hour)
+
+	tests := []struct {
+		name    string
+		filter  *domain.PRListFilter
+		wantErr error
+	}{
+		{name: "unknown status", filter: &domain.PRListFilter{Status: "CLOSED"}, wantErr: domain.ErrInvalidPRStatus},
+		{name: "limit too large", filter: &domain.PRListFilter{Limit: 101}, wantErr: domain.ErrInvalidPageLimit},
+		{name: "negative limit", filter: &domain.PRListFilter{Limit: -1}, wantErr: domain.ErrInvalidPageLimit},
+		{name: "empty range", filter: &domain.PRListFilter{CreatedFrom: &from, CreatedTo: &to}, wantErr: domain.ErrInvalidTimeRange},
+		{name: "malformed cursor", filter: &domain.PRListFilter{Cursor: "not a cursor!"}, wantErr: domain.ErrInvalidCursor},
+		{name: "cursor without id", filter: &domain.PRListFilter{Cursor: "MjAyNS0xMC0yNFQxMjowMDowMFo"}, wantErr: domain.ErrInvalidCursor},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := usecase.NewPRUseCase(&mocks.PRRepository{}, &mocks.UserRepository{})
+
+			page, err := uc.ListPRs(ctx, tt.filter)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Nil(t, page)
+		})
+	}
+}
+
+func TestPRUseCase_ReassignReviewer_PublishesEvents(t *testing.T) {
+	ctx := context.Background()
+	prRepo := &mocks.PRRepository{}
+	userRepo := &mocks.UserRepository{}
+	broker := events.NewBroker()
+	sub := broker.Subscribe(nil)
+	defer sub.Close()
+	uc := usecase.NewPRUseCase(prRepo, userRepo, usecase.WithEvents(broker))
+
+	pr := &domain.PullRequest{ID: "pr-1001", Name: "Add feature", AuthorID: "u1", Status: "OPEN"}
+	updatedPR := &domain.PullRequest{ID: "pr-1001", Name: "Add feature", AuthorID: "u1", Status: "OPEN", AssignedReviewers: []string{"u3"}}
+
+	prRepo.On("GetByID", ctx, "pr-1001").Return(pr, nil).Once()
+	prRepo.On("IsUserReviewer", ctx, "pr-1001", "u2").Return(true, nil)
+	userRepo.On("GetByID", ctx, "u2").Return(&domain.User{ID: "u2", TeamName: "backend", IsActive: true}, nil)
+	userRepo.On("GetTeamRoster", ctx, "backend").Return(toRoster([]*domain.User{{ID: "u3", TeamName: "backend", IsActive: true}}), nil)
+	prRepo.On("ReassignReviewer", ctx, "pr-1001", "u2", "u3", int64(0)).Return(nil)
+	prRepo.On("GetByID", ctx, "pr-1001").Return(updatedPR, nil).Once()
+
+	_, _, err := uc.ReassignReviewer(ctx, "pr-1001", "u2", 0)
+	assert.NoError(t, err)
+
+	unassigned := <-sub.C
+	assigned := <-sub.C
+	assert.Equal(t, domain.AssignmentUnassigned, unassigned.Type)
+	assert.Equal(t, "u2", unassigned.ReviewerID)
+	assert.Equal(t, domain.AssignmentAssigned, assigned.Type)
+	assert.Equal(t, "u3", assigned.ReviewerID)
+	assert.Equal(t, "Add feature", assigned.PullRequestName)
+	assert.Empty(t, sub.C)
+}
+
+func TestPRUseCase_CreatePR_FairShare(t *testing.T) {
+	ctx := context.Background()
+	prRepo := &mocks.PRRepository{}
+	userRepo := &mocks.UserRepository{}
+	statsRepo := mocks.NewStatsRepository(t)
+	uc := usecase.NewPRUseCase(prRepo, userRepo, usecase.WithFairShare(statsRepo, 7*24*time.Hour))
+
+	author := &domain.User{ID: "u1", Username: "Alice", TeamName: "backend", IsActive: true}
+	epoch := time.Unix(0, 0).UTC()
+
+	userRepo.On("GetByID", ctx, "u1").Return(author, nil)
+	prRepo.On("ExistsPr", ctx, "pr-1001").Return(false, nil)
+	userRepo.On("GetTeamRoster", ctx, "backend").Return([]*domain.ReviewCandidate{
+		{User: author},
+		{User: &domain.User{ID: "u2", TeamName: "backend", IsActive: true}, OpenReviews: 4},
+		{User: &domain.User{ID: "u3", TeamName: "backend", IsActive: true}, OpenReviews: 1},
+		{User: &domain.User{ID: "u4", TeamName: "backend", IsActive: true}, OpenReviews: 2},
+	}, nil)
+	statsRepo.On("GetMemberAssignments", ctx, "backend", mock.Anything, mock.Anything).Return([]*domain.MemberAssignments{
+		{UserID: "u1", TeamName: "backend", Assignments: 0},
+		{UserID: "u2", TeamName: "backend", Assignments: 10},
+		{UserID: "u3", TeamName: "backend", Assignments: 0},
+		{UserID: "u4", TeamName: "backend", Assignments: 5},
+	}, nil)
+	statsRepo.On("GetUserStatusHistory", ctx, "backend", mock.Anything).Return([]*domain.UserStatusChange{
+		{UserID: "u1", IsActive: true, ChangedAt: epoch},
+		{UserID: "u2", IsActive: true, ChangedAt: epoch},
+		{UserID: "u3", IsActive: true, ChangedAt: epoch},
+		{UserID: "u4", IsActive: true, ChangedAt: epoch},
+	}, nil)
+	prRepo.On("CreateWithReviewers", ctx, mock.AnythingOfType("*domain.PullRequest"), []string{"u3", "u4"}).Return(nil)
+
+	pr, err := uc.CreatePR(ctx, &domain.CreatePRRequest{ID: "pr-1001", Name: "Add feature", AuthorID: "u1"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, domain.StrategyFairShare, pr.Rationale.Strategy)
+	assert.Equal(t, []*domain.ReviewerSelection{
+		{UserID: "u3", Reason: domain.SelectionFairShare, OpenReviews: 1},
+		{UserID: "u4", Reason: domain.SelectionFairShare, OpenReviews: 2},
+	}, pr.Rationale.Reviewers)
+	prRepo.AssertExpectations(t)
+}
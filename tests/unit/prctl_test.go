@@ -0,0 +1,197 @@
+package usecase_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"pr-reviewer-service/internal/prctl"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// prctlServer - фейковый API: отвечает заранее заданными телами и запоминает вызванные пути
+type prctlServer struct {
+	*httptest.Server
+	calls     []string
+	authHeads []string
+}
+
+func newPRCtlServer(t *testing.T, responses map[string]string) *prctlServer {
+	s := &prctlServer{}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.calls = append(s.calls, r.Method+" "+r.URL.RequestURI())
+		s.authHeads = append(s.authHeads, r.Header.Get("Authorization"))
+
+		body, ok := responses[r.Method+" "+r.URL.RequestURI()]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"code":"NOT_FOUND","message":"resource not found"}}`))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func writePRCtlProfiles(t *testing.T, baseURL string) string {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	content := "current: dev\nprofiles:\n  dev:\n    base_url: " + baseURL + "\n    token: dev-token\n" +
+		"  prod:\n    base_url: http://prod.invalid\n    token: prod-token\n"
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func runPRCtl(t *testing.T, args ...string) (int, string, string) {
+	t.Setenv("PRCTL_PROFILE", "")
+	t.Setenv("PRCTL_BASE_URL", "")
+	t.Setenv("PRCTL_TOKEN", "")
+
+	var stdout, stderr bytes.Buffer
+	code := prctl.Run(context.Background(), args, strings.NewReader(""), &stdout, &stderr)
+	return code, stdout.String(), stderr.String()
+}
+
+const prctlTeamResponse = `{"team_name":"backend","parent_team_name":null,"members":[
+	{"user_id":"u1","username":"Alice","is_active":true},
+	{"user_id":"u2","username":"Bob","is_active":true},
+	{"user_id":"u3","username":"Carol","is_active":false}]}`
+
+func TestPRCtl_TeamGet_Table(t *testing.T) {
+	srv := newPRCtlServer(t, map[string]string{"GET /team/get?team_name=backend": prctlTeamResponse})
+	config := writePRCtlProfiles(t, srv.URL)
+
+	code, stdout, stderr := runPRCtl(t, "--config", config, "team", "get", "backend")
+
+	require.Equal(t, 0, code, stderr)
+	assert.Contains(t, stdout, "Team:   backend")
+	assert.Contains(t, stdout, "USER_ID  USERNAME  ACTIVE")
+	assert.Contains(t, stdout, "u3       Carol     no")
+	assert.Equal(t, []string{"Bearer dev-token"}, srv.authHeads)
+}
+
+func TestPRCtl_JSONOutputIsRawResponse(t *testing.T) {
+	srv := newPRCtlServer(t, map[string]string{"GET /team/get?team_name=backend": prctlTeamResponse})
+	config := writePRCtlProfiles(t, srv.URL)
+
+	code, stdout, _ := runPRCtl(t, "--config", config, "-o", "json", "team", "get", "backend")
+
+	require.Equal(t, 0, code)
+	var team map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(stdout), &team))
+	assert.Equal(t, "backend", team["team_name"])
+}
+
+func TestPRCtl_ProfileAndEnvOverrides(t *testing.T) {
+	srv := newPRCtlServer(t, map[string]string{"GET /stats/reviews": `{"stats":[{"user_id":"u1","username":"Alice","review_count":3}]}`})
+	config := writePRCtlProfiles(t, "http://unused.invalid")
+	t.Setenv("PRCTL_BASE_URL", srv.URL)
+
+	profile, err := prctl.LoadProfile(config, "prod")
+	require.NoError(t, err)
+	assert.Equal(t, srv.URL, profile.BaseURL)
+	assert.Equal(t, "prod-token", profile.Token)
+
+	_, err = prctl.LoadProfile(config, "staging")
+	assert.Error(t, err)
+
+	var stdout, stderr bytes.Buffer
+	code := prctl.Run(context.Background(), []string{"--config", config, "--profile", "prod", "stats", "reviews"},
+		strings.NewReader(""), &stdout, &stderr)
+	require.Equal(t, 0, code, stderr.String())
+	assert.Contains(t, stdout.String(), "u1       Alice     3")
+	assert.Equal(t, []string{"Bearer prod-token"}, srv.authHeads)
+}
+
+func TestPRCtl_StatsTeams_Filter(t *testing.T) {
+	srv := newPRCtlServer(t, map[string]string{"GET /stats/teams?created_from=2025-10-01T00%3A00%3A00Z&status=MERGED": `{"stats":[
+		{"team_name":"backend","pull_requests":4,"open_pull_requests":0,"avg_reviewers_per_pr":1.5,
+		 "reviews_given":5,"reviews_received":6,"cross_team_reviews_share":0.3333333333333333}]}`})
+	config := writePRCtlProfiles(t, srv.URL)
+
+	code, stdout, stderr := runPRCtl(t, "--config", config, "stats", "teams", "--status", "MERGED", "--from", "2025-10-01T00:00:00Z")
+
+	require.Equal(t, 0, code, stderr)
+	assert.Contains(t, stdout, "TEAM     PRS  OPEN  AVG_REVIEWERS  GIVEN  RECEIVED  CROSS_TEAM")
+	assert.Contains(t, stdout, "backend  4    0     1.50           5      6         33%")
+}
+
+func TestPRCtl_StatsFairness(t *testing.T) {
+	srv := newPRCtlServer(t, map[string]string{"GET /stats/fairness?team_name=backend": `{
+		"created_from":"2025-10-01T00:00:00Z","created_to":"2025-10-31T00:00:00Z","teams":[
+		{"team_name":"backend","assignments":20,"gini":0.4,"max_min_spread":16,"members":[
+			{"user_id":"u1","username":"Alice","active_days":30,"assignments":18,"expected_assignments":10,"deviation":8,"outlier":"overloaded"},
+			{"user_id":"u2","username":"Bob","active_days":12.5,"assignments":2,"expected_assignments":10,"deviation":-8}]}]}`})
+	config := writePRCtlProfiles(t, srv.URL)
+
+	code, stdout, stderr := runPRCtl(t, "--config", config, "stats", "fairness", "--team", "backend")
+
+	require.Equal(t, 0, code, stderr)
+	assert.Contains(t, stdout, "TEAM     GINI  USER_ID  ACTIVE_DAYS  ASSIGNED  EXPECTED  DEVIATION  OUTLIER")
+	assert.Contains(t, stdout, "backend  0.40  u1       30.0         18        10.0      8.0        overloaded")
+	assert.Contains(t, stdout, "backend  0.40  u2       12.5         2         10.0      -8.0")
+
+	// У отчета нет фильтра по статусу PR
+	code, _, _ = runPRCtl(t, "--config", config, "stats", "fairness", "--status", "MERGED")
+	assert.Equal(t, 2, code)
+}
+
+func TestPRCtl_TeamDeactivate_DryRunDoesNotMutate(t *testing.T) {
+	srv := newPRCtlServer(t, map[string]string{
+		"GET /team/get?team_name=backend": prctlTeamResponse,
+		"GET /users/getReview?user_id=u1": `{"user_id":"u1","pull_requests":[
+			{"pull_request_id":"pr-1","pull_request_name":"a","author_id":"x","status":"OPEN"},
+			{"pull_request_id":"pr-2","pull_request_name":"b","author_id":"x","status":"MERGED"}]}`,
+		"GET /users/getReview?user_id=u2": `{"user_id":"u2","pull_requests":[
+			{"pull_request_id":"pr-1","pull_request_name":"a","author_id":"x","status":"OPEN"}]}`,
+	})
+	config := writePRCtlProfiles(t, srv.URL)
+
+	code, stdout, stderr := runPRCtl(t, "--config", config, "-o", "json", "team", "deactivate", "backend", "--dry-run")
+
+	require.Equal(t, 0, code, stderr)
+	for _, call := range srv.calls {
+		assert.True(t, strings.HasPrefix(call, "GET "), "unexpected mutating call %s", call)
+	}
+
+	var plan struct {
+		DryRun      bool     `json:"dry_run"`
+		AffectedPRs []string `json:"affected_prs"`
+		Users       []struct {
+			UserID      string   `json:"user_id"`
+			OpenReviews []string `json:"open_reviews"`
+		} `json:"users"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(stdout), &plan))
+	assert.True(t, plan.DryRun)
+	assert.Equal(t, []string{"pr-1"}, plan.AffectedPRs)
+	require.Len(t, plan.Users, 2, "inactive users are not part of the plan")
+	assert.Equal(t, []string{"pr-1"}, plan.Users[0].OpenReviews)
+}
+
+func TestPRCtl_Errors(t *testing.T) {
+	srv := newPRCtlServer(t, nil)
+	config := writePRCtlProfiles(t, srv.URL)
+
+	code, _, stderr := runPRCtl(t, "--config", config, "pr", "merge", "pr-404")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, "NOT_FOUND: resource not found")
+
+	code, _, _ = runPRCtl(t, "--config", config, "pr", "reassign", "pr-1")
+	assert.Equal(t, 2, code, "missing --old")
+
+	code, _, _ = runPRCtl(t, "--config", config, "team", "explode")
+	assert.Equal(t, 2, code)
+
+	code, _, _ = runPRCtl(t, "--config", config, "-o", "yaml", "stats", "reviews")
+	assert.Equal(t, 2, code)
+}
@@ -3,12 +3,14 @@ package usecase_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"pr-reviewer-service/internal/domain"
 	"pr-reviewer-service/internal/usecase"
 	"pr-reviewer-service/tests/mocks"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func TestStatsUseCase_GetStatsReviews_Success(t *testing.T) {
@@ -21,9 +23,9 @@ func TestStatsUseCase_GetStatsReviews_Success(t *testing.T) {
 		{UserID: "u2", Username: "Bob", ReviewCount: 3},
 	}
 
-	statsRepo.On("GetStatsReviews", ctx).Return(expectedStats, nil)
+	statsRepo.On("GetStatsReviews", ctx, &domain.StatsFilter{}).Return(expectedStats, nil)
 
-	result, err := uc.GetStatsReviews(ctx)
+	result, err := uc.GetStatsReviews(ctx, &domain.StatsFilter{})
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedStats, result)
@@ -49,11 +51,140 @@ func TestStatsUseCase_GetStatsPrAssignments_Success(t *testing.T) {
 		},
 	}
 
-	statsRepo.On("GetStatsPrAssignments", ctx).Return(expectedStats, nil)
+	statsRepo.On("GetStatsPrAssignments", ctx, &domain.StatsFilter{}).Return(expectedStats, nil)
 
-	result, err := uc.GetStatsPrAssignments(ctx)
+	result, err := uc.GetStatsPrAssignments(ctx, &domain.StatsFilter{})
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedStats, result)
 	assert.Len(t, result, 2)
 }
+
+func TestStatsUseCase_GetStatsTeams_PassesFilter(t *testing.T) {
+	ctx := context.Background()
+	statsRepo := mocks.NewStatsRepository(t)
+	uc := usecase.NewStatsUseCase(statsRepo)
+
+	from := time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC)
+	filter := &domain.StatsFilter{CreatedFrom: &from, TeamName: "backend", Status: domain.PRStatusMerged}
+	expectedStats := []*domain.TeamReviewStat{
+		{TeamName: "backend", PullRequests: 4, OpenPullRequests: 1, ReviewsGiven: 5, ReviewsReceived: 6, CrossTeamReviews: 3},
+	}
+	statsRepo.On("GetStatsTeams", ctx, filter).Return(expectedStats, nil)
+
+	result, err := uc.GetStatsTeams(ctx, filter)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedStats, result)
+	assert.Equal(t, 1.5, result[0].AvgReviewersPerPR())
+	assert.Equal(t, 0.5, result[0].CrossTeamShare())
+}
+
+func TestStatsUseCase_InvalidFilter(t *testing.T) {
+	ctx := context.Background()
+	uc := usecase.NewStatsUseCase(mocks.NewStatsRepository(t))
+
+	from := time.Date(2025, 10, 2, 0, 0, 0, 0, time.UTC)
+	to := from.Add(-time.Hour)
+
+	_, err := uc.GetStatsReviews(ctx, &domain.StatsFilter{Status: "CLOSED"})
+	assert.ErrorIs(t, err, domain.ErrInvalidPRStatus)
+	_, err = uc.GetStatsPrAssignments(ctx, &domain.StatsFilter{CreatedFrom: &from, CreatedTo: &to})
+	assert.ErrorIs(t, err, domain.ErrInvalidTimeRange)
+	_, err = uc.GetStatsTeams(ctx, &domain.StatsFilter{CreatedFrom: &from, CreatedTo: &from})
+	assert.ErrorIs(t, err, domain.ErrInvalidTimeRange)
+}
+
+func TestTeamReviewStat_RatiosWithoutPRs(t *testing.T) {
+	stat := &domain.TeamReviewStat{TeamName: "design"}
+
+	assert.Zero(t, stat.AvgReviewersPerPR())
+	assert.Zero(t, stat.CrossTeamShare())
+}
+
+func TestStatsUseCase_GetFairnessReport_ScoresTeam(t *testing.T) {
+	ctx := context.Background()
+	statsRepo := mocks.NewStatsRepository(t)
+	uc := usecase.NewStatsUseCase(statsRepo)
+
+	from := time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 10)
+	epoch := time.Unix(0, 0).UTC()
+	statsRepo.On("GetMemberAssignments", ctx, "backend", from, to).Return([]*domain.MemberAssignments{
+		{UserID: "u1", Username: "Alice", TeamName: "backend", Assignments: 18},
+		{UserID: "u2", Username: "Bob", TeamName: "backend", Assignments: 2},
+		{UserID: "u3", Username: "Charlie", TeamName: "backend", Assignments: 5},
+		{UserID: "u4", Username: "Dave", TeamName: "backend", Assignments: 0},
+	}, nil)
+	statsRepo.On("GetUserStatusHistory", ctx, "backend", to).Return([]*domain.UserStatusChange{
+		{UserID: "u1", IsActive: true, ChangedAt: epoch},
+		{UserID: "u2", IsActive: true, ChangedAt: epoch},
+		// Пришел в команду в середине периода
+		{UserID: "u3", IsActive: true, ChangedAt: from.AddDate(0, 0, 5)},
+		// Деактивирован до начала периода
+		{UserID: "u4", IsActive: true, ChangedAt: epoch},
+		{UserID: "u4", IsActive: false, ChangedAt: from.AddDate(0, -1, 0)},
+	}, nil)
+
+	report, err := uc.GetFairnessReport(ctx, &domain.StatsFilter{CreatedFrom: &from, CreatedTo: &to, TeamName: "backend"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, from, report.From)
+	assert.Equal(t, to, report.To)
+	assert.Len(t, report.Teams, 1)
+
+	team := report.Teams[0]
+	assert.Equal(t, int64(25), team.Assignments)
+	assert.InDelta(t, 16, team.MaxMinSpread, 1e-9)
+	assert.InDelta(t, 32.0/90, team.Gini, 1e-9)
+
+	expected := []struct {
+		days, assignments float64
+		outlier           domain.FairnessOutlier
+	}{
+		{10, 10, domain.FairnessOverloaded},
+		{10, 10, domain.FairnessUnderloaded},
+		{5, 5, ""},
+		{0, 0, ""},
+	}
+	assert.Len(t, team.Members, len(expected))
+	for i, member := range team.Members {
+		assert.InDelta(t, expected[i].days, member.ActiveDays, 1e-9, member.UserID)
+		assert.InDelta(t, expected[i].assignments, member.ExpectedAssignments, 1e-9, member.UserID)
+		assert.Equal(t, expected[i].outlier, member.Outlier, member.UserID)
+	}
+	assert.InDelta(t, 8, team.Members[0].Deviation(), 1e-9)
+}
+
+func TestStatsUseCase_GetFairnessReport_DefaultPeriod(t *testing.T) {
+	ctx := context.Background()
+	statsRepo := mocks.NewStatsRepository(t)
+	uc := usecase.NewStatsUseCase(statsRepo)
+
+	statsRepo.On("GetMemberAssignments", ctx, "", mock.Anything, mock.Anything).Return([]*domain.MemberAssignments{}, nil)
+	statsRepo.On("GetUserStatusHistory", ctx, "", mock.Anything).Return([]*domain.UserStatusChange{}, nil)
+
+	before := time.Now()
+	report, err := uc.GetFairnessReport(ctx, &domain.StatsFilter{})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 30*24*time.Hour, report.To.Sub(report.From))
+	assert.WithinRange(t, report.To, before, time.Now())
+	assert.NotNil(t, report.Teams)
+	assert.Empty(t, report.Teams)
+
+	// С одной границей период отсчитывается от нее
+	from := time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC)
+	report, err = uc.GetFairnessReport(ctx, &domain.StatsFilter{CreatedFrom: &from})
+	assert.NoError(t, err)
+	assert.Equal(t, from.AddDate(0, 0, 30), report.To)
+}
+
+func TestStatsUseCase_GetFairnessReport_InvalidRange(t *testing.T) {
+	uc := usecase.NewStatsUseCase(mocks.NewStatsRepository(t))
+
+	from := time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC)
+	_, err := uc.GetFairnessReport(context.Background(), &domain.StatsFilter{CreatedFrom: &from, CreatedTo: &from})
+
+	assert.ErrorIs(t, err, domain.ErrInvalidTimeRange)
+}
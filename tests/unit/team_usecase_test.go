@@ -5,10 +5,12 @@ import (
 	"testing"
 
 	"pr-reviewer-service/internal/domain"
+	"pr-reviewer-service/internal/events"
 	"pr-reviewer-service/internal/usecase"
 	"pr-reviewer-service/tests/mocks"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestTeamUseCase_CreateTeam_Success(t *testing.T) {
@@ -133,6 +135,47 @@ func TestTeamUseCase_DeactivateTeamUsers_Success(t *testing.T) {
 	assert.ElementsMatch(t, []string{"u1", "u2"}, result.DeactivatedUserIDs)
 }
 
+func TestTeamUseCase_DeactivateTeamUsers_PublishesLoadedPR(t *testing.T) {
+	ctx := context.Background()
+	teamRepo := &mocks.TeamRepository{}
+	userRepo := &mocks.UserRepository{}
+	prRepo := &mocks.PRRepository{}
+	broker := events.NewBroker()
+	sub := broker.Subscribe(nil)
+	defer sub.Close()
+	uc := usecase.NewTeamUseCase(teamRepo, userRepo, prRepo, usecase.WithTeamEvents(broker))
+
+	teamRepo.On("ExistsTeam", ctx, "backend").Return(true, nil)
+	teamRepo.On("GetActiveUsersFromTeam", ctx, "backend").Return([]*domain.User{{ID: "u2", TeamName: "backend", IsActive: true}}, nil)
+	teamRepo.On("GetOpenPRsWithTeamReviewers", ctx, "backend").Return([]string{"pr-1001"}, nil)
+	userRepo.On("UpdateActiveStatus", ctx, "u2", false).Return(&domain.User{ID: "u2", IsActive: false}, nil)
+	teamRepo.On("GetPRReviewersFromTeam", ctx, "pr-1001", "backend").Return([]string{"u2"}, nil)
+	teamRepo.On("GetAllTeams", ctx).Return([]*domain.Team{{Name: "backend"}, {Name: "frontend"}}, nil)
+	teamRepo.On("GetActiveUsersFromTeam", ctx, "frontend").Return([]*domain.User{{ID: "u5", TeamName: "frontend", IsActive: true}}, nil)
+	prRepo.On("ReassignReviewer", ctx, "pr-1001", "u2", "u5", int64(0)).Return(nil)
+	prRepo.On("GetByID", ctx, "pr-1001").Return(&domain.PullRequest{
+		ID: "pr-1001", Name: "Add feature", AuthorID: "u1", Status: domain.PRStatusOpen, AssignedReviewers: []string{"u5"},
+	}, nil)
+
+	result, err := uc.DeactivateTeamUsers(ctx, "backend")
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.ReassignedPRs)
+
+	unassigned := <-sub.C
+	assigned := <-sub.C
+	assert.Equal(t, domain.AssignmentUnassigned, unassigned.Type)
+	assert.Equal(t, "u2", unassigned.ReviewerID)
+	assert.Equal(t, domain.AssignmentAssigned, assigned.Type)
+	assert.Equal(t, "u5", assigned.ReviewerID)
+	for _, event := range []domain.AssignmentEvent{unassigned, assigned} {
+		assert.Equal(t, "pr-1001", event.PullRequestID)
+		assert.Equal(t, "Add feature", event.PullRequestName)
+		assert.Equal(t, "u1", event.AuthorID)
+	}
+	assert.Empty(t, sub.C)
+	prRepo.AssertExpectations(t)
+}
+
 func TestTeamUseCase_DeactivateTeamUsers_NoActiveUsers(t *testing.T) {
 	ctx := context.Background()
 	teamRepo := &mocks.TeamRepository{}
@@ -148,3 +191,99 @@ func TestTeamUseCase_DeactivateTeamUsers_NoActiveUsers(t *testing.T) {
 	assert.ErrorIs(t, err, domain.ErrNoActiveUsersInTeam)
 	assert.Nil(t, result)
 }
+
+func TestTeamUseCase_CreateTeam_ParentNotFound(t *testing.T) {
+	ctx := context.Background()
+	teamRepo := &mocks.TeamRepository{}
+	userRepo := &mocks.UserRepository{}
+	prRepo := &mocks.PRRepository{}
+	uc := usecase.NewTeamUseCase(teamRepo, userRepo, prRepo)
+
+	team := &domain.Team{
+		Name:       "payments",
+		ParentName: "fintech",
+		Members:    []*domain.User{{ID: "u1", Username: "Alice", IsActive: true}},
+	}
+
+	teamRepo.On("ExistsTeam", ctx, "payments").Return(false, nil)
+	teamRepo.On("ExistsTeam", ctx, "fintech").Return(false, nil)
+
+	err := uc.CreateTeam(ctx, team)
+
+	assert.ErrorIs(t, err, domain.ErrParentTeamNotFound)
+	teamRepo.AssertNotCalled(t, "Create", ctx, team)
+}
+
+func TestTeamUseCase_SetTeamParent_Cycle(t *testing.T) {
+	ctx := context.Background()
+	teamRepo := &mocks.TeamRepository{}
+	userRepo := &mocks.UserRepository{}
+	prRepo := &mocks.PRRepository{}
+	uc := usecase.NewTeamUseCase(teamRepo, userRepo, prRepo)
+
+	// fintech -> payments -> payments-core; пытаемся поставить fintech под payments-core.
+	// Цикл находит репозиторий в транзакции переноса
+	teamRepo.On("ExistsTeam", ctx, "fintech").Return(true, nil)
+	teamRepo.On("ExistsTeam", ctx, "payments-core").Return(true, nil)
+	teamRepo.On("SetParent", ctx, "fintech", "payments-core").Return(domain.ErrTeamHierarchyCycle)
+
+	team, err := uc.SetTeamParent(ctx, "fintech", "payments-core")
+
+	assert.ErrorIs(t, err, domain.ErrTeamHierarchyCycle)
+	assert.Nil(t, team)
+	teamRepo.AssertNotCalled(t, "GetByName", ctx, "fintech")
+}
+
+func TestTeamUseCase_SetTeamParent_Success(t *testing.T) {
+	ctx := context.Background()
+	teamRepo := &mocks.TeamRepository{}
+	userRepo := &mocks.UserRepository{}
+	prRepo := &mocks.PRRepository{}
+	uc := usecase.NewTeamUseCase(teamRepo, userRepo, prRepo)
+
+	updated := &domain.Team{Name: "payments", ParentName: "fintech"}
+
+	teamRepo.On("ExistsTeam", ctx, "payments").Return(true, nil)
+	teamRepo.On("ExistsTeam", ctx, "fintech").Return(true, nil)
+	teamRepo.On("SetParent", ctx, "payments", "fintech").Return(nil)
+	teamRepo.On("GetByName", ctx, "payments").Return(updated, nil)
+
+	team, err := uc.SetTeamParent(ctx, "payments", "fintech")
+
+	assert.NoError(t, err)
+	assert.Equal(t, updated, team)
+	teamRepo.AssertExpectations(t)
+}
+
+func TestTeamUseCase_GetTeamTree_RollsUpStats(t *testing.T) {
+	ctx := context.Background()
+	teamRepo := &mocks.TeamRepository{}
+	userRepo := &mocks.UserRepository{}
+	prRepo := &mocks.PRRepository{}
+	uc := usecase.NewTeamUseCase(teamRepo, userRepo, prRepo)
+
+	teamRepo.On("GetTeamTreeStats", ctx).Return([]*domain.TeamTreeNode{
+		{Name: "billing", ParentName: "fintech", Stats: domain.TeamStats{MembersCount: 2, ActiveMembersCount: 1, OpenPRsCount: 1}},
+		{Name: "fintech", Stats: domain.TeamStats{MembersCount: 1, ActiveMembersCount: 1, OpenReviewsCount: 2}},
+		{Name: "mobile", Stats: domain.TeamStats{MembersCount: 4, ActiveMembersCount: 4}},
+		{Name: "payments", ParentName: "fintech", Stats: domain.TeamStats{MembersCount: 3, ActiveMembersCount: 3, OpenPRsCount: 2, OpenReviewsCount: 1}},
+	}, nil)
+
+	roots, err := uc.GetTeamTree(ctx, "")
+
+	assert.NoError(t, err)
+	assert.Len(t, roots, 2)
+	assert.Equal(t, "fintech", roots[0].Name)
+	assert.Len(t, roots[0].Children, 2)
+	assert.Equal(t, domain.TeamStats{MembersCount: 6, ActiveMembersCount: 5, OpenPRsCount: 3, OpenReviewsCount: 3}, roots[0].SubtreeStats)
+	assert.Equal(t, roots[1].Stats, roots[1].SubtreeStats)
+
+	subtree, err := uc.GetTeamTree(ctx, "payments")
+
+	assert.NoError(t, err)
+	assert.Len(t, subtree, 1)
+	assert.Equal(t, "payments", subtree[0].Name)
+
+	_, err = uc.GetTeamTree(ctx, "unknown")
+	assert.ErrorIs(t, err, domain.ErrTeamNotFound)
+}
@@ -0,0 +1,89 @@
+package usecase_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"pr-reviewer-service/internal/domain"
+	"pr-reviewer-service/internal/tracing"
+	"pr-reviewer-service/internal/usecase"
+	"pr-reviewer-service/tests/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// exportedSpans разбирает вывод JSONExporter
+func exportedSpans(t *testing.T, buf *bytes.Buffer) []tracing.SpanData {
+	t.Helper()
+	var spans []tracing.SpanData
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		var span tracing.SpanData
+		require.NoError(t, json.Unmarshal([]byte(line), &span))
+		spans = append(spans, span)
+	}
+	return spans
+}
+
+func TestTracing_ParseTraceparent(t *testing.T) {
+	sc, ok := tracing.ParseTraceparent("00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	require.True(t, ok)
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", sc.TraceID.String())
+	assert.Equal(t, "00f067aa0ba902b7", sc.SpanID.String())
+	assert.Equal(t, "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01", sc.Traceparent())
+
+	invalid := []string{
+		"",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7",
+		"00-00000000000000000000000000000000-00f067aa0ba902b7-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-0000000000000000-01",
+		"00-4BF92F3577B34DA6A3CE929D0E0E4736-00f067aa0ba902b7-01",
+		"ff-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01-extra",
+	}
+	for _, value := range invalid {
+		_, ok := tracing.ParseTraceparent(value)
+		assert.False(t, ok, value)
+	}
+}
+
+func TestTracing_UseCaseSpanIsChildOfRequestSpan(t *testing.T) {
+	var buf bytes.Buffer
+	tracer := tracing.NewTracer(tracing.NewJSONExporter(&buf))
+	parent, _ := tracing.ParseTraceparent("00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	ctx, root := tracer.StartRemote(context.Background(), parent, "GET /pullRequest/get", tracing.KindServer)
+
+	prRepo := &mocks.PRRepository{}
+	uc := usecase.NewPRUseCase(prRepo, &mocks.UserRepository{})
+	prRepo.On("GetByID", mock.Anything, "pr-1").Return(nil, domain.ErrPRNotFound)
+
+	_, err := uc.GetPR(ctx, "pr-1", false)
+	require.ErrorIs(t, err, domain.ErrPRNotFound)
+	root.End()
+
+	spans := exportedSpans(t, &buf)
+	require.Len(t, spans, 2)
+	child, server := spans[0], spans[1]
+
+	assert.Equal(t, "PRUseCase.GetPR", child.Name)
+	assert.Equal(t, server.SpanID, child.ParentSpanID)
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", child.TraceID)
+	assert.Equal(t, "00f067aa0ba902b7", server.ParentSpanID)
+	assert.Equal(t, tracing.KindServer, server.Kind)
+}
+
+func TestTracing_StartWithoutActiveSpanIsNoop(t *testing.T) {
+	ctx := context.Background()
+
+	spanCtx, span := tracing.Start(ctx, "orphan")
+	span.SetAttribute("key", "value")
+	span.End()
+
+	assert.Nil(t, span)
+	assert.Equal(t, ctx, spanCtx)
+	assert.Empty(t, tracing.TraceIDFromContext(spanCtx))
+}
@@ -0,0 +1,334 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"pr-reviewer-service/internal/domain"
+	"pr-reviewer-service/internal/usecase"
+	"pr-reviewer-service/tests/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// runImport настраивает TransferRepository.Import на вызов fn с store и возвращает ошибку fn
+func runImport(repo *mocks.TransferRepository, store domain.ImportStore) {
+	repo.On("Import", mock.Anything, mock.Anything).Return(
+		func(_ context.Context, fn func(domain.ImportStore) error) error { return fn(store) })
+}
+
+// runExport настраивает TransferRepository.Export на отправку records в переданный emit
+func runExport(repo *mocks.TransferRepository, records []*domain.ExportRecord) {
+	repo.On("Export", mock.Anything, mock.Anything).Return(
+		func(_ context.Context, emit func(*domain.ExportRecord) error) error {
+			for _, record := range records {
+				if err := emit(record); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+}
+
+// collectExport выгружает записи через use case и возвращает их в порядке отправки
+func collectExport(t *testing.T, uc domain.TransferUseCase) []*domain.ExportRecord {
+	var records []*domain.ExportRecord
+	require.NoError(t, uc.Export(context.Background(), func(record *domain.ExportRecord) error {
+		records = append(records, record)
+		return nil
+	}))
+	return records
+}
+
+func teamRecords(teams ...*domain.Team) []*domain.ExportRecord {
+	records := make([]*domain.ExportRecord, len(teams))
+	for i, team := range teams {
+		records[i] = &domain.ExportRecord{Type: domain.RecordTypeTeam, Team: team}
+	}
+	return records
+}
+
+func exportedNames(records []*domain.ExportRecord) []string {
+	var names []string
+	for _, record := range records {
+		switch {
+		case record.Team != nil:
+			names = append(names, record.Team.Name)
+		case record.User != nil:
+			names = append(names, record.User.ID)
+		default:
+			names = append(names, record.PullRequest.ID)
+		}
+	}
+	return names
+}
+
+func TestTransferUseCase_Export_ParentsFirst(t *testing.T) {
+	repo := mocks.NewTransferRepository(t)
+	uc := usecase.NewTransferUseCase(repo)
+
+	records := teamRecords(
+		&domain.Team{Name: "api", ParentName: "backend"},
+		&domain.Team{Name: "backend", ParentName: "eng"},
+		&domain.Team{Name: "eng"},
+		&domain.Team{Name: "design"},
+	)
+	records = append(records,
+		&domain.ExportRecord{Type: domain.RecordTypeUser, User: &domain.User{ID: "u1", TeamName: "api"}},
+		&domain.ExportRecord{Type: domain.RecordTypePullRequest, PullRequest: &domain.PullRequest{ID: "pr-1"}},
+	)
+	runExport(repo, records)
+
+	assert.Equal(t, []string{"eng", "design", "backend", "api", "u1", "pr-1"}, exportedNames(collectExport(t, uc)))
+}
+
+func TestTransferUseCase_Export_StopsOnHierarchyCycle(t *testing.T) {
+	repo := mocks.NewTransferRepository(t)
+	uc := usecase.NewTransferUseCase(repo)
+
+	runExport(repo, teamRecords(
+		&domain.Team{Name: "a", ParentName: "b"},
+		&domain.Team{Name: "b", ParentName: "a"},
+		&domain.Team{Name: "c", ParentName: "a"},
+		&domain.Team{Name: "eng"},
+	))
+
+	assert.Equal(t, []string{"eng", "a", "b", "c"}, exportedNames(collectExport(t, uc)))
+}
+
+func TestTransferUseCase_Export_StopsOnEmitError(t *testing.T) {
+	repo := mocks.NewTransferRepository(t)
+	uc := usecase.NewTransferUseCase(repo)
+
+	runExport(repo, append(teamRecords(&domain.Team{Name: "backend"}),
+		&domain.ExportRecord{Type: domain.RecordTypeUser, User: &domain.User{ID: "u1", TeamName: "backend"}},
+		&domain.ExportRecord{Type: domain.RecordTypeUser, User: &domain.User{ID: "u2", TeamName: "backend"}},
+	))
+
+	writeErr := errors.New("broken pipe")
+	var sent []string
+	err := uc.Export(context.Background(), func(record *domain.ExportRecord) error {
+		if record.User != nil {
+			return writeErr
+		}
+		sent = append(sent, record.Team.Name)
+		return nil
+	})
+
+	assert.ErrorIs(t, err, writeErr)
+	assert.Equal(t, []string{"backend"}, sent)
+}
+
+func TestTransferUseCase_Import_Modes(t *testing.T) {
+	ctx := context.Background()
+	records := []*domain.ImportRecord{
+		{Line: 1, Type: domain.RecordTypeTeam, Team: &domain.Team{Name: "backend"}},
+		{Line: 2, Type: domain.RecordTypeUser, User: &domain.User{ID: "u1", Username: "Alice", TeamName: "backend", IsActive: true}},
+	}
+
+	testCases := []struct {
+		mode      domain.ImportMode
+		saves     bool
+		committed bool
+		updated   int
+		skipped   int
+		errCodes  []string
+	}{
+		{mode: domain.ImportModeUpsert, saves: true, committed: true, updated: 2},
+		{mode: domain.ImportModeSkip, committed: true, skipped: 2},
+		{mode: domain.ImportModeFail, errCodes: []string{"TEAM_EXISTS", "USER_EXISTS"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(string(tc.mode), func(t *testing.T) {
+			repo := mocks.NewTransferRepository(t)
+			store := mocks.NewImportStore(t)
+			uc := usecase.NewTransferUseCase(repo)
+
+			runImport(repo, store)
+			store.On("ExistsTeam", ctx, "backend").Return(true, nil)
+			store.On("ExistsUser", ctx, "u1").Return(true, nil)
+			if tc.saves {
+				store.On("SaveTeam", ctx, records[0].Team).Return(nil)
+				store.On("SaveUser", ctx, mock.MatchedBy(func(user *domain.User) bool {
+					return user.ID == "u1" && user.Skills != nil
+				})).Return(nil)
+			}
+
+			report, err := uc.Import(ctx, records, domain.ImportOptions{Mode: tc.mode})
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.committed, report.Committed)
+			assert.Equal(t, tc.updated, report.Updated)
+			assert.Equal(t, tc.skipped, report.Skipped)
+			var codes []string
+			for _, recordErr := range report.Errors {
+				codes = append(codes, recordErr.Code)
+			}
+			assert.Equal(t, tc.errCodes, codes)
+		})
+	}
+}
+
+func TestTransferUseCase_Import_StatusHistory(t *testing.T) {
+	ctx := context.Background()
+	monday := time.Date(2025, 10, 20, 9, 0, 0, 0, time.UTC)
+	tuesday := monday.Add(24 * time.Hour)
+
+	testCases := []struct {
+		name     string
+		isActive bool
+		history  []*domain.UserStatusChange
+		expected []*domain.UserStatusChange
+		invalid  bool
+	}{
+		{
+			name:     "missing history starts at epoch",
+			isActive: true,
+			expected: []*domain.UserStatusChange{{UserID: "u1", IsActive: true, ChangedAt: domain.StatusHistoryEpoch}},
+		},
+		{
+			name:     "history is kept as is",
+			isActive: false,
+			history:  []*domain.UserStatusChange{{IsActive: true, ChangedAt: monday}, {IsActive: false, ChangedAt: tuesday}},
+			expected: []*domain.UserStatusChange{
+				{UserID: "u1", IsActive: true, ChangedAt: monday},
+				{UserID: "u1", IsActive: false, ChangedAt: tuesday},
+			},
+		},
+		{
+			name:     "history out of order",
+			isActive: false,
+			history:  []*domain.UserStatusChange{{IsActive: true, ChangedAt: tuesday}, {IsActive: false, ChangedAt: monday}},
+			invalid:  true,
+		},
+		{
+			name:     "last change disagrees with is_active",
+			isActive: true,
+			history:  []*domain.UserStatusChange{{IsActive: false, ChangedAt: monday}},
+			invalid:  true,
+		},
+		{
+			name:     "change without time",
+			isActive: true,
+			history:  []*domain.UserStatusChange{{IsActive: true}},
+			invalid:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := mocks.NewTransferRepository(t)
+			store := mocks.NewImportStore(t)
+			uc := usecase.NewTransferUseCase(repo)
+
+			runImport(repo, store)
+			store.On("ExistsTeam", ctx, "backend").Return(true, nil)
+			store.On("ExistsUser", ctx, "u1").Return(false, nil)
+			if !tc.invalid {
+				store.On("SaveUser", ctx, mock.MatchedBy(func(user *domain.User) bool {
+					return assert.ObjectsAreEqual(tc.expected, user.StatusHistory)
+				})).Return(nil)
+			}
+
+			report, err := uc.Import(ctx, []*domain.ImportRecord{{Line: 1, Type: domain.RecordTypeUser, User: &domain.User{
+				ID: "u1", Username: "Alice", TeamName: "backend", IsActive: tc.isActive, StatusHistory: tc.history,
+			}}}, domain.ImportOptions{Mode: domain.ImportModeFail})
+
+			require.NoError(t, err)
+			assert.Equal(t, !tc.invalid, report.Committed)
+			if tc.invalid {
+				require.Len(t, report.Errors, 1)
+				assert.Equal(t, "INVALID_RECORD", report.Errors[0].Code)
+			}
+		})
+	}
+}
+
+func TestTransferUseCase_Import_ReportsInvalidRecords(t *testing.T) {
+	ctx := context.Background()
+	repo := mocks.NewTransferRepository(t)
+	store := mocks.NewImportStore(t)
+	uc := usecase.NewTransferUseCase(repo)
+
+	createdAt := time.Date(2025, 10, 24, 12, 0, 0, 0, time.UTC)
+	records := []*domain.ImportRecord{
+		{Line: 1, Invalid: domain.ErrInvalidImportRecord},
+		{Line: 2, Type: domain.RecordTypeTeam, Team: &domain.Team{Name: "api", ParentName: "backend"}},
+		{Line: 3, Type: domain.RecordTypeUser, User: &domain.User{ID: "u1", Username: "Alice", TeamName: "backend"}},
+		{Line: 4, Type: domain.RecordTypePullRequest, PullRequest: &domain.PullRequest{
+			ID: "pr-1", Name: "Add search", AuthorID: "u2", Status: domain.PRStatusMerged, CreatedAt: &createdAt,
+		}},
+		{Line: 5, Type: domain.RecordTypePullRequest, PullRequest: &domain.PullRequest{
+			ID: "pr-2", Name: "Fix", AuthorID: "u2", Status: domain.PRStatusOpen, CreatedAt: &createdAt,
+			AssignedReviewers: []string{"u3"},
+		}},
+	}
+
+	runImport(repo, store)
+	store.On("ExistsTeam", ctx, "api").Return(false, nil)
+	store.On("GetParent", ctx, "backend").Return("", domain.ErrTeamNotFound)
+	store.On("ExistsTeam", ctx, "backend").Return(false, nil)
+	store.On("ExistsUser", ctx, "u2").Return(true, nil)
+	store.On("ExistsUser", ctx, "u3").Return(false, nil)
+
+	report, err := uc.Import(ctx, records, domain.ImportOptions{Mode: domain.ImportModeFail})
+
+	require.NoError(t, err)
+	assert.False(t, report.Committed)
+	assert.Equal(t, []*domain.ImportRecordError{
+		{Line: 1, Code: "INVALID_RECORD", Message: "record does not match the export format"},
+		{Line: 2, Type: "team", ID: "api", Code: "NOT_FOUND", Message: domain.ErrorMapping[domain.ErrParentTeamNotFound].Message},
+		{Line: 3, Type: "user", ID: "u1", Code: "NOT_FOUND", Message: domain.ErrorMapping[domain.ErrTeamNotFound].Message},
+		{Line: 4, Type: "pull_request", ID: "pr-1", Code: "INVALID_RECORD", Message: "record does not match the export format"},
+		{Line: 5, Type: "pull_request", ID: "pr-2", Code: "NOT_FOUND", Message: domain.ErrorMapping[domain.ErrUserNotFound].Message},
+	}, report.Errors)
+}
+
+func TestTransferUseCase_Import_DryRunRollsBack(t *testing.T) {
+	ctx := context.Background()
+	repo := mocks.NewTransferRepository(t)
+	store := mocks.NewImportStore(t)
+	uc := usecase.NewTransferUseCase(repo)
+
+	var fnErr error
+	repo.On("Import", mock.Anything, mock.Anything).Return(
+		func(_ context.Context, fn func(domain.ImportStore) error) error {
+			fnErr = fn(store)
+			return fnErr
+		})
+	store.On("ExistsTeam", ctx, "backend").Return(false, nil)
+	store.On("SaveTeam", ctx, mock.Anything).Return(nil)
+
+	report, err := uc.Import(ctx, []*domain.ImportRecord{
+		{Line: 1, Type: domain.RecordTypeTeam, Team: &domain.Team{Name: "backend"}},
+	}, domain.ImportOptions{Mode: domain.ImportModeFail, DryRun: true})
+
+	require.NoError(t, err)
+	assert.Error(t, fnErr, "dry-run must roll back the transaction")
+	assert.False(t, report.Committed)
+	assert.Equal(t, 1, report.Created)
+	assert.Empty(t, report.Errors)
+}
+
+func TestTransferUseCase_Import_DatabaseErrorAborts(t *testing.T) {
+	ctx := context.Background()
+	repo := mocks.NewTransferRepository(t)
+	store := mocks.NewImportStore(t)
+	uc := usecase.NewTransferUseCase(repo)
+
+	dbErr := errors.New("connection reset")
+	runImport(repo, store)
+	store.On("ExistsTeam", ctx, "backend").Return(false, dbErr)
+
+	report, err := uc.Import(ctx, []*domain.ImportRecord{
+		{Line: 1, Type: domain.RecordTypeTeam, Team: &domain.Team{Name: "backend"}},
+	}, domain.ImportOptions{Mode: domain.ImportModeFail})
+
+	assert.ErrorIs(t, err, dbErr)
+	assert.Nil(t, report)
+}
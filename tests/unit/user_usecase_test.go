@@ -3,12 +3,15 @@ package usecase_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"pr-reviewer-service/internal/domain"
 	"pr-reviewer-service/internal/usecase"
 	"pr-reviewer-service/tests/mocks"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 func TestUserUseCase_SetUserActive_Success(t *testing.T) {
@@ -57,13 +60,56 @@ func TestUserUseCase_GetUserReviewPRs_Success(t *testing.T) {
 	}
 
 	userRepo.On("GetByID", ctx, "u1").Return(user, nil)
-	prRepo.On("GetUserAssignedPRs", ctx, "u1").Return(prs, nil)
+	prRepo.On("GetUserAssignedPRs", ctx, "u1", (*domain.PRCursor)(nil), 51).Return(prs, nil)
 
-	result, err := uc.GetUserReviewPRs(ctx, "u1")
+	result, err := uc.GetUserReviewPRs(ctx, "u1", "", 0)
 
 	assert.NoError(t, err)
-	assert.Equal(t, prs, result)
-	assert.Len(t, result, 2)
+	assert.Equal(t, prs, result.PullRequests)
+	assert.Empty(t, result.NextCursor)
+}
+
+func TestUserUseCase_GetUserReviewPRs_Paginates(t *testing.T) {
+	ctx := context.Background()
+	userRepo := &mocks.UserRepository{}
+	prRepo := &mocks.PRRepository{}
+	uc := usecase.NewUserUseCase(userRepo, prRepo)
+
+	createdAt := time.Date(2025, 10, 24, 12, 0, 0, 0, time.UTC)
+	prs := []*domain.PullRequest{
+		{ID: "pr-3", CreatedAt: &createdAt},
+		{ID: "pr-2", CreatedAt: &createdAt},
+		{ID: "pr-1", CreatedAt: &createdAt},
+	}
+
+	userRepo.On("GetByID", ctx, "u1").Return(&domain.User{ID: "u1"}, nil)
+	prRepo.On("GetUserAssignedPRs", ctx, "u1", (*domain.PRCursor)(nil), 3).Return(prs, nil).Once()
+
+	first, err := uc.GetUserReviewPRs(ctx, "u1", "", 2)
+	require.NoError(t, err)
+	assert.Len(t, first.PullRequests, 2)
+	require.NotEmpty(t, first.NextCursor)
+
+	// Курсор указывает на последний PR страницы
+	prRepo.On("GetUserAssignedPRs", ctx, "u1", &domain.PRCursor{CreatedAt: createdAt, ID: "pr-2"}, 3).
+		Return(prs[2:], nil).Once()
+
+	second, err := uc.GetUserReviewPRs(ctx, "u1", first.NextCursor, 2)
+	require.NoError(t, err)
+	assert.Equal(t, prs[2:], second.PullRequests)
+	assert.Empty(t, second.NextCursor)
+	prRepo.AssertExpectations(t)
+}
+
+func TestUserUseCase_GetUserReviewPRs_InvalidPage(t *testing.T) {
+	ctx := context.Background()
+	uc := usecase.NewUserUseCase(&mocks.UserRepository{}, &mocks.PRRepository{})
+
+	_, err := uc.GetUserReviewPRs(ctx, "u1", "", 101)
+	assert.ErrorIs(t, err, domain.ErrInvalidPageLimit)
+
+	_, err = uc.GetUserReviewPRs(ctx, "u1", "not-a-cursor", 0)
+	assert.ErrorIs(t, err, domain.ErrInvalidCursor)
 }
 
 func TestUserUseCase_GetUserReviewPRs_UserNotFound(t *testing.T) {
@@ -74,8 +120,71 @@ func TestUserUseCase_GetUserReviewPRs_UserNotFound(t *testing.T) {
 
 	userRepo.On("GetByID", ctx, "nonexistent").Return(nil, assert.AnError)
 
-	result, err := uc.GetUserReviewPRs(ctx, "nonexistent")
+	result, err := uc.GetUserReviewPRs(ctx, "nonexistent", "", 0)
+
+	assert.ErrorIs(t, err, domain.ErrUserNotFound)
+	assert.Nil(t, result)
+}
+
+func TestUserUseCase_SetUserSkills_NormalizesTags(t *testing.T) {
+	ctx := context.Background()
+	userRepo := &mocks.UserRepository{}
+	prRepo := &mocks.PRRepository{}
+	uc := usecase.NewUserUseCase(userRepo, prRepo)
+
+	userRepo.On("GetByID", ctx, "u1").Return(&domain.User{ID: "u1", Username: "Alice", IsActive: true}, nil)
+	userRepo.On("SetSkills", ctx, "u1", []string{"c++", "go", "postgres"}).Return(nil)
+
+	result, err := uc.SetUserSkills(ctx, "u1", []string{"Postgres", " go", "c++", "GO"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"c++", "go", "postgres"}, result.Skills)
+	userRepo.AssertExpectations(t)
+}
+
+func TestUserUseCase_SetUserSkills_InvalidTag(t *testing.T) {
+	ctx := context.Background()
+	userRepo := &mocks.UserRepository{}
+	prRepo := &mocks.PRRepository{}
+	uc := usecase.NewUserUseCase(userRepo, prRepo)
+
+	for _, tag := range []string{"", "two words", "-go", "über"} {
+		result, err := uc.SetUserSkills(ctx, "u1", []string{tag})
+
+		assert.ErrorIs(t, err, domain.ErrInvalidSkillTag, tag)
+		assert.Nil(t, result)
+	}
+	userRepo.AssertNotCalled(t, "SetSkills", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestUserUseCase_SetUserOutOfOffice_Success(t *testing.T) {
+	ctx := context.Background()
+	userRepo := &mocks.UserRepository{}
+	prRepo := &mocks.PRRepository{}
+	uc := usecase.NewUserUseCase(userRepo, prRepo)
+
+	until := time.Date(2026, time.November, 2, 9, 0, 0, 0, time.UTC)
+	userRepo.On("GetByID", ctx, "u1").Return(&domain.User{ID: "u1", Username: "Alice", IsActive: true}, nil)
+	userRepo.On("SetOutOfOffice", ctx, "u1", &until).Return(nil)
+
+	result, err := uc.SetUserOutOfOffice(ctx, "u1", &until)
+
+	assert.NoError(t, err)
+	assert.Equal(t, &until, result.OutOfOfficeUntil)
+	userRepo.AssertExpectations(t)
+}
+
+func TestUserUseCase_SetUserOutOfOffice_UserNotFound(t *testing.T) {
+	ctx := context.Background()
+	userRepo := &mocks.UserRepository{}
+	prRepo := &mocks.PRRepository{}
+	uc := usecase.NewUserUseCase(userRepo, prRepo)
+
+	userRepo.On("GetByID", ctx, "ghost").Return(nil, domain.ErrUserNotFound)
+
+	result, err := uc.SetUserOutOfOffice(ctx, "ghost", nil)
 
 	assert.ErrorIs(t, err, domain.ErrUserNotFound)
 	assert.Nil(t, result)
+	userRepo.AssertNotCalled(t, "SetOutOfOffice", mock.Anything, mock.Anything, mock.Anything)
 }